@@ -10,6 +10,7 @@ import (
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	ibcswitchtypes "github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+	ibchookstypes "github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
 
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
@@ -32,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	eip191 "github.com/scrtlabs/SecretNetwork/eip191"
+	cosmwasmapi "github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	scrt "github.com/scrtlabs/SecretNetwork/types"
 
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
@@ -61,6 +63,7 @@ import (
 	v1_17 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.17"
 	v1_18 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.18"
 	v1_19 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.19"
+	v1_20 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.20"
 	v1_4 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.4"
 	v1_5 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.5"
 	v1_6 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.6"
@@ -85,6 +88,7 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
@@ -134,6 +138,7 @@ var (
 		v1_17.Upgrade,
 		v1_18.Upgrade,
 		v1_19.Upgrade,
+		v1_20.Upgrade,
 	}
 )
 
@@ -232,6 +237,15 @@ func (app *SecretNetworkApp) TxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetTxConfig implements the TestingApp interface of the ibc-go testing package
+func (app *SecretNetworkApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
+// EnclaveAppOption is the app option of the enclave that the app calls into. It defaults to the enclave that this
+// binary links, tests set it to another enclave backend, like the mock enclave
+const EnclaveAppOption = "enclave-backend"
+
 // WasmWrapper allows us to use namespacing in the config file
 // This is only used for parsing in the app, x/compute expects WasmConfig
 type WasmWrapper struct {
@@ -325,7 +339,11 @@ func NewSecretNetworkApp(
 	}
 	app.txConfig = txConfig
 
-	app.AppKeepers.InitCustomKeepers(appCodec, legacyAmino, bApp, bootstrap, homePath, computeConfig)
+	enclave, ok := appOpts.Get(EnclaveAppOption).(cosmwasmapi.Backend)
+	if !ok {
+		enclave = cosmwasmapi.Enclave{}
+	}
+	app.AppKeepers.InitCustomKeepers(appCodec, legacyAmino, bApp, bootstrap, homePath, computeConfig, enclave)
 	app.setupUpgradeStoreLoaders()
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -394,6 +412,11 @@ func NewSecretNetworkApp(
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// Nodes load the seed of a new consensus seed version after the block that moved the network to it is committed
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{reg.NewNodeSeedListener(*app.AppKeepers.RegKeeper)},
+	})
+
 	if manager := app.BaseApp.SnapshotManager(); manager != nil {
 		err := manager.RegisterExtensions(
 			compute.NewWasmSnapshotter(app.BaseApp.CommitMultiStore(), app.AppKeepers.ComputeKeeper, filepath.Join(homePath, ".compute", "wasm", "wasm")),
@@ -613,6 +636,7 @@ func SetOrderInitGenesis(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
+		ibchookstypes.ModuleName,
 
 		icatypes.ModuleName,
 
@@ -655,6 +679,7 @@ func SetOrderEndBlockers(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
+		ibchookstypes.ModuleName,
 		circuittypes.ModuleName,
 	)
 }
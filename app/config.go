@@ -34,6 +34,7 @@ import (
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	ibchooks "github.com/scrtlabs/SecretNetwork/x/ibc-hooks"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -89,6 +90,7 @@ func customModuleBasics() []module.AppModuleBasic {
 		compute.AppModuleBasic{},
 		registration.AppModuleBasic{},
 		ibcswitch.AppModuleBasic{},
+		ibchooks.AppModuleBasic{},
 	}
 }
 
@@ -54,6 +54,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
 	reg "github.com/scrtlabs/SecretNetwork/x/registration"
 
@@ -115,6 +116,9 @@ type SecretAppKeepers struct {
 
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
+	// Enclave is the enclave that the compute and registration keepers and the upgrade handlers call into
+	Enclave api.Backend
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tKeys   map[string]*storetypes.TransientStoreKey
@@ -356,7 +360,10 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 	bootstrap bool,
 	homePath string,
 	computeConfig *compute.WasmConfig,
+	enclave api.Backend,
 ) {
+	ak.Enclave = enclave
+
 	// Just re-use the full router - do we want to limit this more?
 	regRouter := app.MsgServiceRouter()
 
@@ -365,9 +372,11 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		appCodec,
 		runtime.NewKVStoreService(ak.keys[reg.StoreKey]),
 		regRouter,
-		reg.EnclaveApi{},
+		reg.NewEnclaveApi(ak.Enclave),
 		homePath,
 		bootstrap,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.Logger(),
 	)
 	ak.RegKeeper = &regKeeper
 
@@ -393,7 +402,9 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 	// Setup the ICS4Wrapper used by the hooks middleware
 	// Configure the hooks keeper
 	ibcHooksKeeper := ibchookskeeper.NewKeeper(
+		appCodec,
 		runtime.NewKVStoreService(ak.keys[ibchookstypes.StoreKey]),
+		ak.IbcKeeper.ChannelKeeper,
 	)
 	ak.IbcHooksKeeper = &ibcHooksKeeper
 
@@ -523,6 +534,8 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		*ak.IbcKeeper.PortKeeper,
 		ak.TransferKeeper,
 		ak.IbcKeeper.ChannelKeeper,
+		// Contract packets are sent through the same stack as the compute route below
+		// (Switch -> Fee -> Packet Forward -> WASM Hooks), so relayers of contract ports can be incentivized
 		ak.IbcSwitchKeeper,
 		app.MsgServiceRouter(),
 		app.GRPCQueryRouter(),
@@ -533,6 +546,7 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		nil,
 		&app.LastTxManager,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		compute.WithEnclave(ak.Enclave),
 	)
 	ak.ComputeKeeper = &computeKeeper
 	wasmHooks.ContractKeeper = ak.ComputeKeeper
@@ -540,12 +554,16 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 	// Compute receive: Switch -> Fee -> Packet Forward -> WASM Hooks
 	var computeStack porttypes.IBCModule
 	computeStack = compute.NewIBCHandler(ak.ComputeKeeper, ak.IbcKeeper.ChannelKeeper, ak.IbcFeeKeeper)
-	computeStack = ibchooks.NewIBCMiddleware(computeStack, &ibcHooksICS4Wrapper)
-	computeStack = ibcpacketforward.NewIBCMiddleware(
-		computeStack,
-		ak.PacketForwardKeeper,
-		0,
-		ibcpacketforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp, // 10 minutes
+	computeHooksStack := ibchooks.NewIBCMiddleware(computeStack, &ibcHooksICS4Wrapper)
+	// packet-forward-middleware has no channel upgrade callbacks, so they skip it on the way to the contract
+	computeStack = newUpgradableMiddleware(
+		ibcpacketforward.NewIBCMiddleware(
+			computeHooksStack,
+			ak.PacketForwardKeeper,
+			0,
+			ibcpacketforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp, // 10 minutes
+		),
+		computeHooksStack,
 	)
 	computeStack = ibcfee.NewIBCMiddleware(computeStack, ak.IbcFeeKeeper)
 	computeStack = ibcswitch.NewIBCMiddleware(computeStack, ak.IbcSwitchKeeper)
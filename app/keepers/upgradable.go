@@ -0,0 +1,54 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+var _ porttypes.UpgradableModule = upgradableMiddleware{}
+
+// upgradableMiddleware wraps a middleware that does not implement the channel upgrade callbacks
+// (e.g. packet-forward-middleware) and forwards them straight to the application below it
+type upgradableMiddleware struct {
+	porttypes.IBCModule
+	app porttypes.UpgradableModule
+}
+
+func newUpgradableMiddleware(middleware porttypes.IBCModule, app porttypes.UpgradableModule) upgradableMiddleware {
+	return upgradableMiddleware{IBCModule: middleware, app: app}
+}
+
+func (m upgradableMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	return m.app.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+func (m upgradableMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return m.app.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+func (m upgradableMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	return m.app.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+func (m upgradableMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	m.app.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
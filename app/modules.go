@@ -40,6 +40,7 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	ibchooks "github.com/scrtlabs/SecretNetwork/x/ibc-hooks"
 	reg "github.com/scrtlabs/SecretNetwork/x/registration"
 )
 
@@ -88,5 +89,6 @@ func Modules(
 		packetforward.NewAppModule(app.AppKeepers.PacketForwardKeeper, app.AppKeepers.GetSubspace(packetforwardtypes.ModuleName)),
 		ibcfee.NewAppModule(app.AppKeepers.IbcFeeKeeper),
 		ibcswitch.NewAppModule(app.AppKeepers.IbcSwitchKeeper, app.AppKeepers.GetSubspace(ibcswitch.ModuleName)),
+		ibchooks.NewAppModule(app.AppKeepers.AccountKeeper, *app.AppKeepers.IbcHooksKeeper),
 	}
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/scrtlabs/SecretNetwork/app/keepers"
 	"github.com/scrtlabs/SecretNetwork/app/upgrades"
-	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 )
 
 const upgradeName = "v1.13"
@@ -22,7 +21,7 @@ var Upgrade = upgrades.Upgrade{
 	StoreUpgrades:        store.StoreUpgrades{},
 }
 
-func createUpgradeHandler(mm *module.Manager, _ *keepers.SecretAppKeepers, configurator module.Configurator,
+func createUpgradeHandler(mm *module.Manager, appKeepers *keepers.SecretAppKeepers, configurator module.Configurator,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		logger := log.NewLogger(os.Stderr)
@@ -38,7 +37,7 @@ func createUpgradeHandler(mm *module.Manager, _ *keepers.SecretAppKeepers, confi
 
 		logger.Info(fmt.Sprintf("Running module migrations for %s...", upgradeName))
 
-		_, err := api.MigrationOp(0)
+		_, err := appKeepers.Enclave.MigrationOp(0)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,48 @@
+package v1_20
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"cosmossdk.io/log"
+	store "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/scrtlabs/SecretNetwork/app/keepers"
+	"github.com/scrtlabs/SecretNetwork/app/upgrades"
+	ibchookstypes "github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+const upgradeName = "v1.20"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          upgradeName,
+	CreateUpgradeHandler: createUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
+
+func createUpgradeHandler(mm *module.Manager, _ *keepers.SecretAppKeepers, configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := log.NewLogger(os.Stderr)
+		logger.Info(` _    _ _____   _____ _____            _____  ______ `)
+		logger.Info(`| |  | |  __ \ / ____|  __ \     /\   |  __ \|  ____|`)
+		logger.Info(`| |  | | |__) | |  __| |__) |   /  \  | |  | | |__   `)
+		logger.Info(`| |  | |  ___/| | |_ |  _  /   / /\ \ | |  | |  __|  `)
+		logger.Info(`| |__| | |    | |__| | | \ \  / ____ \| |__| | |____ `)
+		logger.Info(` \____/|_|     \_____|_|  \_\/_/    \_\_____/|______|`)
+
+		// ibc-hooks was never registered with the module manager, so it is missing from the version map.
+		// Its store already holds v1 packet callbacks that must go through its migrations starting from version 1.
+		if _, ok := vm[ibchookstypes.ModuleName]; !ok {
+			vm[ibchookstypes.ModuleName] = 1
+		}
+
+		// compute migrates to version 8, which sets the max schema size and the schema cost params. Until then
+		// MsgStoreCode can't carry a JSON schema.
+		logger.Info(fmt.Sprintf("Running module migrations for %s...", upgradeName))
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
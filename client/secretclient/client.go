@@ -0,0 +1,384 @@
+// Package secretclient is a Go client of Secret Network contracts over gRPC. It encrypts the messages of contracts
+// like the CLI does, broadcasts them, and decrypts their results, without depending on the client.Context of the CLI
+package secretclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+const (
+	defaultGasAdjustment = 1.3
+	defaultPollInterval  = time.Second
+	defaultPollTimeout   = time.Minute
+)
+
+// Client interacts with the contracts of a network through a gRPC connection. Its methods are safe for concurrent
+// use, and broadcast the transactions of its signer one at a time
+type Client struct {
+	chainID  string
+	txConfig client.TxConfig
+
+	computeClient      compute.QueryClient
+	registrationClient registration.QueryClient
+	authClient         authtypes.QueryClient
+	txClient           txtypes.ServiceClient
+
+	signer cryptotypes.PrivKey
+
+	txPrivKey []byte
+	txPubKey  []byte
+
+	gasPrices     sdk.DecCoins
+	gasAdjustment float64
+	gasLimit      uint64
+	memo          string
+
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+
+	mtx         sync.Mutex
+	ioPubKey    []byte
+	codeHashes  map[string]string
+	sequence    uint64
+	hasSequence bool
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithSigner sets the key that signs transactions. Unless WithTxEncryptionKey is used too, the tx encryption key is
+// derived from it the way Keplr derives it
+func WithSigner(signer cryptotypes.PrivKey) Option {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// WithTxEncryptionKey sets the curve25519 private key that encrypts contract messages and decrypts their results
+func WithTxEncryptionKey(privKey []byte) Option {
+	return func(c *Client) {
+		c.txPrivKey = privKey
+	}
+}
+
+// WithConsensusIOPubKey sets the consensus io public key of the network, which otherwise is queried from it
+func WithConsensusIOPubKey(ioPubKey []byte) Option {
+	return func(c *Client) {
+		c.ioPubKey = ioPubKey
+	}
+}
+
+// WithGasPrices sets the gas prices that fees are paid at, e.g. "0.1uscrt". Transactions are free without them
+func WithGasPrices(gasPrices sdk.DecCoins) Option {
+	return func(c *Client) {
+		c.gasPrices = gasPrices
+	}
+}
+
+// WithGasAdjustment sets the factor that simulated gas is multiplied by
+func WithGasAdjustment(gasAdjustment float64) Option {
+	return func(c *Client) {
+		c.gasAdjustment = gasAdjustment
+	}
+}
+
+// WithGasLimit sets the gas limit of transactions, which are then not simulated
+func WithGasLimit(gasLimit uint64) Option {
+	return func(c *Client) {
+		c.gasLimit = gasLimit
+	}
+}
+
+// WithMemo sets the memo of transactions
+func WithMemo(memo string) Option {
+	return func(c *Client) {
+		c.memo = memo
+	}
+}
+
+// WithPolling sets how often a broadcast transaction is looked up, and how long until it is given up on
+func WithPolling(interval time.Duration, timeout time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = interval
+		c.pollTimeout = timeout
+	}
+}
+
+// New returns a Client of the network with chainID behind conn, e.g. a *grpc.ClientConn. txConfig encodes
+// transactions, and must know the messages of the compute module. A Client needs a signer or a tx encryption key
+func New(conn gogogrpc.ClientConn, txConfig client.TxConfig, chainID string, opts ...Option) (*Client, error) {
+	c := &Client{
+		chainID:            chainID,
+		txConfig:           txConfig,
+		computeClient:      compute.NewQueryClient(conn),
+		registrationClient: registration.NewQueryClient(conn),
+		authClient:         authtypes.NewQueryClient(conn),
+		txClient:           txtypes.NewServiceClient(conn),
+		gasAdjustment:      defaultGasAdjustment,
+		pollInterval:       defaultPollInterval,
+		pollTimeout:        defaultPollTimeout,
+		codeHashes:         map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.txPrivKey == nil {
+		if c.signer == nil {
+			return nil, errors.New("a client needs a signer or a tx encryption key")
+		}
+
+		signature, err := c.signer.Sign(wasmUtils.TxEncryptionKeySignDoc(chainID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive the tx encryption key: %w", err)
+		}
+		seed := sha256.Sum256(signature)
+		c.txPrivKey = seed[:]
+	}
+
+	txPubKey, err := curve25519.X25519(c.txPrivKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx encryption key: %w", err)
+	}
+	c.txPubKey = txPubKey
+
+	return c, nil
+}
+
+// Address returns the address of the signer
+func (c *Client) Address() sdk.AccAddress {
+	if c.signer == nil {
+		return nil
+	}
+	return sdk.AccAddress(c.signer.PubKey().Address())
+}
+
+// TxEncryptionPubKey returns the public key that contract messages are encrypted for. It leads their ciphertexts
+func (c *Client) TxEncryptionPubKey() []byte {
+	return c.txPubKey
+}
+
+// TxError is the error of a transaction that failed, in CheckTx or in its block
+type TxError struct {
+	TxResponse *sdk.TxResponse
+	// Log is the error of the transaction, with the error of the contract decrypted
+	Log string
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("transaction %s failed with code %d: %s", e.TxResponse.TxHash, e.TxResponse.Code, e.Log)
+}
+
+// broadcast signs msgs into a transaction, broadcasts it, and waits for it to be included in a block
+func (c *Client) broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := c.broadcastSync(ctx, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitForTx(ctx, res.TxHash)
+}
+
+func (c *Client) broadcastSync(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if c.signer == nil {
+		return nil, errors.New("a client needs a signer to broadcast transactions")
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	info, err := c.authClient.AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: c.Address().String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the account of the signer: %w", err)
+	}
+	// transactions of this client that are not in a block yet are not counted by the sequence of the account
+	if !c.hasSequence || info.Info.Sequence > c.sequence {
+		c.sequence = info.Info.Sequence
+		c.hasSequence = true
+	}
+
+	txBytes, err := c.signTx(ctx, info.Info.AccountNumber, c.sequence, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.txClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.TxResponse.Code != 0 {
+		// the sequence might be off, so it is queried again before the next transaction
+		c.hasSequence = false
+		return nil, &TxError{TxResponse: res.TxResponse, Log: res.TxResponse.RawLog}
+	}
+
+	c.sequence++
+	return res.TxResponse, nil
+}
+
+func (c *Client) signTx(ctx context.Context, accountNumber uint64, sequence uint64, msgs ...sdk.Msg) ([]byte, error) {
+	txBuilder := c.txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetMemo(c.memo)
+
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	// the signer info is part of the signed bytes in direct mode, so it is set before signing
+	emptySignature := signing.SignatureV2{
+		PubKey:   c.signer.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: sequence,
+	}
+	if err := txBuilder.SetSignatures(emptySignature); err != nil {
+		return nil, err
+	}
+
+	gasLimit := c.gasLimit
+	if gasLimit == 0 {
+		simulateBytes, err := c.txConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+		simulation, err := c.txClient.Simulate(ctx, &txtypes.SimulateRequest{TxBytes: simulateBytes})
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate the transaction: %w", err)
+		}
+		gasLimit = uint64(c.gasAdjustment * float64(simulation.GasInfo.GasUsed))
+	}
+	txBuilder.SetGasLimit(gasLimit)
+
+	fees := make(sdk.Coins, len(c.gasPrices))
+	for i, gasPrice := range c.gasPrices {
+		fee := gasPrice.Amount.MulInt(math.NewIntFromUint64(gasLimit)).Ceil().RoundInt()
+		fees[i] = sdk.NewCoin(gasPrice.Denom, fee)
+	}
+	txBuilder.SetFeeAmount(fees.Sort())
+
+	signerData := authsigning.SignerData{
+		Address:       c.Address().String(),
+		ChainID:       c.chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		PubKey:        c.signer.PubKey(),
+	}
+	signature, err := clienttx.SignWithPrivKey(ctx, signMode, signerData, txBuilder, c.signer, c.txConfig, sequence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the transaction: %w", err)
+	}
+	if err := txBuilder.SetSignatures(signature); err != nil {
+		return nil, err
+	}
+
+	return c.txConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// waitForTx polls a transaction until it is in a block
+func (c *Client) waitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.pollTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.txClient.GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+		if err == nil {
+			return res.TxResponse, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s was not included in a block: %w", hash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// encrypt encrypts the message of a contract with code hash codeHash, and returns the ciphertext with its nonce
+func (c *Client) encrypt(ctx context.Context, codeHash string, msg []byte) ([]byte, []byte, error) {
+	ioPubKey, err := c.consensusIOPubKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+
+	txEncryptionKey, err := wasmUtils.GetTxEncryptionKeyOffline(ioPubKey, c.txPrivKey, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plaintext := compute.NewSecretMsg([]byte(codeHash), msg).Serialize()
+	ciphertext, err := wasmUtils.EncryptData(txEncryptionKey, c.txPubKey, plaintext, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ciphertext, nonce, nil
+}
+
+// decrypt decrypts the input or an output of a contract that was encrypted with nonce
+func (c *Client) decrypt(ctx context.Context, ciphertext []byte, nonce []byte) ([]byte, error) {
+	ioPubKey, err := c.consensusIOPubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txEncryptionKey, err := wasmUtils.GetTxEncryptionKeyOffline(ioPubKey, c.txPrivKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return wasmUtils.DecryptData(txEncryptionKey, ciphertext)
+}
+
+func (c *Client) consensusIOPubKey(ctx context.Context) ([]byte, error) {
+	c.mtx.Lock()
+	ioPubKey := c.ioPubKey
+	c.mtx.Unlock()
+	if ioPubKey != nil {
+		return ioPubKey, nil
+	}
+
+	key, err := c.registrationClient.TxKey(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the consensus io public key: %w", err)
+	}
+
+	c.mtx.Lock()
+	c.ioPubKey = key.Key
+	c.mtx.Unlock()
+	return key.Key, nil
+}
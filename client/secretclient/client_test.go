@@ -0,0 +1,163 @@
+package secretclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/scrtlabs/SecretNetwork/app"
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+)
+
+const testMnemonic = "angry twist harsh drastic left brass behave host shove marriage fall update business leg direct reward object ugly security warm tuna model broccoli choice"
+
+var testWasm = []byte("\x00asm\x01\x00\x00\x00counter")
+
+func newTestClient(t *testing.T, network *testNetwork, conn *grpc.ClientConn, secret string, opts ...Option) *Client {
+	signer := secp256k1.GenPrivKeyFromSecret([]byte(secret))
+	network.fund(signer)
+
+	opts = append([]Option{WithSigner(signer), WithPolling(time.Millisecond, time.Second)}, opts...)
+	c, err := New(conn, app.MakeEncodingConfig().TxConfig, testChainID, opts...)
+	require.NoError(t, err)
+	return c
+}
+
+// instantiateCounter stores the counter contract and instantiates it with a count of 10
+func instantiateCounter(t *testing.T, c *Client) (*StoreCodeResult, *InstantiateResult) {
+	ctx := context.Background()
+
+	code, err := c.StoreCode(ctx, StoreCodeRequest{WASMByteCode: testWasm})
+	require.NoError(t, err)
+
+	contract, err := c.Instantiate(ctx, InstantiateRequest{
+		CodeID: code.CodeID,
+		Label:  "counter",
+		Msg:    []byte(`{"count":10}`),
+	})
+	require.NoError(t, err)
+	return code, contract
+}
+
+func TestClientContract(t *testing.T) {
+	ctx := context.Background()
+	network, conn := newTestNetwork(t)
+	gasPrices, err := sdk.ParseDecCoins("0.25uscrt")
+	require.NoError(t, err)
+	c := newTestClient(t, network, conn, "alice", WithGasPrices(gasPrices))
+
+	code, contract := instantiateCounter(t, c)
+	require.Equal(t, uint64(1), code.CodeID)
+	require.Len(t, code.CodeHash, 64)
+	require.Equal(t, `{"count":10}`, string(contract.Data))
+	require.Equal(t, "instantiate", contract.Tx.Messages[0].Type)
+	require.Equal(t, code.CodeHash, contract.Tx.Messages[0].CodeHash)
+	// 1.3 times the simulated gas, at 0.25uscrt
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uscrt", 32_500)), network.checkedFees)
+
+	executed, err := c.Execute(ctx, ExecuteRequest{Contract: contract.Address, Msg: []byte(`{"increment":{}}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"count":11}`, string(executed.Data))
+	require.Equal(t, `{"increment":{}}`, string(executed.Tx.Messages[0].Input))
+	require.Len(t, executed.Tx.Events, 1)
+	require.Contains(t, executed.Tx.Events[0].Attributes, sdk.Attribute{Key: "count", Value: "11"})
+
+	count, err := c.QueryContract(ctx, contract.Address, []byte(`{"get_count":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, `{"count":11}`, string(count))
+
+	decrypted, err := c.DecryptTx(ctx, executed.Tx.TxResponse.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, "execute", decrypted.Messages[0].Type)
+	require.Equal(t, contract.Address, decrypted.Messages[0].ContractAddress)
+	require.Equal(t, code.CodeHash, decrypted.Messages[0].CodeHash)
+	require.Equal(t, `{"count":11}`, string(decrypted.Messages[0].Data))
+
+	// only the sender can decrypt its transactions
+	other := newTestClient(t, network, conn, "bob")
+	_, err = other.DecryptTx(ctx, executed.Tx.TxResponse.TxHash)
+	require.ErrorIs(t, err, ErrNotTxSender)
+}
+
+func TestClientContractErrors(t *testing.T) {
+	ctx := context.Background()
+	network, conn := newTestNetwork(t)
+	c := newTestClient(t, network, conn, "alice")
+	_, contract := instantiateCounter(t, c)
+
+	_, err := c.Execute(ctx, ExecuteRequest{Contract: contract.Address, Msg: []byte(`{"fail":{}}`)})
+	var txErr *TxError
+	require.True(t, errors.As(err, &txErr))
+	require.Equal(t, `message index 0: {"generic_err":{"msg":"unknown message"}}`, txErr.Log)
+
+	decrypted, err := c.DecryptTx(ctx, txErr.TxResponse.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, txErr.Log, decrypted.Error)
+
+	_, err = c.QueryContract(ctx, contract.Address, []byte(`{"fail":{}}`))
+	require.EqualError(t, err, `query contract failed: {"generic_err":{"msg":"unknown message"}}`)
+
+	// a code hash that is not the one of the contract fails to decrypt in the enclave
+	_, err = c.Execute(ctx, ExecuteRequest{Contract: contract.Address, Msg: []byte(`{"increment":{}}`), CodeHash: strings.Repeat("0", 64)})
+	require.True(t, errors.As(err, &txErr))
+	require.Contains(t, txErr.Log, "another code hash")
+
+	// the sequence of the account is tracked across failed transactions
+	executed, err := c.Execute(ctx, ExecuteRequest{Contract: contract.Address, Msg: []byte(`{"increment":{}}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"count":11}`, string(executed.Data))
+}
+
+func TestClientConcurrentExecutes(t *testing.T) {
+	ctx := context.Background()
+	network, conn := newTestNetwork(t)
+	c := newTestClient(t, network, conn, "alice", WithGasLimit(200_000))
+	_, contract := instantiateCounter(t, c)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.Execute(ctx, ExecuteRequest{Contract: contract.Address, Msg: []byte(`{"increment":{}}`)})
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	count, err := c.QueryContract(ctx, contract.Address, []byte(`{"get_count":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, `{"count":15}`, string(count))
+}
+
+func TestNewDerivesTxEncryptionKey(t *testing.T) {
+	network, conn := newTestNetwork(t)
+
+	kr := keyring.NewInMemory(app.MakeEncodingConfig().Codec)
+	_, err := kr.NewAccount("alice", testMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, txPubKey, err := wasmUtils.DeriveTxKeyPair(kr, "alice", testChainID)
+	require.NoError(t, err)
+
+	derivedPrivKey, err := hd.Secp256k1.Derive()(testMnemonic, "", sdk.FullFundraiserPath)
+	require.NoError(t, err)
+	c, err := New(conn, app.MakeEncodingConfig().TxConfig, testChainID, WithSigner(hd.Secp256k1.Generate()(derivedPrivKey)))
+	require.NoError(t, err)
+	require.Equal(t, txPubKey, c.TxEncryptionPubKey(), "the client derives the key that the CLI derives")
+
+	_, err = New(conn, network.txConfig, testChainID)
+	require.Error(t, err, "a client needs a signer or a tx encryption key")
+}
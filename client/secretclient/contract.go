@@ -0,0 +1,304 @@
+package secretclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+)
+
+// StoreCodeRequest uploads the wasm code of contracts
+type StoreCodeRequest struct {
+	// WASMByteCode is the wasm code, which is gzipped unless it already is
+	WASMByteCode []byte
+	// Source is a URL of the source code of the contract, and Builder the docker image that built it
+	Source  string
+	Builder string
+}
+
+// StoreCodeResult is the result of a StoreCodeRequest
+type StoreCodeResult struct {
+	TxResponse *sdk.TxResponse
+	CodeID     uint64
+	CodeHash   string
+}
+
+// InstantiateRequest instantiates a contract
+type InstantiateRequest struct {
+	CodeID uint64
+	Label  string
+	// Msg is the JSON message of the contract, which is encrypted
+	Msg   []byte
+	Funds sdk.Coins
+	// Admin can migrate the contract. A contract without one can not be migrated
+	Admin string
+	// CodeHash is the code hash of CodeID, which is queried and cached if empty
+	CodeHash string
+}
+
+// InstantiateResult is the result of an InstantiateRequest
+type InstantiateResult struct {
+	Tx      *DecryptedTx
+	Address string
+	// Data is the decrypted data that the contract responded with
+	Data []byte
+}
+
+// ExecuteRequest executes a contract
+type ExecuteRequest struct {
+	Contract string
+	// Msg is the JSON message of the contract, which is encrypted
+	Msg   []byte
+	Funds sdk.Coins
+	// CodeHash is the code hash of Contract, which is queried and cached if empty
+	CodeHash string
+}
+
+// ExecuteResult is the result of an ExecuteRequest
+type ExecuteResult struct {
+	Tx *DecryptedTx
+	// Data is the decrypted data that the contract responded with
+	Data []byte
+}
+
+// StoreCode uploads the wasm code of contracts
+func (c *Client) StoreCode(ctx context.Context, req StoreCodeRequest) (*StoreCodeResult, error) {
+	wasm := req.WASMByteCode
+	if wasmUtils.IsWasm(wasm) {
+		var err error
+		wasm, err = wasmUtils.GzipIt(wasm)
+		if err != nil {
+			return nil, err
+		}
+	} else if !wasmUtils.IsGzip(wasm) {
+		return nil, errors.New("invalid input file, use wasm binary or gzip")
+	}
+
+	msg := &compute.MsgStoreCode{
+		Sender:       c.Address(),
+		WASMByteCode: wasm,
+		Source:       req.Source,
+		Builder:      req.Builder,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	txResponse, err := c.broadcast(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if txResponse.Code != 0 {
+		return nil, &TxError{TxResponse: txResponse, Log: txResponse.RawLog}
+	}
+
+	var res compute.MsgStoreCodeResponse
+	if err := unpackMsgResponse(txResponse, &res); err != nil {
+		return nil, err
+	}
+	codeHash, err := c.CodeHashByCodeID(ctx, res.CodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreCodeResult{
+		TxResponse: txResponse,
+		CodeID:     res.CodeID,
+		CodeHash:   codeHash,
+	}, nil
+}
+
+// Instantiate instantiates a contract with an encrypted message, and decrypts its response
+func (c *Client) Instantiate(ctx context.Context, req InstantiateRequest) (*InstantiateResult, error) {
+	codeHash := req.CodeHash
+	if codeHash == "" {
+		var err error
+		codeHash, err = c.CodeHashByCodeID(ctx, req.CodeID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	initMsg, _, err := c.encrypt(ctx, codeHash, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &compute.MsgInstantiateContract{
+		Sender:    c.Address(),
+		CodeID:    req.CodeID,
+		Label:     req.Label,
+		InitMsg:   initMsg,
+		InitFunds: req.Funds,
+		Admin:     req.Admin,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	tx, err := c.broadcastContractMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstantiateResult{
+		Tx:      tx,
+		Address: tx.Messages[0].ContractAddress,
+		Data:    tx.Messages[0].Data,
+	}, nil
+}
+
+// Execute executes a contract with an encrypted message, and decrypts its response
+func (c *Client) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteResult, error) {
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := req.CodeHash
+	if codeHash == "" {
+		codeHash, err = c.CodeHashByContractAddress(ctx, req.Contract)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	executeMsg, _, err := c.encrypt(ctx, codeHash, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &compute.MsgExecuteContract{
+		Sender:    c.Address(),
+		Contract:  contract,
+		Msg:       executeMsg,
+		SentFunds: req.Funds,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	tx, err := c.broadcastContractMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecuteResult{
+		Tx:   tx,
+		Data: tx.Messages[0].Data,
+	}, nil
+}
+
+// broadcastContractMsg broadcasts an encrypted message of a contract, and decrypts the transaction. Transactions that
+// the contract failed return a TxError with the decrypted error of the contract
+func (c *Client) broadcastContractMsg(ctx context.Context, msg sdk.Msg) (*DecryptedTx, error) {
+	txResponse, err := c.broadcast(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.DecryptTxResponse(ctx, txResponse)
+	if err != nil {
+		return nil, err
+	}
+	if txResponse.Code != 0 {
+		log := txResponse.RawLog
+		if tx.Error != "" {
+			log = tx.Error
+		}
+		return nil, &TxError{TxResponse: txResponse, Log: log}
+	}
+	return tx, nil
+}
+
+// QueryContract queries a contract with an encrypted query, and returns the decrypted result. Errors of the contract
+// are decrypted
+func (c *Client) QueryContract(ctx context.Context, contract string, query []byte) ([]byte, error) {
+	codeHash, err := c.CodeHashByContractAddress(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedQuery, nonce, err := c.encrypt(ctx, codeHash, query)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.computeClient.QuerySecretContract(ctx, &compute.QuerySecretContractRequest{
+		ContractAddress: contract,
+		Query:           encryptedQuery,
+	})
+	if err != nil {
+		if compute.ContainsEncryptedString(err.Error()) {
+			contractErr, decryptErr := c.decryptError(ctx, err.Error(), nonce)
+			if decryptErr == nil {
+				return nil, fmt.Errorf("query contract failed: %s", contractErr)
+			}
+		}
+		return nil, err
+	}
+
+	return c.decryptData(ctx, res.Data, nonce)
+}
+
+// CodeHashByCodeID returns the code hash of a code ID, and caches it
+func (c *Client) CodeHashByCodeID(ctx context.Context, codeID uint64) (string, error) {
+	key := fmt.Sprintf("code:%d", codeID)
+	if codeHash, ok := c.cachedCodeHash(key); ok {
+		return codeHash, nil
+	}
+
+	res, err := c.computeClient.CodeHashByCodeId(ctx, &compute.QueryByCodeIdRequest{CodeId: codeID})
+	if err != nil {
+		return "", fmt.Errorf("failed to query the code hash of code %d: %w", codeID, err)
+	}
+	return c.cacheCodeHash(key, res.CodeHash), nil
+}
+
+// CodeHashByContractAddress returns the code hash of a contract, and caches it. The code hash of a contract changes
+// when it is migrated, after which the cache is wrong, so migrated contracts are best used with a new Client
+func (c *Client) CodeHashByContractAddress(ctx context.Context, contract string) (string, error) {
+	key := "contract:" + contract
+	if codeHash, ok := c.cachedCodeHash(key); ok {
+		return codeHash, nil
+	}
+
+	res, err := c.computeClient.CodeHashByContractAddress(ctx, &compute.QueryByContractAddressRequest{ContractAddress: contract})
+	if err != nil {
+		return "", fmt.Errorf("failed to query the code hash of contract %s: %w", contract, err)
+	}
+	return c.cacheCodeHash(key, res.CodeHash), nil
+}
+
+func (c *Client) cachedCodeHash(key string) (string, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	codeHash, ok := c.codeHashes[key]
+	return codeHash, ok
+}
+
+func (c *Client) cacheCodeHash(key string, codeHash string) string {
+	codeHash = strings.ToLower(codeHash)
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.codeHashes[key] = codeHash
+	return codeHash
+}
+
+// unpackMsgResponse decodes the response of the first message of a transaction
+func unpackMsgResponse(txResponse *sdk.TxResponse, res proto.Message) error {
+	txMsgData, err := parseTxMsgData(txResponse.Data)
+	if err != nil {
+		return err
+	}
+	if len(txMsgData.MsgResponses) == 0 {
+		return fmt.Errorf("transaction %s has no message responses", txResponse.TxHash)
+	}
+	return proto.Unmarshal(txMsgData.MsgResponses[0].Value, res)
+}
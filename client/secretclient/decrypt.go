@@ -0,0 +1,298 @@
+package secretclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+)
+
+const (
+	encryptedBlobNonceLength  = 32
+	encryptedBlobPubKeyLength = 32
+)
+
+// ErrNotTxSender is returned when a transaction is decrypted by a client that did not encrypt its messages
+var ErrNotTxSender = errors.New("cannot decrypt, not the original tx sender")
+
+// DecryptedTx is a transaction with the messages of contracts it sent, and their results, decrypted
+type DecryptedTx struct {
+	TxResponse *sdk.TxResponse
+	// Messages are the decrypted messages of the transaction, in its order. Messages that are not sent to contracts
+	// have only their type
+	Messages []DecryptedMsg
+	// Events are the wasm events of the transaction, with the attributes of contracts decrypted
+	Events []sdk.StringEvent
+	// Error is the decrypted error of the contract that failed the transaction, if any
+	Error string
+}
+
+// DecryptedMsg is a decrypted message of a contract, and its result
+type DecryptedMsg struct {
+	// Type is "instantiate", "execute" or "migrate", or the type URL of messages that are not sent to contracts
+	Type            string
+	ContractAddress string
+	CodeHash        string
+	// Input is the message sent to the contract
+	Input []byte
+	// Data is the data that the contract responded with
+	Data []byte
+
+	nonce []byte
+}
+
+// DecryptTx queries a transaction by hash, and decrypts the messages of contracts it sent and their results
+func (c *Client) DecryptTx(ctx context.Context, hash string) (*DecryptedTx, error) {
+	res, err := c.txClient.GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return c.DecryptTxResponse(ctx, res.TxResponse)
+}
+
+// DecryptTxResponse decrypts the messages of contracts that a transaction sent, and their results
+func (c *Client) DecryptTxResponse(ctx context.Context, txResponse *sdk.TxResponse) (*DecryptedTx, error) {
+	msgs, err := c.txMsgs(txResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := &DecryptedTx{
+		TxResponse: txResponse,
+		Messages:   make([]DecryptedMsg, len(msgs)),
+		Events:     []sdk.StringEvent{},
+	}
+	var nonces [][]byte
+
+	for i, msg := range msgs {
+		var encryptedInput []byte
+		switch msg := msg.(type) {
+		case *compute.MsgInstantiateContract:
+			encryptedInput = msg.InitMsg
+			decrypted.Messages[i].Type = "instantiate"
+		case *compute.MsgExecuteContract:
+			encryptedInput = msg.Msg
+			decrypted.Messages[i].Type = "execute"
+			decrypted.Messages[i].ContractAddress = msg.Contract.String()
+		case *compute.MsgMigrateContract:
+			encryptedInput = msg.Msg
+			decrypted.Messages[i].Type = "migrate"
+			decrypted.Messages[i].ContractAddress = msg.Contract
+		default:
+			decrypted.Messages[i].Type = sdk.MsgTypeURL(msg)
+			continue
+		}
+
+		nonce, codeHash, input, err := c.decryptInput(ctx, encryptedInput)
+		if err != nil {
+			return nil, fmt.Errorf("message index %d: %w", i, err)
+		}
+		decrypted.Messages[i].CodeHash = codeHash
+		decrypted.Messages[i].Input = input
+		decrypted.Messages[i].nonce = nonce
+		nonces = append(nonces, nonce)
+	}
+
+	if err := c.decryptMsgResponses(ctx, txResponse.Data, decrypted.Messages); err != nil {
+		return nil, err
+	}
+
+	for _, event := range txResponse.Events {
+		if event.Type != "wasm" {
+			continue
+		}
+		for i, attribute := range event.Attributes {
+			if attribute.Key == "contract_address" {
+				continue
+			}
+			event.Attributes[i].Key = c.tryDecryptAttribute(ctx, attribute.Key, nonces)
+			event.Attributes[i].Value = c.tryDecryptAttribute(ctx, attribute.Value, nonces)
+		}
+		decrypted.Events = append(decrypted.Events, sdk.StringifyEvent(event))
+	}
+
+	if compute.IsEncryptedError(txResponse.Code) && compute.ContainsEncryptedString(txResponse.RawLog) {
+		for i, nonce := range nonces {
+			contractErr, err := c.decryptError(ctx, txResponse.RawLog, nonce)
+			if err != nil {
+				continue
+			}
+			decrypted.Error = fmt.Sprintf("message index %d: %s", i, contractErr)
+			break
+		}
+	}
+
+	return decrypted, nil
+}
+
+// txMsgs decodes the messages of a transaction
+func (c *Client) txMsgs(txResponse *sdk.TxResponse) ([]sdk.Msg, error) {
+	if txResponse.Tx == nil {
+		return nil, fmt.Errorf("transaction %s has no body", txResponse.TxHash)
+	}
+
+	var tx txtypes.Tx
+	if err := proto.Unmarshal(txResponse.Tx.Value, &tx); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", txResponse.TxHash, err)
+	}
+	// the tx decoder takes the raw encoding of transactions, which holds their body and auth info encoded
+	bodyBytes, err := proto.Marshal(tx.Body)
+	if err != nil {
+		return nil, err
+	}
+	authInfoBytes, err := proto.Marshal(tx.AuthInfo)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := proto.Marshal(&txtypes.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: tx.Signatures})
+	if err != nil {
+		return nil, err
+	}
+
+	decodedTx, err := c.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", txResponse.TxHash, err)
+	}
+	return decodedTx.GetMsgs(), nil
+}
+
+// decryptInput decrypts the message of a contract, and returns its nonce and the code hash it was encrypted for
+func (c *Client) decryptInput(ctx context.Context, encryptedInput []byte) ([]byte, string, []byte, error) {
+	if len(encryptedInput) < encryptedBlobNonceLength+encryptedBlobPubKeyLength {
+		return nil, "", nil, errors.New("input is not encrypted")
+	}
+
+	nonce := encryptedInput[:encryptedBlobNonceLength]
+	txSenderPubKey := encryptedInput[encryptedBlobNonceLength : encryptedBlobNonceLength+encryptedBlobPubKeyLength]
+	ciphertext := encryptedInput[encryptedBlobNonceLength+encryptedBlobPubKeyLength:]
+
+	if !bytes.Equal(txSenderPubKey, c.txPubKey) {
+		return nil, "", nil, ErrNotTxSender
+	}
+
+	plaintext, err := c.decrypt(ctx, ciphertext, nonce)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to decrypt the input: %w", err)
+	}
+	// the code hash leads the plaintext as 64 hex characters
+	if len(plaintext) < 64 {
+		return nonce, "", plaintext, nil
+	}
+	return nonce, string(plaintext[:64]), plaintext[64:], nil
+}
+
+// decryptMsgResponses decrypts the data of the responses of the messages in the hex data of a transaction
+func (c *Client) decryptMsgResponses(ctx context.Context, txData string, msgs []DecryptedMsg) error {
+	if txData == "" {
+		return nil
+	}
+
+	txMsgData, err := parseTxMsgData(txData)
+	if err != nil {
+		return err
+	}
+
+	for i, msgResponse := range txMsgData.MsgResponses {
+		if i >= len(msgs) || msgs[i].nonce == nil || len(msgResponse.Value) == 0 {
+			continue
+		}
+
+		var data []byte
+		switch msgResponse.TypeUrl {
+		case "/secret.compute.v1beta1.MsgInstantiateContractResponse":
+			var res compute.MsgInstantiateContractResponse
+			if err := proto.Unmarshal(msgResponse.Value, &res); err != nil {
+				return err
+			}
+			msgs[i].ContractAddress = res.Address
+			data = res.Data
+		case "/secret.compute.v1beta1.MsgExecuteContractResponse":
+			var res compute.MsgExecuteContractResponse
+			if err := proto.Unmarshal(msgResponse.Value, &res); err != nil {
+				return err
+			}
+			data = res.Data
+		case "/secret.compute.v1beta1.MsgMigrateContractResponse":
+			var res compute.MsgMigrateContractResponse
+			if err := proto.Unmarshal(msgResponse.Value, &res); err != nil {
+				return err
+			}
+			data = res.Data
+		default:
+			continue
+		}
+
+		msgs[i].Data, err = c.decryptData(ctx, data, msgs[i].nonce)
+		if err != nil {
+			return fmt.Errorf("message index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// parseTxMsgData decodes the hex data of a transaction, which holds the responses of its messages
+func parseTxMsgData(txData string) (*sdk.TxMsgData, error) {
+	txDataBytes, err := hex.DecodeString(txData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the data of the transaction: %w", err)
+	}
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(txDataBytes, &txMsgData); err != nil {
+		return nil, fmt.Errorf("failed to decode the data of the transaction: %w", err)
+	}
+	return &txMsgData, nil
+}
+
+// decryptData decrypts the data that a contract responded with, which is encrypted as base64
+func (c *Client) decryptData(ctx context.Context, data []byte, nonce []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	dataBase64, err := c.decrypt(ctx, data, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the data: %w", err)
+	}
+	return base64.StdEncoding.DecodeString(string(dataBase64))
+}
+
+// decryptError decrypts the error of a contract in the log of a transaction or the error of a query
+func (c *Client) decryptError(ctx context.Context, errString string, nonce []byte) (string, error) {
+	ciphertext, err := wasmUtils.ParseEncryptedError(errString)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := c.decrypt(ctx, ciphertext, nonce)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// tryDecryptAttribute decrypts the key or value of a wasm event attribute with the nonce of one of the messages of its
+// transaction, and returns it as it is if it does not look encrypted
+func (c *Client) tryDecryptAttribute(ctx context.Context, attribute string, nonces [][]byte) string {
+	if attribute == "" {
+		return attribute
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(attribute)
+	if err != nil {
+		return attribute
+	}
+	for _, nonce := range nonces {
+		plaintext, err := c.decrypt(ctx, ciphertext, nonce)
+		if err == nil {
+			return string(plaintext)
+		}
+	}
+	return attribute
+}
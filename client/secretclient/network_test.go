@@ -0,0 +1,529 @@
+package secretclient
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/miscreant/miscreant.go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/scrtlabs/SecretNetwork/app"
+	eng "github.com/scrtlabs/SecretNetwork/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+const (
+	testChainID = "secret-test-1"
+	// testGasUsed is the gas that every simulated transaction uses
+	testGasUsed = 100_000
+)
+
+func init() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(eng.Bech32PrefixAccAddr, eng.Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(eng.Bech32PrefixValAddr, eng.Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(eng.Bech32PrefixConsAddr, eng.Bech32PrefixConsPub)
+}
+
+// testNetwork is an in-process network that serves the gRPC services the client uses. It plays the enclave with a
+// consensus io key of its own, and runs a counter contract for every code it stores, so the encryption of the client is
+// checked end to end against the decryption of the network, and the other way around
+type testNetwork struct {
+	compute.UnimplementedQueryServer
+	txtypes.UnimplementedServiceServer
+
+	txConfig  client.TxConfig
+	ioPrivKey []byte
+	ioPubKey  []byte
+
+	mtx      sync.Mutex
+	accounts map[string]*testAccount
+	// codes are the hashes of the stored wasm code, by code ID
+	codes     [][]byte
+	contracts map[string]*testContract
+	txs       map[string]*sdk.TxResponse
+	// pending counts the lookups of transactions until they are in a block
+	pending map[string]int
+	height  int64
+	// checkedFees are the fees of the last broadcast transaction
+	checkedFees sdk.Coins
+}
+
+type testAccount struct {
+	number   uint64
+	sequence uint64
+}
+
+type testContract struct {
+	codeID uint64
+	count  int
+}
+
+// newTestNetwork starts a network and returns a connection to it
+func newTestNetwork(t *testing.T) (*testNetwork, *grpc.ClientConn) {
+	encodingConfig := app.MakeEncodingConfig()
+
+	ioPrivKey := sha256.Sum256([]byte("consensus io key"))
+	ioPubKey, err := curve25519.X25519(ioPrivKey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	network := &testNetwork{
+		txConfig:  encodingConfig.TxConfig,
+		ioPrivKey: ioPrivKey[:],
+		ioPubKey:  ioPubKey,
+		accounts:  map[string]*testAccount{},
+		contracts: map[string]*testContract{},
+		txs:       map[string]*sdk.TxResponse{},
+		pending:   map[string]int{},
+	}
+
+	grpcCodec := codec.NewProtoCodec(encodingConfig.InterfaceRegistry).GRPCCodec()
+	server := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	compute.RegisterQueryServer(server, network)
+	registration.RegisterQueryServer(server, &testRegistrationServer{network: network})
+	authtypes.RegisterQueryServer(server, &testAuthServer{network: network})
+	txtypes.RegisterServiceServer(server, network)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return network, conn
+}
+
+// fund creates the account of a key
+func (n *testNetwork) fund(key cryptotypes.PrivKey) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	address := sdk.AccAddress(key.PubKey().Address()).String()
+	n.accounts[address] = &testAccount{number: uint64(len(n.accounts))}
+}
+
+// txEncryptionKey derives the key of an input or output like the enclave does, from the public key of the tx sender
+func (n *testNetwork) txEncryptionKey(txSenderPubKey []byte, nonce []byte) ([]byte, error) {
+	return wasmUtils.GetTxEncryptionKeyOffline(txSenderPubKey, n.ioPrivKey, nonce)
+}
+
+// decryptInput decrypts the input of a contract, checks the code hash it was encrypted for, and returns the message
+// and the key that outputs are encrypted with
+func (n *testNetwork) decryptInput(input []byte, codeHash string) ([]byte, []byte, error) {
+	if len(input) < 64 {
+		return nil, nil, errors.New("input is not encrypted")
+	}
+	key, err := n.txEncryptionKey(input[32:64], input[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	plaintext, err := wasmUtils.DecryptData(key, input[64:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt the input: %w", err)
+	}
+	if len(plaintext) < 64 || string(plaintext[:64]) != codeHash {
+		return nil, nil, errors.New("input was encrypted for another code hash")
+	}
+	return plaintext[64:], key, nil
+}
+
+func encrypt(key []byte, plaintext []byte) []byte {
+	cipher, err := miscreant.NewAESCMACSIV(key)
+	if err != nil {
+		panic(err)
+	}
+	ciphertext, err := cipher.Seal(nil, plaintext, []byte{})
+	if err != nil {
+		panic(err)
+	}
+	return ciphertext
+}
+
+func (n *testNetwork) codeHash(codeID uint64) string {
+	return hex.EncodeToString(n.codes[codeID-1])
+}
+
+// runCounter runs the counter contract, which is instantiated with {"count":n}, executed with {"increment":{}}, which
+// it answers with the new count, and queried with {"get_count":{}}. {"fail":{}} fails it
+func runCounter(contract *testContract, msg []byte) ([]byte, error) {
+	var counterMsg struct {
+		Count     *int      `json:"count"`
+		Increment *struct{} `json:"increment"`
+		GetCount  *struct{} `json:"get_count"`
+	}
+	if err := json.Unmarshal(msg, &counterMsg); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case counterMsg.Count != nil:
+		contract.count = *counterMsg.Count
+	case counterMsg.Increment != nil:
+		contract.count++
+	case counterMsg.GetCount != nil:
+	default:
+		return nil, errors.New("unknown message")
+	}
+	return []byte(fmt.Sprintf(`{"count":%d}`, contract.count)), nil
+}
+
+// contractError returns the error of a contract the way the enclave encrypts it
+func contractError(key []byte, err error) string {
+	stdErr := fmt.Sprintf(`{"generic_err":{"msg":%q}}`, err.Error())
+	return "encrypted: " + base64.StdEncoding.EncodeToString(encrypt(key, []byte(stdErr)))
+}
+
+// encryptedEvent returns the wasm event of a contract with its attributes encrypted like the enclave does
+func encryptedEvent(key []byte, contract string, attributes ...string) abci.Event {
+	event := abci.Event{
+		Type:       "wasm",
+		Attributes: []abci.EventAttribute{{Key: "contract_address", Value: contract}},
+	}
+	for i := 0; i < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   base64.StdEncoding.EncodeToString(encrypt(key, []byte(attributes[i]))),
+			Value: base64.StdEncoding.EncodeToString(encrypt(key, []byte(attributes[i+1]))),
+		})
+	}
+	return event
+}
+
+func (n *testNetwork) QuerySecretContract(_ context.Context, req *compute.QuerySecretContractRequest) (*compute.QuerySecretContractResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	contract, ok := n.contracts[req.ContractAddress]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "contract not found")
+	}
+	msg, key, err := n.decryptInput(req.Query, n.codeHash(contract.codeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := runCounter(&testContract{count: contract.count}, msg)
+	if err != nil {
+		return nil, status.Error(codes.Unknown, contractError(key, err)+": query contract failed")
+	}
+	return &compute.QuerySecretContractResponse{Data: encrypt(key, []byte(base64.StdEncoding.EncodeToString(res)))}, nil
+}
+
+func (n *testNetwork) CodeHashByCodeId(_ context.Context, req *compute.QueryByCodeIdRequest) (*compute.QueryCodeHashResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if req.CodeId == 0 || req.CodeId > uint64(len(n.codes)) {
+		return nil, status.Error(codes.NotFound, "code not found")
+	}
+	return &compute.QueryCodeHashResponse{CodeHash: n.codeHash(req.CodeId)}, nil
+}
+
+func (n *testNetwork) CodeHashByContractAddress(_ context.Context, req *compute.QueryByContractAddressRequest) (*compute.QueryCodeHashResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	contract, ok := n.contracts[req.ContractAddress]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "contract not found")
+	}
+	return &compute.QueryCodeHashResponse{CodeHash: n.codeHash(contract.codeID)}, nil
+}
+
+func (n *testNetwork) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if _, err := n.txConfig.TxDecoder()(req.TxBytes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &txtypes.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: testGasUsed}}, nil
+}
+
+// BroadcastTx checks the signature and the sequence of a transaction like CheckTx, and runs it into the next block,
+// where it can be looked up after the first time
+func (n *testNetwork) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	txHash := sha256.Sum256(req.TxBytes)
+	hash := strings.ToUpper(hex.EncodeToString(txHash[:]))
+
+	tx, err := n.txConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := n.checkTx(ctx, tx); err != nil {
+		code, log := uint32(1), err.Error()
+		var sdkErr interface{ ABCICode() uint32 }
+		if errors.As(err, &sdkErr) {
+			code = sdkErr.ABCICode()
+		}
+		return &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: hash, Code: code, RawLog: log}}, nil
+	}
+
+	res, err := n.deliverTx(tx, req.TxBytes, hash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	n.txs[hash] = res
+	n.pending[hash] = 1
+
+	return &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: hash}}, nil
+}
+
+func (n *testNetwork) checkTx(ctx context.Context, tx sdk.Tx) error {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return sdkerrors.ErrTxDecode.Wrap("not a signed transaction")
+	}
+	signatures, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(signatures) != 1 {
+		return sdkerrors.ErrUnauthorized.Wrapf("expected 1 signature, got %d", len(signatures))
+	}
+	signature := signatures[0]
+
+	address := sdk.AccAddress(signature.PubKey.Address()).String()
+	account, ok := n.accounts[address]
+	if !ok {
+		return sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", address)
+	}
+	if signature.Sequence != account.sequence {
+		return sdkerrors.ErrWrongSequence.Wrapf("account sequence mismatch, expected %d, got %d", account.sequence, signature.Sequence)
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       address,
+		ChainID:       testChainID,
+		AccountNumber: account.number,
+		Sequence:      account.sequence,
+		PubKey:        signature.PubKey,
+	}
+	signatureData, ok := signature.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return sdkerrors.ErrUnauthorized.Wrap("expected a single signature")
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, n.txConfig.SignModeHandler(), signatureData.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+	if !signature.PubKey.VerifySignature(signBytes, signatureData.Signature) {
+		return sdkerrors.ErrUnauthorized.Wrap("signature verification failed")
+	}
+
+	n.checkedFees = sigTx.GetFee()
+	account.sequence++
+	return nil
+}
+
+// deliverTx runs the messages of a transaction. Messages before a failed message are not reverted, which is fine for
+// transactions of one message
+func (n *testNetwork) deliverTx(tx sdk.Tx, txBytes []byte, hash string) (*sdk.TxResponse, error) {
+	n.height++
+	res := &sdk.TxResponse{
+		Height:    n.height,
+		TxHash:    hash,
+		GasWanted: int64(tx.(sdk.FeeTx).GetGas()),
+		GasUsed:   testGasUsed,
+	}
+
+	txAny, err := txToAny(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	res.Tx = txAny
+
+	var msgResponses []*codectypes.Any
+	var events []abci.Event
+	for i, msg := range tx.GetMsgs() {
+		msgResponse, msgEvents, err := n.runMsg(msg)
+		if err != nil {
+			res.Code = 1
+			var sdkErr interface{ ABCICode() uint32 }
+			if errors.As(err, &sdkErr) {
+				res.Code = sdkErr.ABCICode()
+			}
+			res.RawLog = fmt.Sprintf("failed to execute message; message index: %d: %s", i, err.Error())
+			return res, nil
+		}
+
+		msgResponseAny, err := codectypes.NewAnyWithValue(msgResponse)
+		if err != nil {
+			return nil, err
+		}
+		msgResponses = append(msgResponses, msgResponseAny)
+		events = append(events, msgEvents...)
+	}
+
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
+	if err != nil {
+		return nil, err
+	}
+	res.Data = strings.ToUpper(hex.EncodeToString(data))
+	res.Events = events
+	return res, nil
+}
+
+func (n *testNetwork) runMsg(msg sdk.Msg) (proto.Message, []abci.Event, error) {
+	switch msg := msg.(type) {
+	case *compute.MsgStoreCode:
+		reader, err := gzip.NewReader(bytes.NewReader(msg.WASMByteCode))
+		if err != nil {
+			return nil, nil, compute.ErrCreateFailed.Wrap(err.Error())
+		}
+		wasm, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, nil, compute.ErrCreateFailed.Wrap(err.Error())
+		}
+		codeHash := sha256.Sum256(wasm)
+		n.codes = append(n.codes, codeHash[:])
+		return &compute.MsgStoreCodeResponse{CodeID: uint64(len(n.codes))}, nil, nil
+
+	case *compute.MsgInstantiateContract:
+		if msg.CodeID == 0 || msg.CodeID > uint64(len(n.codes)) {
+			return nil, nil, compute.ErrNotFound.Wrapf("code %d", msg.CodeID)
+		}
+		input, key, err := n.decryptInput(msg.InitMsg, n.codeHash(msg.CodeID))
+		if err != nil {
+			return nil, nil, compute.ErrInstantiateFailed.Wrap(err.Error())
+		}
+
+		contract := &testContract{codeID: msg.CodeID}
+		data, err := runCounter(contract, input)
+		if err != nil {
+			return nil, nil, compute.ErrInstantiateFailed.Wrap(contractError(key, err))
+		}
+
+		addressHash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s", msg.CodeID, msg.Label)))
+		address := sdk.AccAddress(addressHash[:20]).String()
+		n.contracts[address] = contract
+
+		return &compute.MsgInstantiateContractResponse{
+			Address: address,
+			Data:    encrypt(key, []byte(base64.StdEncoding.EncodeToString(data))),
+		}, []abci.Event{encryptedEvent(key, address, "action", "instantiate")}, nil
+
+	case *compute.MsgExecuteContract:
+		contract, ok := n.contracts[msg.Contract.String()]
+		if !ok {
+			return nil, nil, compute.ErrNotFound.Wrapf("contract %s", msg.Contract)
+		}
+		input, key, err := n.decryptInput(msg.Msg, n.codeHash(contract.codeID))
+		if err != nil {
+			return nil, nil, compute.ErrExecuteFailed.Wrap(err.Error())
+		}
+
+		data, err := runCounter(contract, input)
+		if err != nil {
+			return nil, nil, compute.ErrExecuteFailed.Wrap(contractError(key, err))
+		}
+
+		return &compute.MsgExecuteContractResponse{
+			Data: encrypt(key, []byte(base64.StdEncoding.EncodeToString(data))),
+		}, []abci.Event{encryptedEvent(key, msg.Contract.String(), "count", fmt.Sprint(contract.count))}, nil
+
+	default:
+		return nil, nil, sdkerrors.ErrUnknownRequest.Wrapf("unexpected message %T", msg)
+	}
+}
+
+// txToAny packs a raw transaction as the Tx of a TxResponse
+func txToAny(txBytes []byte) (*codectypes.Any, error) {
+	var txRaw txtypes.TxRaw
+	if err := proto.Unmarshal(txBytes, &txRaw); err != nil {
+		return nil, err
+	}
+	tx := &txtypes.Tx{Body: &txtypes.TxBody{}, AuthInfo: &txtypes.AuthInfo{}, Signatures: txRaw.Signatures}
+	if err := proto.Unmarshal(txRaw.BodyBytes, tx.Body); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(txRaw.AuthInfoBytes, tx.AuthInfo); err != nil {
+		return nil, err
+	}
+	return codectypes.NewAnyWithValue(tx)
+}
+
+func (n *testNetwork) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	res, ok := n.txs[req.Hash]
+	if !ok || n.pending[req.Hash] > 0 {
+		n.pending[req.Hash]--
+		return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+	}
+	return &txtypes.GetTxResponse{TxResponse: res}, nil
+}
+
+type testRegistrationServer struct {
+	registration.UnimplementedQueryServer
+	network *testNetwork
+}
+
+func (s testRegistrationServer) TxKey(context.Context, *emptypb.Empty) (*registration.Key, error) {
+	return &registration.Key{Key: s.network.ioPubKey}, nil
+}
+
+type testAuthServer struct {
+	authtypes.UnimplementedQueryServer
+	network *testNetwork
+}
+
+func (s testAuthServer) AccountInfo(_ context.Context, req *authtypes.QueryAccountInfoRequest) (*authtypes.QueryAccountInfoResponse, error) {
+	s.network.mtx.Lock()
+	defer s.network.mtx.Unlock()
+
+	account, ok := s.network.accounts[req.Address]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+	return &authtypes.QueryAccountInfoResponse{Info: &authtypes.BaseAccount{
+		Address:       req.Address,
+		AccountNumber: account.number,
+		Sequence:      account.sequence,
+	}}, nil
+}
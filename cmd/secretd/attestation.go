@@ -4,14 +4,11 @@
 package main
 
 import (
-	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -24,6 +21,7 @@ import (
 	"github.com/scrtlabs/SecretNetwork/app"
 	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	reg "github.com/scrtlabs/SecretNetwork/x/registration"
+	regservice "github.com/scrtlabs/SecretNetwork/x/registration/client/service"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +30,9 @@ const (
 	flagReset                     = "reset"
 	flagPulsar                    = "pulsar"
 	flagCustomRegistrationService = "registration-service"
+	flagVerifyNode                = "verify-node"
+	flagNoVerify                  = "no-verify"
+	flagSeedVersion               = "seed-version"
 	flag_no_epid                  = "no-epid"
 	flag_no_dcap                  = "no-dcap"
 	flag_is_migration_report      = "migration"
@@ -39,12 +40,6 @@ const (
 
 const (
 	flagLegacyRegistrationNode = "registration-node"
-	flagLegacyBootstrapNode    = "node"
-)
-
-const (
-	mainnetRegistrationService = "https://mainnet-register.scrtlabs.com/api/registernode"
-	pulsarRegistrationService  = "https://registration-service-testnet.azurewebsites.net/api/registernode"
 )
 
 func InitAttestation() *cobra.Command {
@@ -328,6 +323,10 @@ func ConfigureSecret() *cobra.Command {
 		Use: "configure-secret [master-key] [seed]",
 		Short: "After registration is successful, configure the secret node with the master key file and the encrypted " +
 			"seed that was written on-chain",
+		Long: "After registration is successful, configure the secret node with the master key file and the encrypted " +
+			"seed that was written on-chain. Nodes load the seed of a new version by themselves after a seed rotation. If " +
+			"a node fails to, configure it with the seed of the new version ('secretd q register seed [node-id] " +
+			"--seed-version [version]') and restart the node",
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			masterKey, err := os.ReadFile(args[0])
@@ -335,16 +334,25 @@ func ConfigureSecret() *cobra.Command {
 				return err
 			}
 
+			seedVersion, err := cmd.Flags().GetUint32(flagSeedVersion)
+			if err != nil {
+				return err
+			}
+
 			seed := args[1]
 			println(seed)
-			if (len(seed) != reg.LegacyEncryptedKeyLength && len(seed) != reg.EncryptedKeyLength) || !reg.IsHexString(seed) {
-				return fmt.Errorf("invalid encrypted seed format (requires hex string of length of at least 96 bytes without 0x prefix)")
+			if seedVersion <= reg.SeedConfigVersion {
+				if (len(seed) != reg.LegacyEncryptedKeyLength && len(seed) != reg.EncryptedKeyLength) || !reg.IsHexString(seed) {
+					return fmt.Errorf("invalid encrypted seed format (requires hex string of length of at least 96 bytes without 0x prefix)")
+				}
+			} else if len(seed) != reg.EncryptedSeedLength(seedVersion) || !reg.IsHexString(seed) {
+				return fmt.Errorf("invalid encrypted seed format (seed version %d requires hex string of length %d without 0x prefix)", seedVersion, reg.EncryptedSeedLength(seedVersion))
 			}
 
 			cfg := reg.SeedConfig{
 				EncryptedKey: seed,
 				MasterKey:    string(masterKey),
-				Version:      reg.SeedConfigVersion,
+				Version:      seedVersion,
 			}
 
 			cfgBytes, err := json.Marshal(&cfg)
@@ -374,6 +382,7 @@ func ConfigureSecret() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Uint32(flagSeedVersion, reg.SeedConfigVersion, "Consensus seed version of the seed")
 
 	return cmd
 }
@@ -448,22 +457,6 @@ func ResetEnclave() *cobra.Command {
 	return cmd
 }
 
-type OkayResponse struct {
-	Status          string `json:"status"`
-	Details         KeyVal `json:"details"`
-	RegistrationKey string `json:"registration_key"`
-}
-
-type KeyVal struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-}
-
-type ErrorResponse struct {
-	Status  string `json:"status"`
-	Details string `json:"details"`
-}
-
 // AutoRegisterNode *** EXPERIMENTAL ***
 func AutoRegisterNode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -528,12 +521,12 @@ Please report any issues with this command
 			}
 
 			// verify certificate
-			_, err = ra.VerifyCombinedCert(certCombined)
+			pubKey, err := ra.VerifyCombinedCert(certCombined)
 			if err != nil {
 				return err
 			}
 
-			regUrl := mainnetRegistrationService
+			regUrl := regservice.MainnetURL
 
 			pulsarFlag, err := cmd.Flags().GetBool(flagPulsar)
 			if err != nil {
@@ -547,7 +540,7 @@ Please report any issues with this command
 			}
 
 			if pulsarFlag { 
-				regUrl = pulsarRegistrationService
+				regUrl = regservice.PulsarURL
 				log.Println("Registering node on Pulsar testnet")
 			} else if customRegUrl != "" {
 				regUrl = customRegUrl
@@ -557,46 +550,50 @@ Please report any issues with this command
 			}
 
 			// call registration service to register us
-			data := []byte(fmt.Sprintf(`{
-				"certificate": "%s"
-			}`, base64.StdEncoding.EncodeToString(certCombined)))
-
-			resp, err := http.Post(regUrl, "application/json", bytes.NewBuffer(data))
+			regClient := regservice.NewClient(regUrl)
+			details, err := regClient.Register(cmd.Context(), certCombined)
 			if err != nil {
-				log.Fatalln(err)
+				return fmt.Errorf("registration TX was not successful - %w", err)
 			}
 
-			defer resp.Body.Close()
-
-			body, err := io.ReadAll(resp.Body)
+			seed, err := details.EncryptedSeed()
 			if err != nil {
-				log.Fatalln(err)
-			}
-
-			if resp.StatusCode != http.StatusOK {
-				errDetails := ErrorResponse{}
-				err := json.Unmarshal(body, &errDetails)
-				if err != nil {
-					return fmt.Errorf("registration TX was not successful - %s", err)
-				}
-				return fmt.Errorf("registration TX was not successful - %s", errDetails.Details)
+				return err
 			}
+			log.Printf(`seed: %s\n`, seed)
 
-			details := OkayResponse{}
-			err = json.Unmarshal(body, &details)
+			noVerify, err := cmd.Flags().GetBool(flagNoVerify)
 			if err != nil {
-				return fmt.Errorf("error getting seed from registration service - %s", err)
+				return err
 			}
 
-			seed := details.Details.Value
-			log.Printf(`seed: %s\n`, seed)
+			if !noVerify {
+				// verify with the node of --verify-node, or else with the one of --node or client.toml
+				clientCtx, err := client.GetClientQueryContext(cmd)
+				if err != nil {
+					return err
+				}
 
-			if len(seed) > 2 {
-				seed = seed[2:]
-			}
+				verifyNode, err := cmd.Flags().GetString(flagVerifyNode)
+				if err != nil {
+					return err
+				}
+				if verifyNode != "" {
+					rpcClient, err := client.NewClientFromNode(verifyNode)
+					if err != nil {
+						return err
+					}
+					clientCtx = clientCtx.WithClient(rpcClient).WithNodeURI(verifyNode)
+				}
+				if clientCtx.Client == nil {
+					return fmt.Errorf("no node to verify the registration with, set --%s or --%s", flagVerifyNode, flagNoVerify)
+				}
 
-			if len(seed) != reg.EncryptedKeyLength || !reg.IsHexString(seed) {
-				return fmt.Errorf("invalid encrypted seed format (requires hex string of length 148 without 0x prefix)")
+				log.Printf("Waiting for the registration to be committed on %s\n", clientCtx.NodeURI)
+				err = regClient.VerifyRegistered(cmd.Context(), regservice.NewQuerier(clientCtx), pubKey, seed)
+				if err != nil {
+					return err
+				}
 			}
 
 			regPublicKey := details.RegistrationKey
@@ -657,8 +654,10 @@ Please report any issues with this command
 	cmd.Flags().Bool(flagReset, false, "Optional flag to regenerate the enclave registration key")
 	cmd.Flags().Bool(flagPulsar, false, "Set --pulsar flag if registering with the Pulsar testnet")
 	cmd.Flags().String(flagCustomRegistrationService, "", "Use this flag if you wish to specify a custom registration service")
+	cmd.Flags().String(flagVerifyNode, "", "Optional RPC endpoint of a node (e.g. tcp://localhost:26657) to verify the registration was committed with, instead of the one of --node")
+	cmd.Flags().Bool(flagNoVerify, false, "Optional flag to skip verifying that the registration was committed on chain")
+	cmd.Flags().String(flags.FlagNode, "", "RPC endpoint of the node to verify the registration was committed with. Defaults to the node of client.toml")
 
-	cmd.Flags().String(flagLegacyBootstrapNode, "", "DEPRECATED: This flag is no longer required or in use")
 	cmd.Flags().String(flagLegacyRegistrationNode, "", "DEPRECATED: This flag is no longer required or in use")
 
 	cmd.Flags().Bool(flag_no_epid, false, "Optional flag to disable EPID attestation")
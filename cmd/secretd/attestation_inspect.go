@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagInspectFormat = "format"
+
+	inspectFormatJSON  = "json"
+	inspectFormatTable = "table"
+)
+
+// attestationInspection is the printable form of an attestation summary
+type attestationInspection struct {
+	Type         string   `json:"type"`
+	PubKey       string   `json:"pub_key"`
+	MrEnclave    string   `json:"mr_enclave,omitempty"`
+	MrSigner     string   `json:"mr_signer,omitempty"`
+	IsvProdID    uint16   `json:"isv_prod_id"`
+	IsvSvn       uint16   `json:"isv_svn"`
+	TcbStatus    string   `json:"tcb_status,omitempty"`
+	AdvisoryIDs  []string `json:"advisory_ids,omitempty"`
+	PlatformInfo string   `json:"platform_info,omitempty"`
+	Timestamp    string   `json:"timestamp,omitempty"`
+}
+
+func newAttestationInspection(summary *ra.AttestationSummary) attestationInspection {
+	inspection := attestationInspection{
+		Type:         summary.Type,
+		PubKey:       hex.EncodeToString(summary.PubKey),
+		TcbStatus:    summary.TcbStatus,
+		AdvisoryIDs:  summary.AdvisoryIDs,
+		PlatformInfo: summary.PlatformInfo,
+	}
+
+	if summary.Report != nil {
+		inspection.MrEnclave = hex.EncodeToString(summary.Report.MrEnclave[:])
+		inspection.MrSigner = hex.EncodeToString(summary.Report.MrSigner[:])
+		inspection.IsvProdID = summary.Report.IsvProdID
+		inspection.IsvSvn = summary.Report.IsvSvn
+	}
+
+	if !summary.Timestamp.IsZero() {
+		inspection.Timestamp = summary.Timestamp.Format(time.RFC3339Nano)
+	}
+
+	return inspection
+}
+
+func (i attestationInspection) printTable(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Type\t%s\n", i.Type)
+	fmt.Fprintf(w, "Public key\t%s\n", i.PubKey)
+	fmt.Fprintf(w, "MRENCLAVE\t%s\n", i.MrEnclave)
+	fmt.Fprintf(w, "MRSIGNER\t%s\n", i.MrSigner)
+	fmt.Fprintf(w, "ISV product ID\t%d\n", i.IsvProdID)
+	fmt.Fprintf(w, "ISV SVN\t%d\n", i.IsvSvn)
+	fmt.Fprintf(w, "TCB status\t%s\n", i.TcbStatus)
+	fmt.Fprintf(w, "Advisory IDs\t%s\n", strings.Join(i.AdvisoryIDs, ", "))
+	fmt.Fprintf(w, "Platform info\t%s\n", i.PlatformInfo)
+	fmt.Fprintf(w, "Timestamp\t%s\n", i.Timestamp)
+	return w.Flush()
+}
+
+func AttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation",
+		Short: "Attestation certificate helpers",
+	}
+
+	cmd.AddCommand(
+		InspectAttestationCmd(),
+	)
+
+	return cmd
+}
+
+func InspectAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [cert file]",
+		Short: "Print a summary of an attestation certificate",
+		Long: "Print what an attestation_combined.bin or a legacy DER attestation certificate tells about the attested " +
+			"enclave and its platform. Intel's signatures are not verified, use 'parse' for that",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(flagInspectFormat)
+			if err != nil {
+				return err
+			}
+
+			cert, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			summary, err := ra.ParseAttestation(cert)
+			if err != nil {
+				return fmt.Errorf("failed to parse attestation certificate: %w", err)
+			}
+
+			inspection := newAttestationInspection(summary)
+
+			switch format {
+			case inspectFormatJSON:
+				out, err := json.MarshalIndent(inspection, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			case inspectFormatTable:
+				return inspection.printTable(cmd.OutOrStdout())
+			default:
+				return fmt.Errorf("unknown format %s, use %s or %s", format, inspectFormatJSON, inspectFormatTable)
+			}
+		},
+	}
+	cmd.Flags().String(flagInspectFormat, inspectFormatJSON, fmt.Sprintf("Output format (%s|%s)", inspectFormatJSON, inspectFormatTable))
+
+	return cmd
+}
@@ -12,6 +12,7 @@ import (
 	eip191 "github.com/scrtlabs/SecretNetwork/eip191"
 	scrt "github.com/scrtlabs/SecretNetwork/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
 	"github.com/spf13/viper"
 
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -201,6 +202,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig, basi
 		InitBootstrapCmd(),
 		ParseCert(),
 		DumpBin(),
+		AttestationCmd(),
 		MigrationOp(),
 		EmergencyApproveUpgrade(),
 		ConfigureSecret(),
@@ -208,7 +210,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig, basi
 		ResetEnclave(),
 		AutoRegisterNode(),
 		confixcmd.ConfigCommand(),
-		keys.Commands(),
+		keysCommand(),
 	)
 
 	// add rosetta commands
@@ -219,6 +221,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig, basi
 		false, "Start the node as the bootstrap node for the network (only used when starting a new network)")
 }
 
+// keysCommand returns the keys commands of the SDK, with the commands that manage the tx encryption keys of accounts
+func keysCommand() *cobra.Command {
+	cmd := keys.Commands()
+	cmd.AddCommand(cli.TxEncryptionKeyCmd())
+	return cmd
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(eCfg app.EncodingConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := &cobra.Command{
@@ -275,6 +284,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlockResultsCmd(),
 		rpc.ValidatorCommand(),
 		S20GetQueryCmd(),
+		S721GetQueryCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -304,6 +314,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetDecodeCommand(),
 		flags.LineBreak,
 		S20GetTxCmd(),
+		S721GetTxCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
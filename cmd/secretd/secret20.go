@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 const (
 	MessageBlockSize = 256
 	flagAmount       = "amount"
+	flagExpiration   = "expiration"
 )
 
 // S20GetQueryCmd GetQueryCmd returns the cli query commands for this module
@@ -35,6 +37,10 @@ func S20GetQueryCmd() *cobra.Command {
 		S20BalanceCmd(),
 		S20TransferHistoryCmd(),
 		S20TransactionHistoryCmd(),
+		S20AllowanceCmd(),
+		s20PublicQueryCmd("token-info", "See the name, symbol, decimals and total supply of a token", "token_info"),
+		s20PublicQueryCmd("exchange-rate", "See the rate of conversion between a token and its native currency", "exchange_rate"),
+		s20PublicQueryCmd("minters", "See the addresses that may mint a token", "minters"),
 	)
 
 	return s20QueryCmd
@@ -58,6 +64,14 @@ func S20GetTxCmd() *cobra.Command {
 		s20Redeem(),
 		s20SetViewingKey(),
 		s20BurnCmd(),
+		s20IncreaseAllowanceCmd(),
+		s20DecreaseAllowanceCmd(),
+		s20TransferFromCmd(),
+		s20SendFromCmd(),
+		s20BatchTransferCmd(),
+		s20MintCmd(),
+		createPermitCmd([]string{"balance", "history"}),
+		revokePermitCmd(),
 	)
 
 	return s20TxCmd
@@ -67,8 +81,9 @@ func S20TransferHistoryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfers [contract address] [account] [viewing_key] [optional: page, default: 0] [optional: page_size, default: 10] [optional: should_filter_decoys, default: false]",
 		Short: "View your transfer history",
-		Long:  `Print out transfer you have been a part of - either as a sender or recipient`,
-		Args:  cobra.RangeArgs(3, 6),
+		Long: `Print out transfer you have been a part of - either as a sender or recipient.
+With --permit, the account and viewing key are left out: transfers [contract address] [optional: page] [optional: page_size] [optional: should_filter_decoys]`,
+		Args: permitOrViewingKeyArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -80,44 +95,42 @@ func S20TransferHistoryCmd() *cobra.Command {
 				return err
 			}
 
-			addr, err := sdk.AccAddressFromBech32(args[1])
+			permit, err := readPermit(cmd)
 			if err != nil {
 				return err
 			}
 
-			key := args[2]
-			if key == "" {
-				return errors.New("viewing key must not be empty")
-			}
-
-			var page uint64
-			var pageSize uint64 = 10
-			shouldFilterDecoys := false
-
-			if len(args) >= 4 {
-				page, err = strconv.ParseUint(args[3], 10, 32)
+			var queryData []byte
+			if permit != nil {
+				page, pageSize, shouldFilterDecoys, err := parseHistoryArgs(args[1:])
 				if err != nil {
 					return err
 				}
-			}
-
-			if len(args) >= 5 {
-				pageSize, err = strconv.ParseUint(args[4], 10, 32)
+				queryData, err = queryWithPermitMsg(*permit, PermitTransferHistoryQuery{
+					TransferHistory: PermitHistoryQueryInner{Page: page, PageSize: pageSize, ShouldFilterDecoys: shouldFilterDecoys},
+				})
 				if err != nil {
 					return err
 				}
-			}
-
-			if len(args) >= 6 {
-				shouldFilterDecoys, err = strconv.ParseBool(args[5])
+			} else {
+				addr, err := sdk.AccAddressFromBech32(args[1])
 				if err != nil {
 					return err
 				}
-			}
 
-			queryData, err := queryTransferHistoryMsg(addr, key, uint32(page), uint32(pageSize), shouldFilterDecoys)
-			if err != nil {
-				return err
+				key := args[2]
+				if key == "" {
+					return errors.New("viewing key must not be empty")
+				}
+
+				page, pageSize, shouldFilterDecoys, err := parseHistoryArgs(args[3:])
+				if err != nil {
+					return err
+				}
+				queryData, err = queryTransferHistoryMsg(addr, key, page, pageSize, shouldFilterDecoys)
+				if err != nil {
+					return err
+				}
 			}
 
 			err = cli.QueryWithData(contractAddr, queryData, cliCtx)
@@ -129,6 +142,8 @@ func S20TransferHistoryCmd() *cobra.Command {
 		},
 	}
 
+	addPermitFlag(cmd)
+
 	return cmd
 }
 
@@ -137,8 +152,9 @@ func S20TransactionHistoryCmd() *cobra.Command {
 		Use:   "txs [contract address] [account] [viewing_key] [optional: page, default: 0] [optional: page_size, default: 10] [optional: should_filter_decoys, default: false]",
 		Short: "View your full transaction history",
 		Long: `Print out transactions you have been a part of - either as a sender or recipient.
-Unlike the transfers query, this query shows all kinds of transactions with the contract.`,
-		Args: cobra.RangeArgs(3, 6),
+Unlike the transfers query, this query shows all kinds of transactions with the contract.
+With --permit, the account and viewing key are left out: txs [contract address] [optional: page] [optional: page_size] [optional: should_filter_decoys]`,
+		Args: permitOrViewingKeyArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -150,42 +166,130 @@ Unlike the transfers query, this query shows all kinds of transactions with the
 				return err
 			}
 
-			addr, err := sdk.AccAddressFromBech32(args[1])
+			permit, err := readPermit(cmd)
 			if err != nil {
 				return err
 			}
 
-			key := args[2]
-			if key == "" {
-				return errors.New("viewing key must not be empty")
-			}
+			var queryData []byte
+			if permit != nil {
+				page, pageSize, shouldFilterDecoys, err := parseHistoryArgs(args[1:])
+				if err != nil {
+					return err
+				}
+				queryData, err = queryWithPermitMsg(*permit, PermitTransactionHistoryQuery{
+					TransactionHistory: PermitHistoryQueryInner{Page: page, PageSize: pageSize, ShouldFilterDecoys: shouldFilterDecoys},
+				})
+				if err != nil {
+					return err
+				}
+			} else {
+				addr, err := sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
 
-			var page uint64
-			var pageSize uint64 = 10
-			shouldFilterDecoys := false
+				key := args[2]
+				if key == "" {
+					return errors.New("viewing key must not be empty")
+				}
 
-			if len(args) >= 4 {
-				page, err = strconv.ParseUint(args[3], 10, 32)
+				page, pageSize, shouldFilterDecoys, err := parseHistoryArgs(args[3:])
 				if err != nil {
 					return err
 				}
-			}
-
-			if len(args) >= 5 {
-				pageSize, err = strconv.ParseUint(args[4], 10, 32)
+				queryData, err = queryTransactionHistoryMsg(addr, key, page, pageSize, shouldFilterDecoys)
 				if err != nil {
 					return err
 				}
 			}
 
-			if len(args) == 6 {
-				shouldFilterDecoys, err = strconv.ParseBool(args[5])
+			err = cli.QueryWithData(contractAddr, queryData, cliCtx)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	addPermitFlag(cmd)
+
+	return cmd
+}
+
+// parseHistoryArgs parses the optional page, page_size and should_filter_decoys args of history queries
+func parseHistoryArgs(args []string) (uint32, uint32, bool, error) {
+	var page uint64
+	var pageSize uint64 = 10
+	shouldFilterDecoys := false
+	var err error
+
+	if len(args) >= 1 {
+		page, err = strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return 0, 0, false, err
+		}
+	}
+
+	if len(args) >= 2 {
+		pageSize, err = strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return 0, 0, false, err
+		}
+	}
+
+	if len(args) >= 3 {
+		shouldFilterDecoys, err = strconv.ParseBool(args[2])
+		if err != nil {
+			return 0, 0, false, err
+		}
+	}
+
+	return uint32(page), uint32(pageSize), shouldFilterDecoys, nil
+}
+
+func S20BalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance [contract address] [account] [viewing_key]",
+		Short: "See your current balance for a token",
+		Long: `See your current balance for a token. Viewing key must be set for this command to work. If you did not set your viewing 
+key yet, use the "create-viewing-key" command. Otherwise, you can still see your current balance using a raw transaction,
+or with a query permit: balance [contract address] --permit [permit file]`,
+		Args: permitOrViewingKeyArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			permit, err := readPermit(cmd)
+			if err != nil {
+				return err
+			}
+
+			var queryData []byte
+			if permit != nil {
+				queryData, err = queryWithPermitMsg(*permit, PermitBalanceQuery{})
+			} else {
+				var addr sdk.AccAddress
+				addr, err = sdk.AccAddressFromBech32(args[1])
 				if err != nil {
 					return err
 				}
-			}
 
-			queryData, err := queryTransactionHistoryMsg(addr, key, uint32(page), uint32(pageSize), shouldFilterDecoys)
+				key := args[2]
+				if key == "" {
+					return errors.New("viewing key must not be empty")
+				}
+
+				queryData, err = queryBalanceMsg(addr, key)
+			}
 			if err != nil {
 				return err
 			}
@@ -199,16 +303,23 @@ Unlike the transfers query, this query shows all kinds of transactions with the
 		},
 	}
 
+	addPermitFlag(cmd)
+
 	return cmd
 }
 
-func S20BalanceCmd() *cobra.Command {
+func S20AllowanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "balance [contract address] [account] [viewing_key]",
-		Short: "See your current balance for a token",
-		Long: `See your current balance for a token. Viewing key must be set for this command to work. If you did not set your viewing 
-key yet, use the "create-viewing-key" command. Otherwise, you can still see your current balance using a raw transaction`,
-		Args: cobra.ExactArgs(3),
+		Use:   "allowance [contract address] [owner] [spender] [viewing_key]",
+		Short: "See the allowance of a spender from an owner",
+		Long: `See the amount that a spender may spend from the balance of an owner, and when the allowance expires. The viewing key
+is the key of the owner or of the spender. With --permit, the viewing key is left out: allowance [contract address] [owner] [spender]`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if path, _ := cmd.Flags().GetString(flagPermit); path != "" {
+				return cobra.ExactArgs(3)(cmd, args)
+			}
+			return cobra.ExactArgs(4)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -220,27 +331,69 @@ key yet, use the "create-viewing-key" command. Otherwise, you can still see your
 				return err
 			}
 
-			addr, err := sdk.AccAddressFromBech32(args[1])
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid owner address")
+			}
+			spender, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.New("invalid spender address")
+			}
+
+			permit, err := readPermit(cmd)
 			if err != nil {
 				return err
 			}
 
-			key := args[2]
-			if key == "" {
-				return errors.New("viewing key must not be empty")
+			var queryData []byte
+			if permit != nil {
+				queryData, err = queryWithPermitMsg(*permit, PermitAllowanceQuery{
+					Allowance: PermitAllowanceQueryInner{Owner: owner, Spender: spender},
+				})
+			} else {
+				key := args[3]
+				if key == "" {
+					return errors.New("viewing key must not be empty")
+				}
+				queryData, err = queryAllowanceMsg(owner, spender, key)
+			}
+			if err != nil {
+				return err
 			}
 
-			queryData, err := queryBalanceMsg(addr, key)
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	addPermitFlag(cmd)
+
+	return cmd
+}
+
+// s20PublicQueryCmd returns a command of a query of a token that takes no parameters and needs no viewing key
+func s20PublicQueryCmd(use string, short string, query string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [contract address or label]",
+		Short: short,
+		Long:  short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			err = cli.QueryWithData(contractAddr, queryData, cliCtx)
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
 			if err != nil {
 				return err
 			}
 
-			return nil
+			queryData, err := queryPublicMsg(query)
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
 		},
 	}
 
@@ -538,6 +691,262 @@ WARNING! This action is irreversible and permanent! use at your own risk`,
 	return cmd
 }
 
+func s20IncreaseAllowanceCmd() *cobra.Command {
+	return s20AllowanceChangeCmd("increase-allowance", "Allow a spender to spend more of your tokens",
+		`Allow a spender to transfer and send an amount of your tokens more, with transfer-from and send-from.
+Optionally set when the allowance expires, in seconds since the epoch`,
+		handleIncreaseAllowanceMsg,
+	)
+}
+
+func s20DecreaseAllowanceCmd() *cobra.Command {
+	return s20AllowanceChangeCmd("decrease-allowance", "Allow a spender to spend less of your tokens",
+		`Lower the amount of your tokens that a spender may transfer and send. Optionally set when the allowance expires,
+in seconds since the epoch`,
+		handleDecreaseAllowanceMsg,
+	)
+}
+
+func s20AllowanceChangeCmd(use string, short string, long string, handleMsg func(sdk.AccAddress, string, *uint64) ([]byte, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [contract address or label] [spender] [amount]",
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid spender address")
+			}
+
+			amount := args[2]
+			_, err = strconv.ParseUint(amount, 10, 64)
+			if err != nil {
+				return errors.New("invalid amount format")
+			}
+
+			var expiration *uint64
+			if cmd.Flags().Changed(flagExpiration) {
+				exp, _ := cmd.Flags().GetUint64(flagExpiration)
+				expiration = &exp
+			}
+
+			msg, err := handleMsg(spender, amount, expiration)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagExpiration, 0, "When the allowance expires, in seconds since the epoch")
+
+	return cmd
+}
+
+func s20TransferFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-from [contract address or label] [owner] [to account] [amount]",
+		Short: "Transfer tokens of another address that allowed you to spend them",
+		Long:  `Transfer tokens from the balance of an owner to another address, within the allowance the owner gave you`,
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid owner address")
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			amount := args[3]
+			_, err = strconv.ParseUint(amount, 10, 64)
+			if err != nil {
+				return errors.New("invalid amount format")
+			}
+
+			msg, err := handleTransferFromMsg(owner, toAddr, amount)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s20SendFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-from [contract_address or label] [owner] [to_account] [amount] [optional: callback_message]",
+		Short: "Send tokens of another address that allowed you to spend them. Optionally add a callback message",
+		Long: `Send tokens from the balance of an owner to another address (contract or not), within the allowance the owner gave you.
+If 'to_account' is a contract, you can optionally add a callback message to this contract.`,
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid owner address")
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			amount := args[3]
+			_, err = strconv.ParseUint(amount, 10, 64)
+			if err != nil {
+				return errors.New("invalid amount format")
+			}
+
+			var callback string
+			if len(args) > 4 {
+				callback = args[4]
+			}
+			msg, err := handleSendFromMsg(owner, toAddr, amount, callback)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s20BatchTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-transfer [contract address or label] [to account:amount]...",
+		Short: "Transfer tokens to several addresses at once",
+		Long:  `Transfer tokens to several addresses in one transaction, e.g. batch-transfer my-token secret1...:100 secret1...:250`,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			actions := make([]TransferMsgInner, len(args)-1)
+			for i, arg := range args[1:] {
+				recipient, amount, ok := strings.Cut(arg, ":")
+				if !ok {
+					return fmt.Errorf("invalid transfer %s, must be [to account]:[amount]", arg)
+				}
+
+				toAddr, err := sdk.AccAddressFromBech32(recipient)
+				if err != nil {
+					return fmt.Errorf("invalid recipient address %s", recipient)
+				}
+				_, err = strconv.ParseUint(amount, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid amount format %s", amount)
+				}
+
+				actions[i] = TransferMsgInner{Recipient: toAddr, Amount: amount}
+			}
+
+			msg, err := handleBatchTransferMsg(actions)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s20MintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [contract address or label] [to account] [amount]",
+		Short: "Mint new tokens to an address",
+		Long:  `Mint new tokens to an address. This command will only work for the minters of the token`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			amount := args[2]
+			_, err = strconv.ParseUint(amount, 10, 64)
+			if err != nil {
+				return errors.New("invalid amount format")
+			}
+
+			msg, err := handleMintMsg(toAddr, amount)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 type TransferHistoryMsg struct {
 	TransferHistory TransferHistoryMsgInner `json:"transfer_history"`
 }
@@ -628,6 +1037,103 @@ type BurnMsgInner struct {
 	Amount string `json:"amount"`
 }
 
+type AllowanceMsg struct {
+	Allowance AllowanceMsgInner `json:"allowance"`
+}
+
+type AllowanceMsgInner struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Spender sdk.AccAddress `json:"spender"`
+	Key     string         `json:"key"`
+}
+
+type IncreaseAllowanceMsg struct {
+	IncreaseAllowance AllowanceChangeMsgInner `json:"increase_allowance"`
+}
+
+type DecreaseAllowanceMsg struct {
+	DecreaseAllowance AllowanceChangeMsgInner `json:"decrease_allowance"`
+}
+
+type AllowanceChangeMsgInner struct {
+	Spender    sdk.AccAddress `json:"spender"`
+	Amount     string         `json:"amount"`
+	Expiration *uint64        `json:"expiration,omitempty"`
+}
+
+type TransferFromMsg struct {
+	TransferFrom TransferFromMsgInner `json:"transfer_from"`
+}
+
+type TransferFromMsgInner struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+}
+
+type SendFromMsg struct {
+	SendFrom SendFromMsgInner `json:"send_from"`
+}
+
+type SendFromMsgInner struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+	Msg       string         `json:"msg,omitempty"`
+}
+
+type BatchTransferMsg struct {
+	BatchTransfer BatchTransferMsgInner `json:"batch_transfer"`
+}
+
+type BatchTransferMsgInner struct {
+	Actions []TransferMsgInner `json:"actions"`
+}
+
+type MintMsg struct {
+	Mint MintMsgInner `json:"mint"`
+}
+
+type MintMsgInner struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+}
+
+type RevokePermitMsg struct {
+	RevokePermit RevokePermitMsgInner `json:"revoke_permit"`
+}
+
+type RevokePermitMsgInner struct {
+	PermitName string `json:"permit_name"`
+}
+
+type PermitBalanceQuery struct {
+	Balance struct{} `json:"balance"`
+}
+
+type PermitTransferHistoryQuery struct {
+	TransferHistory PermitHistoryQueryInner `json:"transfer_history"`
+}
+
+type PermitTransactionHistoryQuery struct {
+	TransactionHistory PermitHistoryQueryInner `json:"transaction_history"`
+}
+
+type PermitHistoryQueryInner struct {
+	Page               uint32 `json:"page"`
+	PageSize           uint32 `json:"page_size"`
+	ShouldFilterDecoys bool   `json:"should_filter_decoys"`
+}
+
+type PermitAllowanceQuery struct {
+	Allowance PermitAllowanceQueryInner `json:"allowance"`
+}
+
+type PermitAllowanceQueryInner struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Spender sdk.AccAddress `json:"spender"`
+}
+
 func spacePad(blockSize int, message string) string {
 	surplus := len(message) % blockSize
 	if surplus == 0 {
@@ -785,3 +1291,138 @@ func handleBurnMsg(amount string) ([]byte, error) {
 
 	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
 }
+
+func queryAllowanceMsg(owner sdk.AccAddress, spender sdk.AccAddress, viewingKey string) ([]byte, error) {
+	msg := AllowanceMsg{
+		Allowance: AllowanceMsgInner{
+			Owner:   owner,
+			Spender: spender,
+			Key:     viewingKey,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+// queryPublicMsg returns a query that takes no parameters, e.g. {"token_info":{}}
+func queryPublicMsg(query string) ([]byte, error) {
+	msg := map[string]struct{}{query: {}}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleIncreaseAllowanceMsg(spender sdk.AccAddress, amount string, expiration *uint64) ([]byte, error) {
+	msg := IncreaseAllowanceMsg{
+		IncreaseAllowance: AllowanceChangeMsgInner{
+			Spender:    spender,
+			Amount:     amount,
+			Expiration: expiration,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleDecreaseAllowanceMsg(spender sdk.AccAddress, amount string, expiration *uint64) ([]byte, error) {
+	msg := DecreaseAllowanceMsg{
+		DecreaseAllowance: AllowanceChangeMsgInner{
+			Spender:    spender,
+			Amount:     amount,
+			Expiration: expiration,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleTransferFromMsg(owner sdk.AccAddress, toAddress sdk.AccAddress, amount string) ([]byte, error) {
+	msg := TransferFromMsg{
+		TransferFrom: TransferFromMsgInner{
+			Owner:     owner,
+			Recipient: toAddress,
+			Amount:    amount,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleSendFromMsg(owner sdk.AccAddress, toAddress sdk.AccAddress, amount string, message string) ([]byte, error) {
+	msg := SendFromMsg{
+		SendFrom: SendFromMsgInner{
+			Owner:     owner,
+			Recipient: toAddress,
+			Amount:    amount,
+			Msg:       message,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleBatchTransferMsg(actions []TransferMsgInner) ([]byte, error) {
+	msg := BatchTransferMsg{
+		BatchTransfer: BatchTransferMsgInner{
+			Actions: actions,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleMintMsg(toAddress sdk.AccAddress, amount string) ([]byte, error) {
+	msg := MintMsg{
+		Mint: MintMsgInner{
+			Recipient: toAddress,
+			Amount:    amount,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleRevokePermitMsg(permitName string) ([]byte, error) {
+	msg := RevokePermitMsg{
+		RevokePermit: RevokePermitMsgInner{
+			PermitName: permitName,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPermit      = "permit"
+	flagPermissions = "permissions"
+
+	// permitMsgType is the type of the message of the document that SNIP-24 permits sign
+	permitMsgType = "query_permit"
+)
+
+// permitPermissions are the permissions that a SNIP-24 permit can grant
+var permitPermissions = map[string]bool{"allowance": true, "balance": true, "history": true, "owner": true}
+
+// Permit is a SNIP-24 query permit, which authenticates queries of the account that signed it instead of a viewing key
+type Permit struct {
+	Params    PermitParams    `json:"params"`
+	Signature PermitSignature `json:"signature"`
+}
+
+type PermitParams struct {
+	PermitName    string   `json:"permit_name"`
+	AllowedTokens []string `json:"allowed_tokens"`
+	ChainID       string   `json:"chain_id"`
+	Permissions   []string `json:"permissions"`
+}
+
+type PermitSignature struct {
+	PubKey    PermitPubKey `json:"pub_key"`
+	Signature []byte       `json:"signature"`
+}
+
+type PermitPubKey struct {
+	Type  string `json:"type"`
+	Value []byte `json:"value"`
+}
+
+type WithPermitMsg struct {
+	WithPermit WithPermitMsgInner `json:"with_permit"`
+}
+
+type WithPermitMsgInner struct {
+	Permit Permit      `json:"permit"`
+	Query  interface{} `json:"query"`
+}
+
+// permitSignDoc is the amino JSON document that permits sign, which is a transaction of a query_permit message with
+// zero fees, like Keplr signs it
+type permitSignDoc struct {
+	AccountNumber string          `json:"account_number"`
+	ChainID       string          `json:"chain_id"`
+	Fee           permitSignFee   `json:"fee"`
+	Memo          string          `json:"memo"`
+	Msgs          []permitSignMsg `json:"msgs"`
+	Sequence      string          `json:"sequence"`
+}
+
+type permitSignFee struct {
+	Amount sdk.Coins `json:"amount"`
+	Gas    string    `json:"gas"`
+}
+
+type permitSignMsg struct {
+	Type  string             `json:"type"`
+	Value permitSignMsgValue `json:"value"`
+}
+
+type permitSignMsgValue struct {
+	AllowedTokens []string `json:"allowed_tokens"`
+	Permissions   []string `json:"permissions"`
+	PermitName    string   `json:"permit_name"`
+}
+
+// createPermitCmd returns the command that signs permits, which grant defaultPermissions unless set by flag
+func createPermitCmd(defaultPermissions []string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-permit [permit_name] [contract address]...",
+		Short: "Sign a query permit for contracts, and print it",
+		Long: `Sign a SNIP-24 query permit with the --from key, which lets queries of the contracts use the permissions of the
+permit instead of a viewing key. Save the permit to a file and pass it to the queries with --permit.
+Permits are signed offline, and are valid until they are revoked with the "revoke-permit" command.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if cliCtx.FromName == "" {
+				return errors.New("the key to sign the permit with must be set with --from")
+			}
+
+			for _, contract := range args[1:] {
+				if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+					return fmt.Errorf("invalid contract address %s: %w", contract, err)
+				}
+			}
+
+			permissions, _ := cmd.Flags().GetStringSlice(flagPermissions)
+			params := PermitParams{
+				PermitName:    args[0],
+				AllowedTokens: args[1:],
+				ChainID:       cliCtx.ChainID,
+				Permissions:   permissions,
+			}
+
+			permit, err := signPermit(cliCtx, params)
+			if err != nil {
+				return err
+			}
+
+			jsonPermit, err := json.Marshal(permit)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintRaw(jsonPermit)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the key to sign the permit with")
+	cmd.Flags().StringSlice(flagPermissions, defaultPermissions, "The permissions of the permit, of allowance, balance, history and owner")
+	flags.AddKeyringFlags(cmd.Flags())
+
+	return cmd
+}
+
+func revokePermitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-permit [contract address or label] [permit_name]",
+		Short: "Revoke a query permit",
+		Long:  `Revoke the permits with a name, which queries of the contract won't accept anymore`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			msg, err := handleRevokePermitMsg(args[1])
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// permitSignBytes returns the bytes of the document that a permit signs
+func permitSignBytes(params PermitParams) ([]byte, error) {
+	doc := permitSignDoc{
+		AccountNumber: "0",
+		ChainID:       params.ChainID,
+		Fee: permitSignFee{
+			// sdk.NewCoins would drop the zero coin
+			Amount: sdk.Coins{sdk.NewInt64Coin("uscrt", 0)},
+			Gas:    "1",
+		},
+		Msgs: []permitSignMsg{{
+			Type: permitMsgType,
+			Value: permitSignMsgValue{
+				AllowedTokens: params.AllowedTokens,
+				Permissions:   params.Permissions,
+				PermitName:    params.PermitName,
+			},
+		}},
+		Sequence: "0",
+	}
+	jsonDoc, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(jsonDoc), nil
+}
+
+// signPermit signs the params of a permit with the --from key of cliCtx
+func signPermit(cliCtx client.Context, params PermitParams) (Permit, error) {
+	if params.PermitName == "" {
+		return Permit{}, errors.New("permit name must not be empty")
+	}
+	if params.ChainID == "" {
+		return Permit{}, errors.New("permits are signed for a chain, set it with --chain-id")
+	}
+	if len(params.Permissions) == 0 {
+		return Permit{}, errors.New("permits must have permissions")
+	}
+	for _, permission := range params.Permissions {
+		if !permitPermissions[permission] {
+			return Permit{}, fmt.Errorf("unknown permission %s, must be one of allowance, balance, history or owner", permission)
+		}
+	}
+
+	signBytes, err := permitSignBytes(params)
+	if err != nil {
+		return Permit{}, err
+	}
+
+	signature, pubKey, err := cliCtx.Keyring.Sign(cliCtx.FromName, signBytes, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return Permit{}, fmt.Errorf("failed to sign the permit with key %s: %w", cliCtx.FromName, err)
+	}
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return Permit{}, fmt.Errorf("permits must be signed with secp256k1 keys, key %s is %s", cliCtx.FromName, pubKey.Type())
+	}
+
+	return Permit{
+		Params: params,
+		Signature: PermitSignature{
+			PubKey: PermitPubKey{
+				Type:  "tendermint/PubKeySecp256k1",
+				Value: pubKey.Bytes(),
+			},
+			Signature: signature,
+		},
+	}, nil
+}
+
+// readPermit reads the permit in the file of the --permit flag, or returns nil if the flag isn't set
+func readPermit(cmd *cobra.Command) (*Permit, error) {
+	path, _ := cmd.Flags().GetString(flagPermit)
+	if path == "" {
+		return nil, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var permit Permit
+	if err := json.Unmarshal(bz, &permit); err != nil {
+		return nil, fmt.Errorf("invalid permit in %s: %w", path, err)
+	}
+	return &permit, nil
+}
+
+// permitOrViewingKeyArgs validates the args of queries that are authenticated by the [account] [viewing_key] args,
+// or without them by --permit
+func permitOrViewingKeyArgs(optionalArgs int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if path, _ := cmd.Flags().GetString(flagPermit); path != "" {
+			return cobra.RangeArgs(1, 1+optionalArgs)(cmd, args)
+		}
+		return cobra.RangeArgs(3, 3+optionalArgs)(cmd, args)
+	}
+}
+
+func addPermitFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagPermit, "", "Path of a query permit to query with instead of an account and viewing key, "+
+		"which you can sign with the create-permit command")
+}
+
+func queryWithPermitMsg(permit Permit, query interface{}) ([]byte, error) {
+	msg := WithPermitMsg{
+		WithPermit: WithPermitMsgInner{
+			Permit: permit,
+			Query:  query,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
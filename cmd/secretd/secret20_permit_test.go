@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/app"
+)
+
+const (
+	testMnemonic = "angry twist harsh drastic left brass behave host shove marriage fall update business leg direct reward object ugly security warm tuna model broccoli choice"
+	testToken    = "secret1k0jntykt7e4g3y88ltc60czgjuqdy4c9e8fzek"
+)
+
+// newPermitContext returns a client context that signs with the key of testMnemonic at the HD path of Secret, which is
+// the key of a Keplr account
+func newPermitContext(t *testing.T) client.Context {
+	kr := keyring.NewInMemory(app.MakeEncodingConfig().Codec)
+	_, err := kr.NewAccount("keplr", testMnemonic, "", "m/44'/529'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	return client.Context{}.WithKeyring(kr).WithFromName("keplr")
+}
+
+var testPermitParams = PermitParams{
+	PermitName:    "test",
+	AllowedTokens: []string{testToken},
+	ChainID:       "secret-4",
+	Permissions:   []string{"balance", "history"},
+}
+
+func TestPermitSignBytes(t *testing.T) {
+	signBytes, err := permitSignBytes(testPermitParams)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"secret-4","fee":{"amount":[{"amount":"0","denom":"uscrt"}],"gas":"1"},"memo":"",`+
+			`"msgs":[{"type":"query_permit","value":{"allowed_tokens":["`+testToken+`"],"permissions":["balance","history"],"permit_name":"test"}}],"sequence":"0"}`,
+		string(signBytes),
+	)
+}
+
+// TestSignPermitKnownAnswer checks a permit of a Keplr account. The expected permit was computed apart from this
+// package, by signing the SNIP-24 document of the params like Keplr does (RFC 6979 over its SHA-256 hash, with a low S)
+func TestSignPermitKnownAnswer(t *testing.T) {
+	permit, err := signPermit(newPermitContext(t), testPermitParams)
+	require.NoError(t, err)
+
+	jsonPermit, err := json.Marshal(permit)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"params": {
+			"permit_name": "test",
+			"allowed_tokens": ["`+testToken+`"],
+			"chain_id": "secret-4",
+			"permissions": ["balance", "history"]
+		},
+		"signature": {
+			"pub_key": {
+				"type": "tendermint/PubKeySecp256k1",
+				"value": "A+q7ZT03rzoOwbP5R+zuVpiWs1xzfsxMbQIGZj4mGr4K"
+			},
+			"signature": "41CL30RvodpMTr1439Cy3W+CjHJh/0IGyd+mx9qCzLofFxJjOFaO9ORUGcUwgUMolzj2dZZziuOimKD71HuBJg=="
+		}
+	}`, string(jsonPermit))
+}
+
+func TestSignPermitInvalidParams(t *testing.T) {
+	cliCtx := newPermitContext(t)
+
+	for name, change := range map[string]func(*PermitParams){
+		"no name":            func(p *PermitParams) { p.PermitName = "" },
+		"no chain":           func(p *PermitParams) { p.ChainID = "" },
+		"no permissions":     func(p *PermitParams) { p.Permissions = nil },
+		"unknown permission": func(p *PermitParams) { p.Permissions = []string{"balance", "mint"} },
+	} {
+		t.Run(name, func(t *testing.T) {
+			params := testPermitParams
+			params.Permissions = append([]string{}, testPermitParams.Permissions...)
+			change(&params)
+			_, err := signPermit(cliCtx, params)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := signPermit(cliCtx.WithFromName("unknown"), testPermitParams)
+	require.Error(t, err)
+}
+
+func TestQueryWithPermitMsg(t *testing.T) {
+	permit, err := signPermit(newPermitContext(t), testPermitParams)
+	require.NoError(t, err)
+
+	msg, err := queryWithPermitMsg(permit, PermitAllowanceQuery{
+		Allowance: PermitAllowanceQueryInner{Owner: mustAccAddress(t, testOwner), Spender: mustAccAddress(t, testSpender)},
+	})
+	require.NoError(t, err)
+	require.Zero(t, len(msg)%MessageBlockSize)
+
+	var query struct {
+		WithPermit struct {
+			Permit Permit          `json:"permit"`
+			Query  json.RawMessage `json:"query"`
+		} `json:"with_permit"`
+	}
+	require.NoError(t, json.Unmarshal(msg, &query))
+	require.Equal(t, permit, query.WithPermit.Permit)
+	require.JSONEq(t, `{"allowance":{"owner":"`+testOwner+`","spender":"`+testSpender+`"}}`, string(query.WithPermit.Query))
+}
+
+func TestReadPermit(t *testing.T) {
+	permit, err := signPermit(newPermitContext(t), testPermitParams)
+	require.NoError(t, err)
+	jsonPermit, err := json.Marshal(permit)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "permit.json")
+	require.NoError(t, os.WriteFile(path, jsonPermit, 0o600))
+
+	cmd := &cobra.Command{}
+	addPermitFlag(cmd)
+
+	read, err := readPermit(cmd)
+	require.NoError(t, err)
+	require.Nil(t, read, "without --permit, queries use viewing keys")
+
+	require.NoError(t, cmd.Flags().Set(flagPermit, path))
+	read, err = readPermit(cmd)
+	require.NoError(t, err)
+	require.Equal(t, permit, *read)
+}
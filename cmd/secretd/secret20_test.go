@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	scrt "github.com/scrtlabs/SecretNetwork/types"
+)
+
+const (
+	testOwner     = "secret1qyqszqgpqyqszqgpqyqszqgpqyqszqgpsk4hsq"
+	testSpender   = "secret1qgpqyqszqgpqyqszqgpqyqszqgpqyqszpjnjmk"
+	testRecipient = "secret1qvpsxqcrqvpsxqcrqvpsxqcrqvpsxqcrqzjn3h"
+)
+
+func TestMain(m *testing.M) {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(scrt.Bech32PrefixAccAddr, scrt.Bech32PrefixAccPub)
+	os.Exit(m.Run())
+}
+
+func mustAccAddress(t *testing.T, address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	require.NoError(t, err)
+	return addr
+}
+
+// requirePaddedJSON checks that msg is the json, padded with spaces to a multiple of MessageBlockSize
+func requirePaddedJSON(t *testing.T, json string, msg []byte, msgAndArgs ...interface{}) {
+	require.Zero(t, len(msg)%MessageBlockSize, "messages are padded to blocks of %d bytes", MessageBlockSize)
+	require.Equal(t, json, strings.TrimRight(string(msg), " "), msgAndArgs...)
+}
+
+func TestS20AllowanceMsgs(t *testing.T) {
+	owner := mustAccAddress(t, testOwner)
+	spender := mustAccAddress(t, testSpender)
+	recipient := mustAccAddress(t, testRecipient)
+	expiration := uint64(1700000000)
+
+	msg, err := queryAllowanceMsg(owner, spender, "api_key_1")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"allowance":{"owner":"`+testOwner+`","spender":"`+testSpender+`","key":"api_key_1"}}`, msg)
+
+	msg, err = handleIncreaseAllowanceMsg(spender, "100", nil)
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"increase_allowance":{"spender":"`+testSpender+`","amount":"100"}}`, msg)
+
+	msg, err = handleIncreaseAllowanceMsg(spender, "100", &expiration)
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"increase_allowance":{"spender":"`+testSpender+`","amount":"100","expiration":1700000000}}`, msg)
+
+	msg, err = handleDecreaseAllowanceMsg(spender, "50", &expiration)
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"decrease_allowance":{"spender":"`+testSpender+`","amount":"50","expiration":1700000000}}`, msg)
+
+	msg, err = handleTransferFromMsg(owner, recipient, "10")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"transfer_from":{"owner":"`+testOwner+`","recipient":"`+testRecipient+`","amount":"10"}}`, msg)
+
+	msg, err = handleSendFromMsg(owner, recipient, "10", "")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"send_from":{"owner":"`+testOwner+`","recipient":"`+testRecipient+`","amount":"10"}}`, msg)
+
+	msg, err = handleSendFromMsg(owner, recipient, "10", "eyJhIjoxfQ==")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"send_from":{"owner":"`+testOwner+`","recipient":"`+testRecipient+`","amount":"10","msg":"eyJhIjoxfQ=="}}`, msg)
+
+	msg, err = handleBatchTransferMsg([]TransferMsgInner{
+		{Recipient: spender, Amount: "1"},
+		{Recipient: recipient, Amount: "2"},
+	})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"batch_transfer":{"actions":[{"recipient":"`+testSpender+`","amount":"1"},{"recipient":"`+testRecipient+`","amount":"2"}]}}`, msg)
+}
+
+func TestS20MintMsg(t *testing.T) {
+	msg, err := handleMintMsg(mustAccAddress(t, testRecipient), "1000000")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"mint":{"recipient":"`+testRecipient+`","amount":"1000000"}}`, msg)
+}
+
+func TestS20PublicQueryMsgs(t *testing.T) {
+	msg, err := queryPublicMsg("token_info")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"token_info":{}}`, msg)
+
+	msg, err = queryPublicMsg("minters")
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"minters":{}}`, msg)
+}
@@ -0,0 +1,694 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagOwner           = "owner"
+	flagPublicMetadata  = "public-metadata"
+	flagPrivateMetadata = "private-metadata"
+	flagIncludeExpired  = "include-expired"
+	flagStartAfter      = "start-after"
+	flagLimit           = "limit"
+)
+
+// S721GetQueryCmd returns the cli query commands of SNIP-721 contracts
+func S721GetQueryCmd() *cobra.Command {
+	s721QueryCmd := &cobra.Command{
+		Use:                        "snip721",
+		Short:                      "Querying commands for the secret NFT (SNIP-721) contracts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	s721QueryCmd.AddCommand(
+		S721OwnerOfCmd(),
+		S721NftInfoCmd(),
+		S721PrivateMetadataCmd(),
+		S721TokensCmd(),
+		S721TransactionHistoryCmd(),
+	)
+
+	return s721QueryCmd
+}
+
+// S721GetTxCmd returns the transaction commands of SNIP-721 contracts
+func S721GetTxCmd() *cobra.Command {
+	s721TxCmd := &cobra.Command{
+		Use:                        "snip721",
+		Short:                      "Snip721 transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	s721TxCmd.AddCommand(
+		s721MintCmd(),
+		s721TransferCmd(),
+		s721SendCmd(),
+		s721ApproveCmd(),
+		s721RevokeCmd(),
+		s20CreatingViewingKey(),
+		s20SetViewingKey(),
+		createPermitCmd([]string{"owner"}),
+		revokePermitCmd(),
+	)
+
+	return s721TxCmd
+}
+
+// viewerArgs validates the args of queries that take a token and are optionally authenticated by the
+// [viewer] [viewing_key] args, or by --permit
+func viewerArgs(cmd *cobra.Command, args []string) error {
+	if path, _ := cmd.Flags().GetString(flagPermit); path != "" {
+		return cobra.ExactArgs(2)(cmd, args)
+	}
+	if len(args) != 2 && len(args) != 4 {
+		return fmt.Errorf("accepts 2 args, or 4 with a viewer and viewing key, received %d", len(args))
+	}
+	return nil
+}
+
+// parseViewer returns the viewer of the [viewer] [viewing_key] args, if they are set
+func parseViewer(args []string) (*ViewerInfo, error) {
+	if len(args) < 2 {
+		return nil, nil
+	}
+
+	viewer, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, errors.New("invalid viewer address")
+	}
+	if args[1] == "" {
+		return nil, errors.New("viewing key must not be empty")
+	}
+	return &ViewerInfo{Address: viewer, ViewingKey: args[1]}, nil
+}
+
+func S721OwnerOfCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-of [contract address or label] [token_id] [optional: viewer] [optional: viewing_key]",
+		Short: "See the owner of a token, and its approvals",
+		Long: `See the owner of a token and its approvals. Private owners are only shown to the viewers that the owner allowed.
+With --permit, the viewer and viewing key are left out`,
+		Args: viewerArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			viewer, err := parseViewer(args[2:])
+			if err != nil {
+				return err
+			}
+			includeExpired, _ := cmd.Flags().GetBool(flagIncludeExpired)
+
+			query := OwnerOfQuery{
+				OwnerOf: OwnerOfQueryInner{
+					TokenID:        args[1],
+					Viewer:         viewer,
+					IncludeExpired: includeExpired,
+				},
+			}
+			queryData, err := s721QueryMsg(cmd, query)
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	addPermitFlag(cmd)
+	cmd.Flags().Bool(flagIncludeExpired, false, "Also show the approvals that expired")
+
+	return cmd
+}
+
+func S721NftInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-info [contract address or label] [token_id]",
+		Short: "See the public metadata of a token",
+		Long:  `See the public metadata of a token`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			queryData, err := padMsg(NftInfoQuery{NftInfo: NftInfoQueryInner{TokenID: args[1]}})
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	return cmd
+}
+
+func S721PrivateMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "private-metadata [contract address or label] [token_id] [optional: viewer] [optional: viewing_key]",
+		Short: "See the private metadata of a token",
+		Long: `See the private metadata of a token, which is only shown to the viewers that the owner allowed.
+With --permit, the viewer and viewing key are left out`,
+		Args: viewerArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			viewer, err := parseViewer(args[2:])
+			if err != nil {
+				return err
+			}
+
+			query := PrivateMetadataQuery{
+				PrivateMetadata: PrivateMetadataQueryInner{
+					TokenID: args[1],
+					Viewer:  viewer,
+				},
+			}
+			queryData, err := s721QueryMsg(cmd, query)
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	addPermitFlag(cmd)
+
+	return cmd
+}
+
+func S721TokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens [contract address or label] [owner] [optional: viewing_key]",
+		Short: "See the tokens of an owner",
+		Long: `See the IDs of the tokens of an owner. Without a viewing key of the owner or --permit, only the tokens that the owner
+made public are shown`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if path, _ := cmd.Flags().GetString(flagPermit); path != "" {
+				return cobra.ExactArgs(2)(cmd, args)
+			}
+			return cobra.RangeArgs(2, 3)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid owner address")
+			}
+
+			query := TokensQuery{Tokens: TokensQueryInner{Owner: owner}}
+			if len(args) > 2 {
+				query.Tokens.ViewingKey = args[2]
+			}
+			query.Tokens.StartAfter, _ = cmd.Flags().GetString(flagStartAfter)
+			if cmd.Flags().Changed(flagLimit) {
+				limit, _ := cmd.Flags().GetUint32(flagLimit)
+				query.Tokens.Limit = &limit
+			}
+
+			queryData, err := s721QueryMsg(cmd, query)
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	addPermitFlag(cmd)
+	cmd.Flags().String(flagStartAfter, "", "Only show the tokens after this token ID")
+	cmd.Flags().Uint32(flagLimit, 30, "The number of tokens to show")
+
+	return cmd
+}
+
+func S721TransactionHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "txs [contract address or label] [account] [viewing_key] [optional: page, default: 0] [optional: page_size, default: 10]",
+		Short: "View your transaction history",
+		Long: `Print out the mints, transfers and burns you have been a part of.
+With --permit, the account and viewing key are left out: txs [contract address] [optional: page] [optional: page_size]`,
+		Args: permitOrViewingKeyArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			permit, err := readPermit(cmd)
+			if err != nil {
+				return err
+			}
+
+			var queryData []byte
+			if permit != nil {
+				page, pageSize, _, err := parseHistoryArgs(args[1:])
+				if err != nil {
+					return err
+				}
+				queryData, err = queryWithPermitMsg(*permit, S721TransactionHistoryQuery{
+					TransactionHistory: S721TransactionHistoryQueryInner{Page: page, PageSize: pageSize},
+				})
+				if err != nil {
+					return err
+				}
+			} else {
+				addr, err := sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+
+				key := args[2]
+				if key == "" {
+					return errors.New("viewing key must not be empty")
+				}
+
+				page, pageSize, _, err := parseHistoryArgs(args[3:])
+				if err != nil {
+					return err
+				}
+				queryData, err = padMsg(S721TransactionHistoryQuery{
+					TransactionHistory: S721TransactionHistoryQueryInner{
+						Address:    addr,
+						ViewingKey: key,
+						Page:       page,
+						PageSize:   pageSize,
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	addPermitFlag(cmd)
+
+	return cmd
+}
+
+func s721MintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [contract address or label] [optional: token_id]",
+		Short: "Mint a new token",
+		Long: `Mint a new token, to the --owner or to yourself. The contract picks the ID of the token if it isn't set.
+The metadata are JSON, e.g. --public-metadata '{"token_uri":"https://..."}'. This command will only work for the minters of the contract`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			mint := MintNftMsgInner{}
+			if len(args) > 1 {
+				mint.TokenID = args[1]
+			}
+
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				mint.Owner, err = sdk.AccAddressFromBech32(owner)
+				if err != nil {
+					return errors.New("invalid owner address")
+				}
+			}
+
+			mint.PublicMetadata, err = metadataFlag(cmd, flagPublicMetadata)
+			if err != nil {
+				return err
+			}
+			mint.PrivateMetadata, err = metadataFlag(cmd, flagPrivateMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg, err := padMsg(MintNftMsg{MintNft: mint})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagOwner, "", "The address to mint the token to, yourself if not set")
+	cmd.Flags().String(flagPublicMetadata, "", "The public metadata of the token, as JSON")
+	cmd.Flags().String(flagPrivateMetadata, "", "The private metadata of the token, as JSON")
+
+	return cmd
+}
+
+// metadataFlag returns the JSON metadata of a flag, or nil if it isn't set
+func metadataFlag(cmd *cobra.Command, flag string) (json.RawMessage, error) {
+	metadata, _ := cmd.Flags().GetString(flag)
+	if metadata == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(metadata)) {
+		return nil, fmt.Errorf("--%s must be JSON", flag)
+	}
+	return json.RawMessage(metadata), nil
+}
+
+func s721TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [contract address or label] [to account] [token_id]",
+		Short: "Transfer a token to another address",
+		Long:  `Transfer a token that you own, or that you were approved to transfer, to another address`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			msg, err := padMsg(TransferNftMsg{TransferNft: TransferNftMsgInner{Recipient: toAddr, TokenID: args[2]}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s721SendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [contract address or label] [to contract] [token_id] [optional: callback_message]",
+		Short: "Send a token to a contract. Optionally add a callback message",
+		Long: `Send a token to a contract, which is notified of the token if it registered to receive it. The callback message
+is passed to the receiving contract as-is.`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := addressFromBechOrLabel(args[1], cliCtx)
+			if err != nil {
+				return errors.New("invalid recipient contract")
+			}
+
+			send := SendNftMsgInner{Contract: toAddr, TokenID: args[2]}
+			if len(args) > 3 {
+				send.Msg = []byte(args[3])
+			}
+			msg, err := padMsg(SendNftMsg{SendNft: send})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s721ApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [contract address or label] [spender] [token_id]",
+		Short: "Allow a spender to transfer a token of yours",
+		Long:  `Allow a spender to transfer and send a token of yours. Optionally set when the approval expires, in seconds since the epoch`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid spender address")
+			}
+
+			approve := ApproveMsgInner{Spender: spender, TokenID: args[2]}
+			if cmd.Flags().Changed(flagExpiration) {
+				expiration, _ := cmd.Flags().GetUint64(flagExpiration)
+				approve.Expires = &ExpirationAtTime{AtTime: expiration}
+			}
+
+			msg, err := padMsg(ApproveMsg{Approve: approve})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagExpiration, 0, "When the approval expires, in seconds since the epoch")
+
+	return cmd
+}
+
+func s721RevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [contract address or label] [spender] [token_id]",
+		Short: "Revoke the approval of a spender to transfer a token of yours",
+		Long:  `Revoke the approval of a spender to transfer and send a token of yours`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid spender address")
+			}
+
+			msg, err := padMsg(RevokeMsg{Revoke: RevokeMsgInner{Spender: spender, TokenID: args[2]}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+type ViewerInfo struct {
+	Address    sdk.AccAddress `json:"address"`
+	ViewingKey string         `json:"viewing_key"`
+}
+
+type OwnerOfQuery struct {
+	OwnerOf OwnerOfQueryInner `json:"owner_of"`
+}
+
+type OwnerOfQueryInner struct {
+	TokenID        string      `json:"token_id"`
+	Viewer         *ViewerInfo `json:"viewer,omitempty"`
+	IncludeExpired bool        `json:"include_expired,omitempty"`
+}
+
+type NftInfoQuery struct {
+	NftInfo NftInfoQueryInner `json:"nft_info"`
+}
+
+type NftInfoQueryInner struct {
+	TokenID string `json:"token_id"`
+}
+
+type PrivateMetadataQuery struct {
+	PrivateMetadata PrivateMetadataQueryInner `json:"private_metadata"`
+}
+
+type PrivateMetadataQueryInner struct {
+	TokenID string      `json:"token_id"`
+	Viewer  *ViewerInfo `json:"viewer,omitempty"`
+}
+
+type TokensQuery struct {
+	Tokens TokensQueryInner `json:"tokens"`
+}
+
+type TokensQueryInner struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	ViewingKey string         `json:"viewing_key,omitempty"`
+	StartAfter string         `json:"start_after,omitempty"`
+	Limit      *uint32        `json:"limit,omitempty"`
+}
+
+type S721TransactionHistoryQuery struct {
+	TransactionHistory S721TransactionHistoryQueryInner `json:"transaction_history"`
+}
+
+type S721TransactionHistoryQueryInner struct {
+	Address    sdk.AccAddress `json:"address,omitempty"`
+	ViewingKey string         `json:"viewing_key,omitempty"`
+	Page       uint32         `json:"page"`
+	PageSize   uint32         `json:"page_size"`
+}
+
+type MintNftMsg struct {
+	MintNft MintNftMsgInner `json:"mint_nft"`
+}
+
+type MintNftMsgInner struct {
+	TokenID         string          `json:"token_id,omitempty"`
+	Owner           sdk.AccAddress  `json:"owner,omitempty"`
+	PublicMetadata  json.RawMessage `json:"public_metadata,omitempty"`
+	PrivateMetadata json.RawMessage `json:"private_metadata,omitempty"`
+}
+
+type TransferNftMsg struct {
+	TransferNft TransferNftMsgInner `json:"transfer_nft"`
+}
+
+type TransferNftMsgInner struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	TokenID   string         `json:"token_id"`
+}
+
+type SendNftMsg struct {
+	SendNft SendNftMsgInner `json:"send_nft"`
+}
+
+type SendNftMsgInner struct {
+	Contract sdk.AccAddress `json:"contract"`
+	TokenID  string         `json:"token_id"`
+	// Msg is a binary, which is base64 in JSON
+	Msg []byte `json:"msg,omitempty"`
+}
+
+type ApproveMsg struct {
+	Approve ApproveMsgInner `json:"approve"`
+}
+
+type ApproveMsgInner struct {
+	Spender sdk.AccAddress    `json:"spender"`
+	TokenID string            `json:"token_id"`
+	Expires *ExpirationAtTime `json:"expires,omitempty"`
+}
+
+type ExpirationAtTime struct {
+	AtTime uint64 `json:"at_time"`
+}
+
+type RevokeMsg struct {
+	Revoke RevokeMsgInner `json:"revoke"`
+}
+
+type RevokeMsgInner struct {
+	Spender sdk.AccAddress `json:"spender"`
+	TokenID string         `json:"token_id"`
+}
+
+// s721QueryMsg returns a query, which is wrapped with the permit of the --permit flag if it is set. Queries with
+// permits leave out the viewer, which the permit authenticates
+func s721QueryMsg(cmd *cobra.Command, query interface{}) ([]byte, error) {
+	permit, err := readPermit(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if permit != nil {
+		return queryWithPermitMsg(*permit, query)
+	}
+	return padMsg(query)
+}
+
+func padMsg(msg interface{}) ([]byte, error) {
+	jsonMsg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseViewer(t *testing.T) {
+	viewer, err := parseViewer(nil)
+	require.NoError(t, err)
+	require.Nil(t, viewer)
+
+	viewer, err = parseViewer([]string{testOwner, "api_key_1"})
+	require.NoError(t, err)
+	require.Equal(t, &ViewerInfo{Address: mustAccAddress(t, testOwner), ViewingKey: "api_key_1"}, viewer)
+
+	_, err = parseViewer([]string{"secret1invalid", "api_key_1"})
+	require.Error(t, err)
+	_, err = parseViewer([]string{testOwner, ""})
+	require.Error(t, err)
+}
+
+func TestViewerArgs(t *testing.T) {
+	cmd := &cobra.Command{}
+	addPermitFlag(cmd)
+
+	require.NoError(t, viewerArgs(cmd, []string{"contract", "1"}))
+	require.NoError(t, viewerArgs(cmd, []string{"contract", "1", testOwner, "api_key_1"}))
+	require.Error(t, viewerArgs(cmd, []string{"contract", "1", testOwner}))
+
+	require.NoError(t, cmd.Flags().Set(flagPermit, "permit.json"))
+	require.NoError(t, viewerArgs(cmd, []string{"contract", "1"}))
+	require.Error(t, viewerArgs(cmd, []string{"contract", "1", testOwner, "api_key_1"}), "permits replace the viewer")
+}
+
+func TestMetadataFlag(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String(flagPublicMetadata, "", "")
+
+	metadata, err := metadataFlag(cmd, flagPublicMetadata)
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+
+	require.NoError(t, cmd.Flags().Set(flagPublicMetadata, `{"token_uri":"https://example.com/1"}`))
+	metadata, err = metadataFlag(cmd, flagPublicMetadata)
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`{"token_uri":"https://example.com/1"}`), metadata)
+
+	require.NoError(t, cmd.Flags().Set(flagPublicMetadata, `{"token_uri":`))
+	_, err = metadataFlag(cmd, flagPublicMetadata)
+	require.Error(t, err)
+}
+
+func TestS721QueryMsgs(t *testing.T) {
+	owner := mustAccAddress(t, testOwner)
+	cmd := &cobra.Command{}
+	addPermitFlag(cmd)
+
+	msg, err := s721QueryMsg(cmd, OwnerOfQuery{OwnerOf: OwnerOfQueryInner{TokenID: "1"}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"owner_of":{"token_id":"1"}}`, msg)
+
+	msg, err = s721QueryMsg(cmd, OwnerOfQuery{OwnerOf: OwnerOfQueryInner{
+		TokenID:        "1",
+		Viewer:         &ViewerInfo{Address: owner, ViewingKey: "api_key_1"},
+		IncludeExpired: true,
+	}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"owner_of":{"token_id":"1","viewer":{"address":"`+testOwner+`","viewing_key":"api_key_1"},"include_expired":true}}`, msg)
+
+	msg, err = padMsg(NftInfoQuery{NftInfo: NftInfoQueryInner{TokenID: "1"}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"nft_info":{"token_id":"1"}}`, msg)
+
+	msg, err = s721QueryMsg(cmd, PrivateMetadataQuery{PrivateMetadata: PrivateMetadataQueryInner{
+		TokenID: "1",
+		Viewer:  &ViewerInfo{Address: owner, ViewingKey: "api_key_1"},
+	}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"private_metadata":{"token_id":"1","viewer":{"address":"`+testOwner+`","viewing_key":"api_key_1"}}}`, msg)
+
+	msg, err = s721QueryMsg(cmd, TokensQuery{Tokens: TokensQueryInner{Owner: owner}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"tokens":{"owner":"`+testOwner+`"}}`, msg)
+
+	limit := uint32(10)
+	msg, err = s721QueryMsg(cmd, TokensQuery{Tokens: TokensQueryInner{Owner: owner, ViewingKey: "api_key_1", StartAfter: "5", Limit: &limit}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"tokens":{"owner":"`+testOwner+`","viewing_key":"api_key_1","start_after":"5","limit":10}}`, msg)
+
+	msg, err = padMsg(S721TransactionHistoryQuery{TransactionHistory: S721TransactionHistoryQueryInner{
+		Address:    owner,
+		ViewingKey: "api_key_1",
+		Page:       1,
+		PageSize:   10,
+	}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"transaction_history":{"address":"`+testOwner+`","viewing_key":"api_key_1","page":1,"page_size":10}}`, msg)
+}
+
+func TestS721QueryMsgWithPermit(t *testing.T) {
+	params := testPermitParams
+	params.Permissions = []string{"owner"}
+	permit, err := signPermit(newPermitContext(t), params)
+	require.NoError(t, err)
+	jsonPermit, err := json.Marshal(permit)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "permit.json")
+	require.NoError(t, os.WriteFile(path, jsonPermit, 0o600))
+
+	cmd := &cobra.Command{}
+	addPermitFlag(cmd)
+	require.NoError(t, cmd.Flags().Set(flagPermit, path))
+
+	for _, tc := range []struct {
+		query    interface{}
+		expected string
+	}{
+		{OwnerOfQuery{OwnerOf: OwnerOfQueryInner{TokenID: "1"}}, `{"owner_of":{"token_id":"1"}}`},
+		{PrivateMetadataQuery{PrivateMetadata: PrivateMetadataQueryInner{TokenID: "1"}}, `{"private_metadata":{"token_id":"1"}}`},
+		{S721TransactionHistoryQuery{TransactionHistory: S721TransactionHistoryQueryInner{PageSize: 10}}, `{"transaction_history":{"page":0,"page_size":10}}`},
+	} {
+		msg, err := s721QueryMsg(cmd, tc.query)
+		require.NoError(t, err)
+		require.Zero(t, len(msg)%MessageBlockSize)
+
+		var withPermit struct {
+			WithPermit struct {
+				Permit Permit          `json:"permit"`
+				Query  json.RawMessage `json:"query"`
+			} `json:"with_permit"`
+		}
+		require.NoError(t, json.Unmarshal(msg, &withPermit))
+		require.Equal(t, permit, withPermit.WithPermit.Permit)
+		require.JSONEq(t, tc.expected, string(withPermit.WithPermit.Query))
+	}
+}
+
+func TestS721TxMsgs(t *testing.T) {
+	owner := mustAccAddress(t, testOwner)
+	spender := mustAccAddress(t, testSpender)
+	recipient := mustAccAddress(t, testRecipient)
+
+	msg, err := padMsg(MintNftMsg{MintNft: MintNftMsgInner{}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"mint_nft":{}}`, msg, "the contract picks the ID and mints to the sender")
+
+	msg, err = padMsg(MintNftMsg{MintNft: MintNftMsgInner{
+		TokenID:         "1",
+		Owner:           owner,
+		PublicMetadata:  json.RawMessage(`{"token_uri":"https://example.com/1"}`),
+		PrivateMetadata: json.RawMessage(`{"extension":{"name":"secret"}}`),
+	}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"mint_nft":{"token_id":"1","owner":"`+testOwner+`","public_metadata":{"token_uri":"https://example.com/1"},"private_metadata":{"extension":{"name":"secret"}}}}`, msg)
+
+	msg, err = padMsg(TransferNftMsg{TransferNft: TransferNftMsgInner{Recipient: recipient, TokenID: "1"}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"transfer_nft":{"recipient":"`+testRecipient+`","token_id":"1"}}`, msg)
+
+	msg, err = padMsg(SendNftMsg{SendNft: SendNftMsgInner{Contract: recipient, TokenID: "1"}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"send_nft":{"contract":"`+testRecipient+`","token_id":"1"}}`, msg)
+
+	// the callback message is Binary, which the contract receives base64 encoded
+	msg, err = padMsg(SendNftMsg{SendNft: SendNftMsgInner{Contract: recipient, TokenID: "1", Msg: []byte(`{"a":1}`)}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"send_nft":{"contract":"`+testRecipient+`","token_id":"1","msg":"eyJhIjoxfQ=="}}`, msg)
+
+	msg, err = padMsg(ApproveMsg{Approve: ApproveMsgInner{Spender: spender, TokenID: "1"}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"approve":{"spender":"`+testSpender+`","token_id":"1"}}`, msg)
+
+	msg, err = padMsg(ApproveMsg{Approve: ApproveMsgInner{Spender: spender, TokenID: "1", Expires: &ExpirationAtTime{AtTime: 1700000000}}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"approve":{"spender":"`+testSpender+`","token_id":"1","expires":{"at_time":1700000000}}}`, msg)
+
+	msg, err = padMsg(RevokeMsg{Revoke: RevokeMsgInner{Spender: spender, TokenID: "1"}})
+	require.NoError(t, err)
+	requirePaddedJSON(t, `{"revoke":{"spender":"`+testSpender+`","token_id":"1"}}`, msg)
+}
@@ -1,4 +1,4 @@
-use crate::msg::{ExecuteMsg, InstantiateMsg, QueryMsg};
+use crate::msg::{ExecuteMsg, IbcChannelUpgradeMsg, InstantiateMsg, QueryMsg, SudoMsg};
 use crate::state::{count, count_read};
 use cosmwasm_std::{
     entry_point, to_binary, Binary, CosmosMsg, Deps, DepsMut, Empty, Env, Event,
@@ -11,6 +11,7 @@ use serde::{Deserialize, Serialize};
 use serde_json_wasm as serde_json;
 
 pub const IBC_APP_VERSION: &str = "ibc-v1";
+pub const IBC_UPGRADE_VERSION: &str = "ibc-v2";
 
 #[entry_point]
 pub fn instantiate(
@@ -88,6 +89,33 @@ pub fn ibc_channel_open(
     }))
 }
 
+#[entry_point]
+pub fn sudo(deps: DepsMut, _env: Env, msg: SudoMsg) -> StdResult<Response> {
+    match msg {
+        SudoMsg::IbcChannelUpgrade(IbcChannelUpgradeMsg::Init { proposed, .. })
+        | SudoMsg::IbcChannelUpgrade(IbcChannelUpgradeMsg::Try { proposed, .. }) => {
+            if proposed.version != IBC_UPGRADE_VERSION {
+                return Err(StdError::generic_err("Unsupported upgrade version"));
+            }
+            count(deps.storage).save(&11)?
+        }
+        SudoMsg::IbcChannelUpgrade(IbcChannelUpgradeMsg::Ack {
+            counterparty_version,
+            ..
+        }) => {
+            if counterparty_version != IBC_UPGRADE_VERSION {
+                return Err(StdError::generic_err("Unsupported upgrade version"));
+            }
+            count(deps.storage).save(&12)?
+        }
+        SudoMsg::IbcChannelUpgrade(IbcChannelUpgradeMsg::Open { .. }) => {
+            count(deps.storage).save(&13)?
+        }
+    }
+
+    Ok(Response::default())
+}
+
 pub fn increment(deps: DepsMut, c: u64) -> StdResult<Response> {
     let new_count = count_read(deps.storage).load()? + c;
     count(deps.storage).save(&new_count)?;
@@ -1,3 +1,4 @@
+use cosmwasm_std::{IbcChannel, IbcOrder};
 use schemars::JsonSchema;
 use serde::{Deserialize, Serialize};
 
@@ -18,3 +19,36 @@ pub enum ExecuteMsg {
 pub enum QueryMsg {
     Q {},
 }
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum SudoMsg {
+    IbcChannelUpgrade(IbcChannelUpgradeMsg),
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum IbcChannelUpgradeMsg {
+    Init {
+        channel: IbcChannel,
+        proposed: IbcUpgradeFields,
+    },
+    Try {
+        channel: IbcChannel,
+        proposed: IbcUpgradeFields,
+    },
+    Ack {
+        channel: IbcChannel,
+        counterparty_version: String,
+    },
+    Open {
+        channel: IbcChannel,
+    },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct IbcUpgradeFields {
+    pub order: IbcOrder,
+    pub version: String,
+    pub connection_id: String,
+}
@@ -658,7 +658,8 @@ pub fn handle(
         | HandleType::HANDLE_TYPE_IBC_PACKET_TIMEOUT
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK
-        | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => {
+        | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT
+        | HandleType::HANDLE_TYPE_IBC_CHANNEL_UPGRADE => {
             versioned_env.set_msg_sender("")
         }
     }
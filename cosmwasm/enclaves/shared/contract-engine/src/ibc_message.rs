@@ -1,6 +1,6 @@
 use crate::message_utils::try_get_decrypted_secret_msg;
 use crate::types::{ParsedMessage, SecretMessage};
-use cw_types_v1::ibc::IbcPacketReceiveMsg;
+use cw_types_v1::ibc::{IbcChannelUpgradeSudoMsg, IbcPacketReceiveMsg};
 use enclave_ffi_types::EnclaveError;
 use log::{trace, warn};
 
@@ -22,6 +22,21 @@ pub fn parse_plaintext_ibc_protocol_message(
     })
 }
 
+// The channel upgrade callbacks go to the contract's `sudo` entry point, which can't be verified.
+// Make sure the host can only use this handle type to send an `ibc_channel_upgrade` message.
+pub fn parse_ibc_channel_upgrade_message(message: &[u8]) -> Result<ParsedMessage, EnclaveError> {
+    if let Err(err) = serde_json::from_slice::<IbcChannelUpgradeSudoMsg>(message) {
+        warn!(
+            "Got an error while trying to deserialize input bytes msg into IbcChannelUpgradeSudoMsg message {:?}: {}",
+            String::from_utf8_lossy(message),
+            err
+        );
+        return Err(EnclaveError::FailedToDeserialize);
+    }
+
+    parse_plaintext_ibc_protocol_message(message)
+}
+
 pub fn parse_ibc_receive_message(message: &[u8]) -> Result<ParsedMessage, EnclaveError> {
     // TODO: Maybe mark whether the message was encrypted or not.
     let mut parsed_encrypted_ibc_packet: IbcPacketReceiveMsg =
@@ -5,8 +5,8 @@ use enclave_ffi_types::EnclaveError;
 
 use crate::execute_message::parse_execute_message;
 use crate::ibc_message::{
-    parse_ibc_receive_message, parse_plaintext_ibc_protocol_message,
-    parse_plaintext_ibc_validated_message,
+    parse_ibc_channel_upgrade_message, parse_ibc_receive_message,
+    parse_plaintext_ibc_protocol_message, parse_plaintext_ibc_validated_message,
 };
 use crate::reply_message::parse_reply_message;
 use crate::types::ParsedMessage;
@@ -38,6 +38,7 @@ pub fn parse_message(
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => {
             parse_plaintext_ibc_validated_message(message)
         }
+        HandleType::HANDLE_TYPE_IBC_CHANNEL_UPGRADE => parse_ibc_channel_upgrade_message(message),
     }
 }
 
@@ -50,5 +51,6 @@ pub fn is_ibc_msg(handle_type: HandleType) -> bool {
             | HandleType::HANDLE_TYPE_IBC_PACKET_RECEIVE
             | HandleType::HANDLE_TYPE_IBC_PACKET_ACK
             | HandleType::HANDLE_TYPE_IBC_PACKET_TIMEOUT
+            | HandleType::HANDLE_TYPE_IBC_CHANNEL_UPGRADE
     )
 }
@@ -162,6 +162,7 @@ pub enum HandleType {
     HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER = 8,
     HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK = 9,
     HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT = 10,
+    HANDLE_TYPE_IBC_CHANNEL_UPGRADE = 11,
 }
 
 impl HandleType {
@@ -178,6 +179,7 @@ impl HandleType {
             8 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER),
             9 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK),
             10 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT),
+            11 => Ok(HandleType::HANDLE_TYPE_IBC_CHANNEL_UPGRADE),
             _ => {
                 error!("unrecognized handle type: {}", value);
                 Err(EnclaveError::FailedToDeserialize)
@@ -198,6 +200,7 @@ impl HandleType {
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER => "execute",
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK => "sudo",
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => "sudo",
+            HandleType::HANDLE_TYPE_IBC_CHANNEL_UPGRADE => "sudo",
         }
     }
 }
@@ -1,4 +1,5 @@
 use crate::addresses::Addr;
+use crate::coins::Coin;
 use crate::results::{Event, SubMsg};
 use crate::timestamp::Timestamp;
 use cw_types_v010::{encoding::Binary, types::Empty, types::LogAttribute};
@@ -163,6 +164,43 @@ pub enum IbcChannelCloseMsg {
     CloseConfirm { channel: IbcChannel }, // pub channel: IbcChannel,
 }
 
+/// The message that is passed into `sudo` during an ibc-go channel upgrade handshake
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case", deny_unknown_fields)]
+pub enum IbcChannelUpgradeSudoMsg {
+    IbcChannelUpgrade(IbcChannelUpgradeMsg),
+}
+
+/// The ChanUpgrade steps from https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics/UPGRADES.md
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case", deny_unknown_fields)]
+pub enum IbcChannelUpgradeMsg {
+    Init {
+        channel: IbcChannel,
+        proposed: IbcUpgradeFields,
+    },
+    Try {
+        channel: IbcChannel,
+        proposed: IbcUpgradeFields,
+    },
+    Ack {
+        channel: IbcChannel,
+        counterparty_version: String,
+    },
+    Open {
+        channel: IbcChannel,
+    },
+}
+
+/// The channel parameters an upgrade proposes to move to
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(deny_unknown_fields)]
+pub struct IbcUpgradeFields {
+    pub order: IbcOrder,
+    pub version: String,
+    pub connection_id: String,
+}
+
 /// The message that is passed into `ibc_packet_receive`
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq, Eq)]
 pub struct IbcPacketReceiveMsg {
@@ -209,6 +247,15 @@ pub struct IbcTimeout {
     timestamp: Option<Timestamp>,
 }
 
+/// The fee paid to the relayers of an IBC packet (ICS-29).
+/// Whatever is left of the fee after the packet is handled is refunded to the payer.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct IbcFee {
+    pub receive_fee: Vec<Coin>,
+    pub ack_fee: Vec<Coin>,
+    pub timeout_fee: Vec<Coin>,
+}
+
 /// IBCTimeoutHeight Height is a monotonically increasing data type
 /// that can be compared against another Height for the purposes of updating and
 /// freezing clients.
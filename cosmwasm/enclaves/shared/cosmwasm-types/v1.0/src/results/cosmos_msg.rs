@@ -1,7 +1,10 @@
 use serde::{Deserialize, Serialize};
 use std::fmt;
 
-use crate::{coins::Coin, ibc::IbcTimeout};
+use crate::{
+    coins::Coin,
+    ibc::{IbcFee, IbcTimeout},
+};
 
 use cw_types_v010::encoding::Binary;
 
@@ -75,6 +78,9 @@ pub enum IbcMsg {
         timeout: IbcTimeout,
         /// optional memo
         memo: Option<String>,
+        /// register the sender for an `ibc_lifecycle_complete` callback once the packet is acked or timed out
+        #[serde(default)]
+        callback: bool,
     },
     /// Sends an IBC packet with given data over the existing channel.
     /// Data should be encoded in a format defined by the channel version,
@@ -88,6 +94,38 @@ pub enum IbcMsg {
     /// This will close an existing channel that is owned by this contract.
     /// Port is auto-assigned to the contract's IBC port
     CloseChannel { channel_id: String },
+    /// Incentivizes the next IBC packet sent after this message with a fee.
+    /// Note that this does not necessarily have to be a packet sent by this contract.
+    /// The fees are taken from the contract's balance immediately and locked until the packet is handled.
+    ///
+    /// This is translated to a [MsgPayPacketFee](https://github.com/cosmos/ibc-go/blob/v8.0.0/proto/ibc/applications/fee/v1/tx.proto#L74-L88).
+    PayPacketFee {
+        /// The port id on the chain where the packet is sent from (this chain).
+        port_id: String,
+        /// The channel id on the chain where the packet is sent from (this chain).
+        channel_id: String,
+        fee: IbcFee,
+        /// Allowlist of relayer addresses that can receive the fee.
+        /// This is currently not implemented and *must* be empty.
+        relayers: Vec<String>,
+    },
+    /// Incentivizes the existing IBC packet with the given port, channel and sequence with a fee.
+    /// Note that this does not necessarily have to be a packet sent by this contract.
+    /// The fees are taken from the contract's balance immediately and locked until the packet is handled.
+    ///
+    /// This is translated to a [MsgPayPacketFeeAsync](https://github.com/cosmos/ibc-go/blob/v8.0.0/proto/ibc/applications/fee/v1/tx.proto#L93-L103).
+    PayPacketFeeAsync {
+        /// The port id on the chain where the packet is sent from (this chain).
+        port_id: String,
+        /// The channel id on the chain where the packet is sent from (this chain).
+        channel_id: String,
+        /// The sequence number of the packet that should be incentivized.
+        sequence: u64,
+        fee: IbcFee,
+        /// Allowlist of relayer addresses that can receive the fee.
+        /// This is currently not implemented and *must* be empty.
+        relayers: Vec<String>,
+    },
 }
 
 pub const REPLY_ENCRYPTION_MAGIC_BYTES: &[u8] = b"REPLY01";
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+)
+
+// Backend is the surface of the enclave that the chain calls into. Enclave is the enclave that this binary links,
+// other implementations stand in for it, like the in-memory mock that tests run contracts on
+type Backend interface {
+	HealthCheck() ([]byte, error)
+	InitBootstrap(spid []byte, apiKey []byte) ([]byte, error)
+	SubmitBlockSignatures(header []byte, commit []byte, txs []byte, encRandom []byte) ([]byte, []byte, error)
+	SubmitValidatorSetEvidence(evidence []byte) error
+	LoadSeedToEnclave(masterKey []byte, seed []byte, apiKey []byte) (bool, error)
+	MigrationOp(op uint32) (bool, error)
+	EmergencyApproveUpgrade(nodeDir string, msg string) (bool, error)
+	OnUpgradeProposalPassed(mrEnclaveHash []byte) error
+	KeyGen() ([]byte, error)
+	CreateAttestationReport(apiKey []byte, noEpid bool, noDcap bool, isMigrationReport bool) (bool, error)
+	GetEncryptedSeed(cert []byte) ([]byte, error)
+	GetEncryptedGenesisSeed(pk []byte) ([]byte, error)
+
+	InitCache(dataDir string, supportedFeatures string, cacheSize uint64) (Cache, error)
+	ReleaseCache(cache Cache)
+	InitEnclaveRuntime(moduleCacheSize uint16) error
+	Create(cache Cache, wasm []byte) ([]byte, error)
+	GetCode(cache Cache, codeId []byte) ([]byte, error)
+	AnalyzeCode(cache Cache, codeHash []byte) (*v1types.AnalysisReport, error)
+	Instantiate(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, admin []byte) ([]byte, uint64, error)
+	Handle(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, handleType types.HandleType) ([]byte, uint64, error)
+	Query(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64) ([]byte, uint64, error)
+	Migrate(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, admin []byte, adminProof []byte) ([]byte, uint64, error)
+	UpdateAdmin(cache Cache, codeId []byte, params []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, currentAdmin []byte, currentAdminProof []byte, newAdmin []byte) ([]byte, error)
+}
+
+// Enclave is the Backend of the enclave that this binary links
+type Enclave struct{}
+
+var _ Backend = Enclave{}
+
+func (Enclave) HealthCheck() ([]byte, error) {
+	return HealthCheck()
+}
+
+func (Enclave) InitBootstrap(spid []byte, apiKey []byte) ([]byte, error) {
+	return InitBootstrap(spid, apiKey)
+}
+
+func (Enclave) SubmitBlockSignatures(header []byte, commit []byte, txs []byte, encRandom []byte) ([]byte, []byte, error) {
+	return SubmitBlockSignatures(header, commit, txs, encRandom)
+}
+
+func (Enclave) SubmitValidatorSetEvidence(evidence []byte) error {
+	return SubmitValidatorSetEvidence(evidence)
+}
+
+func (Enclave) LoadSeedToEnclave(masterKey []byte, seed []byte, apiKey []byte) (bool, error) {
+	return LoadSeedToEnclave(masterKey, seed, apiKey)
+}
+
+func (Enclave) MigrationOp(op uint32) (bool, error) {
+	return MigrationOp(op)
+}
+
+func (Enclave) EmergencyApproveUpgrade(nodeDir string, msg string) (bool, error) {
+	return EmergencyApproveUpgrade(nodeDir, msg)
+}
+
+func (Enclave) OnUpgradeProposalPassed(mrEnclaveHash []byte) error {
+	return OnUpgradeProposalPassed(mrEnclaveHash)
+}
+
+func (Enclave) KeyGen() ([]byte, error) {
+	return KeyGen()
+}
+
+func (Enclave) CreateAttestationReport(apiKey []byte, noEpid bool, noDcap bool, isMigrationReport bool) (bool, error) {
+	return CreateAttestationReport(apiKey, noEpid, noDcap, isMigrationReport)
+}
+
+func (Enclave) GetEncryptedSeed(cert []byte) ([]byte, error) {
+	return GetEncryptedSeed(cert)
+}
+
+func (Enclave) GetEncryptedGenesisSeed(pk []byte) ([]byte, error) {
+	return GetEncryptedGenesisSeed(pk)
+}
+
+func (Enclave) InitCache(dataDir string, supportedFeatures string, cacheSize uint64) (Cache, error) {
+	return InitCache(dataDir, supportedFeatures, cacheSize)
+}
+
+func (Enclave) ReleaseCache(cache Cache) {
+	ReleaseCache(cache)
+}
+
+func (Enclave) InitEnclaveRuntime(moduleCacheSize uint16) error {
+	return InitEnclaveRuntime(moduleCacheSize)
+}
+
+func (Enclave) Create(cache Cache, wasm []byte) ([]byte, error) {
+	return Create(cache, wasm)
+}
+
+func (Enclave) GetCode(cache Cache, codeId []byte) ([]byte, error) {
+	return GetCode(cache, codeId)
+}
+
+func (Enclave) AnalyzeCode(cache Cache, codeHash []byte) (*v1types.AnalysisReport, error) {
+	return AnalyzeCode(cache, codeHash)
+}
+
+func (Enclave) Instantiate(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, admin []byte) ([]byte, uint64, error) {
+	return Instantiate(cache, codeId, params, msg, gasMeter, store, api, querier, gasLimit, sigInfo, admin)
+}
+
+func (Enclave) Handle(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, handleType types.HandleType) ([]byte, uint64, error) {
+	return Handle(cache, codeId, params, msg, gasMeter, store, api, querier, gasLimit, sigInfo, handleType)
+}
+
+func (Enclave) Query(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64) ([]byte, uint64, error) {
+	return Query(cache, codeId, params, msg, gasMeter, store, api, querier, gasLimit)
+}
+
+func (Enclave) Migrate(cache Cache, codeId []byte, params []byte, msg []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, admin []byte, adminProof []byte) ([]byte, uint64, error) {
+	return Migrate(cache, codeId, params, msg, gasMeter, store, api, querier, gasLimit, sigInfo, admin, adminProof)
+}
+
+func (Enclave) UpdateAdmin(cache Cache, codeId []byte, params []byte, gasMeter *GasMeter, store KVStore, api *GoAPI, querier *Querier, gasLimit uint64, sigInfo []byte, currentAdmin []byte, currentAdminProof []byte, newAdmin []byte) ([]byte, error) {
+	return UpdateAdmin(cache, codeId, params, gasMeter, store, api, querier, gasLimit, sigInfo, currentAdmin, currentAdminProof, newAdmin)
+}
@@ -0,0 +1,442 @@
+package mock
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+)
+
+// CallGasCost is the CosmWasm gas that every contract call is charged, on top of what the contract consumes
+const CallGasCost = 10_000
+
+// Backend is an in-memory api.Backend that runs plaintext Go contracts in place of wasm code, so that the compute
+// module can be tested on machines without SGX or the enclave build. Code is stored by its SHA-256 hash, and runs the
+// Contract that was added for it. Calls that don't run contracts succeed without doing anything
+type Backend struct {
+	mtx       sync.RWMutex
+	codes     map[string][]byte
+	contracts map[string]Contract
+}
+
+var _ api.Backend = &Backend{}
+
+// NewBackend returns a Backend without contracts
+func NewBackend() *Backend {
+	return &Backend{
+		codes:     map[string][]byte{},
+		contracts: map[string]Contract{},
+	}
+}
+
+// AddContract makes code run contract once it is stored, and returns the hash that code is stored by
+func (b *Backend) AddContract(code []byte, contract Contract) []byte {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	hash := sha256.Sum256(code)
+	b.contracts[string(hash[:])] = contract
+	return hash[:]
+}
+
+func (b *Backend) HealthCheck() ([]byte, error) {
+	return []byte(`{"mock":true}`), nil
+}
+
+func (b *Backend) InitBootstrap(_ []byte, _ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+// SubmitBlockSignatures returns a random seed derived from the block, and no validator set evidence
+func (b *Backend) SubmitBlockSignatures(header []byte, _ []byte, _ []byte, encRandom []byte) ([]byte, []byte, error) {
+	random := sha256.Sum256(append(append([]byte{}, header...), encRandom...))
+	return random[:], nil, nil
+}
+
+func (b *Backend) SubmitValidatorSetEvidence(_ []byte) error {
+	return nil
+}
+
+func (b *Backend) LoadSeedToEnclave(_ []byte, _ []byte, _ []byte) (bool, error) {
+	return true, nil
+}
+
+func (b *Backend) MigrationOp(_ uint32) (bool, error) {
+	return true, nil
+}
+
+func (b *Backend) EmergencyApproveUpgrade(_ string, _ string) (bool, error) {
+	return true, nil
+}
+
+func (b *Backend) OnUpgradeProposalPassed(_ []byte) error {
+	return nil
+}
+
+func (b *Backend) KeyGen() ([]byte, error) {
+	return nil, nil
+}
+
+func (b *Backend) CreateAttestationReport(_ []byte, _ bool, _ bool, _ bool) (bool, error) {
+	return true, nil
+}
+
+func (b *Backend) GetEncryptedSeed(_ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *Backend) GetEncryptedGenesisSeed(_ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *Backend) InitCache(_ string, _ string, _ uint64) (api.Cache, error) {
+	return api.Cache{}, nil
+}
+
+func (b *Backend) ReleaseCache(_ api.Cache) {}
+
+func (b *Backend) InitEnclaveRuntime(_ uint16) error {
+	return nil
+}
+
+// Create stores code by its hash. It fails for code that no contract was added for, as wasm code that doesn't
+// compile would
+func (b *Backend) Create(_ api.Cache, wasm []byte) ([]byte, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	hash := sha256.Sum256(wasm)
+	if _, ok := b.contracts[string(hash[:])]; !ok {
+		return nil, fmt.Errorf("no contract was added for code %X", hash)
+	}
+
+	b.codes[string(hash[:])] = append([]byte{}, wasm...)
+	return hash[:], nil
+}
+
+func (b *Backend) GetCode(_ api.Cache, codeId []byte) ([]byte, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	code, ok := b.codes[string(codeId)]
+	if !ok {
+		return nil, fmt.Errorf("no code with hash %X", codeId)
+	}
+	return code, nil
+}
+
+// AnalyzeCode reports IBC entry points for contracts that implement IBCContract
+func (b *Backend) AnalyzeCode(_ api.Cache, codeHash []byte) (*v1types.AnalysisReport, error) {
+	contract, err := b.contract(codeHash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, isIBC := contract.(IBCContract)
+	return &v1types.AnalysisReport{HasIBCEntryPoints: isIBC}, nil
+}
+
+func (b *Backend) Instantiate(_ api.Cache, codeId []byte, params []byte, msg []byte, _ *api.GasMeter, store api.KVStore, goapi *api.GoAPI, querier *api.Querier, gasLimit uint64, _ []byte, admin []byte) ([]byte, uint64, error) {
+	contract, env, deps, err := b.prepare(codeId, params, store, goapi, querier, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	plaintext, err := stripHeader(msg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := contract.Instantiate(deps, env, plaintext)
+	if deps.gasUsed > gasLimit {
+		return nil, gasLimit, types.OutOfGasError{}
+	}
+
+	out, err := json.Marshal(cosmwasm.V010orV1ContractInitResponse{
+		V1: &cosmwasm.V1ContractInitResponse{Ok: res, Err: toStdError(err)},
+	})
+	if err != nil {
+		return nil, deps.gasUsed, err
+	}
+
+	key := contractKey(codeId, env.Contract.Address)
+	proof := adminProof(env.Contract.Address, admin)
+	return append(append(key, proof...), out...), deps.gasUsed, nil
+}
+
+func (b *Backend) Handle(_ api.Cache, codeId []byte, params []byte, msg []byte, _ *api.GasMeter, store api.KVStore, goapi *api.GoAPI, querier *api.Querier, gasLimit uint64, _ []byte, handleType types.HandleType) ([]byte, uint64, error) {
+	contract, env, deps, err := b.prepare(codeId, params, store, goapi, querier, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var resp cosmwasm.ContractExecResponse
+	switch handleType {
+	case types.HandleTypeExecute:
+		plaintext, err := stripHeader(msg)
+		if err != nil {
+			return nil, 0, err
+		}
+		res, err := contract.Execute(deps, env, plaintext)
+		resp.V1 = &cosmwasm.V1ContractExecResponse{Ok: res, Err: toStdError(err)}
+	case types.HandleTypeReply:
+		replier, ok := contract.(Replier)
+		if !ok {
+			return nil, 0, errors.New("contract does not handle replies")
+		}
+		var reply v1types.Reply
+		if err := unmarshalMsg(msg, true, &reply); err != nil {
+			return nil, 0, err
+		}
+		res, err := replier.Reply(deps, env, reply)
+		resp.V1 = &cosmwasm.V1ContractExecResponse{Ok: res, Err: toStdError(err)}
+	default:
+		ibcContract, ok := contract.(IBCContract)
+		if !ok {
+			return nil, 0, errors.New("contract has no IBC entry points")
+		}
+		resp, err = handleIBC(ibcContract, deps, env, msg, handleType)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if deps.gasUsed > gasLimit {
+		return nil, gasLimit, types.OutOfGasError{}
+	}
+
+	out, err := json.Marshal(resp)
+	return out, deps.gasUsed, err
+}
+
+func handleIBC(contract IBCContract, deps *Deps, env types.Env, msg []byte, handleType types.HandleType) (cosmwasm.ContractExecResponse, error) {
+	var resp cosmwasm.ContractExecResponse
+	basic := func(res *v1types.IBCBasicResponse, err error) {
+		resp.IBCBasic = &v1types.IBCBasicResult{Ok: res, Err: toStdError(err)}
+	}
+
+	switch handleType {
+	case types.HandleTypeIbcChannelOpen:
+		var openMsg v1types.IBCChannelOpenMsg
+		if err := unmarshalMsg(msg, false, &openMsg); err != nil {
+			return resp, err
+		}
+		version, err := contract.IBCChannelOpen(deps, env, openMsg)
+		resp.IBCChannelOpen = &v1types.IBCOpenChannelResult{Err: toStdError(err)}
+		if err == nil {
+			resp.IBCChannelOpen.Ok = &version
+		}
+	case types.HandleTypeIbcChannelConnect:
+		var connectMsg v1types.IBCChannelConnectMsg
+		if err := unmarshalMsg(msg, false, &connectMsg); err != nil {
+			return resp, err
+		}
+		basic(contract.IBCChannelConnect(deps, env, connectMsg))
+	case types.HandleTypeIbcChannelClose:
+		var closeMsg v1types.IBCChannelCloseMsg
+		if err := unmarshalMsg(msg, false, &closeMsg); err != nil {
+			return resp, err
+		}
+		basic(contract.IBCChannelClose(deps, env, closeMsg))
+	case types.HandleTypeIbcPacketReceive:
+		var receiveMsg v1types.IBCPacketReceiveMsg
+		if err := unmarshalMsg(msg, false, &receiveMsg); err != nil {
+			return resp, err
+		}
+		res, err := contract.IBCPacketReceive(deps, env, receiveMsg)
+		resp.IBCPacketReceive = &v1types.IBCReceiveResult{Ok: res, Err: toStdError(err)}
+	case types.HandleTypeIbcPacketAck:
+		var ackMsg v1types.IBCPacketAckMsg
+		if err := unmarshalMsg(msg, false, &ackMsg); err != nil {
+			return resp, err
+		}
+		basic(contract.IBCPacketAck(deps, env, ackMsg))
+	case types.HandleTypeIbcPacketTimeout:
+		var timeoutMsg v1types.IBCPacketTimeoutMsg
+		if err := unmarshalMsg(msg, false, &timeoutMsg); err != nil {
+			return resp, err
+		}
+		basic(contract.IBCPacketTimeout(deps, env, timeoutMsg))
+	case types.HandleTypeIbcChannelUpgrade:
+		upgrader, ok := contract.(IBCUpgrader)
+		if !ok {
+			return resp, errors.New("contract does not handle channel upgrades")
+		}
+		var upgradeMsg v1types.IBCChannelUpgradeMsg
+		if err := unmarshalMsg(msg, false, &upgradeMsg); err != nil {
+			return resp, err
+		}
+		basic(upgrader.IBCChannelUpgrade(deps, env, upgradeMsg))
+	default:
+		return resp, fmt.Errorf("handle type %d is not supported by the mock backend", handleType)
+	}
+
+	return resp, nil
+}
+
+func (b *Backend) Query(_ api.Cache, codeId []byte, params []byte, msg []byte, _ *api.GasMeter, store api.KVStore, goapi *api.GoAPI, querier *api.Querier, gasLimit uint64) ([]byte, uint64, error) {
+	contract, env, deps, err := b.prepare(codeId, params, store, goapi, querier, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	plaintext, err := stripHeader(msg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := contract.Query(deps, env, plaintext)
+	if deps.gasUsed > gasLimit {
+		return nil, gasLimit, types.OutOfGasError{}
+	}
+
+	out, err := json.Marshal(types.ContractQueryResponse{
+		Query: &types.QueryResponse{Ok: res, Err: toStdError(err)},
+	})
+	return out, deps.gasUsed, err
+}
+
+// Migrate checks the admin proof of the contract like the enclave does, and runs the Migrate entry point of the new
+// code
+func (b *Backend) Migrate(_ api.Cache, codeId []byte, params []byte, msg []byte, _ *api.GasMeter, store api.KVStore, goapi *api.GoAPI, querier *api.Querier, gasLimit uint64, _ []byte, admin []byte, proof []byte) ([]byte, uint64, error) {
+	contract, env, deps, err := b.prepare(codeId, params, store, goapi, querier, gasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !bytes.Equal(proof, adminProof(env.Contract.Address, admin)) {
+		return nil, 0, errors.New("the admin proof of the contract is invalid")
+	}
+
+	migrator, ok := contract.(Migrator)
+	if !ok {
+		return nil, 0, errors.New("contract can not be migrated to")
+	}
+
+	plaintext, err := stripHeader(msg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := migrator.Migrate(deps, env, plaintext)
+	if deps.gasUsed > gasLimit {
+		return nil, gasLimit, types.OutOfGasError{}
+	}
+
+	out, err := json.Marshal(cosmwasm.ContractExecResponse{
+		V1: &cosmwasm.V1ContractExecResponse{Ok: res, Err: toStdError(err)},
+	})
+	if err != nil {
+		return nil, deps.gasUsed, err
+	}
+
+	key := contractKey(codeId, env.Contract.Address)
+	keyProof := sha256.Sum256(key)
+	return append(append(key, keyProof[:]...), out...), deps.gasUsed, nil
+}
+
+// UpdateAdmin checks the admin proof of the contract like the enclave does, and returns the proof of the new admin
+func (b *Backend) UpdateAdmin(_ api.Cache, codeId []byte, params []byte, _ *api.GasMeter, _ api.KVStore, _ *api.GoAPI, _ *api.Querier, _ uint64, _ []byte, currentAdmin []byte, currentAdminProof []byte, newAdmin []byte) ([]byte, error) {
+	if _, err := b.contract(codeId); err != nil {
+		return nil, err
+	}
+
+	var env types.Env
+	if err := json.Unmarshal(params, &env); err != nil {
+		return nil, fmt.Errorf("invalid env: %w", err)
+	}
+
+	if !bytes.Equal(currentAdminProof, adminProof(env.Contract.Address, currentAdmin)) {
+		return nil, errors.New("the admin proof of the contract is invalid")
+	}
+
+	return adminProof(env.Contract.Address, newAdmin), nil
+}
+
+func (b *Backend) contract(codeHash []byte) (Contract, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	if _, ok := b.codes[string(codeHash)]; !ok {
+		return nil, fmt.Errorf("no code with hash %X", codeHash)
+	}
+	return b.contracts[string(codeHash)], nil
+}
+
+func (b *Backend) prepare(codeHash []byte, params []byte, store api.KVStore, goapi *api.GoAPI, querier *api.Querier, gasLimit uint64) (Contract, types.Env, *Deps, error) {
+	var env types.Env
+
+	contract, err := b.contract(codeHash)
+	if err != nil {
+		return nil, env, nil, err
+	}
+
+	if err := json.Unmarshal(params, &env); err != nil {
+		return nil, env, nil, fmt.Errorf("invalid env: %w", err)
+	}
+
+	if gasLimit < CallGasCost {
+		return nil, env, nil, types.OutOfGasError{}
+	}
+
+	deps := &Deps{
+		Storage:    store,
+		Api:        *goapi,
+		Querier:    *querier,
+		queryDepth: env.QueryDepth,
+		gasLimit:   gasLimit,
+		gasUsed:    CallGasCost,
+	}
+	return contract, env, deps, nil
+}
+
+// stripHeader returns the plaintext of a message in the layout of encrypted messages
+func stripHeader(msg []byte) ([]byte, error) {
+	if len(msg) < MsgHeaderLength {
+		return nil, fmt.Errorf("message is shorter than its %d bytes header", MsgHeaderLength)
+	}
+	return msg[MsgHeaderLength:], nil
+}
+
+func unmarshalMsg(msg []byte, hasHeader bool, v interface{}) error {
+	if hasHeader {
+		var err error
+		msg, err = stripHeader(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := json.Unmarshal(msg, v); err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	return nil
+}
+
+// toStdError reports the errors of contracts as generic errors, like the enclave does
+func toStdError(err error) *types.StdError {
+	if err == nil {
+		return nil
+	}
+	return &types.StdError{GenericErr: &types.GenericErr{Msg: err.Error()}}
+}
+
+// contractKey derives the 64 bytes key of a contract from its code and address
+func contractKey(codeHash []byte, address string) []byte {
+	key := sha512.Sum512(append(append([]byte{}, codeHash...), address...))
+	return key[:]
+}
+
+// adminProof derives the 32 bytes proof that admin is the admin of a contract
+func adminProof(address string, admin []byte) []byte {
+	proof := sha256.Sum256(append([]byte(address), admin...))
+	return proof[:]
+}
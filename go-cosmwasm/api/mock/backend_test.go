@@ -0,0 +1,191 @@
+package mock_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/store/dbadapter"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+)
+
+const gasLimit = 1_000_000
+
+// counter is a contract that keeps a count, which it returns in queries
+type counter struct{}
+
+func (counter) Instantiate(deps *mock.Deps, _ types.Env, msg []byte) (*v1types.Response, error) {
+	var init struct {
+		Count int `json:"count"`
+	}
+	if err := json.Unmarshal(msg, &init); err != nil {
+		return nil, err
+	}
+	deps.Storage.Set([]byte("count"), []byte(strconv.Itoa(init.Count)))
+	return &v1types.Response{}, nil
+}
+
+func (counter) Execute(deps *mock.Deps, _ types.Env, msg []byte) (*v1types.Response, error) {
+	switch string(msg) {
+	case `{"increment":{}}`:
+		count, _ := strconv.Atoi(string(deps.Storage.Get([]byte("count"))))
+		deps.Storage.Set([]byte("count"), []byte(strconv.Itoa(count+1)))
+		return &v1types.Response{Data: []byte("incremented")}, nil
+	case `{"burn":{}}`:
+		deps.ConsumeGas(gasLimit)
+		return &v1types.Response{}, nil
+	default:
+		return nil, errors.New("unknown message")
+	}
+}
+
+func (counter) Query(deps *mock.Deps, _ types.Env, _ []byte) ([]byte, error) {
+	return deps.Storage.Get([]byte("count")), nil
+}
+
+func (counter) Migrate(deps *mock.Deps, _ types.Env, _ []byte) (*v1types.Response, error) {
+	deps.Storage.Set([]byte("count"), []byte("0"))
+	return &v1types.Response{}, nil
+}
+
+func (counter) IBCChannelOpen(_ *mock.Deps, _ types.Env, msg v1types.IBCChannelOpenMsg) (string, error) {
+	return msg.GetChannel().Version, nil
+}
+
+func (counter) IBCChannelConnect(_ *mock.Deps, _ types.Env, _ v1types.IBCChannelConnectMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (counter) IBCChannelClose(_ *mock.Deps, _ types.Env, _ v1types.IBCChannelCloseMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (counter) IBCPacketReceive(_ *mock.Deps, _ types.Env, _ v1types.IBCPacketReceiveMsg) (*v1types.IBCReceiveResponse, error) {
+	return &v1types.IBCReceiveResponse{Acknowledgement: []byte("ack")}, nil
+}
+
+func (counter) IBCPacketAck(_ *mock.Deps, _ types.Env, _ v1types.IBCPacketAckMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (counter) IBCPacketTimeout(_ *mock.Deps, _ types.Env, _ v1types.IBCPacketTimeoutMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (counter) IBCChannelUpgrade(_ *mock.Deps, _ types.Env, msg v1types.IBCChannelUpgradeMsg) (*v1types.IBCBasicResponse, error) {
+	if init := msg.IBCChannelUpgrade.Init; init != nil && init.Proposed.Version != "counter-2" {
+		return nil, errors.New("unsupported version")
+	}
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func setupWasmer(t *testing.T) (*cosmwasm.Wasmer, *mock.Backend) {
+	backend := mock.NewBackend()
+	wasmer, err := cosmwasm.NewWasmerWithBackend(backend, t.TempDir(), "staking", 0, 0, false)
+	require.NoError(t, err)
+	t.Cleanup(wasmer.Cleanup)
+	return wasmer, backend
+}
+
+func TestBackendContract(t *testing.T) {
+	wasmer, backend := setupWasmer(t)
+
+	code := []byte("counter")
+	_, err := wasmer.Create(code)
+	require.Error(t, err, "code without contract")
+
+	hash := backend.AddContract(code, counter{})
+	codeHash, err := wasmer.Create(code)
+	require.NoError(t, err)
+	require.Equal(t, hash, []byte(codeHash))
+
+	stored, err := wasmer.GetCode(codeHash)
+	require.NoError(t, err)
+	require.Equal(t, code, []byte(stored))
+
+	report, err := wasmer.AnalyzeCode(codeHash)
+	require.NoError(t, err)
+	require.True(t, report.HasIBCEntryPoints)
+
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	env := types.Env{Contract: types.ContractInfo{Address: "secret1contract"}}
+	admin := []byte("admin")
+
+	_, key, adminProof, _, err := wasmer.Instantiate(codeHash, env, mock.Msg([]byte(`{"count":41}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, admin)
+	require.NoError(t, err)
+	require.Len(t, key, 64)
+	require.Len(t, adminProof, 32)
+
+	res, gasUsed, err := wasmer.Execute(codeHash, env, mock.Msg([]byte(`{"increment":{}}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeExecute)
+	require.NoError(t, err)
+	require.Equal(t, uint64(mock.CallGasCost), gasUsed)
+	require.Equal(t, []byte("incremented"), res.(*v1types.Response).Data)
+
+	_, _, err = wasmer.Execute(codeHash, env, mock.Msg([]byte(`{"unknown":{}}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeExecute)
+	require.ErrorContains(t, err, "unknown message")
+
+	_, _, err = wasmer.Execute(codeHash, env, mock.Msg([]byte(`{"burn":{}}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeExecute)
+	require.ErrorIs(t, err, types.OutOfGasError{})
+
+	count, _, err := wasmer.Query(codeHash, env, mock.Msg([]byte(`{}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit)
+	require.NoError(t, err)
+	require.Equal(t, "42", string(count))
+
+	newAdmin := []byte("new admin")
+	_, err = wasmer.UpdateAdmin(codeHash, env, store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, newAdmin, adminProof, newAdmin)
+	require.Error(t, err, "proof of another admin")
+	newAdminProof, err := wasmer.UpdateAdmin(codeHash, env, store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, admin, adminProof, newAdmin)
+	require.NoError(t, err)
+
+	_, _, _, _, err = wasmer.Migrate(codeHash, env, mock.Msg([]byte(`{}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, newAdmin, adminProof)
+	require.Error(t, err, "proof of another admin")
+	_, _, _, _, err = wasmer.Migrate(codeHash, env, mock.Msg([]byte(`{}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, newAdmin, newAdminProof)
+	require.NoError(t, err)
+
+	count, _, err = wasmer.Query(codeHash, env, mock.Msg([]byte(`{}`)), store, cosmwasm.GoAPI{}, nil, nil, gasLimit)
+	require.NoError(t, err)
+	require.Equal(t, "0", string(count))
+}
+
+func TestBackendIBC(t *testing.T) {
+	wasmer, backend := setupWasmer(t)
+
+	backend.AddContract([]byte("counter"), counter{})
+	codeHash, err := wasmer.Create([]byte("counter"))
+	require.NoError(t, err)
+
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	env := types.Env{Contract: types.ContractInfo{Address: "secret1contract"}}
+
+	openMsg, err := json.Marshal(v1types.IBCChannelOpenMsg{
+		OpenInit: &v1types.IBCOpenInit{Channel: v1types.IBCChannel{Version: "counter-1"}},
+	})
+	require.NoError(t, err)
+	res, _, err := wasmer.Execute(codeHash, env, openMsg, store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeIbcChannelOpen)
+	require.NoError(t, err)
+	require.Equal(t, "counter-1", *res.(*string))
+
+	for version, expErr := range map[string]bool{"counter-2": false, "counter-3": true} {
+		init := v1types.IBCUpgradeInit{Proposed: v1types.IBCUpgradeFields{Version: version}}
+		upgradeMsg, err := json.Marshal(init.ToMsg())
+		require.NoError(t, err)
+		_, _, err = wasmer.Execute(codeHash, env, upgradeMsg, store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeIbcChannelUpgrade)
+		require.Equal(t, expErr, err != nil, version)
+	}
+
+	receiveMsg, err := json.Marshal(v1types.IBCPacketReceiveMsg{})
+	require.NoError(t, err)
+	res, _, err = wasmer.Execute(codeHash, env, receiveMsg, store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeIbcPacketReceive)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ack"), res.(*v1types.IBCReceiveResponse).Acknowledgement)
+
+	_, _, err = wasmer.Execute(codeHash, env, receiveMsg, store, cosmwasm.GoAPI{}, nil, nil, gasLimit, types.SigInfo{}, types.HandleTypeIbcWasmHooksIncomingTransfer)
+	require.Error(t, err, "wasm hooks are not supported")
+}
@@ -0,0 +1,79 @@
+package mock
+
+import (
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+)
+
+// MsgHeaderLength is the length of the nonce and public key that lead encrypted messages. The backend expects
+// plaintext messages to lead with a header of the same length, as the compute module copies it from the original
+// message of a transaction into replies
+const MsgHeaderLength = 64
+
+// Msg returns a plaintext message in the layout of encrypted messages, with a zero header in front of it
+func Msg(plaintext []byte) []byte {
+	return append(make([]byte, MsgHeaderLength), plaintext...)
+}
+
+// Contract is a plaintext Go contract that the backend runs in place of wasm code. It gets its messages without the
+// header, and returns CosmWasm v1 responses
+type Contract interface {
+	Instantiate(deps *Deps, env types.Env, msg []byte) (*v1types.Response, error)
+	Execute(deps *Deps, env types.Env, msg []byte) (*v1types.Response, error)
+	Query(deps *Deps, env types.Env, msg []byte) ([]byte, error)
+}
+
+// Migrator is a Contract that can be migrated to
+type Migrator interface {
+	Migrate(deps *Deps, env types.Env, msg []byte) (*v1types.Response, error)
+}
+
+// Replier is a Contract that handles the replies of its submessages
+type Replier interface {
+	Reply(deps *Deps, env types.Env, reply v1types.Reply) (*v1types.Response, error)
+}
+
+// IBCContract is a Contract with the IBC entry points, which gets an IBC port once instantiated
+type IBCContract interface {
+	// IBCChannelOpen returns the version of the channel
+	IBCChannelOpen(deps *Deps, env types.Env, msg v1types.IBCChannelOpenMsg) (string, error)
+	IBCChannelConnect(deps *Deps, env types.Env, msg v1types.IBCChannelConnectMsg) (*v1types.IBCBasicResponse, error)
+	IBCChannelClose(deps *Deps, env types.Env, msg v1types.IBCChannelCloseMsg) (*v1types.IBCBasicResponse, error)
+	IBCPacketReceive(deps *Deps, env types.Env, msg v1types.IBCPacketReceiveMsg) (*v1types.IBCReceiveResponse, error)
+	IBCPacketAck(deps *Deps, env types.Env, msg v1types.IBCPacketAckMsg) (*v1types.IBCBasicResponse, error)
+	IBCPacketTimeout(deps *Deps, env types.Env, msg v1types.IBCPacketTimeoutMsg) (*v1types.IBCBasicResponse, error)
+}
+
+// IBCUpgrader is an IBCContract that takes part in the upgrades of its channels. Upgrades of the channels of other
+// IBC contracts fail
+type IBCUpgrader interface {
+	IBCChannelUpgrade(deps *Deps, env types.Env, msg v1types.IBCChannelUpgradeMsg) (*v1types.IBCBasicResponse, error)
+}
+
+// Deps are what a Contract gets from the chain, its storage, the address API and the querier of other modules and
+// contracts
+type Deps struct {
+	Storage api.KVStore
+	Api     api.GoAPI
+	Querier api.Querier
+
+	queryDepth uint32
+	gasLimit   uint64
+	gasUsed    uint64
+}
+
+// ConsumeGas charges the call for work of the contract, in CosmWasm gas
+func (d *Deps) ConsumeGas(gas uint64) {
+	d.gasUsed += gas
+}
+
+// QueryRaw sends a query to the querier of the chain, with the gas that the call has left. The querier charges the
+// chain for the gas of the query itself
+func (d *Deps) QueryRaw(request types.QueryRequest) ([]byte, error) {
+	if d.gasUsed >= d.gasLimit {
+		return nil, types.OutOfGasError{}
+	}
+
+	return d.Querier.Query(request, d.queryDepth+1, d.gasLimit-d.gasUsed)
+}
@@ -32,7 +32,8 @@ type GasMeter = api.GasMeter
 // You should create an instance with it's own subdirectory to manage state inside,
 // and call it for all cosmwasm code related actions.
 type Wasmer struct {
-	cache api.Cache
+	backend api.Backend
+	cache   api.Cache
 }
 
 // NewWasmer creates a new binding, with the given dataDir where
@@ -41,23 +42,29 @@ type Wasmer struct {
 // They allow popular contracts to be executed very rapidly (no loading overhead),
 // but require ~32-64MB each in memory usage.
 func NewWasmer(dataDir string, supportedFeatures string, cacheSize uint64, moduleCacheSize uint16, initEnclave bool) (*Wasmer, error) {
-	cache, err := api.InitCache(dataDir, supportedFeatures, cacheSize)
+	return NewWasmerWithBackend(api.Enclave{}, dataDir, supportedFeatures, cacheSize, moduleCacheSize, initEnclave)
+}
+
+// NewWasmerWithBackend creates a new binding like NewWasmer, that runs contracts on the given backend rather than
+// on the enclave that this binary links
+func NewWasmerWithBackend(backend api.Backend, dataDir string, supportedFeatures string, cacheSize uint64, moduleCacheSize uint16, initEnclave bool) (*Wasmer, error) {
+	cache, err := backend.InitCache(dataDir, supportedFeatures, cacheSize)
 	if err != nil {
 		return nil, err
 	}
 	if initEnclave {
-		err = api.InitEnclaveRuntime(moduleCacheSize)
+		err = backend.InitEnclaveRuntime(moduleCacheSize)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &Wasmer{cache: cache}, nil
+	return &Wasmer{backend: backend, cache: cache}, nil
 }
 
 // Cleanup should be called when no longer using this to free resources on the rust-side
 func (w *Wasmer) Cleanup() {
-	api.ReleaseCache(w.cache)
+	w.backend.ReleaseCache(w.cache)
 }
 
 // Create will compile the wasm code, and store the resulting pre-compile
@@ -71,7 +78,7 @@ func (w *Wasmer) Cleanup() {
 //
 // TODO: return gas cost? Add gas limit??? there is no metering here...
 func (w *Wasmer) Create(code WasmCode) (CodeHash, error) {
-	return api.Create(w.cache, code)
+	return w.backend.Create(w.cache, code)
 }
 
 // GetCode will load the original wasm code for the given code id.
@@ -82,7 +89,7 @@ func (w *Wasmer) Create(code WasmCode) (CodeHash, error) {
 // and the larger binary blobs (wasm and pre-compiles) are all managed by the
 // rust library
 func (w *Wasmer) GetCode(code CodeHash) (WasmCode, error) {
-	return api.GetCode(w.cache, code)
+	return w.backend.GetCode(w.cache, code)
 }
 
 // This struct helps us to distinguish between v0.10 contract response and v1 contract response
@@ -154,7 +161,7 @@ func (w *Wasmer) Instantiate(
 		return nil, nil, nil, 0, err
 	}
 
-	data, gasUsed, err := api.Instantiate(w.cache, codeId, paramBin, initMsg, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, admin)
+	data, gasUsed, err := w.backend.Instantiate(w.cache, codeId, paramBin, initMsg, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, admin)
 	if err != nil {
 		return nil, nil, nil, gasUsed, err
 	}
@@ -256,7 +263,7 @@ func (w *Wasmer) Execute(
 		return nil, 0, err
 	}
 
-	data, gasUsed, err := api.Handle(w.cache, code, paramBin, executeMsg, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, handleType)
+	data, gasUsed, err := w.backend.Handle(w.cache, code, paramBin, executeMsg, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, handleType)
 	if err != nil {
 		return nil, gasUsed, err
 	}
@@ -358,7 +365,7 @@ func (w *Wasmer) Query(
 	if err != nil {
 		return nil, 0, err
 	}
-	data, gasUsed, err := api.Query(w.cache, code, paramBin, queryMsg, &gasMeter, store, &goapi, &querier, gasLimit)
+	data, gasUsed, err := w.backend.Query(w.cache, code, paramBin, queryMsg, &gasMeter, store, &goapi, &querier, gasLimit)
 	if err != nil {
 		return nil, gasUsed, err
 	}
@@ -390,7 +397,7 @@ func (w *Wasmer) Query(
 func (w *Wasmer) AnalyzeCode(
 	codeHash []byte,
 ) (*v1types.AnalysisReport, error) {
-	return api.AnalyzeCode(w.cache, codeHash)
+	return w.backend.AnalyzeCode(w.cache, codeHash)
 }
 
 // Migrate will migrate an existing contract to a new code binary.
@@ -423,7 +430,7 @@ func (w *Wasmer) Migrate(
 		return nil, nil, nil, 0, err
 	}
 
-	data, gasUsed, err := api.Migrate(w.cache, newCodeId, paramBin, migrateMsg, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, admin, adminProof)
+	data, gasUsed, err := w.backend.Migrate(w.cache, newCodeId, paramBin, migrateMsg, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, admin, adminProof)
 	if err != nil {
 		return nil, nil, nil, gasUsed, err
 	}
@@ -512,7 +519,7 @@ func (w *Wasmer) UpdateAdmin(
 		return nil, err
 	}
 
-	newAdminProof, err := api.UpdateAdmin(w.cache, newCodeId, paramBin, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, currentAdmin, currentAdminProof, newAdmin)
+	newAdminProof, err := w.backend.UpdateAdmin(w.cache, newCodeId, paramBin, &gasMeter, store, &goapi, &querier, gasLimit, sigInfoBin, currentAdmin, currentAdminProof, newAdmin)
 	if err != nil {
 		return nil, err
 	}
@@ -422,6 +422,19 @@ type ChannelQuery struct {
 type ChannelResponse struct {
 	// may be empty if there is no matching channel
 	Channel *IBCChannel `json:"channel,omitempty"`
+	// State of the channel, STATE_OPEN unless the channel is being upgraded
+	// (STATE_FLUSHING or STATE_FLUSHCOMPLETE)
+	State string `json:"state,omitempty"`
+	// UpgradeSequence is the number of upgrades the channel went through
+	UpgradeSequence uint64 `json:"upgrade_sequence,omitempty"`
+	// Upgrade holds the ordering, version and connection proposed by an ongoing upgrade
+	Upgrade *IBCChannelUpgradeFields `json:"upgrade,omitempty"`
+}
+
+type IBCChannelUpgradeFields struct {
+	Order        string `json:"order"`
+	Version      string `json:"version"`
+	ConnectionID string `json:"connection_id"`
 }
 
 type MintQuery struct {
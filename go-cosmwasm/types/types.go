@@ -82,6 +82,7 @@ const (
 	HandleTypeIbcWasmHooksIncomingTransfer
 	HandleTypeIbcWasmHooksOutgoingTransferAck
 	HandleTypeIbcWasmHooksOutgoingTransferTimeout
+	HandleTypeIbcChannelUpgrade
 )
 
 type CosmosMsgVersion int
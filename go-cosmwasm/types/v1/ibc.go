@@ -138,6 +138,79 @@ func (m *IBCCloseConfirm) ToMsg() IBCChannelCloseMsg {
 	}
 }
 
+// IBCChannelUpgradeMsg is passed to the contract's `sudo` entry point during an ibc-go channel upgrade handshake
+// (ICS-004 channel upgradability). Contracts that don't handle it reject every upgrade of their channels.
+//
+// There is no callback for the `Channel Upgrade Confirm` step, the contract is notified
+// with `Open` once the upgrade is complete on its side.
+type IBCChannelUpgradeMsg struct {
+	IBCChannelUpgrade IBCChannelUpgrade `json:"ibc_channel_upgrade"`
+}
+
+type IBCChannelUpgrade struct {
+	Init *IBCUpgradeInit `json:"init,omitempty"`
+	Try  *IBCUpgradeTry  `json:"try,omitempty"`
+	Ack  *IBCUpgradeAck  `json:"ack,omitempty"`
+	Open *IBCUpgradeOpen `json:"open,omitempty"`
+}
+
+// IBCUpgradeFields are the channel parameters an upgrade proposes to move to
+type IBCUpgradeFields struct {
+	Order        IBCOrder `json:"order"`
+	Version      string   `json:"version"`
+	ConnectionID string   `json:"connection_id"`
+}
+
+// IBCUpgradeInit is sent on the chain that initiates the upgrade.
+// The contract accepts the proposed fields by returning successfully.
+type IBCUpgradeInit struct {
+	Channel  IBCChannel       `json:"channel"`
+	Proposed IBCUpgradeFields `json:"proposed"`
+}
+
+func (m *IBCUpgradeInit) ToMsg() IBCChannelUpgradeMsg {
+	return IBCChannelUpgradeMsg{
+		IBCChannelUpgrade: IBCChannelUpgrade{Init: m},
+	}
+}
+
+// IBCUpgradeTry is sent on the counterparty chain of the one that initiated the upgrade.
+// Proposed.Version is the version proposed by the counterparty.
+type IBCUpgradeTry struct {
+	Channel  IBCChannel       `json:"channel"`
+	Proposed IBCUpgradeFields `json:"proposed"`
+}
+
+func (m *IBCUpgradeTry) ToMsg() IBCChannelUpgradeMsg {
+	return IBCChannelUpgradeMsg{
+		IBCChannelUpgrade: IBCChannelUpgrade{Try: m},
+	}
+}
+
+// IBCUpgradeAck is sent on the initiating chain once the counterparty accepted the upgrade.
+type IBCUpgradeAck struct {
+	Channel             IBCChannel `json:"channel"`
+	CounterpartyVersion string     `json:"counterparty_version"`
+}
+
+func (m *IBCUpgradeAck) ToMsg() IBCChannelUpgradeMsg {
+	return IBCChannelUpgradeMsg{
+		IBCChannelUpgrade: IBCChannelUpgrade{Ack: m},
+	}
+}
+
+// IBCUpgradeOpen is sent on both chains once the upgrade is complete. Channel holds the upgraded channel.
+// Errors returned by the contract cannot abort the upgrade anymore.
+type IBCUpgradeOpen struct {
+	Channel IBCChannel `json:"channel"`
+}
+
+func (m *IBCUpgradeOpen) ToMsg() IBCChannelUpgradeMsg {
+	return IBCChannelUpgradeMsg{
+		IBCChannelUpgrade: IBCChannelUpgrade{Open: m},
+	}
+}
+
 type IBCPacketReceiveMsg struct {
 	Packet  IBCPacket `json:"packet"`
 	Relayer string    `json:"relayer"`
@@ -103,9 +103,11 @@ type BurnMsg struct {
 }
 
 type IBCMsg struct {
-	Transfer     *TransferMsg     `json:"transfer,omitempty"`
-	SendPacket   *SendPacketMsg   `json:"send_packet,omitempty"`
-	CloseChannel *CloseChannelMsg `json:"close_channel,omitempty"`
+	Transfer          *TransferMsg          `json:"transfer,omitempty"`
+	SendPacket        *SendPacketMsg        `json:"send_packet,omitempty"`
+	CloseChannel      *CloseChannelMsg      `json:"close_channel,omitempty"`
+	PayPacketFee      *PayPacketFeeMsg      `json:"pay_packet_fee,omitempty"`
+	PayPacketFeeAsync *PayPacketFeeAsyncMsg `json:"pay_packet_fee_async,omitempty"`
 }
 
 type GovMsg struct {
@@ -170,6 +172,16 @@ type TransferMsg struct {
 	Amount    types.Coin `json:"amount"`
 	Timeout   IBCTimeout `json:"timeout"`
 	Memo      string     `json:"memo"`
+	// Callback registers the sending contract for an `ibc_lifecycle_complete` sudo callback
+	// once the packet is acknowledged or timed out (see x/ibc-hooks).
+	// The submessage response data is then a JSON encoded TransferResponse.
+	Callback bool `json:"callback,omitempty"`
+}
+
+// TransferResponse is returned as the submessage response data of a TransferMsg with Callback set
+type TransferResponse struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
 }
 
 type SendPacketMsg struct {
@@ -182,6 +194,38 @@ type CloseChannelMsg struct {
 	ChannelID string `json:"channel_id"`
 }
 
+// PayPacketFeeMsg incentivizes the next packet sent on the given port and channel
+// (ICS-29 fee middleware). It is translated to a MsgPayPacketFee, so it must be emitted
+// before the message that sends the packet.
+type PayPacketFeeMsg struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+	Fee       IBCFee `json:"fee"`
+	// Allowlist of relayer addresses that can receive the fee.
+	// This is currently not implemented by ibc-go and must be empty.
+	Relayers []string `json:"relayers"`
+}
+
+// PayPacketFeeAsyncMsg incentivizes an already sent packet, identified by its sequence
+// (ICS-29 fee middleware). It is translated to a MsgPayPacketFeeAsync.
+type PayPacketFeeAsyncMsg struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	Fee       IBCFee `json:"fee"`
+	// Allowlist of relayer addresses that can receive the fee.
+	// This is currently not implemented by ibc-go and must be empty.
+	Relayers []string `json:"relayers"`
+}
+
+// IBCFee is the fee paid to the relayers of a packet. Unused parts of the fee are
+// refunded to the contract.
+type IBCFee struct {
+	ReceiveFee types.Coins `json:"receive_fee"`
+	AckFee     types.Coins `json:"ack_fee"`
+	TimeoutFee types.Coins `json:"timeout_fee"`
+}
+
 type StakingMsg struct {
 	Delegate   *v010msgtypes.DelegateMsg   `json:"delegate,omitempty"`
 	Undelegate *v010msgtypes.UndelegateMsg `json:"undelegate,omitempty"`
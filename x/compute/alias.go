@@ -50,6 +50,10 @@ var (
 	DefaultWasmConfig         = types.DefaultWasmConfig
 	IsEncryptedError          = types.IsEncryptedErrorCode
 	ErrContainsQueryError     = types.ErrContainsQueryError
+	ContainsEncryptedString   = types.ContainsEncryptedString
+	NewSecretMsg              = types.NewSecretMsg
+	NewQueryClient            = types.NewQueryClient
+	RegisterQueryServer       = types.RegisterQueryServer
 	GetConfig                 = types.GetConfig
 	InitGenesis               = keeper.InitGenesis
 	ExportGenesis             = keeper.ExportGenesis
@@ -60,6 +64,7 @@ var (
 	EncodeStakingMsg          = keeper.EncodeStakingMsg
 	EncodeWasmMsg             = keeper.EncodeWasmMsg
 	NewKeeper                 = keeper.NewKeeper
+	WithEnclave               = keeper.WithEnclave
 	NewQuerier                = keeper.NewGrpcQuerier
 	DefaultQueryPlugins       = keeper.DefaultQueryPlugins
 	BankQuerier               = keeper.BankQuerier
@@ -116,8 +121,23 @@ type (
 	GovEncoder                 = keeper.GovEncoder
 	MessageEncoders            = keeper.MessageEncoders
 	Keeper                     = keeper.Keeper
+	Option                     = keeper.Option
 	ContractInfoWithAddress    = types.ContractInfoWithAddress
 	QueryHandler               = keeper.QueryHandler
 	CustomQuerier              = keeper.CustomQuerier
 	QueryPlugins               = keeper.QueryPlugins
+
+	MsgStoreCodeResponse           = types.MsgStoreCodeResponse
+	MsgInstantiateContractResponse = types.MsgInstantiateContractResponse
+	MsgMigrateContractResponse     = types.MsgMigrateContractResponse
+	SecretMsg                      = types.SecretMsg
+	QueryClient                    = types.QueryClient
+	QueryServer                    = types.QueryServer
+	UnimplementedQueryServer       = types.UnimplementedQueryServer
+	QuerySecretContractRequest     = types.QuerySecretContractRequest
+	QuerySecretContractResponse    = types.QuerySecretContractResponse
+	QueryByCodeIdRequest           = types.QueryByCodeIdRequest
+	QueryByContractAddressRequest  = types.QueryByContractAddressRequest
+	QueryCodeHashResponse          = types.QueryCodeHashResponse
+	QueryCodeSchemaResponse        = types.QueryCodeSchemaResponse
 )
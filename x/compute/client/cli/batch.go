@@ -0,0 +1,534 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// BatchCmd broadcasts the actions of a batch file as the messages of one transaction
+func BatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [file.json|file.yaml]",
+		Short: "Store, instantiate, execute, migrate and administer contracts in one transaction",
+		Long: `Broadcast a list of actions as the messages of one transaction, which fails or succeeds as a whole.
+Each action of the list has exactly one of these keys:
+
+  - store:       {wasm_file, source, builder}
+  - instantiate: {code_id, label, msg, amount, admin, code_hash}
+  - execute:     {contract|label, msg, amount, code_hash}
+  - migrate:     {contract|label, code_id, msg, code_hash}
+  - set_admin:   {contract|label, new_admin}
+  - clear_admin: {contract|label}
+
+Contracts are addressed by address, or by the label they were instantiated with. Each msg is encrypted with its own
+nonce, for the code hash of its contract, which is queried unless it is set. With --generate-only, code hashes are
+not queried and labels can't be resolved, so set the code hashes in the file or with --code-hash, keyed by contract
+address, label or code ID, and set --enclave-key. Or, pass the --offline-bundle of the contracts and codes instead.
+
+The code IDs and addresses of the code and contracts that a batch stores and instantiates are only known once it is
+executed, so they can't be used by the later actions of the same batch.`,
+		Example: `  - instantiate:
+      code_id: 1
+      label: counter
+      msg: {"count": 0}
+  - execute:
+      label: other-counter
+      msg: {"increment": {}}
+      amount: 10uscrt`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			actions, err := readBatchFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			wasmCtx, err := newWASMContext(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bundle, err := readOfflineBundle(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			builder := newBatchBuilder(cliCtx, wasmCtx, filepath.Dir(args[0]))
+			builder.codeHashes, _ = cmd.Flags().GetStringToString(flagCodeHash)
+			builder.offline, _ = cmd.Flags().GetBool(flags.FlagGenerateOnly)
+			if bundle != nil {
+				builder.offline = true
+				builder.bundle = bundle
+				builder.wasmCtx.ConsensusIOPubKey = bundle.IOKey
+			} else if builder.offline {
+				builder.ioKeyPath, _ = cmd.Flags().GetString(flagIoMasterKey)
+				if builder.ioKeyPath == "" {
+					return fmt.Errorf("missing flag --%s. To create an offline transaction, you must specify path to the enclave key", flagIoMasterKey)
+				}
+			}
+
+			msgs, err := builder.msgs(actions)
+			if err != nil {
+				return err
+			}
+			for i, msg := range msgs {
+				if m, ok := msg.(sdk.HasValidateBasic); ok {
+					if err := m.ValidateBasic(); err != nil {
+						return fmt.Errorf("action %d: %w", i, err)
+					}
+				}
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msgs...)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringToString(flagCodeHash, nil, "For offline transactions, the code hashes of the contracts and codes "+
+		"of the batch, keyed by contract address, label or code ID, e.g. counter=<hash>,1=<hash>")
+	cmd.Flags().String(flagIoMasterKey, "", "For offline transactions, use this to specify the path to the "+
+		"io-master-key.txt file, which you can get using the command `secretcli q register secret-network-params` ")
+	flags.AddTxFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
+	addOfflineBundleFlag(cmd)
+	return cmd
+}
+
+// batchAction is an action of a batch file, which sets exactly one of its fields
+type batchAction struct {
+	Store       *batchStore       `json:"store,omitempty"`
+	Instantiate *batchInstantiate `json:"instantiate,omitempty"`
+	Execute     *batchExecute     `json:"execute,omitempty"`
+	Migrate     *batchMigrate     `json:"migrate,omitempty"`
+	SetAdmin    *batchSetAdmin    `json:"set_admin,omitempty"`
+	ClearAdmin  *batchClearAdmin  `json:"clear_admin,omitempty"`
+}
+
+type batchStore struct {
+	// WASMFile is the path of the wasm or gzipped wasm file, relative to the batch file
+	WASMFile string `json:"wasm_file"`
+	Source   string `json:"source"`
+	Builder  string `json:"builder"`
+}
+
+type batchInstantiate struct {
+	CodeID   uint64          `json:"code_id"`
+	Label    string          `json:"label"`
+	Msg      json.RawMessage `json:"msg"`
+	Amount   string          `json:"amount"`
+	Admin    string          `json:"admin"`
+	CodeHash string          `json:"code_hash"`
+}
+
+// batchContract addresses a contract by its address, or by its label
+type batchContract struct {
+	Contract string `json:"contract"`
+	Label    string `json:"label"`
+}
+
+type batchExecute struct {
+	batchContract
+	Msg      json.RawMessage `json:"msg"`
+	Amount   string          `json:"amount"`
+	CodeHash string          `json:"code_hash"`
+}
+
+type batchMigrate struct {
+	batchContract
+	CodeID   uint64          `json:"code_id"`
+	Msg      json.RawMessage `json:"msg"`
+	CodeHash string          `json:"code_hash"`
+}
+
+type batchSetAdmin struct {
+	batchContract
+	NewAdmin string `json:"new_admin"`
+}
+
+type batchClearAdmin struct {
+	batchContract
+}
+
+// readBatchFile reads the actions of a JSON or YAML batch file
+func readBatchFile(path string) ([]batchAction, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseBatch(bz)
+}
+
+// parseBatch parses the actions of a batch. YAML is a superset of JSON, so both are parsed as YAML
+func parseBatch(bz []byte) ([]batchAction, error) {
+	jsonBz, err := yaml.YAMLToJSON(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch file: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBz))
+	decoder.DisallowUnknownFields()
+	var actions []batchAction
+	if err := decoder.Decode(&actions); err != nil {
+		return nil, fmt.Errorf("invalid batch file: %w", err)
+	}
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("the batch has no actions")
+	}
+
+	for i, action := range actions {
+		set := 0
+		for _, isSet := range []bool{
+			action.Store != nil, action.Instantiate != nil, action.Execute != nil,
+			action.Migrate != nil, action.SetAdmin != nil, action.ClearAdmin != nil,
+		} {
+			if isSet {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("action %d: must have exactly one of store, instantiate, execute, migrate, set_admin or clear_admin", i)
+		}
+	}
+	return actions, nil
+}
+
+// batchBuilder builds the messages of the actions of a batch
+type batchBuilder struct {
+	sender  sdk.AccAddress
+	wasmCtx wasmUtils.WASMContext
+	// dir is the directory of the batch file, which the paths of wasm files are relative to
+	dir string
+
+	// offline batches are encrypted with the enclave key at ioKeyPath or of the bundle, and don't query the chain
+	offline   bool
+	ioKeyPath string
+	bundle    *OfflineBundle
+	// codeHashes are the code hashes set by flag, keyed by contract address, label or code ID
+	codeHashes map[string]string
+
+	addressByLabel     func(label string) (string, error)
+	codeHashByCodeID   func(codeID uint64) (string, error)
+	codeHashByContract func(contract string) (string, error)
+
+	// labels are the labels that the batch instantiates
+	labels map[string]bool
+}
+
+func newBatchBuilder(cliCtx client.Context, wasmCtx wasmUtils.WASMContext, dir string) *batchBuilder {
+	return &batchBuilder{
+		sender:  cliCtx.GetFromAddress(),
+		wasmCtx: wasmCtx,
+		dir:     dir,
+		addressByLabel: func(label string) (string, error) {
+			return GetContractAddressByLabel(label, cliCtx)
+		},
+		codeHashByCodeID: func(codeID uint64) (string, error) {
+			codeHash, err := GetCodeHashByCodeId(cliCtx, strconv.FormatUint(codeID, 10))
+			return string(codeHash), err
+		},
+		codeHashByContract: func(contract string) (string, error) {
+			codeHash, err := GetCodeHashByContractAddr(cliCtx, contract)
+			return string(codeHash), err
+		},
+	}
+}
+
+// msgs returns the messages of the actions, in order
+func (b *batchBuilder) msgs(actions []batchAction) ([]sdk.Msg, error) {
+	b.labels = map[string]bool{}
+
+	msgs := make([]sdk.Msg, len(actions))
+	for i, action := range actions {
+		var err error
+		switch {
+		case action.Store != nil:
+			msgs[i], err = b.store(action.Store)
+		case action.Instantiate != nil:
+			msgs[i], err = b.instantiate(action.Instantiate)
+		case action.Execute != nil:
+			msgs[i], err = b.execute(action.Execute)
+		case action.Migrate != nil:
+			msgs[i], err = b.migrate(action.Migrate)
+		case action.SetAdmin != nil:
+			msgs[i], err = b.setAdmin(action.SetAdmin)
+		case action.ClearAdmin != nil:
+			msgs[i], err = b.clearAdmin(action.ClearAdmin)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i, err)
+		}
+	}
+	return msgs, nil
+}
+
+func (b *batchBuilder) store(action *batchStore) (sdk.Msg, error) {
+	path := action.WASMFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(b.dir, path)
+	}
+	wasm, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// gzip the wasm file
+	if wasmUtils.IsWasm(wasm) {
+		wasm, err = wasmUtils.GzipIt(wasm)
+		if err != nil {
+			return nil, err
+		}
+	} else if !wasmUtils.IsGzip(wasm) {
+		return nil, fmt.Errorf("invalid input file %s. Use wasm binary or gzip", action.WASMFile)
+	}
+
+	return &types.MsgStoreCode{
+		Sender:       b.sender,
+		WASMByteCode: wasm,
+		Source:       action.Source,
+		Builder:      action.Builder,
+	}, nil
+}
+
+func (b *batchBuilder) instantiate(action *batchInstantiate) (sdk.Msg, error) {
+	if action.Label == "" {
+		return nil, fmt.Errorf("label is required on all contracts")
+	}
+	if b.labels[action.Label] {
+		return nil, fmt.Errorf("label %s is instantiated twice in the batch", action.Label)
+	}
+	if !b.offline {
+		if res, _ := b.addressByLabel(action.Label); res != "" {
+			return nil, fmt.Errorf("label %s already exists. You must choose a unique label for your contract instance", action.Label)
+		}
+	}
+	b.labels[action.Label] = true
+
+	amount, err := sdk.ParseCoinsNormalized(action.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if action.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(action.Admin); err != nil {
+			return nil, fmt.Errorf("admin address is not in bech32 format: %s", err)
+		}
+	}
+
+	codeHash, err := b.codeHashOfCode(action.CodeID, action.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	encryptedMsg, err := b.encrypt(codeHash, action.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstantiateContract{
+		Sender:    b.sender,
+		CodeID:    action.CodeID,
+		Label:     action.Label,
+		InitMsg:   encryptedMsg,
+		InitFunds: amount,
+		Admin:     action.Admin,
+	}, nil
+}
+
+func (b *batchBuilder) execute(action *batchExecute) (sdk.Msg, error) {
+	contract, err := b.contractAddress(action.batchContract)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := sdk.ParseCoinsNormalized(action.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash, err := b.codeHashOfContract(action.batchContract, contract, action.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	encryptedMsg, err := b.encrypt(codeHash, action.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteContract{
+		Sender:    b.sender,
+		Contract:  contract,
+		Msg:       encryptedMsg,
+		SentFunds: amount,
+	}, nil
+}
+
+func (b *batchBuilder) migrate(action *batchMigrate) (sdk.Msg, error) {
+	contract, err := b.contractAddress(action.batchContract)
+	if err != nil {
+		return nil, err
+	}
+
+	// migrate messages are encrypted for the code that the contract migrates to
+	codeHash, err := b.codeHashOfCode(action.CodeID, action.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	encryptedMsg, err := b.encrypt(codeHash, action.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateContract{
+		Sender:   b.sender.String(),
+		Contract: contract.String(),
+		CodeID:   action.CodeID,
+		Msg:      encryptedMsg,
+	}, nil
+}
+
+func (b *batchBuilder) setAdmin(action *batchSetAdmin) (sdk.Msg, error) {
+	contract, err := b.contractAddress(action.batchContract)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(action.NewAdmin); err != nil {
+		return nil, fmt.Errorf("new admin address is not in bech32 format: %s", err)
+	}
+
+	return &types.MsgUpdateAdmin{
+		Sender:   b.sender.String(),
+		NewAdmin: action.NewAdmin,
+		Contract: contract.String(),
+	}, nil
+}
+
+func (b *batchBuilder) clearAdmin(action *batchClearAdmin) (sdk.Msg, error) {
+	contract, err := b.contractAddress(action.batchContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearAdmin{
+		Sender:   b.sender.String(),
+		Contract: contract.String(),
+	}, nil
+}
+
+// contractAddress returns the address of a contract, which is resolved from its label if it isn't set
+func (b *batchBuilder) contractAddress(contract batchContract) (sdk.AccAddress, error) {
+	switch {
+	case contract.Contract != "" && contract.Label != "":
+		return nil, fmt.Errorf("set either the contract or the label of the contract, not both")
+	case contract.Contract != "":
+		return sdk.AccAddressFromBech32(contract.Contract)
+	case contract.Label == "":
+		return nil, fmt.Errorf("missing the contract or the label of the contract")
+	case b.labels[contract.Label]:
+		return nil, fmt.Errorf("contract %s is instantiated by the batch, so its address is only known once the batch is executed", contract.Label)
+	case b.bundle != nil:
+		return b.bundle.contractAddress(contract.Label)
+	case b.offline:
+		return nil, fmt.Errorf("can't resolve label %s offline, set the contract address instead", contract.Label)
+	}
+
+	address, err := b.addressByLabel(contract.Label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve label %s: %w", contract.Label, err)
+	}
+	return sdk.AccAddressFromBech32(address)
+}
+
+// codeHashOfCode returns the code hash of a code ID, which is set by the action, set by flag, or queried
+func (b *batchBuilder) codeHashOfCode(codeID uint64, codeHash string) (string, error) {
+	id := strconv.FormatUint(codeID, 10)
+	if codeHash == "" {
+		codeHash = b.codeHashes[id]
+	}
+	if codeHash != "" {
+		return normalizeCodeHash(codeHash)
+	}
+	if b.bundle != nil {
+		return b.bundle.codeHashOfCode(codeID)
+	}
+	if b.offline {
+		return "", fmt.Errorf("missing the code hash of code %s. To create an offline transaction, set it in the batch file or with --%s %s=<hash>", id, flagCodeHash, id)
+	}
+
+	codeHash, err := b.codeHashByCodeID(codeID)
+	if err != nil {
+		return "", fmt.Errorf("failed to query the code hash of code %s: %w", id, err)
+	}
+	return codeHash, nil
+}
+
+// codeHashOfContract returns the code hash of a contract, which is set by the action, set by flag for its address or
+// label, or queried
+func (b *batchBuilder) codeHashOfContract(contract batchContract, address sdk.AccAddress, codeHash string) (string, error) {
+	if codeHash == "" {
+		codeHash = b.codeHashes[address.String()]
+	}
+	if codeHash == "" && contract.Label != "" {
+		codeHash = b.codeHashes[contract.Label]
+	}
+	if codeHash != "" {
+		return normalizeCodeHash(codeHash)
+	}
+	if b.bundle != nil {
+		return b.bundle.codeHashOfContract(address.String())
+	}
+	if b.offline {
+		return "", fmt.Errorf("missing the code hash of contract %s. To create an offline transaction, set it in the batch file or with --%s %s=<hash>", address, flagCodeHash, address)
+	}
+
+	codeHash, err := b.codeHashByContract(address.String())
+	if err != nil {
+		return "", fmt.Errorf("contract address %s not found: %w", address, err)
+	}
+	return codeHash, nil
+}
+
+func normalizeCodeHash(codeHash string) (string, error) {
+	codeHash = strings.ToLower(strings.TrimPrefix(codeHash, "0x"))
+	if len(codeHash) != 64 {
+		return "", fmt.Errorf("invalid code hash %s, must be 64 hex characters", codeHash)
+	}
+	return codeHash, nil
+}
+
+// encrypt encrypts a message for a code hash with a nonce of its own
+func (b *batchBuilder) encrypt(codeHash string, msg json.RawMessage) ([]byte, error) {
+	if len(msg) == 0 {
+		return nil, fmt.Errorf("missing msg")
+	}
+	secretMsg := types.SecretMsg{
+		CodeHash: []byte(codeHash),
+		Msg:      msg,
+	}
+
+	var encryptedMsg []byte
+	var err error
+	if b.offline && b.bundle == nil {
+		encryptedMsg, err = b.wasmCtx.OfflineEncrypt(secretMsg.Serialize(), b.ioKeyPath)
+	} else {
+		encryptedMsg, err = b.wasmCtx.Encrypt(secretMsg.Serialize())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt the message: %w", err)
+	}
+	return encryptedMsg, nil
+}
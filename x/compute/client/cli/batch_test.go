@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+func TestParseBatch(t *testing.T) {
+	actions, err := parseBatch([]byte(`
+- store:
+    wasm_file: contract.wasm
+- instantiate:
+    code_id: 1
+    label: counter
+    msg: {"count": 0}
+- execute:
+    label: other
+    msg: {"increment": {}}
+    amount: 10uscrt
+- clear_admin:
+    contract: secret1abc
+`))
+	require.NoError(t, err)
+	require.Len(t, actions, 4)
+	require.Equal(t, "contract.wasm", actions[0].Store.WASMFile)
+	require.Equal(t, uint64(1), actions[1].Instantiate.CodeID)
+	require.JSONEq(t, `{"count":0}`, string(actions[1].Instantiate.Msg))
+	require.Equal(t, "other", actions[2].Execute.Label)
+	require.Equal(t, "10uscrt", actions[2].Execute.Amount)
+	require.Equal(t, "secret1abc", actions[3].ClearAdmin.Contract)
+
+	// JSON is YAML
+	actions, err = parseBatch([]byte(`[{"execute": {"contract": "secret1abc", "msg": {"a": {}}}}]`))
+	require.NoError(t, err)
+	require.Equal(t, "secret1abc", actions[0].Execute.Contract)
+
+	_, err = parseBatch([]byte(`[{"execute": {"contract": "a", "msg": {}}, "clear_admin": {"contract": "a"}}]`))
+	require.ErrorContains(t, err, "action 0: must have exactly one of")
+	_, err = parseBatch([]byte(`[{"exec": {}}]`))
+	require.ErrorContains(t, err, "unknown field")
+	_, err = parseBatch([]byte(`[]`))
+	require.ErrorContains(t, err, "no actions")
+}
+
+func TestBatchBuilder(t *testing.T) {
+	ioPrivkey := sha256.Sum256([]byte("io"))
+	ioPubkey, err := curve25519.X25519(ioPrivkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	me := newTestSender(t, "me", ioPubkey)
+	dir := t.TempDir()
+	keyPairPath := filepath.Join(dir, "id_tx_io.json")
+	require.NoError(t, wasmUtils.WriteTxKeyPair(keyPairPath, me.privkey, me.pubkey))
+	wasmCtx := wasmUtils.WASMContext{TestKeyPairPath: keyPairPath, TestMasterIOKey: regtypes.MasterKey{Bytes: ioPubkey}}
+
+	sender := sdk.AccAddress(strings.Repeat("s", 20))
+	counter := sdk.AccAddress(strings.Repeat("c", 20))
+	admin := sdk.AccAddress(strings.Repeat("a", 20))
+	otherCodeHash := strings.Repeat("cd", 32)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "contract.wasm"), []byte("\x00asm"), 0o600))
+
+	newBuilder := func() *batchBuilder {
+		return &batchBuilder{
+			sender:  sender,
+			wasmCtx: wasmCtx,
+			dir:     dir,
+			addressByLabel: func(label string) (string, error) {
+				if label == "counter" {
+					return counter.String(), nil
+				}
+				return "", fmt.Errorf("no contract with label %s", label)
+			},
+			codeHashByCodeID: func(codeID uint64) (string, error) {
+				if codeID == 2 {
+					return otherCodeHash, nil
+				}
+				return testCodeHash, nil
+			},
+			codeHashByContract: func(string) (string, error) { return testCodeHash, nil },
+		}
+	}
+
+	// decrypt returns the code hash and message of an encrypted message, and its nonce
+	decrypt := func(encrypted []byte) (string, string, []byte) {
+		_, plaintext, err := decryptInput(wasmCtx, me.pubkey, encrypted)
+		require.NoError(t, err)
+		return string(plaintext[:64]), string(plaintext[64:]), encrypted[:32]
+	}
+
+	actions, err := parseBatch([]byte(`
+- store:
+    wasm_file: contract.wasm
+- instantiate:
+    code_id: 1
+    label: new
+    msg: {"count": 0}
+- execute:
+    label: counter
+    msg: {"increment": {}}
+    amount: 10uscrt
+- execute:
+    contract: ` + counter.String() + `
+    msg: {"increment": {}}
+- migrate:
+    label: counter
+    code_id: 2
+    msg: {"migrate": {}}
+- set_admin:
+    label: counter
+    new_admin: ` + admin.String() + `
+`))
+	require.NoError(t, err)
+
+	msgs, err := newBuilder().msgs(actions)
+	require.NoError(t, err)
+	require.Len(t, msgs, 6)
+
+	store := msgs[0].(*types.MsgStoreCode)
+	require.True(t, wasmUtils.IsGzip(store.WASMByteCode))
+
+	instantiate := msgs[1].(*types.MsgInstantiateContract)
+	require.Equal(t, "new", instantiate.Label)
+	codeHash, msg, _ := decrypt(instantiate.InitMsg)
+	require.Equal(t, testCodeHash, codeHash)
+	require.Equal(t, `{"count":0}`, msg)
+
+	execute := msgs[2].(*types.MsgExecuteContract)
+	require.Equal(t, counter, execute.Contract)
+	require.Equal(t, "10uscrt", execute.SentFunds.String())
+	_, msg, nonce := decrypt(execute.Msg)
+	require.Equal(t, `{"increment":{}}`, msg)
+	_, _, otherNonce := decrypt(msgs[3].(*types.MsgExecuteContract).Msg)
+	require.NotEqual(t, nonce, otherNonce, "each message has a nonce of its own")
+
+	migrate := msgs[4].(*types.MsgMigrateContract)
+	require.Equal(t, counter.String(), migrate.Contract)
+	codeHash, _, _ = decrypt(migrate.Msg)
+	require.Equal(t, otherCodeHash, codeHash, "migrate messages are encrypted for the new code")
+
+	require.Equal(t, &types.MsgUpdateAdmin{Sender: sender.String(), NewAdmin: admin.String(), Contract: counter.String()}, msgs[5])
+	for _, msg := range msgs {
+		require.NoError(t, msg.(sdk.HasValidateBasic).ValidateBasic())
+	}
+
+	// contracts that the batch instantiates can't be addressed by the batch
+	actions, err = parseBatch([]byte(`[
+		{"instantiate": {"code_id": 1, "label": "new", "msg": {}}},
+		{"execute": {"label": "new", "msg": {}}}
+	]`))
+	require.NoError(t, err)
+	_, err = newBuilder().msgs(actions)
+	require.ErrorContains(t, err, "action 1: contract new is instantiated by the batch")
+
+	actions, err = parseBatch([]byte(`[{"instantiate": {"code_id": 1, "label": "counter", "msg": {}}}]`))
+	require.NoError(t, err)
+	_, err = newBuilder().msgs(actions)
+	require.ErrorContains(t, err, "label counter already exists")
+
+	// offline batches take their code hashes from the batch file and the flag, and don't resolve labels
+	ioKeyPath := filepath.Join(dir, "io-master-key.txt")
+	require.NoError(t, os.WriteFile(ioKeyPath, []byte(base64.StdEncoding.EncodeToString(ioPubkey)), 0o600))
+	offline := newBuilder()
+	offline.offline = true
+	offline.ioKeyPath = ioKeyPath
+	offline.codeHashes = map[string]string{counter.String(): strings.ToUpper(otherCodeHash)}
+
+	actions, err = parseBatch([]byte(`[
+		{"execute": {"contract": "` + counter.String() + `", "msg": {"a": {}}}},
+		{"instantiate": {"code_id": 3, "label": "new", "msg": {}, "code_hash": "` + testCodeHash + `"}}
+	]`))
+	require.NoError(t, err)
+	msgs, err = offline.msgs(actions)
+	require.NoError(t, err)
+	codeHash, msg, _ = decrypt(msgs[0].(*types.MsgExecuteContract).Msg)
+	require.Equal(t, otherCodeHash, codeHash)
+	require.Equal(t, `{"a":{}}`, msg)
+	codeHash, _, _ = decrypt(msgs[1].(*types.MsgInstantiateContract).InitMsg)
+	require.Equal(t, testCodeHash, codeHash)
+
+	actions, err = parseBatch([]byte(`[{"execute": {"label": "counter", "msg": {}}}]`))
+	require.NoError(t, err)
+	_, err = offline.msgs(actions)
+	require.ErrorContains(t, err, "can't resolve label counter offline")
+
+	actions, err = parseBatch([]byte(`[{"migrate": {"contract": "` + counter.String() + `", "code_id": 4, "msg": {}}}]`))
+	require.NoError(t, err)
+	_, err = offline.msgs(actions)
+	require.ErrorContains(t, err, "missing the code hash of code 4")
+}
@@ -0,0 +1,316 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// DecryptedTx is the output of `secretd q compute tx`. Indexers read its JSON, so its fields are only ever added to,
+// and are always present
+type DecryptedTx struct {
+	TxHash  string              `json:"tx_hash"`
+	Height  int64               `json:"height"`
+	Code    uint32              `json:"code"`
+	Answers []DecryptedTxAnswer `json:"answers"`
+	// OutputLogs are the wasm and wasm-* events of the transaction, with the attributes decrypted
+	OutputLogs []sdk.StringEvent `json:"output_logs"`
+	// OutputError is the decrypted error of the contract that failed the transaction
+	OutputError string `json:"output_error"`
+	// PlaintextError is the error of the enclave when it failed the transaction
+	PlaintextError string `json:"plaintext_error"`
+}
+
+// DecryptedTxAnswer is a message of a transaction and its output. Messages that were encrypted with the tx encryption
+// key in use are decrypted, the others have a DecryptionError
+type DecryptedTxAnswer struct {
+	MsgIndex int `json:"msg_index"`
+	// Type is the type of compute messages, e.g. "execute", and the type URL of other messages
+	Type            string `json:"type"`
+	ContractAddress string `json:"contract_address"`
+	CodeHash        string `json:"code_hash"`
+	Input           string `json:"input"`
+	// OutputData is the base64 data that the contract responded with, and OutputDataAsString the data itself
+	OutputData         string `json:"output_data"`
+	OutputDataAsString string `json:"output_data_as_string"`
+	Decrypted          bool   `json:"decrypted"`
+	DecryptionError    string `json:"decryption_error"`
+}
+
+var msgIndexRegex = regexp.MustCompile(`message index: (\d+)`)
+
+// decryptTx decrypts the messages of a transaction that were encrypted with the tx encryption key of wasmCtx, and the
+// outputs, events and error of those messages. Messages that can't be decrypted are reported as such
+func decryptTx(wasmCtx wasmUtils.WASMContext, result *sdk.TxResponse) (*DecryptedTx, error) {
+	if result.GetTx() == nil {
+		return nil, fmt.Errorf("transaction %s has no messages", result.TxHash)
+	}
+	msgs := result.GetTx().GetMsgs()
+
+	_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
+	}
+
+	decrypted := &DecryptedTx{
+		TxHash:     result.TxHash,
+		Height:     result.Height,
+		Code:       result.Code,
+		Answers:    make([]DecryptedTxAnswer, len(msgs)),
+		OutputLogs: []sdk.StringEvent{},
+	}
+	// txEncryptionKeys are the keys of the messages, which are nil for messages that can't be decrypted
+	txEncryptionKeys := make([][]byte, len(msgs))
+
+	for i, msg := range msgs {
+		answer := &decrypted.Answers[i]
+		answer.MsgIndex = i
+
+		var encryptedInput []byte
+		switch msg := msg.(type) {
+		case *types.MsgStoreCode:
+			answer.Type = "store-code"
+		case *types.MsgInstantiateContract:
+			answer.Type = "instantiate"
+			encryptedInput = msg.InitMsg
+		case *types.MsgExecuteContract:
+			answer.Type = "execute"
+			answer.ContractAddress = msg.Contract.String()
+			encryptedInput = msg.Msg
+		case *types.MsgMigrateContract:
+			answer.Type = "migrate"
+			answer.ContractAddress = msg.Contract
+			encryptedInput = msg.Msg
+		case *types.MsgUpdateAdmin:
+			answer.Type = "update-admin"
+			answer.ContractAddress = msg.Contract
+		case *types.MsgClearAdmin:
+			answer.Type = "clear-admin"
+			answer.ContractAddress = msg.Contract
+		default:
+			answer.Type = sdk.MsgTypeURL(msg)
+		}
+		if encryptedInput == nil {
+			continue
+		}
+
+		txEncryptionKey, plaintextInput, err := decryptInput(wasmCtx, myPubkey, encryptedInput)
+		if err != nil {
+			answer.DecryptionError = err.Error()
+			continue
+		}
+
+		// the code hash leads the input as 64 hex characters
+		if len(plaintextInput) >= 64 {
+			answer.CodeHash = string(plaintextInput[:64])
+			plaintextInput = plaintextInput[64:]
+		}
+		answer.Input = string(plaintextInput)
+		answer.Decrypted = true
+		txEncryptionKeys[i] = txEncryptionKey
+	}
+
+	if err := decryptOutputData(result.Data, decrypted.Answers, txEncryptionKeys); err != nil {
+		return nil, err
+	}
+
+	for _, event := range result.Events {
+		if event.Type != "wasm" && !strings.HasPrefix(event.Type, "wasm-") {
+			continue
+		}
+		decrypted.OutputLogs = append(decrypted.OutputLogs, sdk.StringifyEvent(decryptEvent(event, txEncryptionKeys)))
+	}
+
+	if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
+		decrypted.OutputError = decryptOutputError(result.RawLog, txEncryptionKeys)
+	} else if types.ContainsEnclaveError(result.RawLog) {
+		decrypted.PlaintextError = result.RawLog
+	}
+
+	return decrypted, nil
+}
+
+// decryptInput decrypts the input of a message, and returns it with the tx encryption key of the message
+func decryptInput(wasmCtx wasmUtils.WASMContext, myPubkey []byte, encryptedInput []byte) ([]byte, []byte, error) {
+	nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't parse encrypted blob: %w", err)
+	}
+
+	if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
+		return nil, nil, fmt.Errorf("cannot decrypt, not encrypted with the tx encryption key of this account")
+	}
+
+	txEncryptionKey, err := wasmCtx.GetTxEncryptionKey(nonce)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while trying to derive the tx encryption key: %w", err)
+	}
+
+	plaintextInput, err := wasmUtils.DecryptData(txEncryptionKey, ciphertextInput)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while trying to decrypt the tx input: %w", err)
+	}
+
+	return txEncryptionKey, plaintextInput, nil
+}
+
+// decryptOutputData decrypts the data of the responses of the messages in the hex data of a transaction
+func decryptOutputData(dataOutputHex string, answers []DecryptedTxAnswer, txEncryptionKeys [][]byte) error {
+	if dataOutputHex == "" {
+		return nil
+	}
+
+	dataOutputAsProtobuf, err := hex.DecodeString(dataOutputHex)
+	if err != nil {
+		return fmt.Errorf("error while trying to decode the encrypted output data from hex string: %w", err)
+	}
+
+	var txData sdk.TxMsgData
+	err = proto.Unmarshal(dataOutputAsProtobuf, &txData)
+	if err != nil {
+		return fmt.Errorf("error while trying to parse data as protobuf: %w: %s", err, dataOutputHex)
+	}
+
+	for i, msgData := range txData.MsgResponses {
+		if i >= len(answers) || txEncryptionKeys[i] == nil || len(msgData.Value) == 0 {
+			continue
+		}
+
+		var dataField []byte
+		switch msgData.TypeUrl {
+		case "/secret.compute.v1beta1.MsgInstantiateContractResponse":
+			var msgResponse types.MsgInstantiateContractResponse
+			if err := proto.Unmarshal(msgData.Value, &msgResponse); err != nil {
+				continue
+			}
+			answers[i].ContractAddress = msgResponse.Address
+			dataField = msgResponse.Data
+		case "/secret.compute.v1beta1.MsgExecuteContractResponse":
+			var msgResponse types.MsgExecuteContractResponse
+			if err := proto.Unmarshal(msgData.Value, &msgResponse); err != nil {
+				continue
+			}
+			dataField = msgResponse.Data
+		case "/secret.compute.v1beta1.MsgMigrateContractResponse":
+			var msgResponse types.MsgMigrateContractResponse
+			if err := proto.Unmarshal(msgData.Value, &msgResponse); err != nil {
+				continue
+			}
+			dataField = msgResponse.Data
+		default:
+			continue
+		}
+		if len(dataField) == 0 {
+			continue
+		}
+
+		dataPlaintextB64Bz, err := wasmUtils.DecryptData(txEncryptionKeys[i], dataField)
+		if err != nil {
+			answers[i].DecryptionError = fmt.Sprintf("error while trying to decrypt the output data: %s", err)
+			continue
+		}
+		answers[i].OutputData = string(dataPlaintextB64Bz)
+
+		dataPlaintext, err := base64.StdEncoding.DecodeString(answers[i].OutputData)
+		if err != nil {
+			continue
+		}
+		answers[i].OutputDataAsString = string(dataPlaintext)
+	}
+
+	return nil
+}
+
+// decryptEvent decrypts the attribute keys and values of a wasm event. Events of a message are tried with its key
+// first, as the msg_index attribute tells, and then with the keys of the other messages, because events of submessages
+// are encrypted with the key of the message that sent them. Attributes that don't look encrypted are left as-is
+func decryptEvent(event abci.Event, txEncryptionKeys [][]byte) abci.Event {
+	msgIndex := -1
+	for _, a := range event.Attributes {
+		if a.Key == "msg_index" {
+			if index, err := strconv.Atoi(a.Value); err == nil {
+				msgIndex = index
+			}
+		}
+	}
+	keys := keysByMsgIndex(msgIndex, txEncryptionKeys)
+
+	attributes := make([]abci.EventAttribute, len(event.Attributes))
+	for i, a := range event.Attributes {
+		if a.Key != "contract_address" && a.Key != "msg_index" {
+			a.Key = tryDecryptAttribute(a.Key, keys)
+			a.Value = tryDecryptAttribute(a.Value, keys)
+		}
+		attributes[i] = a
+	}
+	event.Attributes = attributes
+	return event
+}
+
+func tryDecryptAttribute(attribute string, txEncryptionKeys [][]byte) string {
+	if attribute == "" {
+		return attribute
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(attribute)
+	if err != nil {
+		return attribute
+	}
+	for _, txEncryptionKey := range txEncryptionKeys {
+		plaintext, err := wasmUtils.DecryptData(txEncryptionKey, ciphertext)
+		if err == nil {
+			return string(plaintext)
+		}
+	}
+	return attribute
+}
+
+// decryptOutputError decrypts the error of the contract that failed a transaction with the key of the message that
+// failed, or with the keys of the other messages if the log doesn't tell which one failed
+func decryptOutputError(rawLog string, txEncryptionKeys [][]byte) string {
+	errorCipherBz, err := wasmUtils.ParseEncryptedError(rawLog)
+	if err != nil {
+		return ""
+	}
+
+	msgIndex := -1
+	if match := msgIndexRegex.FindStringSubmatch(rawLog); match != nil {
+		msgIndex, _ = strconv.Atoi(match[1])
+	}
+
+	for i, txEncryptionKey := range txEncryptionKeys {
+		if txEncryptionKey == nil || (msgIndex >= 0 && i != msgIndex) {
+			continue
+		}
+		stdErr, err := wasmUtils.DecryptData(txEncryptionKey, errorCipherBz)
+		if err != nil {
+			continue
+		}
+		return fmt.Sprintf("message index %d: %s", i, stdErr)
+	}
+	return ""
+}
+
+// keysByMsgIndex returns the keys of the messages that can be decrypted, the key of msgIndex first
+func keysByMsgIndex(msgIndex int, txEncryptionKeys [][]byte) [][]byte {
+	var keys [][]byte
+	if msgIndex >= 0 && msgIndex < len(txEncryptionKeys) && txEncryptionKeys[msgIndex] != nil {
+		keys = append(keys, txEncryptionKeys[msgIndex])
+	}
+	for i, txEncryptionKey := range txEncryptionKeys {
+		if txEncryptionKey != nil && i != msgIndex {
+			keys = append(keys, txEncryptionKey)
+		}
+	}
+	return keys
+}
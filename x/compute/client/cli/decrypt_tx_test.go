@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+var testCodeHash = strings.Repeat("ab", 32)
+
+// testSender encrypts messages and outputs with a tx encryption key, like a client and the enclave do
+type testSender struct {
+	t        *testing.T
+	privkey  []byte
+	pubkey   []byte
+	ioPubkey []byte
+}
+
+func newTestSender(t *testing.T, seed string, ioPubkey []byte) testSender {
+	privkey := sha256.Sum256([]byte(seed))
+	pubkey, err := curve25519.X25519(privkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+	return testSender{t: t, privkey: privkey[:], pubkey: pubkey, ioPubkey: ioPubkey}
+}
+
+// encryptMsg returns an encrypted message and the key of its outputs
+func (s testSender) encryptMsg(msg string) ([]byte, []byte) {
+	nonce := sha256.Sum256([]byte(msg))
+	key, err := wasmUtils.GetTxEncryptionKeyOffline(s.ioPubkey, s.privkey, nonce[:])
+	require.NoError(s.t, err)
+	ciphertext, err := wasmUtils.EncryptData(key, s.pubkey, []byte(testCodeHash+msg), nonce[:])
+	require.NoError(s.t, err)
+	return ciphertext, key
+}
+
+func encryptOutput(t *testing.T, key []byte, output string) []byte {
+	ciphertext, err := wasmUtils.EncryptData(key, nil, []byte(output), nil)
+	require.NoError(t, err)
+	return ciphertext
+}
+
+func encryptAttribute(t *testing.T, key []byte, attribute string) string {
+	return base64.StdEncoding.EncodeToString(encryptOutput(t, key, attribute))
+}
+
+func encryptData(t *testing.T, key []byte, data string) []byte {
+	return encryptOutput(t, key, base64.StdEncoding.EncodeToString([]byte(data)))
+}
+
+func newTestTxResponse(t *testing.T, msgs []sdk.Msg, msgResponses []proto.Message) *sdk.TxResponse {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+	}
+	txAny, err := codectypes.NewAnyWithValue(&txtypes.Tx{Body: &txtypes.TxBody{Messages: anys}})
+	require.NoError(t, err)
+
+	txMsgData := sdk.TxMsgData{}
+	for _, msgResponse := range msgResponses {
+		msgResponseAny, err := codectypes.NewAnyWithValue(msgResponse)
+		require.NoError(t, err)
+		txMsgData.MsgResponses = append(txMsgData.MsgResponses, msgResponseAny)
+	}
+	data, err := proto.Marshal(&txMsgData)
+	require.NoError(t, err)
+
+	return &sdk.TxResponse{TxHash: "HASH", Height: 7, Tx: txAny, Data: strings.ToUpper(hex.EncodeToString(data))}
+}
+
+func TestDecryptTx(t *testing.T) {
+	ioPrivkey := sha256.Sum256([]byte("io"))
+	ioPubkey, err := curve25519.X25519(ioPrivkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	me := newTestSender(t, "me", ioPubkey)
+	other := newTestSender(t, "other", ioPubkey)
+
+	keyPairPath := filepath.Join(t.TempDir(), "id_tx_io.json")
+	require.NoError(t, wasmUtils.WriteTxKeyPair(keyPairPath, me.privkey, me.pubkey))
+	wasmCtx := wasmUtils.WASMContext{TestKeyPairPath: keyPairPath, TestMasterIOKey: regtypes.MasterKey{Bytes: ioPubkey}}
+
+	contract := sdk.AccAddress(strings.Repeat("c", 20))
+	executeMsg, executeKey := me.encryptMsg(`{"increment":{}}`)
+	otherMsg, otherKey := other.encryptMsg(`{"reset":{}}`)
+	migrateMsg, migrateKey := me.encryptMsg(`{"migrate":{}}`)
+
+	result := newTestTxResponse(t,
+		[]sdk.Msg{
+			&types.MsgExecuteContract{Contract: contract, Msg: executeMsg},
+			&types.MsgExecuteContract{Contract: contract, Msg: otherMsg},
+			&types.MsgMigrateContract{Contract: contract.String(), CodeID: 2, Msg: migrateMsg},
+			&types.MsgClearAdmin{Contract: contract.String()},
+			&banktypes.MsgSend{},
+		},
+		[]proto.Message{
+			&types.MsgExecuteContractResponse{Data: encryptData(t, executeKey, `{"count":1}`)},
+			&types.MsgExecuteContractResponse{Data: encryptData(t, otherKey, `{"count":0}`)},
+			&types.MsgMigrateContractResponse{Data: encryptData(t, migrateKey, `migrated`)},
+			&types.MsgClearAdminResponse{},
+			&banktypes.MsgSendResponse{},
+		},
+	)
+	result.Events = []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "execute"}}},
+		{Type: "wasm", Attributes: []abci.EventAttribute{
+			{Key: "contract_address", Value: contract.String()},
+			{Key: encryptAttribute(t, executeKey, "count"), Value: encryptAttribute(t, executeKey, "1")},
+			{Key: "msg_index", Value: "0"},
+		}},
+		// events of submessages are encrypted with the key of their message, which msg_index doesn't tell
+		{Type: "wasm-counted", Attributes: []abci.EventAttribute{
+			{Key: "contract_address", Value: contract.String()},
+			{Key: encryptAttribute(t, migrateKey, "by"), Value: encryptAttribute(t, migrateKey, "migrate")},
+			{Key: "msg_index", Value: "0"},
+		}},
+		{Type: "wasm", Attributes: []abci.EventAttribute{
+			{Key: "contract_address", Value: contract.String()},
+			{Key: encryptAttribute(t, otherKey, "secret"), Value: "plain"},
+		}},
+	}
+
+	decrypted, err := decryptTx(wasmCtx, result)
+	require.NoError(t, err)
+	require.Equal(t, "HASH", decrypted.TxHash)
+	require.Len(t, decrypted.Answers, 5)
+
+	require.Equal(t, DecryptedTxAnswer{
+		MsgIndex:           0,
+		Type:               "execute",
+		ContractAddress:    contract.String(),
+		CodeHash:           testCodeHash,
+		Input:              `{"increment":{}}`,
+		OutputData:         base64.StdEncoding.EncodeToString([]byte(`{"count":1}`)),
+		OutputDataAsString: `{"count":1}`,
+		Decrypted:          true,
+	}, decrypted.Answers[0])
+
+	require.False(t, decrypted.Answers[1].Decrypted)
+	require.Contains(t, decrypted.Answers[1].DecryptionError, "not encrypted with the tx encryption key of this account")
+	require.Empty(t, decrypted.Answers[1].OutputDataAsString)
+
+	require.Equal(t, "migrate", decrypted.Answers[2].Type)
+	require.Equal(t, `{"migrate":{}}`, decrypted.Answers[2].Input)
+	require.Equal(t, "migrated", decrypted.Answers[2].OutputDataAsString)
+
+	require.Equal(t, "clear-admin", decrypted.Answers[3].Type)
+	require.Equal(t, contract.String(), decrypted.Answers[3].ContractAddress)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", decrypted.Answers[4].Type)
+
+	require.Len(t, decrypted.OutputLogs, 3)
+	require.Equal(t, "wasm", decrypted.OutputLogs[0].Type)
+	require.Contains(t, decrypted.OutputLogs[0].Attributes, sdk.Attribute{Key: "count", Value: "1"})
+	require.Equal(t, "wasm-counted", decrypted.OutputLogs[1].Type)
+	require.Contains(t, decrypted.OutputLogs[1].Attributes, sdk.Attribute{Key: "by", Value: "migrate"})
+	// attributes of other senders stay encrypted
+	require.NotContains(t, decrypted.OutputLogs[2].Attributes, sdk.Attribute{Key: "secret", Value: "plain"})
+	require.Contains(t, decrypted.OutputLogs[2].Attributes, sdk.Attribute{Key: encryptAttribute(t, otherKey, "secret"), Value: "plain"})
+}
+
+func TestDecryptTxError(t *testing.T) {
+	ioPrivkey := sha256.Sum256([]byte("io"))
+	ioPubkey, err := curve25519.X25519(ioPrivkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	me := newTestSender(t, "me", ioPubkey)
+	keyPairPath := filepath.Join(t.TempDir(), "id_tx_io.json")
+	require.NoError(t, wasmUtils.WriteTxKeyPair(keyPairPath, me.privkey, me.pubkey))
+	wasmCtx := wasmUtils.WASMContext{TestKeyPairPath: keyPairPath, TestMasterIOKey: regtypes.MasterKey{Bytes: ioPubkey}}
+
+	contract := sdk.AccAddress(strings.Repeat("c", 20))
+	firstMsg, _ := me.encryptMsg(`{"first":{}}`)
+	secondMsg, secondKey := me.encryptMsg(`{"second":{}}`)
+
+	result := newTestTxResponse(t, []sdk.Msg{
+		&types.MsgExecuteContract{Contract: contract, Msg: firstMsg},
+		&types.MsgExecuteContract{Contract: contract, Msg: secondMsg},
+	}, nil)
+	result.Data = ""
+	result.Code = types.ErrExecuteFailed.ABCICode()
+	result.RawLog = "failed to execute message; message index: 1: encrypted: " +
+		encryptAttribute(t, secondKey, `{"generic_err":{"msg":"failed"}}`) + ": execute contract failed"
+
+	decrypted, err := decryptTx(wasmCtx, result)
+	require.NoError(t, err)
+	require.Equal(t, `message index 1: {"generic_err":{"msg":"failed"}}`, decrypted.OutputError)
+	require.Empty(t, decrypted.PlaintextError)
+	require.True(t, decrypted.Answers[0].Decrypted)
+	require.True(t, decrypted.Answers[1].Decrypted)
+}
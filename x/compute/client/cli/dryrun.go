@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const flagGasSafetyFactor = "gas-safety-factor"
+
+// DryRunResult is the output of `secretd tx compute execute --dry-run`
+type DryRunResult struct {
+	GasUsed uint64 `json:"gas_used"`
+	// SuggestedGasLimit is the gas used times the safety factor, rounded up
+	SuggestedGasLimit uint64 `json:"suggested_gas_limit"`
+	// GasBreakdown are the contract calls, sub-messages and contract queries of the execution, in the order they
+	// started. The gas of a call includes the gas of the calls it made
+	GasBreakdown []types.GasProfileCall `json:"gas_breakdown"`
+	// OtherGas is the gas that the transaction used outside of contracts, e.g. to verify its signature
+	OtherGas   uint64            `json:"other_gas"`
+	Answer     DecryptedTxAnswer `json:"answer"`
+	OutputLogs []sdk.StringEvent `json:"output_logs"`
+}
+
+// dryRun simulates an execution of a contract, and prints its decrypted output and the gas it used
+func dryRun(cliCtx client.Context, flagSet *flag.FlagSet, wasmCtx wasmUtils.WASMContext, msg *types.MsgExecuteContract) error {
+	safetyFactor, err := flagSet.GetFloat64(flagGasSafetyFactor)
+	if err != nil {
+		return err
+	}
+	if safetyFactor < 1 {
+		return fmt.Errorf("--%s must be at least 1", flagGasSafetyFactor)
+	}
+	if cliCtx.Offline {
+		return errors.New("cannot simulate in offline mode")
+	}
+
+	_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+	if err != nil {
+		return fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
+	}
+	txEncryptionKey, plaintextInput, err := decryptInput(wasmCtx, myPubkey, msg.Msg)
+	if err != nil {
+		return err
+	}
+
+	txf, err := tx.NewFactoryCLI(cliCtx, flagSet)
+	if err != nil {
+		return err
+	}
+	txf, err = txf.Prepare(cliCtx)
+	if err != nil {
+		return err
+	}
+	simRes, _, simErr := tx.CalculateGas(cliCtx, txf, msg)
+
+	result, err := decryptSimulation(msg, plaintextInput, txEncryptionKey, simRes, simErr, safetyFactor)
+	if err != nil {
+		return err
+	}
+
+	jsonBz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return cliCtx.PrintRaw(jsonBz)
+}
+
+// decryptSimulation decrypts the output of the simulation of an execution with its tx encryption key, and breaks down
+// the gas it used by the gas profile events of the simulation
+func decryptSimulation(msg *types.MsgExecuteContract, plaintextInput []byte, txEncryptionKey []byte, simRes *txtypes.SimulateResponse, simErr error, safetyFactor float64) (*DryRunResult, error) {
+	txEncryptionKeys := [][]byte{txEncryptionKey}
+
+	if simErr != nil {
+		if types.ContainsEncryptedString(simErr.Error()) {
+			if outputError := decryptOutputError(simErr.Error(), txEncryptionKeys); outputError != "" {
+				return nil, fmt.Errorf("the simulation failed: %s", outputError)
+			}
+		}
+		return nil, fmt.Errorf("the simulation failed: %w", simErr)
+	}
+	if simRes.GasInfo == nil || simRes.Result == nil {
+		return nil, errors.New("the simulation has no result")
+	}
+
+	result := &DryRunResult{
+		GasUsed:           simRes.GasInfo.GasUsed,
+		SuggestedGasLimit: uint64(math.Ceil(float64(simRes.GasInfo.GasUsed) * safetyFactor)),
+		GasBreakdown:      []types.GasProfileCall{},
+		OutputLogs:        []sdk.StringEvent{},
+		Answer: DecryptedTxAnswer{
+			Type:            "execute",
+			ContractAddress: msg.Contract.String(),
+			Decrypted:       true,
+		},
+	}
+
+	// the code hash leads the input as 64 hex characters
+	if len(plaintextInput) >= 64 {
+		result.Answer.CodeHash = string(plaintextInput[:64])
+		plaintextInput = plaintextInput[64:]
+	}
+	result.Answer.Input = string(plaintextInput)
+
+	txData, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: simRes.Result.MsgResponses})
+	if err != nil {
+		return nil, err
+	}
+	answers := []DecryptedTxAnswer{result.Answer}
+	if err := decryptOutputData(hex.EncodeToString(txData), answers, txEncryptionKeys); err != nil {
+		return nil, err
+	}
+	result.Answer = answers[0]
+
+	contractGas := uint64(0)
+	for _, event := range simRes.Result.Events {
+		switch {
+		case event.Type == types.EventTypeGasProfile:
+			call := types.GasProfileCall{}
+			for _, a := range event.Attributes {
+				switch a.Key {
+				case types.AttributeKeyCallKind:
+					call.Kind = a.Value
+				case types.AttributeKeyContractAddr:
+					call.Contract = a.Value
+				case types.AttributeKeyCallDepth:
+					depth, _ := strconv.ParseUint(a.Value, 10, 32)
+					call.Depth = uint32(depth)
+				case types.AttributeKeyGasUsed:
+					call.GasUsed, _ = strconv.ParseUint(a.Value, 10, 64)
+				}
+			}
+			if call.Depth == 0 {
+				contractGas += call.GasUsed
+			}
+			result.GasBreakdown = append(result.GasBreakdown, call)
+		case event.Type == "wasm" || strings.HasPrefix(event.Type, "wasm-"):
+			result.OutputLogs = append(result.OutputLogs, sdk.StringifyEvent(decryptEvent(event, txEncryptionKeys)))
+		}
+	}
+	if contractGas < result.GasUsed {
+		result.OtherGas = result.GasUsed - contractGas
+	}
+
+	return result, nil
+}
+
+func addDryRunFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64(flagGasSafetyFactor, 1.3, fmt.Sprintf("With --%s, the suggested gas limit is the gas "+
+		"used times this factor", flags.FlagDryRun))
+}
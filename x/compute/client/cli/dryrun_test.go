@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+func TestDecryptSimulation(t *testing.T) {
+	ioPrivkey := sha256.Sum256([]byte("io"))
+	ioPubkey, err := curve25519.X25519(ioPrivkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	me := newTestSender(t, "me", ioPubkey)
+	keyPairPath := filepath.Join(t.TempDir(), "id_tx_io.json")
+	require.NoError(t, wasmUtils.WriteTxKeyPair(keyPairPath, me.privkey, me.pubkey))
+	wasmCtx := wasmUtils.WASMContext{TestKeyPairPath: keyPairPath, TestMasterIOKey: regtypes.MasterKey{Bytes: ioPubkey}}
+
+	forwarder := sdk.AccAddress(strings.Repeat("f", 20))
+	counter := sdk.AccAddress(strings.Repeat("c", 20))
+	encryptedMsg, _ := me.encryptMsg(`{"forward":{}}`)
+	msg := &types.MsgExecuteContract{Contract: forwarder, Msg: encryptedMsg}
+
+	key, plaintextInput, err := decryptInput(wasmCtx, me.pubkey, encryptedMsg)
+	require.NoError(t, err)
+
+	msgResponse, err := codectypes.NewAnyWithValue(&types.MsgExecuteContractResponse{Data: encryptData(t, key, `{"count":1}`)})
+	require.NoError(t, err)
+	gasEvent := func(kind string, contract sdk.AccAddress, depth string, gasUsed string) abci.Event {
+		return abci.Event{Type: types.EventTypeGasProfile, Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyCallKind, Value: kind},
+			{Key: types.AttributeKeyContractAddr, Value: contract.String()},
+			{Key: types.AttributeKeyCallDepth, Value: depth},
+			{Key: types.AttributeKeyGasUsed, Value: gasUsed},
+			{Key: "msg_index", Value: "0"},
+		}}
+	}
+	simRes := &txtypes.SimulateResponse{
+		GasInfo: &sdk.GasInfo{GasUsed: 100_000},
+		Result: &sdk.Result{
+			MsgResponses: []*codectypes.Any{msgResponse},
+			Events: []abci.Event{
+				{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "execute"}}},
+				{Type: "wasm", Attributes: []abci.EventAttribute{
+					{Key: "contract_address", Value: counter.String()},
+					{Key: encryptAttribute(t, key, "count"), Value: encryptAttribute(t, key, "1")},
+					{Key: "msg_index", Value: "0"},
+				}},
+				gasEvent(types.GasProfileExecute, forwarder, "0", "80000"),
+				gasEvent(types.GasProfileSubMsg, forwarder, "1", "50000"),
+				gasEvent(types.GasProfileExecute, counter, "2", "45000"),
+				gasEvent(types.GasProfileReply, forwarder, "1", "20000"),
+			},
+		},
+	}
+
+	result, err := decryptSimulation(msg, plaintextInput, key, simRes, nil, 1.3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_000), result.GasUsed)
+	require.Equal(t, uint64(130_000), result.SuggestedGasLimit)
+	require.Equal(t, uint64(20_000), result.OtherGas)
+	require.Equal(t, []types.GasProfileCall{
+		{Kind: types.GasProfileExecute, Contract: forwarder.String(), Depth: 0, GasUsed: 80_000},
+		{Kind: types.GasProfileSubMsg, Contract: forwarder.String(), Depth: 1, GasUsed: 50_000},
+		{Kind: types.GasProfileExecute, Contract: counter.String(), Depth: 2, GasUsed: 45_000},
+		{Kind: types.GasProfileReply, Contract: forwarder.String(), Depth: 1, GasUsed: 20_000},
+	}, result.GasBreakdown)
+
+	require.Equal(t, testCodeHash, result.Answer.CodeHash)
+	require.Equal(t, `{"forward":{}}`, result.Answer.Input)
+	require.Equal(t, `{"count":1}`, result.Answer.OutputDataAsString)
+	require.Len(t, result.OutputLogs, 1)
+	require.Contains(t, result.OutputLogs[0].Attributes, sdk.Attribute{Key: "count", Value: "1"})
+
+	// suggested gas limits are rounded up
+	result, err = decryptSimulation(msg, plaintextInput, key, simRes, nil, 1.000001)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_001), result.SuggestedGasLimit)
+
+	// errors of the contract are decrypted
+	simErr := errors.New("rpc error: code = Unknown desc = failed to execute message; message index: 0: encrypted: " +
+		encryptAttribute(t, key, `{"generic_err":{"msg":"failed"}}`) + ": execute contract failed")
+	_, err = decryptSimulation(msg, plaintextInput, key, nil, simErr, 1.3)
+	require.EqualError(t, err, `the simulation failed: message index 0: {"generic_err":{"msg":"failed"}}`)
+
+	_, err = decryptSimulation(msg, plaintextInput, key, nil, errors.New("out of gas"), 1.3)
+	require.EqualError(t, err, "the simulation failed: out of gas")
+}
@@ -6,9 +6,44 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
 )
 
+const flagDeriveTxKey = "derive-tx-key"
+
+// addTxKeyFlags adds the flags that select the tx encryption key to a command that encrypts or decrypts. Commands
+// without tx flags get --from, to select the account whose key decrypts
+func addTxKeyFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagDeriveTxKey, false, "Derive the tx encryption key of the --from account from a signature "+
+		"of its key, like Keplr does, if the account has no tx encryption key file yet")
+	if cmd.Flags().Lookup(flags.FlagFrom) == nil {
+		cmd.Flags().String(flags.FlagFrom, "", "Name or address of the account whose tx encryption key to use")
+	}
+}
+
+// newWASMContext returns the context that encrypts and decrypts with the tx encryption key selected by the flags of
+// addTxKeyFlags
+func newWASMContext(cliCtx client.Context, flagSet *flag.FlagSet) (wasmUtils.WASMContext, error) {
+	deriveTxKey, _ := flagSet.GetBool(flagDeriveTxKey)
+
+	if cliCtx.FromAddress.Empty() {
+		if from, _ := flagSet.GetString(flags.FlagFrom); from != "" {
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, from)
+			if err != nil {
+				return wasmUtils.WASMContext{}, err
+			}
+			cliCtx = cliCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
+		}
+	}
+
+	return wasmUtils.WASMContext{CLIContext: cliCtx, DeriveTxKey: deriveTxKey}, nil
+}
+
 type argumentDecoder struct {
 	dec                func(string) ([]byte, error)
 	asciiF, hexF, b64F bool
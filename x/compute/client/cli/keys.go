@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+)
+
+const flagOverwrite = "overwrite"
+
+// TxEncryptionKeyCmd returns the commands that manage the tx encryption keys of accounts, which encrypt contract
+// messages and decrypt their results. It is added to `secretd keys`
+func TxEncryptionKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-encryption-key",
+		Short: "Export and import the tx encryption keys of accounts",
+		Long: "Accounts encrypt contract messages with their own tx encryption key once it is stored in " +
+			filepath.Join("<home>", wasmUtils.TxKeysDir) + ". Accounts without one use the shared key in " +
+			"<home>/id_tx_io.json, unless --" + flagDeriveTxKey + " derives theirs from a signature of their key",
+		RunE: client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		exportTxEncryptionKeyCmd(),
+		importTxEncryptionKeyCmd(),
+	)
+	return cmd
+}
+
+func exportTxEncryptionKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [name]",
+		Short: "Print the tx encryption key of an account, or the shared key if no account is given",
+		Long: "Print the tx encryption key file of an account. With --" + flagDeriveTxKey + ", accounts without a key " +
+			"file get the key derived from a signature of their key on --" + flags.FlagChainID + ", which is the key " +
+			"that Keplr derives for them",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyPairPath := filepath.Join(clientCtx.HomeDir, "id_tx_io.json")
+			if len(args) == 1 {
+				fromAddr, fromName, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, args[0])
+				if err != nil {
+					return err
+				}
+
+				keyPairPath = wasmUtils.TxKeyPairPath(clientCtx.HomeDir, fromAddr)
+				if _, err := os.Stat(keyPairPath); os.IsNotExist(err) {
+					deriveTxKey, _ := cmd.Flags().GetBool(flagDeriveTxKey)
+					if !deriveTxKey {
+						return fmt.Errorf("account %s has no tx encryption key file, use --%s to derive its key", fromName, flagDeriveTxKey)
+					}
+
+					privkey, pubkey, err := wasmUtils.DeriveTxKeyPair(clientCtx.Keyring, fromName, clientCtx.ChainID)
+					if err != nil {
+						return err
+					}
+					return printTxKeyPair(clientCtx, privkey, pubkey)
+				}
+			}
+
+			privkey, pubkey, err := wasmUtils.ReadTxKeyPair(keyPairPath)
+			if err != nil {
+				return err
+			}
+			return printTxKeyPair(clientCtx, privkey, pubkey)
+		},
+	}
+	cmd.Flags().Bool(flagDeriveTxKey, false, "Derive the tx encryption key of accounts without a key file from a signature of their key")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain to derive the tx encryption key for")
+	return cmd
+}
+
+func importTxEncryptionKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [name] [file]",
+		Short: "Store a tx encryption key file, as exported by the export command, as the key of an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			keyPairJSONBytes, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			privkey, pubkey, err := wasmUtils.ParseTxKeyPair(keyPairJSONBytes)
+			if err != nil {
+				return fmt.Errorf("invalid tx encryption key file: %w", err)
+			}
+
+			keyPairPath := wasmUtils.TxKeyPairPath(clientCtx.HomeDir, fromAddr)
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+			if _, err := os.Stat(keyPairPath); err == nil && !overwrite {
+				return fmt.Errorf("account %s already has a tx encryption key, use --%s to replace it", fromName, flagOverwrite)
+			}
+
+			if err := wasmUtils.WriteTxKeyPair(keyPairPath, privkey, pubkey); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("Imported the tx encryption key of %s into %s\n", fromName, keyPairPath))
+		},
+	}
+	cmd.Flags().Bool(flagOverwrite, false, "Replace the tx encryption key of the account if it has one")
+	return cmd
+}
+
+func printTxKeyPair(clientCtx client.Context, privkey []byte, pubkey []byte) error {
+	keyPairJSONBytes, err := wasmUtils.MarshalTxKeyPair(privkey, pubkey)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(string(keyPairJSONBytes) + "\n")
+}
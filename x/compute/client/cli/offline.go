@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+const flagOfflineBundle = "offline-bundle"
+
+// OfflineBundle is what transactions that are built offline need from the chain: the consensus IO pubkey that
+// messages are encrypted for, and the code hashes of contracts and codes. It is signed by the account that prepared it
+type OfflineBundle struct {
+	ChainID string `json:"chain_id"`
+	// Height is the height that the bundle was prepared at
+	Height          int64  `json:"height"`
+	IOKey           []byte `json:"io_key"`
+	RegistrationKey []byte `json:"registration_key"`
+	// CodeHashes are the code hashes of contracts by address, and of codes by code ID
+	CodeHashes map[string]string `json:"code_hashes"`
+	// Labels are the addresses of the contracts that were given by label
+	Labels map[string]string `json:"labels,omitempty"`
+
+	Signer    string `json:"signer"`
+	PubKey    []byte `json:"pub_key"`
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// PrepareOfflineCmd writes the offline bundle of contracts and codes, which lets an air-gapped machine build their
+// transactions
+func PrepareOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepare-offline [contract_addr_bech32|label|code_id]...",
+		Short: "Prepare a signed bundle of what offline transactions of contracts need from the chain",
+		Long: `Snapshot the consensus IO pubkey of the chain and the code hashes of contracts and codes into a bundle, signed
+by the --from key. Pass the bundle to the compute tx commands of an air-gapped machine with --offline-bundle, which
+then builds and encrypts their messages without querying the chain.
+
+The air-gapped machine only trusts bundles signed by a key of its keyring. If the key that signs the bundle stays
+online, add its public key to the air-gapped keyring with "secretd keys add [name] --pubkey [pubkey]".`,
+		Example: `  secretd tx compute prepare-offline secret1... my-counter 3 --from online -O bundle.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if cliCtx.FromName == "" {
+				return errors.New("the key to sign the bundle with must be set with --from")
+			}
+			if cliCtx.ChainID == "" {
+				return errors.New("bundles are prepared for a chain, set it with --chain-id")
+			}
+
+			bundle, err := prepareOfflineBundle(cliCtx, args)
+			if err != nil {
+				return err
+			}
+			if err := bundle.sign(cliCtx); err != nil {
+				return err
+			}
+
+			jsonBundle, err := json.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				return cliCtx.PrintRaw(jsonBundle)
+			}
+			return os.WriteFile(outputDoc, jsonBundle, 0o600)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the key to sign the bundle with")
+	cmd.Flags().StringP(flags.FlagOutputDocument, "O", "", "The file to write the bundle to, instead of printing it")
+	flags.AddKeyringFlags(cmd.Flags())
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// prepareOfflineBundle queries the keys of the chain, and the code hashes of contracts, labels and code IDs
+func prepareOfflineBundle(cliCtx client.Context, contracts []string) (*OfflineBundle, error) {
+	regClient := regtypes.NewQueryClient(cliCtx)
+	ioKey, err := regClient.TxKey(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the consensus IO pubkey: %w", err)
+	}
+	registrationKey, err := regClient.RegistrationKey(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the registration key: %w", err)
+	}
+
+	status, err := cliCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &OfflineBundle{
+		ChainID:         cliCtx.ChainID,
+		Height:          status.SyncInfo.LatestBlockHeight,
+		IOKey:           ioKey.Key,
+		RegistrationKey: registrationKey.Key,
+		CodeHashes:      map[string]string{},
+	}
+
+	for _, contract := range contracts {
+		if _, err := strconv.ParseUint(contract, 10, 64); err == nil {
+			codeHash, err := GetCodeHashByCodeId(cliCtx, contract)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query the code hash of code %s: %w", contract, err)
+			}
+			bundle.CodeHashes[contract] = string(codeHash)
+			continue
+		}
+
+		address := contract
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			address, err = GetContractAddressByLabel(contract, cliCtx)
+			if err != nil {
+				return nil, fmt.Errorf("%s is neither a code ID, a contract address nor a label of a contract: %w", contract, err)
+			}
+			if bundle.Labels == nil {
+				bundle.Labels = map[string]string{}
+			}
+			bundle.Labels[contract] = address
+		}
+
+		codeHash, err := GetCodeHashByContractAddr(cliCtx, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the code hash of contract %s: %w", address, err)
+		}
+		bundle.CodeHashes[address] = string(codeHash)
+	}
+
+	return bundle, nil
+}
+
+// signBytes returns the bytes that the signature of the bundle signs, which are its sorted JSON without the signature
+func (b OfflineBundle) signBytes() ([]byte, error) {
+	b.Signature = nil
+	jsonBundle, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(jsonBundle), nil
+}
+
+// sign signs the bundle with the --from key of cliCtx
+func (b *OfflineBundle) sign(cliCtx client.Context) error {
+	record, err := cliCtx.Keyring.Key(cliCtx.FromName)
+	if err != nil {
+		return err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return fmt.Errorf("bundles must be signed with secp256k1 keys, key %s is %s", cliCtx.FromName, pubKey.Type())
+	}
+	b.Signer = sdk.AccAddress(pubKey.Address()).String()
+	b.PubKey = pubKey.Bytes()
+
+	signBytes, err := b.signBytes()
+	if err != nil {
+		return err
+	}
+	b.Signature, _, err = cliCtx.Keyring.Sign(cliCtx.FromName, signBytes, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return fmt.Errorf("failed to sign the bundle with key %s: %w", cliCtx.FromName, err)
+	}
+	return nil
+}
+
+// verify verifies that the bundle is for the chain of cliCtx, and is signed by a key of its keyring
+func (b *OfflineBundle) verify(cliCtx client.Context) error {
+	if cliCtx.ChainID != "" && cliCtx.ChainID != b.ChainID {
+		return fmt.Errorf("the offline bundle is for chain %s, not %s", b.ChainID, cliCtx.ChainID)
+	}
+	if len(b.IOKey) != 32 {
+		return fmt.Errorf("the offline bundle has an invalid IO key")
+	}
+
+	pubKey := &secp256k1.PubKey{Key: b.PubKey}
+	if len(b.PubKey) != secp256k1.PubKeySize || sdk.AccAddress(pubKey.Address()).String() != b.Signer {
+		return fmt.Errorf("the offline bundle isn't signed by %s", b.Signer)
+	}
+	signBytes, err := b.signBytes()
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, b.Signature) {
+		return fmt.Errorf("invalid signature of the offline bundle")
+	}
+
+	if cliCtx.Keyring == nil {
+		return fmt.Errorf("can't verify the signer of the offline bundle without a keyring")
+	}
+	signer, err := sdk.AccAddressFromBech32(b.Signer)
+	if err != nil {
+		return err
+	}
+	record, err := cliCtx.Keyring.KeyByAddress(signer)
+	if err != nil {
+		return fmt.Errorf("the offline bundle is signed by %s, which isn't a key of the keyring. "+
+			"If you trust it, add its public key with \"secretd keys add [name] --pubkey [pubkey]\"", b.Signer)
+	}
+	recordPubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(recordPubKey.Bytes(), b.PubKey) {
+		return fmt.Errorf("the offline bundle isn't signed by the key %s of the keyring", record.Name)
+	}
+	return nil
+}
+
+// codeHashOfCode returns the code hash of a code ID
+func (b *OfflineBundle) codeHashOfCode(codeID uint64) (string, error) {
+	id := strconv.FormatUint(codeID, 10)
+	codeHash, ok := b.CodeHashes[id]
+	if !ok {
+		return "", fmt.Errorf("the offline bundle has no code hash of code %s, prepare it with the code ID", id)
+	}
+	return normalizeCodeHash(codeHash)
+}
+
+// codeHashOfContract returns the code hash of a contract
+func (b *OfflineBundle) codeHashOfContract(contract string) (string, error) {
+	codeHash, ok := b.CodeHashes[contract]
+	if !ok {
+		return "", fmt.Errorf("the offline bundle has no code hash of contract %s, prepare it with the contract", contract)
+	}
+	return normalizeCodeHash(codeHash)
+}
+
+// contractAddress returns the address of a contract that the bundle was prepared with by label
+func (b *OfflineBundle) contractAddress(label string) (sdk.AccAddress, error) {
+	address, ok := b.Labels[label]
+	if !ok {
+		return nil, fmt.Errorf("the offline bundle has no contract with label %s, prepare it with the label", label)
+	}
+	return sdk.AccAddressFromBech32(address)
+}
+
+// readOfflineBundle reads and verifies the bundle of the --offline-bundle flag, or returns nil if the flag isn't set
+func readOfflineBundle(cliCtx client.Context, flagSet *flag.FlagSet) (*OfflineBundle, error) {
+	path, _ := flagSet.GetString(flagOfflineBundle)
+	if path == "" {
+		return nil, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var bundle OfflineBundle
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return nil, fmt.Errorf("invalid offline bundle %s: %w", path, err)
+	}
+	if err := bundle.verify(cliCtx); err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+func addOfflineBundleFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagOfflineBundle, "", "Path of an offline bundle made by prepare-offline, which has the "+
+		"enclave key and code hashes that the transaction needs, so that it is built without querying the chain")
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const testMnemonic = "angry twist harsh drastic left brass behave host shove marriage fall update business leg direct reward object ugly security warm tuna model broccoli choice"
+
+func TestOfflineBundle(t *testing.T) {
+	kr := keyring.NewInMemory(keeper.MakeTestCodec())
+	record, err := kr.NewAccount("online", testMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	signerPubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+
+	ioPrivkey := sha256.Sum256([]byte("io"))
+	ioPubkey, err := curve25519.X25519(ioPrivkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	counter := sdk.AccAddress(strings.Repeat("c", 20))
+	otherCodeHash := strings.Repeat("cd", 32)
+	cliCtx := client.Context{}.WithKeyring(kr).WithFromName("online").WithChainID("secret-4")
+
+	bundle := &OfflineBundle{
+		ChainID:    "secret-4",
+		Height:     100,
+		IOKey:      ioPubkey,
+		CodeHashes: map[string]string{counter.String(): testCodeHash, "2": otherCodeHash},
+		Labels:     map[string]string{"counter": counter.String()},
+	}
+	require.NoError(t, bundle.sign(cliCtx))
+	require.Equal(t, sdk.AccAddress(signerPubKey.Address()).String(), bundle.Signer)
+
+	dir := t.TempDir()
+	writeBundle := func(bundle OfflineBundle) *pflag.FlagSet {
+		bz, err := json.Marshal(bundle)
+		require.NoError(t, err)
+		path := filepath.Join(dir, "bundle.json")
+		require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flagSet.String(flagOfflineBundle, path, "")
+		return flagSet
+	}
+
+	read, err := readOfflineBundle(cliCtx, writeBundle(*bundle))
+	require.NoError(t, err)
+	require.Equal(t, bundle, read)
+
+	// the flag is optional
+	read, err = readOfflineBundle(cliCtx, pflag.NewFlagSet("test", pflag.ContinueOnError))
+	require.NoError(t, err)
+	require.Nil(t, read)
+
+	tampered := *bundle
+	tampered.CodeHashes = map[string]string{counter.String(): otherCodeHash}
+	_, err = readOfflineBundle(cliCtx, writeBundle(tampered))
+	require.ErrorContains(t, err, "invalid signature of the offline bundle")
+
+	_, err = readOfflineBundle(cliCtx.WithChainID("pulsar-3"), writeBundle(*bundle))
+	require.ErrorContains(t, err, "the offline bundle is for chain secret-4, not pulsar-3")
+
+	// air-gapped keyrings trust bundles of the keys they have, even if they only have their public key
+	airGapped := keyring.NewInMemory(keeper.MakeTestCodec())
+	_, err = readOfflineBundle(cliCtx.WithKeyring(airGapped), writeBundle(*bundle))
+	require.ErrorContains(t, err, "which isn't a key of the keyring")
+	_, err = airGapped.SaveOfflineKey("online", signerPubKey)
+	require.NoError(t, err)
+	_, err = readOfflineBundle(cliCtx.WithKeyring(airGapped), writeBundle(*bundle))
+	require.NoError(t, err)
+
+	// batches take the enclave key, code hashes and labels from the bundle
+	me := newTestSender(t, "me", ioPubkey)
+	keyPairPath := filepath.Join(dir, "id_tx_io.json")
+	require.NoError(t, wasmUtils.WriteTxKeyPair(keyPairPath, me.privkey, me.pubkey))
+
+	builder := &batchBuilder{
+		sender:  sdk.AccAddress(strings.Repeat("s", 20)),
+		wasmCtx: wasmUtils.WASMContext{TestKeyPairPath: keyPairPath, ConsensusIOPubKey: bundle.IOKey},
+		dir:     dir,
+		offline: true,
+		bundle:  bundle,
+	}
+	actions, err := parseBatch([]byte(`[
+		{"execute": {"label": "counter", "msg": {"increment": {}}}},
+		{"migrate": {"contract": "` + counter.String() + `", "code_id": 2, "msg": {}}}
+	]`))
+	require.NoError(t, err)
+	msgs, err := builder.msgs(actions)
+	require.NoError(t, err)
+
+	execute := msgs[0].(*types.MsgExecuteContract)
+	require.Equal(t, counter, execute.Contract)
+	_, plaintext, err := decryptInput(builder.wasmCtx, me.pubkey, execute.Msg)
+	require.NoError(t, err)
+	require.Equal(t, testCodeHash+`{"increment":{}}`, string(plaintext))
+
+	_, plaintext, err = decryptInput(builder.wasmCtx, me.pubkey, msgs[1].(*types.MsgMigrateContract).Msg)
+	require.NoError(t, err)
+	require.Equal(t, otherCodeHash+`{}`, string(plaintext))
+
+	actions, err = parseBatch([]byte(`[{"instantiate": {"code_id": 3, "label": "new", "msg": {}}}]`))
+	require.NoError(t, err)
+	_, err = builder.msgs(actions)
+	require.ErrorContains(t, err, "the offline bundle has no code hash of code 3")
+}
@@ -4,15 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"strconv"
 
-	"github.com/gogo/protobuf/proto"
-
 	cosmwasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	"github.com/spf13/cobra"
 
@@ -44,8 +41,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
 		GetCmdQueryCode(),
+		GetCmdCodeSchema(),
 		GetCmdGetContractInfo(),
 		GetQueryDecryptTxCmd(),
+		GetCmdWatchContract(),
 		GetCmdQueryLabel(),
 		GetCmdCodeHashByContractAddress(),
 		GetCmdGetContractStateSmart(),
@@ -148,7 +147,10 @@ func GetCmdDecryptText() *cobra.Command {
 				return fmt.Errorf("error while parsing encrypted blob: %w", err)
 			}
 
-			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
+			wasmCtx, err := newWASMContext(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
 			_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
 			if err != nil {
 				return fmt.Errorf("error while getting tx sender key pair: %w", err)
@@ -169,6 +171,7 @@ func GetCmdDecryptText() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
 	return cmd
 }
 
@@ -300,12 +303,23 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 				return err
 			}
 
-			return QueryWithData(contractAddr, queryData, clientCtx)
+			if err := validateMsgByContract(clientCtx, cmd.Flags(), contractAddr.String(), wasmUtils.SchemaQuery, queryData); err != nil {
+				return err
+			}
+
+			wasmCtx, err := newWASMContext(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return QueryWithWASMContext(contractAddr, queryData, wasmCtx)
 		},
 		SilenceUsage: true,
 	}
 	decoder.RegisterFlags(cmd.PersistentFlags(), "key argument")
 	flags.AddQueryFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
+	addSkipValidationFlag(cmd)
 	return cmd
 }
 
@@ -454,7 +468,11 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx [hash]",
 		Short: "Query for a transaction by hash in a committed block, decrypt input and outputs if I'm the tx sender",
-		Args:  cobra.ExactArgs(1),
+		Long: "Query for a transaction by hash in a committed block. The inputs of its compute messages, their output " +
+			"data, the attributes of its wasm and wasm-* events, and its contract error are decrypted when they were " +
+			"encrypted with the tx encryption key of the account. Messages of other senders are listed with a " +
+			"decryption_error. With --output json, the output has a stable schema for indexers",
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -470,191 +488,37 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 				return fmt.Errorf("no transaction found with hash %s", args[0])
 			}
 
-			txInputs := result.GetTx().GetMsgs()
-
-			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
-			_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+			wasmCtx, err := newWASMContext(clientCtx, cmd.Flags())
 			if err != nil {
-				return fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
-			}
-
-			answers := types.DecryptedAnswers{
-				Answers:        make([]*types.DecryptedAnswer, len(txInputs)),
-				OutputLogs:     []sdk.StringEvent{},
-				OutputError:    "",
-				PlaintextError: "",
-			}
-			nonces := make([][]byte, len(txInputs))
-
-			for i, tx := range txInputs {
-				var encryptedInput []byte
-				answers.Answers[i] = &types.DecryptedAnswer{}
-
-				switch txInput := tx.(type) {
-				case *types.MsgExecuteContract:
-					{
-						encryptedInput = txInput.Msg
-						answers.Answers[i].Type = "execute"
-					}
-				case *types.MsgInstantiateContract:
-					{
-						encryptedInput = txInput.InitMsg
-						answers.Answers[i].Type = "instantiate"
-					}
-				}
-
-				if encryptedInput != nil {
-					nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
-					if err != nil {
-						return fmt.Errorf("can't parse encrypted blob: %w", err)
-					}
-
-					if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
-						return fmt.Errorf("cannot decrypt, not original tx sender")
-					}
-
-					var plaintextInput []byte
-					if len(ciphertextInput) > 0 {
-						plaintextInput, err = wasmCtx.Decrypt(ciphertextInput, nonce)
-						if err != nil {
-							return fmt.Errorf("error while trying to decrypt the tx input: %w", err)
-						}
-					}
-
-					answers.Answers[i].Input = string(plaintextInput)
-					nonces[i] = nonce
-				}
-			}
-
-			dataOutputHexB64 := result.Data
-			if dataOutputHexB64 != "" {
-				dataOutputAsProtobuf, err := hex.DecodeString(dataOutputHexB64)
-				if err != nil {
-					return fmt.Errorf("error while trying to decode the encrypted output data from hex string: %w", err)
-				}
-
-				var txData sdk.TxMsgData
-				err = proto.Unmarshal(dataOutputAsProtobuf, &txData)
-				if err != nil {
-					return fmt.Errorf("error while trying to parse data as protobuf: %w: %s", err, dataOutputHexB64)
-				}
-
-				for i, msgData := range txData.MsgResponses {
-					if len(msgData.Value) != 0 {
-						var dataField []byte
-						switch {
-						case msgData.TypeUrl == "/secret.compute.v1beta1.MsgInstantiateContractResponse":
-							var msgResponse types.MsgInstantiateContractResponse
-							err := proto.Unmarshal(msgData.Value, &msgResponse)
-							if err != nil {
-								continue
-							}
-
-							dataField = msgResponse.Data
-						case msgData.TypeUrl == "/secret.compute.v1beta1.MsgExecuteContractResponse":
-							var msgResponse types.MsgExecuteContractResponse
-							err := proto.Unmarshal(msgData.Value, &msgResponse)
-							if err != nil {
-								continue
-							}
-
-							dataField = msgResponse.Data
-						default:
-							continue
-						}
-
-						dataPlaintextB64Bz, err := wasmCtx.Decrypt(dataField, nonces[i])
-						if err != nil {
-							continue
-						}
-						dataPlaintextB64 := string(dataPlaintextB64Bz)
-						answers.Answers[i].OutputData = dataPlaintextB64
-
-						dataPlaintext, err := base64.StdEncoding.DecodeString(dataPlaintextB64)
-						if err != nil {
-							continue
-						}
-
-						answers.Answers[i].OutputDataAsString = string(dataPlaintext)
-					}
-				}
-			}
-
-			// decrypt logs
-			answers.OutputLogs = []sdk.StringEvent{}
-			for _, e := range result.Events {
-				if e.Type == "wasm" {
-					for i, a := range e.Attributes {
-						if a.Key != "contract_address" {
-							// key
-							if a.Key != "" {
-								// Try to decrypt the log key. If it doesn't look encrypted, leave it as-is
-								keyCiphertext, err := base64.StdEncoding.DecodeString(a.Key)
-								if err != nil {
-									continue
-								}
-
-								for _, nonce := range nonces {
-									keyPlaintext, err := wasmCtx.Decrypt(keyCiphertext, nonce)
-									if err != nil {
-										continue
-									}
-									a.Key = string(keyPlaintext)
-									break
-								}
-							}
-
-							// value
-							if a.Value != "" {
-								// Try to decrypt the log value. If it doesn't look encrypted, leave it as-is
-								valueCiphertext, err := base64.StdEncoding.DecodeString(a.Value)
-								if err != nil {
-									continue
-								}
-								for _, nonce := range nonces {
-									valuePlaintext, err := wasmCtx.Decrypt(valueCiphertext, nonce)
-									if err != nil {
-										continue
-									}
-									a.Value = string(valuePlaintext)
-									break
-								}
-							}
-							e.Attributes[i] = a
-						}
-					}
-					answers.OutputLogs = append(answers.OutputLogs, sdk.StringifyEvent(e))
-				}
+				return err
 			}
 
-			if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
-				for i, nonce := range nonces {
-					stdErr, err := wasmCtx.DecryptError(result.RawLog, nonce)
-					if err != nil {
-						continue
-					}
-					answers.OutputError = string(append(json.RawMessage(fmt.Sprintf("message index %d: ", i)), stdErr...))
-					break
-				}
-			} else if types.ContainsEnclaveError(result.RawLog) {
-				answers.PlaintextError = result.RawLog
+			decrypted, err := decryptTx(wasmCtx, result)
+			if err != nil {
+				return err
 			}
 
-			jsonBz, err := json.MarshalIndent(answers, "", "    ")
+			jsonBz, err := json.Marshal(decrypted)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintString(string(jsonBz))
+			return clientCtx.PrintRaw(jsonBz)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
 	return cmd
 }
 
 func QueryWithData(contractAddress sdk.AccAddress, queryData []byte, clientCtx client.Context) error {
-	wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
+	return QueryWithWASMContext(contractAddress, queryData, wasmUtils.WASMContext{CLIContext: clientCtx})
+}
+
+// QueryWithWASMContext queries a contract like QueryWithData, encrypting with the tx encryption key of wasmCtx
+func QueryWithWASMContext(contractAddress sdk.AccAddress, queryData []byte, wasmCtx wasmUtils.WASMContext) error {
+	clientCtx := wasmCtx.CLIContext
 
 	codeHash, err := GetCodeHashByContractAddr(clientCtx, contractAddress.String())
 	if err != nil {
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const (
+	flagSchema         = "schema"
+	flagSkipValidation = "skip-validation"
+)
+
+// GetCmdCodeSchema prints the JSON schema that a code was stored with
+func GetCmdCodeSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-schema [code_id]",
+		Short: "Print the JSON schema of the messages of a code",
+		Long:  "Print the JSON schema of the messages of a code, which it was stored with by `tx compute store --schema`",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			schema, err := GetCodeSchema(clientCtx, codeID)
+			if err != nil {
+				return err
+			}
+			if schema == nil {
+				return fmt.Errorf("code %d was stored without a schema", codeID)
+			}
+
+			return clientCtx.PrintRaw(schema)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCodeSchema returns the JSON schema of a code, or nil if the code was stored without one or the node doesn't
+// serve schemas
+func GetCodeSchema(cliCtx client.Context, codeID uint64) ([]byte, error) {
+	queryClient := types.NewQueryClient(cliCtx)
+	res, err := queryClient.CodeSchema(context.Background(), &types.QueryByCodeIdRequest{CodeId: codeID})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query the schema of code %d: %w", codeID, err)
+	}
+	if res.Schema == "" {
+		return nil, nil
+	}
+	return []byte(res.Schema), nil
+}
+
+// validateMsgByCodeID validates a message of a kind against the schema of a code, unless the code has no schema or
+// the command was run with --skip-validation
+func validateMsgByCodeID(cliCtx client.Context, cmdFlags *flag.FlagSet, codeID uint64, kind string, msg []byte) error {
+	if skipValidation(cmdFlags) {
+		return nil
+	}
+
+	bz, err := GetCodeSchema(cliCtx, codeID)
+	if err != nil || bz == nil {
+		return err
+	}
+	schema, err := wasmUtils.ParseContractSchema(bz)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.ValidateMsg(kind, msg); err != nil {
+		return fmt.Errorf("%w (use --%s to send it anyway)", err, flagSkipValidation)
+	}
+	return nil
+}
+
+// validateMsgByContract validates a message of a kind against the schema of the code of a contract, like
+// validateMsgByCodeID
+func validateMsgByContract(cliCtx client.Context, cmdFlags *flag.FlagSet, contractAddr string, kind string, msg []byte) error {
+	if skipValidation(cmdFlags) {
+		return nil
+	}
+
+	queryClient := types.NewQueryClient(cliCtx)
+	res, err := queryClient.ContractInfo(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: contractAddr})
+	if err != nil {
+		return fmt.Errorf("failed to query the code of contract %s: %w", contractAddr, err)
+	}
+	if res.ContractInfo == nil {
+		return fmt.Errorf("contract %s not found", contractAddr)
+	}
+	return validateMsgByCodeID(cliCtx, cmdFlags, res.CodeID, kind, msg)
+}
+
+// skipValidation returns whether messages are sent without validating them against the schema of their code.
+// Messages are validated only by the commands that have the --skip-validation flag
+func skipValidation(cmdFlags *flag.FlagSet) bool {
+	f := cmdFlags.Lookup(flagSkipValidation)
+	return f == nil || f.Value.String() == "true"
+}
+
+func addSkipValidationFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagSkipValidation, false, "Don't validate the message against the JSON schema of the contract's code")
+}
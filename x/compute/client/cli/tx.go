@@ -51,6 +51,8 @@ func GetTxCmd() *cobra.Command {
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		BatchCmd(),
+		PrepareOfflineCmd(),
 		UpgradeProposalPassedCmd(),
 	)
 	return txCmd
@@ -83,6 +85,7 @@ func StoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().String(flagSchema, "", "Path of the JSON schema of the contract's messages, like the api.json of cosmwasm-schema, optional")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -113,12 +116,24 @@ func parseStoreCodeArgs(args []string, cliCtx client.Context, flags *flag.FlagSe
 		return types.MsgStoreCode{}, fmt.Errorf("builder: %s", err)
 	}
 
+	var schema []byte
+	if schemaPath, _ := flags.GetString(flagSchema); schemaPath != "" {
+		schema, err = os.ReadFile(schemaPath)
+		if err != nil {
+			return types.MsgStoreCode{}, fmt.Errorf("schema: %s", err)
+		}
+		if _, err = wasmUtils.ParseContractSchema(schema); err != nil {
+			return types.MsgStoreCode{}, err
+		}
+	}
+
 	// build and sign the transaction, then broadcast to Tendermint
 	msg := types.MsgStoreCode{
 		Sender:       cliCtx.GetFromAddress(),
 		WASMByteCode: wasm,
 		Source:       source,
 		Builder:      builder,
+		Schema:       schema,
 	}
 	return msg, nil
 }
@@ -154,6 +169,9 @@ func InstantiateContractCmd() *cobra.Command {
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Optional: Bech32 address of the admin of the contract")
 	flags.AddTxFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
+	addSkipValidationFlag(cmd)
+	addOfflineBundleFlag(cmd)
 	return cmd
 }
 
@@ -182,12 +200,34 @@ func parseInstantiateArgs(args []string, cliCtx client.Context, initFlags *flag.
 		return types.MsgInstantiateContract{}, err
 	}
 
-	wasmCtx := wasmUtils.WASMContext{CLIContext: cliCtx}
+	wasmCtx, err := newWASMContext(cliCtx, initFlags)
+	if err != nil {
+		return types.MsgInstantiateContract{}, err
+	}
 	initMsg := types.SecretMsg{}
 
+	bundle, err := readOfflineBundle(cliCtx, initFlags)
+	if err != nil {
+		return types.MsgInstantiateContract{}, err
+	}
+
 	var encryptedMsg []byte
 	genOnly, err := initFlags.GetBool(flags.FlagGenerateOnly)
-	if err != nil && genOnly {
+	if bundle != nil {
+		// the offline bundle has the enclave key and the code hash, so nothing is queried
+		codeHash, err := bundle.codeHashOfCode(codeID)
+		if err != nil {
+			return types.MsgInstantiateContract{}, err
+		}
+		initMsg.CodeHash = []byte(codeHash)
+		initMsg.Msg = []byte(args[1])
+
+		wasmCtx.ConsensusIOPubKey = bundle.IOKey
+		encryptedMsg, err = wasmCtx.Encrypt(initMsg.Serialize())
+		if err != nil {
+			return types.MsgInstantiateContract{}, err
+		}
+	} else if err != nil && genOnly {
 		// if we're creating an offline transaction we just need the path to the io master key
 		ioKeyPath, err := initFlags.GetString(flagIoMasterKey)
 		if err != nil {
@@ -224,8 +264,10 @@ func parseInstantiateArgs(args []string, cliCtx client.Context, initFlags *flag.
 			return types.MsgInstantiateContract{}, err
 		}
 
-		// todo: Add check that this is valid json and stuff
 		initMsg.Msg = []byte(args[1])
+		if err := validateMsgByCodeID(cliCtx, initFlags, codeID, wasmUtils.SchemaInstantiate, initMsg.Msg); err != nil {
+			return types.MsgInstantiateContract{}, err
+		}
 
 		encryptedMsg, err = wasmCtx.Encrypt(initMsg.Serialize())
 	}
@@ -289,7 +331,22 @@ func ExecuteContractCmd() *cobra.Command {
 				return err
 			}
 
-			if len(args) == 1 {
+			bundle, err := readOfflineBundle(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 && bundle != nil {
+				label, _ := cmd.Flags().GetString(flagLabel)
+				if label == "" {
+					return fmt.Errorf("label or bech32 contract address is required")
+				}
+				contractAddr, err = bundle.contractAddress(label)
+				if err != nil {
+					return err
+				}
+				msg = []byte(args[0])
+			} else if len(args) == 1 {
 				if genOnly {
 					return fmt.Errorf("offline transactions must contain contract address")
 				}
@@ -334,7 +391,7 @@ func ExecuteContractCmd() *cobra.Command {
 				msg = []byte(args[1])
 			}
 
-			if genOnly {
+			if genOnly && bundle == nil {
 
 				ioKeyPath, err = cmd.Flags().GetString(flagIoMasterKey)
 				if err != nil {
@@ -363,11 +420,18 @@ func ExecuteContractCmd() *cobra.Command {
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	flags.AddTxFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
+	addSkipValidationFlag(cmd)
+	addOfflineBundleFlag(cmd)
+	addDryRunFlags(cmd)
 	return cmd
 }
 
 func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []byte, amount string, genOnly bool, ioMasterKeyPath string, codeHash string, cliCtx client.Context) error {
-	wasmCtx := wasmUtils.WASMContext{CLIContext: cliCtx}
+	wasmCtx, err := newWASMContext(cliCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
 	execMsg := types.SecretMsg{}
 
 	execMsg.Msg = msg
@@ -377,8 +441,23 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		return err
 	}
 
+	bundle, err := readOfflineBundle(cliCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
 	var encryptedMsg []byte
-	if genOnly {
+	if bundle != nil {
+		if codeHash == "" {
+			codeHash, err = bundle.codeHashOfContract(contractAddress.String())
+			if err != nil {
+				return err
+			}
+		}
+		execMsg.CodeHash = []byte(codeHash)
+		wasmCtx.ConsensusIOPubKey = bundle.IOKey
+		encryptedMsg, err = wasmCtx.Encrypt(execMsg.Serialize())
+	} else if genOnly {
 		execMsg.CodeHash = []byte(codeHash)
 		encryptedMsg, err = wasmCtx.OfflineEncrypt(execMsg.Serialize(), ioMasterKeyPath)
 	} else {
@@ -391,6 +470,9 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		if err != nil {
 			return sdkerrors.ErrNotFound.Wrapf("Contract address %s not found. Error:%s", contractAddress.String(), err)
 		}
+		if err := validateMsgByContract(cliCtx, cmd.Flags(), contractAddress.String(), wasmUtils.SchemaExecute, msg); err != nil {
+			return err
+		}
 		encryptedMsg, err = wasmCtx.Encrypt(execMsg.Serialize())
 		if err != nil {
 			return fmt.Errorf("failed to encrypt the message. Error:%s", err.Error())
@@ -408,6 +490,9 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		SentFunds:        coins,
 		Msg:              encryptedMsg,
 	}
+	if cliCtx.Simulate {
+		return dryRun(cliCtx, cmd.Flags(), wasmCtx, &msgExec)
+	}
 	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msgExec)
 }
 
@@ -461,7 +546,7 @@ func MigrateContractCmd() *cobra.Command {
 				return err
 			}
 
-			msg, err := parseMigrateContractArgs(args, cliCtx)
+			msg, err := parseMigrateContractArgs(args, cliCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
@@ -473,24 +558,47 @@ func MigrateContractCmd() *cobra.Command {
 		SilenceUsage: true,
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
+	addSkipValidationFlag(cmd)
+	addOfflineBundleFlag(cmd)
 	return cmd
 }
 
-func parseMigrateContractArgs(args []string, cliCtx client.Context) (types.MsgMigrateContract, error) {
+func parseMigrateContractArgs(args []string, cliCtx client.Context, migrateFlags *flag.FlagSet) (types.MsgMigrateContract, error) {
 	// get the id of the code to instantiate
 	codeID, err := strconv.ParseUint(args[1], 10, 64)
 	if err != nil {
 		return types.MsgMigrateContract{}, errorsmod.Wrap(err, "code id")
 	}
 	migrateMsg := types.SecretMsg{}
+	migrateMsg.Msg = []byte(args[2])
 
-	migrateMsg.CodeHash, err = GetCodeHashByCodeId(cliCtx, args[1])
+	wasmCtx, err := newWASMContext(cliCtx, migrateFlags)
 	if err != nil {
-		return types.MsgMigrateContract{}, errorsmod.Wrap(err, "code hash")
+		return types.MsgMigrateContract{}, err
 	}
 
-	migrateMsg.Msg = []byte(args[2])
-	wasmCtx := wasmUtils.WASMContext{CLIContext: cliCtx}
+	bundle, err := readOfflineBundle(cliCtx, migrateFlags)
+	if err != nil {
+		return types.MsgMigrateContract{}, err
+	}
+	if bundle != nil {
+		// the offline bundle has the enclave key and the code hash, so nothing is queried
+		codeHash, err := bundle.codeHashOfCode(codeID)
+		if err != nil {
+			return types.MsgMigrateContract{}, err
+		}
+		migrateMsg.CodeHash = []byte(codeHash)
+		wasmCtx.ConsensusIOPubKey = bundle.IOKey
+	} else {
+		migrateMsg.CodeHash, err = GetCodeHashByCodeId(cliCtx, args[1])
+		if err != nil {
+			return types.MsgMigrateContract{}, errorsmod.Wrap(err, "code hash")
+		}
+		if err := validateMsgByCodeID(cliCtx, migrateFlags, codeID, wasmUtils.SchemaMigrate, migrateMsg.Msg); err != nil {
+			return types.MsgMigrateContract{}, err
+		}
+	}
 	encryptedMsg, err := wasmCtx.Encrypt(migrateMsg.Serialize())
 	if err != nil {
 		return types.MsgMigrateContract{}, errorsmod.Wrap(err, "encrypt")
@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const (
+	flagFromHeight   = "from-height"
+	flagSender       = "sender"
+	flagMsgType      = "msg-type"
+	flagPollInterval = "poll-interval"
+
+	watchPageLimit = 100
+)
+
+// GetCmdWatchContract streams the transactions of a contract, decrypted like GetQueryDecryptTxCmd decrypts them
+func GetCmdWatchContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [contract]",
+		Short: "Stream the transactions of a contract as JSON lines, decrypted where the tx encryption key can decrypt them",
+		Long: "Poll the transactions of a contract with tx search, and print each as a line of JSON in the format of " +
+			"`secretd q compute tx --output json`. Transactions are printed from --" + flagFromHeight + " on, or " +
+			"from the next block without it, so a watch is resumed from the height of the last line it printed",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			wasmCtx, err := newWASMContext(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, _ := cmd.Flags().GetString(flagSender)
+			if sender != "" {
+				if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+					return fmt.Errorf("invalid sender: %w", err)
+				}
+			}
+			msgTypes, _ := cmd.Flags().GetStringSlice(flagMsgType)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+
+			fromHeight, _ := cmd.Flags().GetInt64(flagFromHeight)
+			if fromHeight <= 0 {
+				node, err := clientCtx.GetNode()
+				if err != nil {
+					return err
+				}
+				status, err := node.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				fromHeight = status.SyncInfo.LatestBlockHeight + 1
+			}
+
+			watcher := newContractWatcher(contract, sender, msgTypes, fromHeight, wasmCtx,
+				func(page, limit int, query string) (*sdk.SearchTxsResult, error) {
+					return authtx.QueryTxsByEvents(clientCtx, page, limit, query, "asc")
+				},
+			)
+
+			return watcher.run(cmd.Context(), pollInterval, func(decrypted *DecryptedTx) error {
+				jsonBz, err := json.Marshal(decrypted)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(append(jsonBz, '\n'))
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addTxKeyFlags(cmd)
+	cmd.Flags().Int64(flagFromHeight, 0, "The height to stream transactions from, the next block if not set")
+	cmd.Flags().String(flagSender, "", "Only stream the transactions of this sender")
+	cmd.Flags().StringSlice(flagMsgType, nil, "Only stream transactions with messages of these types, e.g. execute,migrate")
+	cmd.Flags().Duration(flagPollInterval, 5*time.Second, "How often to search for new transactions")
+	return cmd
+}
+
+// txSearcher searches transactions by events, in ascending order
+type txSearcher func(page, limit int, query string) (*sdk.SearchTxsResult, error)
+
+// contractWatcher polls the transactions of a contract. It searches from the height of the last transaction it saw,
+// because the blocks at that height may still be indexed, and skips the transactions of that height that it printed
+type contractWatcher struct {
+	contract string
+	sender   string
+	msgTypes map[string]bool
+	wasmCtx  wasmUtils.WASMContext
+	search   txSearcher
+
+	height int64
+	// seen are the hashes of the transactions at height that were handled
+	seen map[string]bool
+}
+
+func newContractWatcher(contract sdk.AccAddress, sender string, msgTypes []string, fromHeight int64, wasmCtx wasmUtils.WASMContext, search txSearcher) *contractWatcher {
+	watcher := &contractWatcher{
+		contract: contract.String(),
+		sender:   sender,
+		msgTypes: map[string]bool{},
+		wasmCtx:  wasmCtx,
+		search:   search,
+		height:   fromHeight,
+		seen:     map[string]bool{},
+	}
+	for _, msgType := range msgTypes {
+		watcher.msgTypes[msgType] = true
+	}
+	return watcher
+}
+
+// watchEventTypes are the events that carry the address of the contract a transaction called, including through
+// sub-messages and IBC. Tx search can't OR conditions, so each of them is searched on its own
+var watchEventTypes = []string{types.EventTypeInstantiate, types.EventTypeExecute, types.EventTypeMigrate, types.CustomEventType}
+
+// queries return the tx search queries of the transactions of the contract from the current height
+func (w *contractWatcher) queries() []string {
+	queries := make([]string, len(watchEventTypes))
+	for i, eventType := range watchEventTypes {
+		queries[i] = fmt.Sprintf("%s.%s='%s' AND tx.height>=%d", eventType, types.AttributeKeyContractAddr, w.contract, w.height)
+		if w.sender != "" {
+			queries[i] += fmt.Sprintf(" AND %s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, w.sender)
+		}
+	}
+	return queries
+}
+
+// searchAll returns the transactions that match any of the queries from the current height, by height. The transactions
+// of a block are in the order of the queries that found them
+func (w *contractWatcher) searchAll() ([]*sdk.TxResponse, error) {
+	var txs []*sdk.TxResponse
+	found := map[string]bool{}
+	for _, query := range w.queries() {
+		for page := 1; ; page++ {
+			result, err := w.search(page, watchPageLimit, query)
+			if err != nil {
+				return nil, err
+			}
+			for _, tx := range result.Txs {
+				if !found[tx.TxHash] {
+					found[tx.TxHash] = true
+					txs = append(txs, tx)
+				}
+			}
+			if uint64(page) >= result.PageTotal {
+				break
+			}
+		}
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Height < txs[j].Height
+	})
+	return txs, nil
+}
+
+// poll returns the transactions that were indexed since the last poll, decrypted, and in order
+func (w *contractWatcher) poll() ([]*DecryptedTx, error) {
+	found, err := w.searchAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var txs []*DecryptedTx
+	for _, tx := range found {
+		if tx.Height < w.height || (tx.Height == w.height && w.seen[tx.TxHash]) {
+			continue
+		}
+		if tx.Height > w.height {
+			w.height = tx.Height
+			w.seen = map[string]bool{}
+		}
+		w.seen[tx.TxHash] = true
+
+		decrypted, err := decryptTx(w.wasmCtx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt transaction %s: %w", tx.TxHash, err)
+		}
+		if w.matches(decrypted) {
+			txs = append(txs, decrypted)
+		}
+	}
+	return txs, nil
+}
+
+// matches tells whether a transaction has a message of one of the types to stream
+func (w *contractWatcher) matches(decrypted *DecryptedTx) bool {
+	if len(w.msgTypes) == 0 {
+		return true
+	}
+	for _, answer := range decrypted.Answers {
+		if w.msgTypes[answer.Type] {
+			return true
+		}
+	}
+	return false
+}
+
+// run polls until ctx is done, and passes the transactions to print in order
+func (w *contractWatcher) run(ctx context.Context, pollInterval time.Duration, print func(*DecryptedTx) error) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		txs, err := w.poll()
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			if err := print(tx); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
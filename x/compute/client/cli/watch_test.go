@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+var (
+	minHeightRegex = regexp.MustCompile(`tx\.height>=(\d+)`)
+	contractRegex  = regexp.MustCompile(`^([\w-]+)\.contract_address='(\w+)'`)
+)
+
+// testTxIndex searches its transactions by height and by the contract address of one event
+type testTxIndex struct {
+	txs     []*sdk.TxResponse
+	queries []string
+}
+
+func (index *testTxIndex) search(page, limit int, query string) (*sdk.SearchTxsResult, error) {
+	index.queries = append(index.queries, query)
+	minHeight, _ := strconv.ParseInt(minHeightRegex.FindStringSubmatch(query)[1], 10, 64)
+	contract := contractRegex.FindStringSubmatch(query)
+
+	var txs []*sdk.TxResponse
+	for _, tx := range index.txs {
+		if tx.Height < minHeight {
+			continue
+		}
+		for _, event := range tx.Events {
+			if event.Type == contract[1] && event.Attributes[0].Value == contract[2] {
+				txs = append(txs, tx)
+				break
+			}
+		}
+	}
+	pageTotal := (len(txs) + limit - 1) / limit
+	start := min((page-1)*limit, len(txs))
+	end := min(start+limit, len(txs))
+	return &sdk.SearchTxsResult{Txs: txs[start:end], PageTotal: uint64(pageTotal)}, nil
+}
+
+func TestContractWatcher(t *testing.T) {
+	ioPrivkey := sha256.Sum256([]byte("io"))
+	ioPubkey, err := curve25519.X25519(ioPrivkey[:], curve25519.Basepoint)
+	require.NoError(t, err)
+
+	me := newTestSender(t, "me", ioPubkey)
+	keyPairPath := filepath.Join(t.TempDir(), "id_tx_io.json")
+	require.NoError(t, wasmUtils.WriteTxKeyPair(keyPairPath, me.privkey, me.pubkey))
+	wasmCtx := wasmUtils.WASMContext{TestKeyPairPath: keyPairPath, TestMasterIOKey: regtypes.MasterKey{Bytes: ioPubkey}}
+
+	contract := sdk.AccAddress(strings.Repeat("c", 20))
+	other := sdk.AccAddress(strings.Repeat("o", 20))
+	// events are the types of the events with the address of a contract, as the keeper emits them
+	contractEvents := func(contract sdk.AccAddress, eventTypes ...string) []abci.Event {
+		events := make([]abci.Event, len(eventTypes))
+		for i, eventType := range eventTypes {
+			events[i] = abci.Event{Type: eventType, Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyContractAddr, Value: contract.String()},
+			}}
+		}
+		return events
+	}
+	newTx := func(hash string, height int64, msg sdk.Msg, events ...abci.Event) *sdk.TxResponse {
+		tx := newTestTxResponse(t, []sdk.Msg{msg}, []proto.Message{})
+		tx.TxHash = hash
+		tx.Height = height
+		tx.Events = events
+		return tx
+	}
+	execute := func(input string) sdk.Msg {
+		encrypted, _ := me.encryptMsg(input)
+		return &types.MsgExecuteContract{Contract: contract, Msg: encrypted}
+	}
+	executeTx := func(hash string, height int64, input string) *sdk.TxResponse {
+		return newTx(hash, height, execute(input), contractEvents(contract, types.EventTypeExecute, types.CustomEventType)...)
+	}
+	migrate, _ := me.encryptMsg(`{"migrate":{}}`)
+	forward, _ := me.encryptMsg(`{"forward":{}}`)
+
+	index := &testTxIndex{txs: []*sdk.TxResponse{
+		executeTx("A", 4, `{"a":{}}`),
+		executeTx("B", 5, `{"b":{}}`),
+		// a migration has no execute event
+		newTx("C", 6, &types.MsgMigrateContract{Contract: contract.String(), Msg: migrate},
+			contractEvents(contract, types.EventTypeMigrate)...),
+		// the contract is called by a sub-message of another contract
+		newTx("F", 6, &types.MsgExecuteContract{Contract: other, Msg: forward},
+			append(contractEvents(other, types.EventTypeExecute), contractEvents(contract, types.EventTypeExecute)...)...),
+		newTx("O", 6, &types.MsgExecuteContract{Contract: other, Msg: forward}, contractEvents(other, types.EventTypeExecute)...),
+	}}
+
+	watcher := newContractWatcher(contract, "", nil, 5, wasmCtx, index.search)
+	txs, err := watcher.poll()
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+	require.Equal(t, "B", txs[0].TxHash)
+	require.Equal(t, `{"b":{}}`, txs[0].Answers[0].Input)
+	require.Equal(t, "F", txs[1].TxHash)
+	require.Equal(t, "C", txs[2].TxHash)
+	require.Equal(t, "migrate", txs[2].Answers[0].Type)
+	require.Equal(t, `{"migrate":{}}`, txs[2].Answers[0].Input)
+
+	txs, err = watcher.poll()
+	require.NoError(t, err)
+	require.Empty(t, txs, "transactions are streamed once")
+	require.Contains(t, index.queries[len(index.queries)-1], "tx.height>=6")
+
+	// transactions indexed late at the last height are still streamed
+	index.txs = append(index.txs, executeTx("D", 6, `{"d":{}}`), executeTx("E", 7, `{"e":{}}`))
+	txs, err = watcher.poll()
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, "D", txs[0].TxHash)
+	require.Equal(t, "E", txs[1].TxHash)
+
+	// a watch that resumes from a height filters by message type
+	watcher = newContractWatcher(contract, "", []string{"migrate"}, 5, wasmCtx, index.search)
+	txs, err = watcher.poll()
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, "C", txs[0].TxHash)
+
+	// pages are followed
+	for i := 0; i < watchPageLimit; i++ {
+		index.txs = append(index.txs, executeTx(strconv.Itoa(i), 8, `{"page":{}}`))
+	}
+	watcher = newContractWatcher(contract, "", nil, 7, wasmCtx, index.search)
+	txs, err = watcher.poll()
+	require.NoError(t, err)
+	require.Len(t, txs, watchPageLimit+1)
+
+	sender := sdk.AccAddress(strings.Repeat("s", 20))
+	watcher = newContractWatcher(contract, sender.String(), nil, 1, wasmCtx, index.search)
+	require.Equal(t, []string{
+		"instantiate.contract_address='" + contract.String() + "' AND tx.height>=1 AND message.sender='" + sender.String() + "'",
+		"execute.contract_address='" + contract.String() + "' AND tx.height>=1 AND message.sender='" + sender.String() + "'",
+		"migrate.contract_address='" + contract.String() + "' AND tx.height>=1 AND message.sender='" + sender.String() + "'",
+		"wasm.contract_address='" + contract.String() + "' AND tx.height>=1 AND message.sender='" + sender.String() + "'",
+	}, watcher.queries())
+}
@@ -0,0 +1,580 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// ContractSchema is the JSON schema of the messages of a code, in the format of the api.json bundle of cosmwasm-schema.
+//
+// Messages are validated against the subset of JSON schema (draft 7) that cosmwasm-schema generates, which is the
+// keywords of supportedKeywords. Only local references (#/definitions/...) are followed, and the only formats that are
+// checked are the integer formats, like uint32. The annotations of annotationKeywords are ignored, and a schema with
+// any other keyword can't validate messages, so that a message is never taken as valid by a rule that is skipped
+type ContractSchema struct {
+	ContractName    string          `json:"contract_name,omitempty"`
+	ContractVersion string          `json:"contract_version,omitempty"`
+	Instantiate     json.RawMessage `json:"instantiate,omitempty"`
+	Execute         json.RawMessage `json:"execute,omitempty"`
+	Query           json.RawMessage `json:"query,omitempty"`
+	Migrate         json.RawMessage `json:"migrate,omitempty"`
+}
+
+// The kinds of messages that a ContractSchema describes
+const (
+	SchemaInstantiate = "instantiate"
+	SchemaExecute     = "execute"
+	SchemaQuery       = "query"
+	SchemaMigrate     = "migrate"
+)
+
+// supportedKeywords are the keywords of JSON schema that messages are validated against
+var supportedKeywords = map[string]bool{
+	"$ref":                 true,
+	"type":                 true,
+	"enum":                 true,
+	"const":                true,
+	"allOf":                true,
+	"anyOf":                true,
+	"oneOf":                true,
+	"required":             true,
+	"properties":           true,
+	"additionalProperties": true,
+	"items":                true,
+	"minItems":             true,
+	"maxItems":             true,
+	"uniqueItems":          true,
+	"minimum":              true,
+	"maximum":              true,
+	"format":               true,
+}
+
+// annotationKeywords are the keywords of JSON schema that don't constrain messages
+var annotationKeywords = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"definitions": true,
+	"default":     true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// maxSchemaDepth bounds the nesting of the schemas that messages are validated against, which recursive definitions
+// would otherwise make unbounded
+const maxSchemaDepth = 64
+
+// ParseContractSchema parses the schema bundle of a code
+func ParseContractSchema(bz []byte) (*ContractSchema, error) {
+	var schema ContractSchema
+	if err := json.Unmarshal(bz, &schema); err != nil {
+		return nil, fmt.Errorf("invalid contract schema: %w", err)
+	}
+	return &schema, nil
+}
+
+// ValidateMsg validates a message of a kind (instantiate, execute, query or migrate) against its schema.
+// Messages of kinds that the bundle has no schema for are valid
+func (s *ContractSchema) ValidateMsg(kind string, msg []byte) error {
+	var raw json.RawMessage
+	switch kind {
+	case SchemaInstantiate:
+		raw = s.Instantiate
+	case SchemaExecute:
+		raw = s.Execute
+	case SchemaQuery:
+		raw = s.Query
+	case SchemaMigrate:
+		raw = s.Migrate
+	default:
+		return fmt.Errorf("unknown message kind %s", kind)
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	root, err := decodeJSON(raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s schema: %w", kind, err)
+	}
+	value, err := decodeJSON(msg)
+	if err != nil {
+		return fmt.Errorf("%s message is not valid JSON: %w", kind, err)
+	}
+
+	v := schemaValidator{root: root}
+	if err := v.validate(root, value, "", 0); err != nil {
+		return fmt.Errorf("%s message doesn't match the contract schema: %w", kind, err)
+	}
+	return nil
+}
+
+func decodeJSON(bz []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON value")
+	}
+	return value, nil
+}
+
+type schemaValidator struct {
+	root interface{}
+}
+
+// schemaError is a mismatch of a value and a schema, at the path of the value in the message
+type schemaError struct {
+	path string
+	msg  string
+}
+
+func (e *schemaError) Error() string {
+	if e.path == "" {
+		return e.msg
+	}
+	return fmt.Sprintf("%s: %s", e.path, e.msg)
+}
+
+func mismatch(path string, format string, args ...interface{}) error {
+	return &schemaError{path: path, msg: fmt.Sprintf(format, args...)}
+}
+
+func (v schemaValidator) validate(schema interface{}, value interface{}, path string, depth int) error {
+	if depth > maxSchemaDepth {
+		return mismatch(path, "schema nesting is too deep")
+	}
+
+	switch schema := schema.(type) {
+	case bool:
+		if !schema {
+			return mismatch(path, "no value is allowed")
+		}
+		return nil
+	case map[string]interface{}:
+		return v.validateObjectSchema(schema, value, path, depth)
+	default:
+		return mismatch(path, "invalid schema")
+	}
+}
+
+func (v schemaValidator) validateObjectSchema(schema map[string]interface{}, value interface{}, path string, depth int) error {
+	var unsupported []string
+	for keyword := range schema {
+		if !supportedKeywords[keyword] && !annotationKeywords[keyword] {
+			unsupported = append(unsupported, keyword)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return mismatch(path, "unsupported schema keywords %s", strings.Join(unsupported, ", "))
+	}
+
+	// in draft 7 $ref overrides the keywords next to it
+	if ref, ok := schema["$ref"].(string); ok {
+		target, err := v.resolve(ref)
+		if err != nil {
+			return mismatch(path, "%s", err)
+		}
+		return v.validate(target, value, path, depth+1)
+	}
+
+	if types, ok := schema["type"]; ok {
+		if err := validateType(types, value, path); err != nil {
+			return err
+		}
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		if !containsJSON(enum, value) {
+			return mismatch(path, "%s is not one of %s", jsonString(value), jsonString(enum))
+		}
+	}
+	if c, ok := schema["const"]; ok {
+		if jsonString(c) != jsonString(value) {
+			return mismatch(path, "must be %s", jsonString(c))
+		}
+	}
+
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			if err := v.validate(sub, value, path, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		if err := v.validateAnyOf(anyOf, value, path, depth, false); err != nil {
+			return err
+		}
+	}
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		if err := v.validateAnyOf(oneOf, value, path, depth, true); err != nil {
+			return err
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return v.validateObject(schema, value, path, depth)
+	case []interface{}:
+		return v.validateArray(schema, value, path, depth)
+	case json.Number:
+		return validateNumber(schema, value, path)
+	}
+	return nil
+}
+
+// validateAnyOf validates that value matches some of the schemas, or exactly one of them if exclusive.
+// Mismatches of enums of variants, which cosmwasm-schema generates for Rust enums, are reported by the variant the
+// value names
+func (v schemaValidator) validateAnyOf(schemas []interface{}, value interface{}, path string, depth int, exclusive bool) error {
+	var errs []error
+	matches := 0
+	for _, sub := range schemas {
+		if err := v.validate(sub, value, path, depth+1); err != nil {
+			errs = append(errs, err)
+		} else {
+			matches++
+		}
+	}
+
+	if matches > 1 && exclusive {
+		return mismatch(path, "matches %d of the schemas, but must match exactly one", matches)
+	}
+	if matches > 0 {
+		return nil
+	}
+
+	name, named := variantOf(value)
+	variants := make([]string, 0, len(schemas))
+	for i, sub := range schemas {
+		names := v.variantNames(sub)
+		for _, n := range names {
+			if named && n == name {
+				return errs[i]
+			}
+		}
+		variants = append(variants, names...)
+	}
+	if len(variants) == 0 {
+		// report the mismatch of the schema that matched the deepest, which is the likeliest to be meant
+		var deepest *schemaError
+		for _, err := range errs {
+			if e, ok := err.(*schemaError); ok && len(e.path) > len(path) && (deepest == nil || len(e.path) > len(deepest.path)) {
+				deepest = e
+			}
+		}
+		if deepest != nil {
+			return deepest
+		}
+		return mismatch(path, "doesn't match any of the allowed schemas")
+	}
+
+	sort.Strings(variants)
+	if named {
+		return mismatch(path, "unknown variant %q, expected one of %s", name, strings.Join(variants, ", "))
+	}
+	return mismatch(path, "expected one of the variants %s", strings.Join(variants, ", "))
+}
+
+// variantNames returns the names of the enum variants that a schema describes, which are the strings of its enum or
+// const, or the single property that it requires
+func (v schemaValidator) variantNames(schema interface{}) []string {
+	for depth := 0; depth < maxSchemaDepth; depth++ {
+		obj, ok := schema.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		ref, ok := obj["$ref"].(string)
+		if !ok {
+			break
+		}
+		if schema, _ = v.resolve(ref); schema == nil {
+			return nil
+		}
+	}
+	obj, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	if enum, ok := obj["enum"].([]interface{}); ok {
+		for _, e := range enum {
+			if s, ok := e.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+	if c, ok := obj["const"].(string); ok {
+		names = append(names, c)
+	}
+	if required, ok := obj["required"].([]interface{}); ok && len(required) == 1 {
+		if s, ok := required[0].(string); ok {
+			names = append(names, s)
+		}
+	}
+	return names
+}
+
+// variantOf returns the name of the enum variant that a value names, which is a string, or the key of an object of
+// one key
+func variantOf(value interface{}) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case map[string]interface{}:
+		if len(value) == 1 {
+			for key := range value {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (v schemaValidator) validateObject(schema map[string]interface{}, value map[string]interface{}, path string, depth int) error {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			if key, ok := r.(string); ok {
+				if _, ok := value[key]; !ok {
+					return mismatch(path, "missing field %q", key)
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	additional, hasAdditional := schema["additionalProperties"]
+
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := joinPath(path, key)
+		if sub, ok := properties[key]; ok {
+			if err := v.validate(sub, value[key], fieldPath, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+		if !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok && !allowed {
+			if len(properties) == 0 {
+				return mismatch(path, "unknown field %q", key)
+			}
+			known := make([]string, 0, len(properties))
+			for k := range properties {
+				known = append(known, k)
+			}
+			sort.Strings(known)
+			return mismatch(path, "unknown field %q, expected one of %s", key, strings.Join(known, ", "))
+		}
+		if err := v.validate(additional, value[key], fieldPath, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v schemaValidator) validateArray(schema map[string]interface{}, value []interface{}, path string, depth int) error {
+	if min, ok := schemaUint(schema, "minItems"); ok && uint64(len(value)) < min {
+		return mismatch(path, "must have at least %d items", min)
+	}
+	if max, ok := schemaUint(schema, "maxItems"); ok && uint64(len(value)) > max {
+		return mismatch(path, "must have at most %d items", max)
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		items := map[string]bool{}
+		for i, item := range value {
+			s := jsonString(item)
+			if items[s] {
+				return mismatch(fmt.Sprintf("%s[%d]", path, i), "duplicate item %s", s)
+			}
+			items[s] = true
+		}
+	}
+
+	switch items := schema["items"].(type) {
+	case nil:
+	case []interface{}:
+		// tuples
+		for i, item := range value {
+			if i >= len(items) {
+				break
+			}
+			if err := v.validate(items[i], item, fmt.Sprintf("%s[%d]", path, i), depth+1); err != nil {
+				return err
+			}
+		}
+	default:
+		for i, item := range value {
+			if err := v.validate(items, item, fmt.Sprintf("%s[%d]", path, i), depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// formatRanges are the ranges of the integer formats of cosmwasm-schema
+var formatRanges = map[string][2]*big.Float{
+	"uint8":  {big.NewFloat(0), big.NewFloat(math.MaxUint8)},
+	"uint16": {big.NewFloat(0), big.NewFloat(math.MaxUint16)},
+	"uint32": {big.NewFloat(0), big.NewFloat(math.MaxUint32)},
+	"uint64": {big.NewFloat(0), new(big.Float).SetUint64(math.MaxUint64)},
+	"int8":   {big.NewFloat(math.MinInt8), big.NewFloat(math.MaxInt8)},
+	"int16":  {big.NewFloat(math.MinInt16), big.NewFloat(math.MaxInt16)},
+	"int32":  {big.NewFloat(math.MinInt32), big.NewFloat(math.MaxInt32)},
+	"int64":  {big.NewFloat(math.MinInt64), big.NewFloat(math.MaxInt64)},
+}
+
+func validateNumber(schema map[string]interface{}, value json.Number, path string) error {
+	n, ok := new(big.Float).SetString(value.String())
+	if !ok {
+		return mismatch(path, "invalid number %s", value)
+	}
+
+	if min, ok := schema["minimum"].(json.Number); ok {
+		if m, ok := new(big.Float).SetString(min.String()); ok && n.Cmp(m) < 0 {
+			return mismatch(path, "must be at least %s", min)
+		}
+	}
+	if max, ok := schema["maximum"].(json.Number); ok {
+		if m, ok := new(big.Float).SetString(max.String()); ok && n.Cmp(m) > 0 {
+			return mismatch(path, "must be at most %s", max)
+		}
+	}
+	if format, ok := schema["format"].(string); ok {
+		if r, ok := formatRanges[format]; ok && (n.Cmp(r[0]) < 0 || n.Cmp(r[1]) > 0) {
+			return mismatch(path, "%s is out of the range of %s", value, format)
+		}
+	}
+	return nil
+}
+
+func validateType(types interface{}, value interface{}, path string) error {
+	var allowed []string
+	switch types := types.(type) {
+	case string:
+		allowed = []string{types}
+	case []interface{}:
+		for _, t := range types {
+			if s, ok := t.(string); ok {
+				allowed = append(allowed, s)
+			}
+		}
+	default:
+		return nil
+	}
+
+	actual := jsonType(value)
+	for _, t := range allowed {
+		if t == actual || (t == "number" && actual == "integer") {
+			return nil
+		}
+	}
+	return mismatch(path, "expected %s, got %s", strings.Join(allowed, " or "), actual)
+}
+
+// jsonType returns the JSON schema type of a decoded value, which is integer for numbers without a fraction
+func jsonType(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case json.Number:
+		if n, ok := new(big.Float).SetString(value.String()); ok && n.IsInt() {
+			return "integer"
+		}
+		return "number"
+	}
+	return "unknown"
+}
+
+// resolve returns the schema of a local reference, like #/definitions/Uint128
+func (v schemaValidator) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported schema reference %s", ref)
+	}
+
+	current := v.root
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer == "" {
+		return current, nil
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unresolvable schema reference %s", ref)
+		}
+		if current, ok = obj[token]; !ok {
+			return nil, fmt.Errorf("unresolvable schema reference %s", ref)
+		}
+	}
+	return current, nil
+}
+
+func schemaUint(schema map[string]interface{}, keyword string) (uint64, bool) {
+	n, ok := schema[keyword].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	if err != nil || i < 0 {
+		return 0, false
+	}
+	return uint64(i), true
+}
+
+func containsJSON(values []interface{}, value interface{}) bool {
+	s := jsonString(value)
+	for _, v := range values {
+		if jsonString(v) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonString returns the canonical JSON of a decoded value, whose objects json.Marshal sorts by key
+func jsonString(value interface{}) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(bz)
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
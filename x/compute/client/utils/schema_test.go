@@ -0,0 +1,273 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testContractSchema is a bundle in the format that cosmwasm-schema generates for a counter contract
+const testContractSchema = `{
+  "contract_name": "counter",
+  "contract_version": "1.0.0",
+  "instantiate": {
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "title": "InstantiateMsg",
+    "type": "object",
+    "required": ["count"],
+    "properties": {
+      "count": {"type": "integer", "format": "int32"},
+      "owner": {"type": ["string", "null"]}
+    },
+    "additionalProperties": false
+  },
+  "execute": {
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "title": "ExecuteMsg",
+    "oneOf": [
+      {
+        "type": "object",
+        "required": ["increment"],
+        "properties": {"increment": {"type": "object", "additionalProperties": false}},
+        "additionalProperties": false
+      },
+      {
+        "type": "object",
+        "required": ["transfer"],
+        "properties": {
+          "transfer": {
+            "type": "object",
+            "required": ["amount", "recipient"],
+            "properties": {
+              "amount": {"$ref": "#/definitions/Uint128"},
+              "recipient": {"type": "string"},
+              "memo": {"type": ["string", "null"]},
+              "times": {"type": "integer", "format": "uint8", "minimum": 0.0},
+              "tags": {"type": "array", "items": {"$ref": "#/definitions/Tag"}}
+            },
+            "additionalProperties": false
+          }
+        },
+        "additionalProperties": false
+      }
+    ],
+    "definitions": {
+      "Uint128": {"type": "string"},
+      "Tag": {"type": "string", "enum": ["a", "b"]}
+    }
+  },
+  "query": {
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "title": "QueryMsg",
+    "oneOf": [
+      {"type": "string", "enum": ["get_count"]},
+      {
+        "type": "object",
+        "required": ["balance"],
+        "properties": {"balance": {"type": "object", "required": ["address"], "properties": {"address": {"type": "string"}}}},
+        "additionalProperties": false
+      }
+    ]
+  },
+  "migrate": null
+}`
+
+func TestContractSchemaValidateMsg(t *testing.T) {
+	schema, err := ParseContractSchema([]byte(testContractSchema))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		kind string
+		msg  string
+		err  string
+	}{
+		{kind: SchemaInstantiate, msg: `{"count": 1}`},
+		{kind: SchemaInstantiate, msg: `{"count": 1, "owner": null}`},
+		{kind: SchemaInstantiate, msg: `{"count": "1"}`, err: "count: expected integer, got string"},
+		{kind: SchemaInstantiate, msg: `{"count": 1.5}`, err: "count: expected integer, got number"},
+		{kind: SchemaInstantiate, msg: `{"count": 3000000000}`, err: "count: 3000000000 is out of the range of int32"},
+		{kind: SchemaInstantiate, msg: `{}`, err: `missing field "count"`},
+		{kind: SchemaInstantiate, msg: `{"count": 1, "admin": "a"}`, err: `unknown field "admin", expected one of count, owner`},
+		{kind: SchemaInstantiate, msg: `{"count": 1`, err: "instantiate message is not valid JSON"},
+
+		{kind: SchemaExecute, msg: `{"increment": {}}`},
+		{kind: SchemaExecute, msg: `{"transfer": {"amount": "10", "recipient": "secret1", "tags": ["a", "b"], "times": 2}}`},
+		{kind: SchemaExecute, msg: `{"transfer": {"amount": 10, "recipient": "secret1"}}`, err: "transfer.amount: expected string, got integer"},
+		{kind: SchemaExecute, msg: `{"transfer": {"amount": "10", "recipient": "secret1", "tags": ["c"]}}`, err: `transfer.tags[0]: "c" is not one of ["a","b"]`},
+		{kind: SchemaExecute, msg: `{"transfer": {"amount": "10", "recipient": "secret1", "times": -1}}`, err: "transfer.times: must be at least 0.0"},
+		{kind: SchemaExecute, msg: `{"decrement": {}}`, err: `unknown variant "decrement", expected one of increment, transfer`},
+		{kind: SchemaExecute, msg: `{"increment": {}, "transfer": {}}`, err: "expected one of the variants increment, transfer"},
+
+		{kind: SchemaQuery, msg: `"get_count"`},
+		{kind: SchemaQuery, msg: `{"balance": {"address": "secret1"}}`},
+		{kind: SchemaQuery, msg: `{"balance": {}}`, err: `balance: missing field "address"`},
+		{kind: SchemaQuery, msg: `"get_owner"`, err: `unknown variant "get_owner", expected one of balance, get_count`},
+
+		// the bundle has no migrate schema
+		{kind: SchemaMigrate, msg: `{"anything": 1}`},
+	} {
+		err := schema.ValidateMsg(tc.kind, []byte(tc.msg))
+		if tc.err == "" {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.ErrorContains(t, err, tc.err, tc.msg)
+		}
+	}
+
+	_, err = ParseContractSchema([]byte(`[]`))
+	require.ErrorContains(t, err, "invalid contract schema")
+	require.ErrorContains(t, schema.ValidateMsg("sudo", []byte(`{}`)), "unknown message kind sudo")
+}
+
+func TestContractSchemaRecursiveDefinitions(t *testing.T) {
+	schema, err := ParseContractSchema([]byte(`{"execute": {
+		"$ref": "#/definitions/Node",
+		"definitions": {"Node": {"type": "object", "properties": {"next": {"anyOf": [{"$ref": "#/definitions/Node"}, {"type": "null"}]}}}}
+	}}`))
+	require.NoError(t, err)
+
+	require.NoError(t, schema.ValidateMsg(SchemaExecute, []byte(`{"next": {"next": {"next": null}}}`)))
+	require.ErrorContains(t, schema.ValidateMsg(SchemaExecute, []byte(`{"next": {"next": 1}}`)), "next.next: doesn't match any of the allowed schemas")
+
+	schema, err = ParseContractSchema([]byte(`{"execute": {"$ref": "#/definitions/Missing"}}`))
+	require.NoError(t, err)
+	require.ErrorContains(t, schema.ValidateMsg(SchemaExecute, []byte(`{}`)), "unresolvable schema reference #/definitions/Missing")
+
+	// a schema that references itself directly is bounded by the depth limit
+	schema, err = ParseContractSchema([]byte(`{"execute": {"$ref": "#"}}`))
+	require.NoError(t, err)
+	require.ErrorContains(t, schema.ValidateMsg(SchemaExecute, []byte(`{}`)), "schema nesting is too deep")
+}
+
+// TestContractSchemaKeywords checks every keyword that messages are validated against, on its own
+func TestContractSchemaKeywords(t *testing.T) {
+	for _, tc := range []struct {
+		keyword string
+		schema  string
+		valid   []string
+		invalid map[string]string
+	}{
+		{
+			keyword: "boolean schemas",
+			schema:  `{"properties": {"any": true, "none": false}}`,
+			valid:   []string{`{"any": [1, "a"]}`},
+			invalid: map[string]string{`{"none": 1}`: "none: no value is allowed"},
+		},
+		{
+			keyword: "$ref",
+			schema:  `{"$ref": "#/definitions/Amount", "type": "object", "definitions": {"Amount": {"type": "string"}}}`,
+			valid:   []string{`"10"`},
+			invalid: map[string]string{`10`: "expected string, got integer"},
+		},
+		{
+			keyword: "type",
+			schema:  `{"type": ["number", "boolean", "null"]}`,
+			valid:   []string{`1`, `1.5`, `true`, `null`},
+			invalid: map[string]string{`"1"`: "expected number or boolean or null, got string", `[]`: "got array", `{}`: "got object"},
+		},
+		{
+			keyword: "enum",
+			schema:  `{"enum": ["a", 1, {"b": 2}]}`,
+			valid:   []string{`"a"`, `1`, `{"b": 2}`},
+			invalid: map[string]string{`"c"`: `"c" is not one of ["a",1,{"b":2}]`},
+		},
+		{
+			keyword: "const",
+			schema:  `{"const": {"a": [1]}}`,
+			valid:   []string{`{"a": [1]}`},
+			invalid: map[string]string{`{"a": [2]}`: `must be {"a":[1]}`},
+		},
+		{
+			keyword: "allOf",
+			schema:  `{"allOf": [{"required": ["a"]}, {"required": ["b"]}]}`,
+			valid:   []string{`{"a": 1, "b": 2}`},
+			invalid: map[string]string{`{"a": 1}`: `missing field "b"`},
+		},
+		{
+			keyword: "anyOf",
+			schema:  `{"anyOf": [{"type": "string"}, {"type": "integer", "minimum": 0}]}`,
+			valid:   []string{`"a"`, `1`},
+			invalid: map[string]string{`true`: "doesn't match any of the allowed schemas"},
+		},
+		{
+			keyword: "oneOf",
+			schema:  `{"oneOf": [{"type": "integer"}, {"type": "number"}]}`,
+			valid:   []string{`1.5`},
+			invalid: map[string]string{`1`: "matches 2 of the schemas, but must match exactly one", `"a"`: "doesn't match any of the allowed schemas"},
+		},
+		{
+			keyword: "required",
+			schema:  `{"required": ["a", "b"]}`,
+			valid:   []string{`{"a": 1, "b": null}`, `"not an object"`},
+			invalid: map[string]string{`{"a": 1}`: `missing field "b"`},
+		},
+		{
+			keyword: "properties",
+			schema:  `{"properties": {"a": {"type": "string"}}}`,
+			valid:   []string{`{"a": "x"}`, `{"b": 1}`},
+			invalid: map[string]string{`{"a": 1}`: "a: expected string, got integer"},
+		},
+		{
+			keyword: "additionalProperties",
+			schema:  `{"properties": {"a": {}}, "additionalProperties": {"type": "integer"}}`,
+			valid:   []string{`{"a": "x", "b": 1}`},
+			invalid: map[string]string{`{"a": "x", "b": "y"}`: "b: expected integer, got string"},
+		},
+		{
+			keyword: "items",
+			schema:  `{"properties": {"list": {"items": {"type": "string"}}, "tuple": {"items": [{"type": "string"}, {"type": "integer"}]}}}`,
+			valid:   []string{`{"list": ["a", "b"], "tuple": ["a", 1]}`},
+			invalid: map[string]string{
+				`{"list": ["a", 1]}`:  "list[1]: expected string, got integer",
+				`{"tuple": [1, "a"]}`: "tuple[0]: expected string, got integer",
+			},
+		},
+		{
+			keyword: "minItems and maxItems",
+			schema:  `{"minItems": 1, "maxItems": 2}`,
+			valid:   []string{`[1]`, `[1, 2]`},
+			invalid: map[string]string{`[]`: "must have at least 1 items", `[1, 2, 3]`: "must have at most 2 items"},
+		},
+		{
+			keyword: "uniqueItems",
+			schema:  `{"uniqueItems": true}`,
+			valid:   []string{`[1, "1", {"a": 1}]`},
+			invalid: map[string]string{`[{"a": 1, "b": 2}, {"b": 2, "a": 1}]`: `[1]: duplicate item {"a":1,"b":2}`},
+		},
+		{
+			keyword: "minimum and maximum",
+			schema:  `{"minimum": -1.5, "maximum": 10}`,
+			valid:   []string{`-1.5`, `10`},
+			invalid: map[string]string{`-2`: "must be at least -1.5", `10.1`: "must be at most 10"},
+		},
+		{
+			keyword: "format",
+			schema:  `{"properties": {"u": {"format": "uint64"}, "i": {"format": "int8"}, "s": {"type": "string", "format": "date-time"}}}`,
+			valid:   []string{`{"u": 18446744073709551615, "i": -128, "s": "not checked"}`},
+			invalid: map[string]string{
+				`{"u": 18446744073709551616}`: "u: 18446744073709551616 is out of the range of uint64",
+				`{"i": 128}`:                  "i: 128 is out of the range of int8",
+			},
+		},
+		{
+			keyword: "annotations",
+			schema:  `{"$schema": "http://json-schema.org/draft-07/schema#", "title": "T", "description": "D", "default": 1, "examples": [1], "definitions": {}}`,
+			valid:   []string{`1`, `"a"`},
+		},
+		{
+			keyword: "unsupported keywords",
+			schema:  `{"properties": {"a": {"type": "string", "pattern": "^a", "maxLength": 2}}}`,
+			valid:   []string{`{"b": 1}`},
+			invalid: map[string]string{`{"a": "a"}`: "a: unsupported schema keywords maxLength, pattern"},
+		},
+	} {
+		schema, err := ParseContractSchema([]byte(`{"execute": ` + tc.schema + `}`))
+		require.NoError(t, err, tc.keyword)
+		for _, msg := range tc.valid {
+			require.NoError(t, schema.ValidateMsg(SchemaExecute, []byte(msg)), "%s: %s", tc.keyword, msg)
+		}
+		for msg, expErr := range tc.invalid {
+			require.ErrorContains(t, schema.ValidateMsg(SchemaExecute, []byte(msg)), expErr, "%s: %s", tc.keyword, msg)
+		}
+	}
+}
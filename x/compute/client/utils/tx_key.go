@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"golang.org/x/crypto/curve25519"
+)
+
+// TxKeysDir is the directory in the home directory that holds the tx encryption key files of accounts
+const TxKeysDir = "tx_keys"
+
+// TxEncryptionKeyMemo is the memo of the document that accounts sign to derive their tx encryption key. Keplr signs
+// the same document, so an account gets the same key here as in wallets that pass Keplr's seed to secret.js
+const TxEncryptionKeyMemo = "Create Keplr Secret encryption key. Only approve requests by Keplr."
+
+// TxKeyPairPath returns the path of the tx encryption key file of an account
+func TxKeyPairPath(homeDir string, address sdk.AccAddress) string {
+	return filepath.Join(homeDir, TxKeysDir, address.String()+".json")
+}
+
+// TxEncryptionKeySignDoc returns the document that accounts sign to derive their tx encryption key on a chain. It is
+// serialized like JSON.stringify does in secret.js clients, with the fields in this order
+func TxEncryptionKeySignDoc(chainID string) []byte {
+	doc, _ := json.Marshal(struct {
+		AccountNumber uint64   `json:"account_number"`
+		ChainID       string   `json:"chain_id"`
+		Fee           []string `json:"fee"`
+		Memo          string   `json:"memo"`
+		Msgs          []string `json:"msgs"`
+		Sequence      uint64   `json:"sequence"`
+	}{
+		ChainID: chainID,
+		Fee:     []string{},
+		Memo:    TxEncryptionKeyMemo,
+		Msgs:    []string{},
+	})
+	return doc
+}
+
+// DeriveTxKeyPair derives the tx encryption key of a keyring key on a chain. The seed of the key is the SHA-256 hash of
+// the signature of the key over TxEncryptionKeySignDoc, which is deterministic for secp256k1 keys
+func DeriveTxKeyPair(kr keyring.Keyring, uid string, chainID string) (privkey []byte, pubkey []byte, er error) {
+	if kr == nil {
+		return nil, nil, fmt.Errorf("deriving the tx encryption key requires a keyring")
+	}
+	if chainID == "" {
+		return nil, nil, fmt.Errorf("deriving the tx encryption key requires a chain ID")
+	}
+
+	signature, _, err := kr.Sign(uid, TxEncryptionKeySignDoc(chainID), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign with key %s: %w", uid, err)
+	}
+
+	seed := sha256.Sum256(signature)
+	pubkey, err = curve25519.X25519(seed[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return seed[:], pubkey, nil
+}
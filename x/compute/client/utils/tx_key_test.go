@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+)
+
+const testMnemonic = "angry twist harsh drastic left brass behave host shove marriage fall update business leg direct reward object ugly security warm tuna model broccoli choice"
+
+func newTestKeyring(t *testing.T) (keyring.Keyring, sdk.AccAddress) {
+	kr := keyring.NewInMemory(keeper.MakeTestCodec())
+	record, err := kr.NewAccount("alice", testMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+	return kr, address
+}
+
+func TestTxEncryptionKeySignDoc(t *testing.T) {
+	require.Equal(t,
+		`{"account_number":0,"chain_id":"secret-4","fee":[],"memo":"Create Keplr Secret encryption key. Only approve requests by Keplr.","msgs":[],"sequence":0}`,
+		string(TxEncryptionKeySignDoc("secret-4")),
+	)
+}
+
+func TestDeriveTxKeyPair(t *testing.T) {
+	kr, _ := newTestKeyring(t)
+
+	privkey, pubkey, err := DeriveTxKeyPair(kr, "alice", "secret-4")
+	require.NoError(t, err)
+	require.Len(t, privkey, 32)
+	require.Len(t, pubkey, 32)
+
+	again, _, err := DeriveTxKeyPair(kr, "alice", "secret-4")
+	require.NoError(t, err)
+	require.Equal(t, privkey, again, "derivation is deterministic")
+
+	otherChain, _, err := DeriveTxKeyPair(kr, "alice", "pulsar-3")
+	require.NoError(t, err)
+	require.NotEqual(t, privkey, otherChain)
+
+	_, _, err = DeriveTxKeyPair(kr, "alice", "")
+	require.Error(t, err)
+	_, _, err = DeriveTxKeyPair(kr, "bob", "secret-4")
+	require.Error(t, err)
+}
+
+// TestDeriveTxKeyPairKnownAnswer checks the key of a Keplr account, which is derived at the HD path of Secret. The
+// expected keys were computed apart from this package, by signing TxEncryptionKeySignDoc like Keplr does (RFC 6979
+// over its SHA-256 hash) and deriving the curve25519 key pair of the seed like secret.js does
+func TestDeriveTxKeyPairKnownAnswer(t *testing.T) {
+	kr := keyring.NewInMemory(keeper.MakeTestCodec())
+	record, err := kr.NewAccount("keplr", testMnemonic, "", "m/44'/529'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	accountPubkey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, "03eabb653d37af3a0ec1b3f947ecee569896b35c737ecc4c6d0206663e261abe0a", hex.EncodeToString(accountPubkey.Bytes()))
+
+	privkey, pubkey, err := DeriveTxKeyPair(kr, "keplr", "secret-4")
+	require.NoError(t, err)
+	require.Equal(t, "849bc8e46e460697cbd7b24c5d8839b146b6874dcbed8329b04cd89139d0a4d0", hex.EncodeToString(privkey))
+	require.Equal(t, "969cea7037bc193150151372fb5f6b89a4e876758f8931d715bf1d3838610176", hex.EncodeToString(pubkey))
+}
+
+func TestGetTxSenderKeyPair(t *testing.T) {
+	kr, address := newTestKeyring(t)
+	homeDir := t.TempDir()
+
+	cliCtx := client.Context{}.WithHomeDir(homeDir).WithKeyring(kr).WithChainID("secret-4")
+	accountCtx := cliCtx.WithFrom("alice").WithFromName("alice").WithFromAddress(address)
+
+	// without a key file, accounts use the shared key
+	sharedPrivkey, _, err := WASMContext{CLIContext: accountCtx}.GetTxSenderKeyPair()
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(homeDir, "id_tx_io.json"))
+
+	_, _, err = WASMContext{CLIContext: cliCtx, DeriveTxKey: true}.GetTxSenderKeyPair()
+	require.Error(t, err, "deriving requires an account")
+
+	// deriving stores the key file of the account, which is used from then on
+	derivedPrivkey, derivedPubkey, err := WASMContext{CLIContext: accountCtx, DeriveTxKey: true}.GetTxSenderKeyPair()
+	require.NoError(t, err)
+	require.NotEqual(t, sharedPrivkey, derivedPrivkey)
+	require.FileExists(t, TxKeyPairPath(homeDir, address))
+
+	privkey, pubkey, err := WASMContext{CLIContext: accountCtx}.GetTxSenderKeyPair()
+	require.NoError(t, err)
+	require.Equal(t, derivedPrivkey, privkey)
+	require.Equal(t, derivedPubkey, pubkey)
+
+	// other accounts still use the shared key
+	privkey, _, err = WASMContext{CLIContext: cliCtx}.GetTxSenderKeyPair()
+	require.NoError(t, err)
+	require.Equal(t, sharedPrivkey, privkey)
+}
+
+func TestParseTxKeyPair(t *testing.T) {
+	privkey := make([]byte, 32)
+	privkey[0] = 1
+	pubkey := make([]byte, 32)
+
+	keyPairJSONBytes, err := MarshalTxKeyPair(privkey, pubkey)
+	require.NoError(t, err)
+	_, _, err = ParseTxKeyPair(keyPairJSONBytes)
+	require.Error(t, err, "public key of another private key")
+
+	path := filepath.Join(t.TempDir(), "key.json")
+	privkey, pubkey, err = getOrCreateTxKeyPair(path)
+	require.NoError(t, err)
+
+	keyPairJSONBytes, err = os.ReadFile(path)
+	require.NoError(t, err)
+	parsedPrivkey, parsedPubkey, err := ParseTxKeyPair(keyPairJSONBytes)
+	require.NoError(t, err)
+	require.Equal(t, privkey, parsedPrivkey)
+	require.Equal(t, pubkey, parsedPubkey)
+}
@@ -62,6 +62,12 @@ type WASMContext struct {
 	CLIContext      client.Context
 	TestKeyPairPath string
 	TestMasterIOKey regtypes.MasterKey
+	// DeriveTxKey derives the tx encryption key of the from account from a signature of its keyring key, when the
+	// account has no key file yet
+	DeriveTxKey bool
+	// ConsensusIOPubKey is the consensus IO pubkey that messages are encrypted for, which is queried if it isn't set.
+	// Offline transactions set it from their offline bundle
+	ConsensusIOPubKey []byte
 }
 
 type keyPair struct {
@@ -69,15 +75,38 @@ type keyPair struct {
 	Public  string `json:"public"`
 }
 
-// GetTxSenderKeyPair get the local tx encryption id
+// GetTxSenderKeyPair get the local tx encryption id. The from account uses its own key file once it has one, and
+// gets one derived from its keyring key if DeriveTxKey is set. Otherwise the key in id_tx_io.json is used, which is
+// generated the first time
 func (ctx WASMContext) GetTxSenderKeyPair() (privkey []byte, pubkey []byte, er error) {
-	var keyPairFilePath string
 	if len(ctx.TestKeyPairPath) > 0 {
-		keyPairFilePath = ctx.TestKeyPairPath
-	} else {
-		keyPairFilePath = filepath.Join(ctx.CLIContext.HomeDir, "id_tx_io.json")
+		return getOrCreateTxKeyPair(ctx.TestKeyPairPath)
 	}
 
+	if fromAddress := ctx.CLIContext.GetFromAddress(); !fromAddress.Empty() {
+		accountKeyPairPath := TxKeyPairPath(ctx.CLIContext.HomeDir, fromAddress)
+		if _, err := os.Stat(accountKeyPairPath); err == nil {
+			return ReadTxKeyPair(accountKeyPairPath)
+		}
+
+		if ctx.DeriveTxKey {
+			privkey, pubkey, err := DeriveTxKeyPair(ctx.CLIContext.Keyring, ctx.CLIContext.GetFromName(), ctx.CLIContext.ChainID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := WriteTxKeyPair(accountKeyPairPath, privkey, pubkey); err != nil {
+				return nil, nil, err
+			}
+			return privkey, pubkey, nil
+		}
+	} else if ctx.DeriveTxKey {
+		return nil, nil, fmt.Errorf("deriving the tx encryption key requires an account to sign with")
+	}
+
+	return getOrCreateTxKeyPair(filepath.Join(ctx.CLIContext.HomeDir, "id_tx_io.json"))
+}
+
+func getOrCreateTxKeyPair(keyPairFilePath string) (privkey []byte, pubkey []byte, er error) {
 	if _, err := os.Stat(keyPairFilePath); os.IsNotExist(err) {
 		var privkey [32]byte
 		rand.Read(privkey[:]) //nolint:errcheck
@@ -85,17 +114,7 @@ func (ctx WASMContext) GetTxSenderKeyPair() (privkey []byte, pubkey []byte, er e
 		var pubkey [32]byte
 		curve25519.ScalarBaseMult(&pubkey, &privkey)
 
-		keyPair := keyPair{
-			Private: hex.EncodeToString(privkey[:]),
-			Public:  hex.EncodeToString(pubkey[:]),
-		}
-
-		keyPairJSONBytes, err := json.MarshalIndent(keyPair, "", "    ")
-		if err != nil {
-			return nil, nil, err
-		}
-
-		err = os.WriteFile(keyPairFilePath, keyPairJSONBytes, 0o600)
+		err = WriteTxKeyPair(keyPairFilePath, privkey[:], pubkey[:])
 		if err != nil {
 			return nil, nil, err
 		}
@@ -103,14 +122,25 @@ func (ctx WASMContext) GetTxSenderKeyPair() (privkey []byte, pubkey []byte, er e
 		return privkey[:], pubkey[:], nil
 	}
 
+	return ReadTxKeyPair(keyPairFilePath)
+}
+
+// ReadTxKeyPair reads a tx encryption key file, and checks that its public key belongs to its private key
+func ReadTxKeyPair(keyPairFilePath string) (privkey []byte, pubkey []byte, er error) {
 	keyPairJSONBytes, err := os.ReadFile(keyPairFilePath)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return ParseTxKeyPair(keyPairJSONBytes)
+}
+
+// ParseTxKeyPair parses the JSON of a tx encryption key file, and checks that its public key belongs to its private
+// key
+func ParseTxKeyPair(keyPairJSONBytes []byte) (privkey []byte, pubkey []byte, er error) {
 	var keyPair keyPair
 
-	err = json.Unmarshal(keyPairJSONBytes, &keyPair)
+	err := json.Unmarshal(keyPairJSONBytes, &keyPair)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,11 +154,42 @@ func (ctx WASMContext) GetTxSenderKeyPair() (privkey []byte, pubkey []byte, er e
 		return nil, nil, err
 	}
 
-	// TODO verify pubkey
+	if len(privkey) != curve25519.ScalarSize {
+		return nil, nil, fmt.Errorf("private key has length %d, expected %d bytes", len(privkey), curve25519.ScalarSize)
+	}
+	expectedPubkey, err := curve25519.X25519(privkey, curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(pubkey, expectedPubkey) {
+		return nil, nil, fmt.Errorf("public key does not belong to the private key")
+	}
 
 	return privkey, pubkey, nil
 }
 
+// MarshalTxKeyPair returns the JSON of a tx encryption key file
+func MarshalTxKeyPair(privkey []byte, pubkey []byte) ([]byte, error) {
+	return json.MarshalIndent(keyPair{
+		Private: hex.EncodeToString(privkey),
+		Public:  hex.EncodeToString(pubkey),
+	}, "", "    ")
+}
+
+// WriteTxKeyPair writes a tx encryption key file, readable by its owner only
+func WriteTxKeyPair(keyPairFilePath string, privkey []byte, pubkey []byte) error {
+	keyPairJSONBytes, err := MarshalTxKeyPair(privkey, pubkey)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(keyPairFilePath), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(keyPairFilePath, keyPairJSONBytes, 0o600)
+}
+
 var hkdfSalt = []byte{
 	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 	0x00, 0x02, 0x4b, 0xea, 0xd8, 0xdf, 0x69, 0x99,
@@ -140,6 +201,8 @@ func (ctx WASMContext) getConsensusIoPubKey() ([]byte, error) {
 	var masterIoKey regtypes.Key
 	if ctx.TestMasterIOKey.Bytes != nil { // TODO check length?
 		masterIoKey.Key = ctx.TestMasterIOKey.Bytes
+	} else if ctx.ConsensusIOPubKey != nil {
+		masterIoKey.Key = ctx.ConsensusIOPubKey
 	} else {
 		res, _, err := ctx.CLIContext.Query("/secret.registration.v1beta1.Query/TxKey")
 		if err != nil {
@@ -208,7 +271,7 @@ func (ctx WASMContext) OfflineEncrypt(plaintext []byte, pathToMasterIoKey string
 		return nil, err
 	}
 
-	return encryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
+	return EncryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
 }
 
 // Encrypt encrypts
@@ -232,7 +295,7 @@ func (ctx WASMContext) Encrypt(plaintext []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return encryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
+	return EncryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
 }
 
 // Decrypt decrypts
@@ -251,17 +314,39 @@ func (ctx WASMContext) Decrypt(ciphertext []byte, nonce []byte) ([]byte, error)
 		return nil, err
 	}
 
-	cipher, err := miscreant.NewAESCMACSIV(txEncryptionKey)
+	return DecryptData(txEncryptionKey, ciphertext)
+}
+
+// GetTxEncryptionKey returns the tx encryption key of a nonce, which decrypts the input of a message and all of its
+// outputs, so that they are decrypted without querying the consensus io key for each
+func (ctx WASMContext) GetTxEncryptionKey(nonce []byte) ([]byte, error) {
+	txSenderPrivKey, _, err := ctx.GetTxSenderKeyPair()
 	if err != nil {
 		return nil, err
 	}
 
-	return cipher.Open(nil, ciphertext, []byte{})
+	return ctx.getTxEncryptionKey(txSenderPrivKey, nonce)
 }
 
 var re = regexp.MustCompile("encrypted: (.+?):")
 
 func (ctx WASMContext) DecryptError(errString string, nonce []byte) (json.RawMessage, error) {
+	errorCipherBz, err := ParseEncryptedError(errString)
+	if err != nil {
+		return nil, err
+	}
+
+	errorPlainBz, err := ctx.Decrypt(errorCipherBz, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("got an error decrypting the error: %w", err)
+	}
+
+	return errorPlainBz, nil
+}
+
+// ParseEncryptedError returns the ciphertext of the encrypted error of a contract in the log of a transaction or the
+// error of a query
+func ParseEncryptedError(errString string) ([]byte, error) {
 	regexMatch := re.FindStringSubmatch(errString)
 	if len(regexMatch) != 2 {
 		return nil, fmt.Errorf("got an error finding base64 of the error: regexMatch '%v' should have a length of 2. error: %v", regexMatch, errString)
@@ -273,15 +358,12 @@ func (ctx WASMContext) DecryptError(errString string, nonce []byte) (json.RawMes
 		return nil, fmt.Errorf("got an error decoding base64 of the error: %w", err)
 	}
 
-	errorPlainBz, err := ctx.Decrypt(errorCipherBz, nonce)
-	if err != nil {
-		return nil, fmt.Errorf("got an error decrypting the error: %w", err)
-	}
-
-	return errorPlainBz, nil
+	return errorCipherBz, nil
 }
 
-func encryptData(aesEncryptionKey []byte, txSenderPubKey []byte, plaintext []byte, nonce []byte) ([]byte, error) {
+// EncryptData encrypts the input of a contract with the tx encryption key of a nonce, and prepends the nonce and the
+// public key of the tx sender, which the enclave derives the same key from
+func EncryptData(aesEncryptionKey []byte, txSenderPubKey []byte, plaintext []byte, nonce []byte) ([]byte, error) {
 	cipher, err := miscreant.NewAESCMACSIV(aesEncryptionKey)
 	if err != nil {
 		log.Println(err)
@@ -300,6 +382,22 @@ func encryptData(aesEncryptionKey []byte, txSenderPubKey []byte, plaintext []byt
 	return ciphertext, nil
 }
 
+// DecryptData decrypts the input or the outputs of a contract with the tx encryption key of their nonce
+func DecryptData(aesEncryptionKey []byte, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) == 0 {
+		return []byte{}, nil
+	}
+
+	cipher, err := miscreant.NewAESCMACSIV(aesEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.Open(nil, ciphertext, []byte{})
+}
+
+// GetTxEncryptionKeyOffline derives the tx encryption key of a nonce from the consensus io public key and the private
+// key of the tx sender
 func GetTxEncryptionKeyOffline(pubkey []byte, txSenderPrivKey []byte, nonce []byte) ([]byte, error) {
 	txEncryptionIkm, err := curve25519.X25519(txSenderPrivKey, pubkey)
 	if err != nil {
@@ -14,7 +14,10 @@ import (
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
-var _ porttypes.IBCModule = IBCHandler{}
+var (
+	_ porttypes.IBCModule        = IBCHandler{}
+	_ porttypes.UpgradableModule = IBCHandler{}
+)
 
 // internal interface that is implemented by ibc middleware
 type appVersionGetter interface {
@@ -237,6 +240,124 @@ func (i IBCHandler) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string
 	return err
 }
 
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (i IBCHandler) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	contractAddr, channel, err := i.contractChannel(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+	proposed, err := toWasmVMUpgradeFields(proposedOrder, proposedConnectionHops, proposedVersion)
+	if err != nil {
+		return "", err
+	}
+	msg := v1types.IBCUpgradeInit{
+		Channel:  channel,
+		Proposed: proposed,
+	}
+	if err := i.keeper.OnUpgradeChannel(ctx, contractAddr, msg.ToMsg()); err != nil {
+		return "", err
+	}
+	return proposedVersion, nil
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (i IBCHandler) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	contractAddr, channel, err := i.contractChannel(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+	proposed, err := toWasmVMUpgradeFields(proposedOrder, proposedConnectionHops, counterpartyVersion)
+	if err != nil {
+		return "", err
+	}
+	msg := v1types.IBCUpgradeTry{
+		Channel:  channel,
+		Proposed: proposed,
+	}
+	if err := i.keeper.OnUpgradeChannel(ctx, contractAddr, msg.ToMsg()); err != nil {
+		return "", err
+	}
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (i IBCHandler) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	contractAddr, channel, err := i.contractChannel(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	msg := v1types.IBCUpgradeAck{
+		Channel:             channel,
+		CounterpartyVersion: counterpartyVersion,
+	}
+	return i.keeper.OnUpgradeChannel(ctx, contractAddr, msg.ToMsg())
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+// The upgrade is already committed at this point, so an error from the contract is only logged.
+func (i IBCHandler) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	contractAddr, channel, err := i.contractChannel(ctx, portID, channelID)
+	if err == nil {
+		// the channel end is only rewritten after this callback, so the contract sees it with the upgraded fields
+		var upgrade v1types.IBCUpgradeFields
+		upgrade, err = toWasmVMUpgradeFields(proposedOrder, proposedConnectionHops, proposedVersion)
+		channel.Order, channel.ConnectionID, channel.Version = upgrade.Order, upgrade.ConnectionID, upgrade.Version
+	}
+	if err == nil {
+		msg := v1types.IBCUpgradeOpen{Channel: channel}
+		err = i.keeper.OnUpgradeChannel(ctx, contractAddr, msg.ToMsg())
+	}
+	if err != nil {
+		ctx.Logger().Error("ibc-upgrade-channel open", "port", portID, "channel", channelID, "error", err.Error())
+	}
+}
+
+// contractChannel returns the contract bound to portID and the channel as the contract sees it
+func (i IBCHandler) contractChannel(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, v1types.IBCChannel, error) {
+	contractAddr, err := ContractFromPortID(portID)
+	if err != nil {
+		return nil, v1types.IBCChannel{}, errorsmod.Wrapf(err, "contract port id")
+	}
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return nil, v1types.IBCChannel{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	appVersion, ok := i.appVersionGetter.GetAppVersion(ctx, portID, channelID)
+	if !ok {
+		return nil, v1types.IBCChannel{}, errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	return contractAddr, toWasmVMChannel(portID, channelID, channelInfo, appVersion), nil
+}
+
+func toWasmVMUpgradeFields(order channeltypes.Order, connectionHops []string, version string) (v1types.IBCUpgradeFields, error) {
+	if len(connectionHops) == 0 {
+		return v1types.IBCUpgradeFields{}, errorsmod.Wrap(channeltypes.ErrInvalidUpgrade, "no connection hops")
+	}
+	return v1types.IBCUpgradeFields{
+		Order:        order.String(),
+		Version:      version,
+		ConnectionID: connectionHops[0],
+	}, nil
+}
+
 func toWasmVMChannel(portID, channelID string, channelInfo channeltypes.Channel, appVersion string) v1types.IBCChannel {
 	return v1types.IBCChannel{
 		Endpoint:             v1types.IBCEndpoint{PortID: portID, ChannelID: channelID},
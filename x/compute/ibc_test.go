@@ -0,0 +1,343 @@
+package compute
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	scrt "github.com/scrtlabs/SecretNetwork/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+)
+
+func init() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(scrt.Bech32PrefixAccAddr, scrt.Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(scrt.Bech32PrefixValAddr, scrt.Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(scrt.Bech32PrefixConsAddr, scrt.Bech32PrefixConsPub)
+}
+
+// upgrader is an IBC contract that records the steps of the upgrades of its channels, and rejects upgrades to
+// versions other than upgradeVersion at the step in rejectAt
+type upgrader struct {
+	steps    *[]string
+	rejectAt string
+}
+
+const (
+	channelVersion = "ping-1"
+	upgradeVersion = "ping-2"
+)
+
+func (upgrader) Instantiate(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1types.Response, error) {
+	return &v1types.Response{}, nil
+}
+
+func (upgrader) Execute(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1types.Response, error) {
+	return nil, errors.New("unknown message")
+}
+
+func (upgrader) Query(deps *mock.Deps, _ wasmTypes.Env, _ []byte) ([]byte, error) {
+	return deps.Storage.Get([]byte("version")), nil
+}
+
+func (upgrader) IBCChannelOpen(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCChannelOpenMsg) (string, error) {
+	return channelVersion, nil
+}
+
+func (upgrader) IBCChannelConnect(deps *mock.Deps, _ wasmTypes.Env, msg v1types.IBCChannelConnectMsg) (*v1types.IBCBasicResponse, error) {
+	deps.Storage.Set([]byte("version"), []byte(msg.GetChannel().Version))
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (upgrader) IBCChannelClose(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCChannelCloseMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (upgrader) IBCPacketReceive(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCPacketReceiveMsg) (*v1types.IBCReceiveResponse, error) {
+	return &v1types.IBCReceiveResponse{Acknowledgement: []byte("ack")}, nil
+}
+
+func (upgrader) IBCPacketAck(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCPacketAckMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (upgrader) IBCPacketTimeout(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCPacketTimeoutMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (u upgrader) IBCChannelUpgrade(deps *mock.Deps, _ wasmTypes.Env, msg v1types.IBCChannelUpgradeMsg) (*v1types.IBCBasicResponse, error) {
+	var step, version string
+	switch upgrade := msg.IBCChannelUpgrade; {
+	case upgrade.Init != nil:
+		step, version = "init", upgrade.Init.Proposed.Version
+	case upgrade.Try != nil:
+		step, version = "try", upgrade.Try.Proposed.Version
+	case upgrade.Ack != nil:
+		step, version = "ack", upgrade.Ack.CounterpartyVersion
+	case upgrade.Open != nil:
+		step, version = "open", upgrade.Open.Channel.Version
+		deps.Storage.Set([]byte("version"), []byte(version))
+	}
+	*u.steps = append(*u.steps, step+" "+version)
+
+	if step == u.rejectAt && version != upgradeVersion {
+		return nil, errors.New("unsupported version")
+	}
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+// upgradeTest has two contracts with a channel between them. Both ends of the channel are on the same chain, over the
+// localhost client, so the IBC core keeper runs the handshakes in full
+type upgradeTest struct {
+	t        *testing.T
+	ctx      sdk.Context
+	keepers  keeper.TestKeepers
+	portA    string
+	portB    string
+	channelA string
+	channelB string
+	stepsA   []string
+	stepsB   []string
+}
+
+func setupUpgradeTest(t *testing.T, rejectAtA string, rejectAtB string) *upgradeTest {
+	test := &upgradeTest{t: t}
+
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("a"), upgrader{steps: &test.stepsA, rejectAt: rejectAtA})
+	enclave.AddContract([]byte("b"), upgrader{steps: &test.stepsB, rejectAt: rejectAtB})
+
+	ctx, keepers := keeper.CreateTestInput(t, false, "staking", nil, nil, keeper.WithEnclave(enclave))
+	ibcKeeper := keepers.IBCKeeper
+	ibc.InitGenesis(ctx, *ibcKeeper, ibctypes.DefaultGenesisState())
+	router := porttypes.NewRouter()
+	router.AddRoute(ModuleName, NewIBCHandler(keepers.WasmKeeper, ibcKeeper.ChannelKeeper, ibcKeeper.ChannelKeeper))
+	ibcKeeper.SetRouter(router)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	relayer, relayerPrivKey, _ := keeper.CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+	instantiate := func(code string) string {
+		codeID, err := keepers.WasmKeeper.Create(ctx, relayer, []byte(code), "", "", nil)
+		require.NoError(t, err)
+		contract, _, err := keepers.WasmKeeper.Instantiate(ctx, codeID, relayer, nil, mock.Msg([]byte(`{}`)), code, nil, []byte("callback"))
+		require.NoError(t, err)
+		return keepers.WasmKeeper.GetContractInfo(ctx, contract).IBCPortID
+	}
+	test.portA, test.portB = instantiate("a"), instantiate("b")
+
+	// the contract calls of IBC messages read the signer of the transaction that relays them
+	test.ctx = keeper.PrepareSignedTx(t, keepers.WasmKeeper, ctx, relayer, relayerPrivKey, &channeltypes.MsgRecvPacket{Signer: relayer.String()})
+	test.keepers = keepers
+
+	init, err := ibcKeeper.ChannelOpenInit(test.ctx, channeltypes.NewMsgChannelOpenInit(
+		test.portA, channelVersion, channeltypes.UNORDERED, []string{ibcexported.LocalhostConnectionID}, test.portB, relayer.String()))
+	require.NoError(t, err)
+	test.channelA = init.ChannelId
+
+	try, err := ibcKeeper.ChannelOpenTry(test.ctx, channeltypes.NewMsgChannelOpenTry(
+		test.portB, channelVersion, channeltypes.UNORDERED, []string{ibcexported.LocalhostConnectionID}, test.portA, test.channelA,
+		channelVersion, localhost.SentinelProof, test.proofHeight(), relayer.String()))
+	require.NoError(t, err)
+	test.channelB = try.ChannelId
+
+	_, err = ibcKeeper.ChannelOpenAck(test.ctx, channeltypes.NewMsgChannelOpenAck(
+		test.portA, test.channelA, test.channelB, channelVersion, localhost.SentinelProof, test.proofHeight(), relayer.String()))
+	require.NoError(t, err)
+	_, err = ibcKeeper.ChannelOpenConfirm(test.ctx, channeltypes.NewMsgChannelOpenConfirm(
+		test.portB, test.channelB, localhost.SentinelProof, test.proofHeight(), relayer.String()))
+	require.NoError(t, err)
+
+	require.Equal(t, channelVersion, test.contractVersion(test.portA))
+	require.Equal(t, channelVersion, test.contractVersion(test.portB))
+	return test
+}
+
+// deliver runs an IBC message the way a transaction would: its writes are dropped when it fails
+func deliver[T any](test *upgradeTest, msg func(ctx sdk.Context) (T, error)) (T, error) {
+	cacheCtx, write := test.ctx.CacheContext()
+	res, err := msg(cacheCtx)
+	if err == nil {
+		write()
+	}
+	return res, err
+}
+
+func (test *upgradeTest) proofHeight() clienttypes.Height {
+	return clienttypes.GetSelfHeight(test.ctx)
+}
+
+func (test *upgradeTest) channel(portID, channelID string) channeltypes.Channel {
+	channel, found := test.keepers.IBCKeeper.ChannelKeeper.GetChannel(test.ctx, portID, channelID)
+	require.True(test.t, found)
+	return channel
+}
+
+func (test *upgradeTest) upgrade(portID, channelID string) channeltypes.Upgrade {
+	upgrade, found := test.keepers.IBCKeeper.ChannelKeeper.GetUpgrade(test.ctx, portID, channelID)
+	require.True(test.t, found)
+	return upgrade
+}
+
+// contractVersion returns the version of the channel that the contract bound to portID last saw
+func (test *upgradeTest) contractVersion(portID string) string {
+	contract, err := ContractFromPortID(portID)
+	require.NoError(test.t, err)
+	version, err := test.keepers.WasmKeeper.QuerySmart(test.ctx, contract, mock.Msg([]byte(`{}`)), false)
+	require.NoError(test.t, err)
+	return string(version)
+}
+
+// upgradeInit starts an upgrade of the channel of contract a to version
+func (test *upgradeTest) upgradeInit(version string) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	fields := channeltypes.NewUpgradeFields(channeltypes.UNORDERED, []string{ibcexported.LocalhostConnectionID}, version)
+	return deliver(test, func(ctx sdk.Context) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
+		return test.keepers.IBCKeeper.ChannelUpgradeInit(ctx, channeltypes.NewMsgChannelUpgradeInit(test.portA, test.channelA, fields, authority))
+	})
+}
+
+// upgradeTry relays an upgrade of the channel of contract a to the channel of contract b
+func (test *upgradeTest) upgradeTry() (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+	upgradeA := test.upgrade(test.portA, test.channelA)
+	return deliver(test, func(ctx sdk.Context) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+		return test.keepers.IBCKeeper.ChannelUpgradeTry(ctx, channeltypes.NewMsgChannelUpgradeTry(
+			test.portB, test.channelB, []string{ibcexported.LocalhostConnectionID}, upgradeA.Fields,
+			test.channel(test.portA, test.channelA).UpgradeSequence, localhost.SentinelProof, localhost.SentinelProof,
+			test.proofHeight(), test.keepers.IBCKeeper.GetAuthority()))
+	})
+}
+
+func (test *upgradeTest) upgradeAck() (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+	return deliver(test, func(ctx sdk.Context) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+		return test.keepers.IBCKeeper.ChannelUpgradeAck(ctx, channeltypes.NewMsgChannelUpgradeAck(
+			test.portA, test.channelA, test.upgrade(test.portB, test.channelB), localhost.SentinelProof, localhost.SentinelProof,
+			test.proofHeight(), test.keepers.IBCKeeper.GetAuthority()))
+	})
+}
+
+func TestChannelUpgradeHandshake(t *testing.T) {
+	test := setupUpgradeTest(t, "", "")
+
+	init, err := test.upgradeInit(upgradeVersion)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), init.UpgradeSequence)
+
+	try, err := test.upgradeTry()
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.SUCCESS, try.Result)
+	require.Equal(t, channeltypes.FLUSHING, test.channel(test.portB, test.channelB).State)
+
+	ack, err := test.upgradeAck()
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.SUCCESS, ack.Result)
+	// nothing is in flight, so a is done flushing right away
+	require.Equal(t, channeltypes.FLUSHCOMPLETE, test.channel(test.portA, test.channelA).State)
+
+	// the counterparty of b is done flushing, so confirming the upgrade opens the channel of b
+	_, err = deliver(test, func(ctx sdk.Context) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
+		return test.keepers.IBCKeeper.ChannelUpgradeConfirm(ctx, channeltypes.NewMsgChannelUpgradeConfirm(
+			test.portB, test.channelB, channeltypes.FLUSHCOMPLETE, test.upgrade(test.portA, test.channelA),
+			localhost.SentinelProof, localhost.SentinelProof, test.proofHeight(), test.keepers.IBCKeeper.GetAuthority()))
+	})
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.OPEN, test.channel(test.portB, test.channelB).State)
+
+	_, err = deliver(test, func(ctx sdk.Context) (*channeltypes.MsgChannelUpgradeOpenResponse, error) {
+		return test.keepers.IBCKeeper.ChannelUpgradeOpen(ctx, channeltypes.NewMsgChannelUpgradeOpen(
+			test.portA, test.channelA, channeltypes.OPEN, test.channel(test.portB, test.channelB).UpgradeSequence,
+			localhost.SentinelProof, test.proofHeight(), test.keepers.IBCKeeper.GetAuthority()))
+	})
+	require.NoError(t, err)
+
+	for _, end := range []struct{ port, channel string }{{test.portA, test.channelA}, {test.portB, test.channelB}} {
+		channel := test.channel(end.port, end.channel)
+		require.Equal(t, channeltypes.OPEN, channel.State)
+		require.Equal(t, upgradeVersion, channel.Version)
+		require.Equal(t, upgradeVersion, test.contractVersion(end.port))
+	}
+	require.Equal(t, []string{"init ping-2", "ack ping-2", "open ping-2"}, test.stepsA)
+	require.Equal(t, []string{"try ping-2", "open ping-2"}, test.stepsB)
+}
+
+func TestChannelUpgradeRejected(t *testing.T) {
+	t.Run("init", func(t *testing.T) {
+		test := setupUpgradeTest(t, "init", "")
+
+		_, err := test.upgradeInit("ping-3")
+		require.ErrorContains(t, err, "unsupported version")
+		_, found := test.keepers.IBCKeeper.ChannelKeeper.GetUpgrade(test.ctx, test.portA, test.channelA)
+		require.False(t, found)
+		require.Equal(t, uint64(0), test.channel(test.portA, test.channelA).UpgradeSequence)
+	})
+
+	t.Run("try", func(t *testing.T) {
+		test := setupUpgradeTest(t, "", "try")
+
+		_, err := test.upgradeInit("ping-3")
+		require.NoError(t, err)
+		_, err = test.upgradeTry()
+		require.ErrorContains(t, err, "unsupported version")
+		require.Equal(t, channeltypes.OPEN, test.channel(test.portB, test.channelB).State)
+		_, found := test.keepers.IBCKeeper.ChannelKeeper.GetUpgrade(test.ctx, test.portB, test.channelB)
+		require.False(t, found)
+	})
+
+	t.Run("ack", func(t *testing.T) {
+		test := setupUpgradeTest(t, "ack", "")
+
+		_, err := test.upgradeInit("ping-3")
+		require.NoError(t, err)
+		_, err = test.upgradeTry()
+		require.NoError(t, err)
+
+		// a rejected ack aborts the upgrade on a, and leaves an error receipt for b to cancel its side with
+		ack, err := test.upgradeAck()
+		require.NoError(t, err)
+		require.Equal(t, channeltypes.FAILURE, ack.Result)
+		channelA := test.channel(test.portA, test.channelA)
+		require.Equal(t, channeltypes.OPEN, channelA.State)
+		require.Equal(t, channelVersion, channelA.Version)
+		_, found := test.keepers.IBCKeeper.ChannelKeeper.GetUpgrade(test.ctx, test.portA, test.channelA)
+		require.False(t, found)
+		receipt, found := test.keepers.IBCKeeper.ChannelKeeper.GetUpgradeErrorReceipt(test.ctx, test.portA, test.channelA)
+		require.True(t, found)
+		require.Equal(t, channelA.UpgradeSequence, receipt.Sequence)
+
+		_, err = deliver(test, func(ctx sdk.Context) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
+			return test.keepers.IBCKeeper.ChannelUpgradeCancel(ctx, channeltypes.NewMsgChannelUpgradeCancel(
+				test.portB, test.channelB, receipt, localhost.SentinelProof, test.proofHeight(), test.keepers.IBCKeeper.GetAuthority()))
+		})
+		require.NoError(t, err)
+		channelB := test.channel(test.portB, test.channelB)
+		require.Equal(t, channeltypes.OPEN, channelB.State)
+		require.Equal(t, channelVersion, channelB.Version)
+
+		require.Equal(t, channelVersion, test.contractVersion(test.portA))
+		require.Equal(t, channelVersion, test.contractVersion(test.portB))
+		require.Equal(t, []string{"init ping-3", "ack ping-3"}, test.stepsA)
+		require.Equal(t, []string{"try ping-3"}, test.stepsB)
+	})
+}
+
+func TestToWasmVMUpgradeFields(t *testing.T) {
+	fields, err := toWasmVMUpgradeFields(channeltypes.ORDERED, []string{"connection-0"}, upgradeVersion)
+	require.NoError(t, err)
+	require.Equal(t, v1types.IBCUpgradeFields{Order: channeltypes.ORDERED.String(), Version: upgradeVersion, ConnectionID: "connection-0"}, fields)
+
+	_, err = toWasmVMUpgradeFields(channeltypes.ORDERED, nil, upgradeVersion)
+	require.ErrorIs(t, err, channeltypes.ErrInvalidUpgrade)
+}
@@ -0,0 +1,262 @@
+package compute_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/app"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+)
+
+// stepRecorder is an IBC contract that accepts every channel and upgrade, and records the steps of the upgrades of its
+// channels by chain. ibc-go discards the state written in the init, try and ack steps, so the steps are kept out of the
+// state of the chains
+type stepRecorder struct {
+	steps map[string][]string
+}
+
+func (stepRecorder) Instantiate(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1types.Response, error) {
+	return &v1types.Response{}, nil
+}
+
+func (stepRecorder) Execute(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1types.Response, error) {
+	return &v1types.Response{}, nil
+}
+
+func (stepRecorder) Query(_ *mock.Deps, _ wasmTypes.Env, _ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (stepRecorder) IBCChannelOpen(_ *mock.Deps, _ wasmTypes.Env, msg v1types.IBCChannelOpenMsg) (string, error) {
+	return msg.GetChannel().Version, nil
+}
+
+func (stepRecorder) IBCChannelConnect(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCChannelConnectMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (stepRecorder) IBCChannelClose(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCChannelCloseMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (stepRecorder) IBCPacketReceive(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCPacketReceiveMsg) (*v1types.IBCReceiveResponse, error) {
+	return &v1types.IBCReceiveResponse{Acknowledgement: []byte("ack")}, nil
+}
+
+func (stepRecorder) IBCPacketAck(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCPacketAckMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (stepRecorder) IBCPacketTimeout(_ *mock.Deps, _ wasmTypes.Env, _ v1types.IBCPacketTimeoutMsg) (*v1types.IBCBasicResponse, error) {
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+func (r stepRecorder) IBCChannelUpgrade(_ *mock.Deps, env wasmTypes.Env, msg v1types.IBCChannelUpgradeMsg) (*v1types.IBCBasicResponse, error) {
+	var step string
+	switch upgrade := msg.IBCChannelUpgrade; {
+	case upgrade.Init != nil:
+		step = "init " + upgrade.Init.Proposed.Version
+	case upgrade.Try != nil:
+		step = "try " + upgrade.Try.Proposed.Version
+	case upgrade.Ack != nil:
+		step = "ack " + upgrade.Ack.CounterpartyVersion
+	case upgrade.Open != nil:
+		step = "open " + upgrade.Open.Channel.Version
+	}
+
+	r.steps[env.Block.ChainID] = append(r.steps[env.Block.ChainID], step)
+	return &v1types.IBCBasicResponse{}, nil
+}
+
+// testingApp is the app of a test chain. ibctesting finalizes blocks without the last commit and with nil txs, which the
+// begin blocker of Secret Network can't take, so the txs of the chain are delivered in blocks that testingApp finalizes
+type testingApp struct {
+	*app.SecretNetworkApp
+
+	txs [][]byte
+	res *abci.ResponseFinalizeBlock
+}
+
+func (a *testingApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	req.Commit = &cmtproto.Commit{}
+	req.Txs = append([][]byte{}, a.txs...)
+	a.txs = nil
+
+	res, err := a.SecretNetworkApp.FinalizeBlock(req)
+	a.res = res
+	return res, err
+}
+
+// sendMsgs delivers msgs in a tx of the sender of chain in the next block, like TestChain.SendMsgs
+func sendMsgs(chain *ibctesting.TestChain, msgs ...sdk.Msg) (*abci.ExecTxResult, error) {
+	chain.Coordinator.UpdateTimeForChain(chain)
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		chain.TxConfig,
+		msgs,
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		chain.ChainID,
+		[]uint64{chain.SenderAccount.GetAccountNumber()},
+		[]uint64{chain.SenderAccount.GetSequence()},
+		chain.SenderPrivKey,
+	)
+	require.NoError(chain.TB, err)
+	txBytes, err := chain.TxConfig.TxEncoder()(tx)
+	require.NoError(chain.TB, err)
+
+	testApp := chain.App.(*testingApp)
+	testApp.txs = [][]byte{txBytes}
+	chain.NextBlock()
+	require.NoError(chain.TB, chain.SenderAccount.SetSequence(chain.SenderAccount.GetSequence()+1))
+
+	require.Len(chain.TB, testApp.res.TxResults, 1)
+	txResult := testApp.res.TxResults[0]
+	if txResult.Code != 0 {
+		return txResult, fmt.Errorf("%s/%d: %q", txResult.Codespace, txResult.Code, txResult.Log)
+	}
+
+	chain.Coordinator.IncrementTime()
+	return txResult, nil
+}
+
+// setupSecretChains creates a coordinator of two Secret Network chains that run contracts on the mock enclave
+func setupSecretChains(t *testing.T, enclave *mock.Backend) *ibctesting.Coordinator {
+	defaultTestingAppInit := ibctesting.DefaultTestingAppInit
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = defaultTestingAppInit })
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		appOpts := simtestutil.AppOptionsMap{
+			flags.FlagHome:       t.TempDir(),
+			app.EnclaveAppOption: enclave,
+		}
+		secretApp := app.NewSecretNetworkApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, true, appOpts, compute.DefaultWasmConfig())
+		return &testingApp{SecretNetworkApp: secretApp}, app.NewDefaultGenesisState()
+	}
+
+	coord := ibctesting.NewCoordinator(t, 2)
+	for _, chain := range coord.Chains {
+		chain := chain
+		chain.SendMsgsOverride = func(msgs ...sdk.Msg) (*abci.ExecTxResult, error) {
+			return sendMsgs(chain, msgs...)
+		}
+	}
+	return coord
+}
+
+func secretApp(chain *ibctesting.TestChain) *app.SecretNetworkApp {
+	return chain.App.(*testingApp).SecretNetworkApp
+}
+
+// instantiateContract instantiates code on chain and returns the IBC port of the contract
+func instantiateContract(t *testing.T, chain *ibctesting.TestChain, code []byte) string {
+	computeKeeper := secretApp(chain).AppKeepers.ComputeKeeper
+	ctx := chain.GetContext()
+	creator := chain.SenderAccount.GetAddress()
+
+	codeID, err := computeKeeper.Create(ctx, creator, code, "", "", nil)
+	require.NoError(t, err)
+	contract, _, err := computeKeeper.Instantiate(ctx, codeID, creator, nil, mock.Msg([]byte(`{}`)), "contract", nil, []byte("callback"))
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	return computeKeeper.GetContractInfo(chain.GetContext(), contract).IBCPortID
+}
+
+// passProposal submits a governance proposal of msgs on chain, and votes it through
+func passProposal(t *testing.T, coord *ibctesting.Coordinator, chain *ibctesting.TestChain, msgs ...sdk.Msg) {
+	govKeeper := secretApp(chain).AppKeepers.GovKeeper
+	params, err := govKeeper.Params.Get(chain.GetContext())
+	require.NoError(t, err)
+
+	proposer := chain.SenderAccount.GetAddress().String()
+	proposal, err := govtypesv1.NewMsgSubmitProposal(msgs, params.MinDeposit, proposer, "", "channel upgrade", "upgrade the contract channel", false)
+	require.NoError(t, err)
+	res, err := chain.SendMsgs(proposal)
+	require.NoError(t, err)
+	proposalID, err := ibctesting.ParseProposalIDFromEvents(res.Events)
+	require.NoError(t, err)
+
+	_, err = chain.SendMsgs(govtypesv1.NewMsgVote(chain.SenderAccount.GetAddress(), proposalID, govtypesv1.OptionYes, ""))
+	require.NoError(t, err)
+
+	// the proposal is tallied and executed in the end blocker of the first block after the voting period
+	coord.IncrementTimeBy(*params.VotingPeriod)
+	coord.CommitBlock(chain)
+
+	passed, err := govKeeper.Proposals.Get(chain.GetContext(), proposalID)
+	require.NoError(t, err)
+	require.Equal(t, govtypesv1.StatusPassed, passed.Status, passed.FailedReason)
+}
+
+// TestContractChannelUpgradeBetweenChains upgrades a channel between contracts on two chains, with the handshake
+// messages relayed with proofs of the state of the counterparty chain
+func TestContractChannelUpgradeBetweenChains(t *testing.T) {
+	enclave := mock.NewBackend()
+	recorder := stepRecorder{steps: map[string][]string{}}
+	enclave.AddContract([]byte("recorder"), recorder)
+
+	coord := setupSecretChains(t, enclave)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = instantiateContract(t, chainA, []byte("recorder"))
+	path.EndpointB.ChannelConfig.PortID = instantiateContract(t, chainB, []byte("recorder"))
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.Version = "ping-1"
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+		endpoint.ChannelConfig.ProposedUpgrade.Fields.Version = "ping-2"
+	}
+	coord.Setup(path)
+	require.Equal(t, "ping-1", path.EndpointA.GetChannel().Version)
+	require.Equal(t, "ping-1", path.EndpointB.GetChannel().Version)
+
+	// only the authority of the IBC module can start an upgrade
+	passProposal(t, coord, chainA, channeltypes.NewMsgChannelUpgradeInit(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointA.GetProposedUpgrade().Fields,
+		secretApp(chainA).AppKeepers.IbcKeeper.GetAuthority(),
+	))
+	require.Equal(t, uint64(1), path.EndpointA.GetChannel().UpgradeSequence)
+
+	require.NoError(t, path.EndpointB.ChanUpgradeTry())
+	require.Equal(t, channeltypes.FLUSHING, path.EndpointB.GetChannel().State)
+
+	// nothing is in flight, so chain A is done flushing right away
+	require.NoError(t, path.EndpointA.ChanUpgradeAck())
+	require.Equal(t, channeltypes.FLUSHCOMPLETE, path.EndpointA.GetChannel().State)
+
+	require.NoError(t, path.EndpointB.ChanUpgradeConfirm())
+	require.Equal(t, channeltypes.OPEN, path.EndpointB.GetChannel().State)
+
+	require.NoError(t, path.EndpointA.ChanUpgradeOpen())
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		require.Equal(t, channeltypes.OPEN, channel.State)
+		require.Equal(t, "ping-2", channel.Version)
+	}
+	require.Equal(t, []string{"init ping-2", "ack ping-2", "open ping-2"}, recorder.steps[chainA.ChainID])
+	require.Equal(t, []string{"try ping-2", "open ping-2"}, recorder.steps[chainB.ChainID])
+}
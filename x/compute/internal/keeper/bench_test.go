@@ -161,7 +161,7 @@ func initBenchContract(t *testing.T) (contract sdk.AccAddress, creator sdk.AccAd
 	// store the code
 	wasmCode, err := os.ReadFile(TestContractPaths[benchContract])
 	require.NoError(t, err)
-	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddr, _, initErr := initHelper(t, keeper, ctx, codeID, creator, nil, creatorPriv, `{"init": {}}`, true, true, defaultGasForTests)
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestGasProfile(t *testing.T) {
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("counter"), mockCounter{})
+	enclave.AddContract([]byte("forwarder"), mockForwarder{})
+
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil, WithEnclave(enclave))
+	keeper := keepers.WasmKeeper
+	msgServer := NewMsgServerImpl(keeper)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, _, _ := CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+
+	counterCodeID, err := keeper.Create(ctx, creator, []byte("counter"), "", "", nil)
+	require.NoError(t, err)
+	forwarderCodeID, err := keeper.Create(ctx, creator, []byte("forwarder"), "", "", nil)
+	require.NoError(t, err)
+
+	counter, _, err := keeper.Instantiate(ctx, counterCodeID, creator, nil, mock.Msg([]byte(`{}`)), "counter", nil, []byte("callback"))
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, counterCodeID)
+	require.NoError(t, err)
+	forwardMsg := `{"contract":"` + counter.String() + `","code_hash":"` + hex.EncodeToString(codeInfo.CodeHash) + `"}`
+
+	gasProfile := func(events sdk.Events) []types.GasProfileCall {
+		var calls []types.GasProfileCall
+		for _, event := range events {
+			if event.Type != types.EventTypeGasProfile {
+				continue
+			}
+			attrs := map[string]string{}
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			depth, err := strconv.ParseUint(attrs[types.AttributeKeyCallDepth], 10, 32)
+			require.NoError(t, err)
+			gasUsed, err := strconv.ParseUint(attrs[types.AttributeKeyGasUsed], 10, 64)
+			require.NoError(t, err)
+			calls = append(calls, types.GasProfileCall{
+				Kind:     attrs[types.AttributeKeyCallKind],
+				Contract: attrs[types.AttributeKeyContractAddr],
+				Depth:    uint32(depth),
+				GasUsed:  gasUsed,
+			})
+		}
+		return calls
+	}
+
+	// delivered messages aren't profiled
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	instantiateRes, err := msgServer.InstantiateContract(ctx, &types.MsgInstantiateContract{
+		Sender:      creator,
+		CodeID:      forwarderCodeID,
+		Label:       "forwarder",
+		InitMsg:     mock.Msg([]byte(`{}`)),
+		CallbackSig: []byte("callback"),
+	})
+	require.NoError(t, err)
+	require.Empty(t, gasProfile(ctx.EventManager().Events()))
+	forwarder, err := sdk.AccAddressFromBech32(instantiateRes.Address)
+	require.NoError(t, err)
+
+	simulateCtx := ctx.WithExecMode(sdk.ExecModeSimulate).WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.InstantiateContract(simulateCtx, &types.MsgInstantiateContract{
+		Sender:      creator,
+		CodeID:      counterCodeID,
+		Label:       "counter 2",
+		InitMsg:     mock.Msg([]byte(`{}`)),
+		CallbackSig: []byte("callback"),
+	})
+	require.NoError(t, err)
+	calls := gasProfile(simulateCtx.EventManager().Events())
+	require.Len(t, calls, 1)
+	require.Equal(t, types.GasProfileInstantiate, calls[0].Kind)
+	require.NotEmpty(t, calls[0].Contract)
+	require.GreaterOrEqual(t, calls[0].GasUsed, types.InstanceCost)
+
+	// forward simulates an execution of the forwarder, and returns its gas profile and the gas it used
+	forward := func(forwardMsg string) ([]types.GasProfileCall, uint64, error) {
+		simulateCtx := simulateCtx.WithEventManager(sdk.NewEventManager())
+		gasBefore := simulateCtx.GasMeter().GasConsumed()
+		_, err := msgServer.ExecuteContract(simulateCtx, &types.MsgExecuteContract{
+			Sender:      creator,
+			Contract:    forwarder,
+			Msg:         mock.Msg([]byte(forwardMsg)),
+			CallbackSig: []byte("callback"),
+		})
+		return gasProfile(simulateCtx.EventManager().Events()), simulateCtx.GasMeter().GasConsumed() - gasBefore, err
+	}
+
+	// calls are profiled in the order they start, with the gas of the calls they make
+	calls, gasUsed, err := forward(forwardMsg)
+	require.NoError(t, err)
+	require.Len(t, calls, 4)
+	expected := []types.GasProfileCall{
+		{Kind: types.GasProfileExecute, Contract: forwarder.String(), Depth: 0},
+		{Kind: types.GasProfileSubMsg, Contract: forwarder.String(), Depth: 1},
+		{Kind: types.GasProfileExecute, Contract: counter.String(), Depth: 2},
+		{Kind: types.GasProfileReply, Contract: forwarder.String(), Depth: 1},
+	}
+	for i, call := range calls {
+		expected[i].GasUsed = call.GasUsed
+		require.Equal(t, expected[i], call)
+		require.NotZero(t, call.GasUsed)
+	}
+	require.Equal(t, gasUsed, calls[0].GasUsed)
+	require.GreaterOrEqual(t, calls[1].GasUsed, calls[2].GasUsed)
+	require.GreaterOrEqual(t, calls[0].GasUsed, calls[1].GasUsed+calls[3].GasUsed)
+
+	// a sub-message with a gas limit runs on a gas meter of its own, and is profiled with the gas that its parent is
+	// charged for it, which is the same as without the limit
+	limitedMsg := forwardMsg[:len(forwardMsg)-1] + `,"gas_limit":1000000}`
+	limitedCalls, limitedGasUsed, err := forward(limitedMsg)
+	require.NoError(t, err)
+	require.Equal(t, calls, limitedCalls)
+	require.Equal(t, gasUsed, limitedGasUsed)
+
+	// a sub-message that runs out of its gas limit is charged the whole limit, which the call of its message can't
+	// use more than
+	outOfGasMsg := forwardMsg[:len(forwardMsg)-1] + `,"gas_limit":1000}`
+	calls, _, err = forward(outOfGasMsg)
+	// the reply of the forwarder fails on the out of gas error, which is redacted to its code
+	require.ErrorContains(t, err, fmt.Sprintf("codespace: %s, code: %d", sdkerrors.ErrOutOfGas.Codespace(), sdkerrors.ErrOutOfGas.ABCICode()))
+	require.Len(t, calls, 4)
+	require.Equal(t, types.GasProfileSubMsg, calls[1].Kind)
+	require.Equal(t, uint64(1000), calls[1].GasUsed)
+	require.Equal(t, types.GasProfileExecute, calls[2].Kind)
+	require.LessOrEqual(t, calls[2].GasUsed, uint64(1000))
+	require.NotZero(t, calls[2].GasUsed)
+}
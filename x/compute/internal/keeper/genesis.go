@@ -17,6 +17,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		if err != nil {
 			return errorsmod.Wrapf(err, "code %d with id: %d", i, code.CodeID)
 		}
+		// the code info of the code already references its schema
+		if len(code.Schema) != 0 {
+			if _, err := keeper.setSchema(ctx, code.Schema); err != nil {
+				return errorsmod.Wrapf(err, "schema of code %d with id: %d", i, code.CodeID)
+			}
+		}
 		if code.CodeID > maxCodeID {
 			maxCodeID = code.CodeID
 		}
@@ -62,10 +68,15 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 		if err != nil {
 			panic(err)
 		}
+		schema, _, err := keeper.GetCodeSchema(ctx, codeID)
+		if err != nil {
+			panic(err)
+		}
 		genState.Codes = append(genState.Codes, types.Code{
 			CodeID:    codeID,
 			CodeInfo:  info,
 			CodeBytes: bytecode,
+			Schema:    schema,
 		})
 		return false
 	})
@@ -58,7 +58,7 @@ func TestGovQueryProposals(t *testing.T) {
 	// upload staking derivates code
 	govCode, err := os.ReadFile("./testdata/gov.wasm")
 	require.NoError(t, err)
-	govId, err := keeper.Create(ctx, creator, govCode, "", "")
+	govId, err := keeper.Create(ctx, creator, govCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), govId)
 
@@ -126,7 +126,7 @@ func TestGovVote(t *testing.T) {
 	// upload staking derivates code
 	govCode, err := os.ReadFile("./testdata/gov.wasm")
 	require.NoError(t, err)
-	govId, err := keeper.Create(ctx, creator, govCode, "", "")
+	govId, err := keeper.Create(ctx, creator, govCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), govId)
 
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -31,6 +32,7 @@ import (
 	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	ibchookstypes "github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
 )
 
 // MessageHandlerChain defines a chain of handlers that are called one by one until it can be handled.
@@ -51,6 +53,19 @@ func NewSDKMessageHandler(router MessageRouter /*legacyRouter sdk.Router,*/, enc
 	}
 }
 
+// IBCTransferCallbackHandler handles IBC.Transfer messages that ask for an ibc-hooks callback.
+// It returns the packet sequence as the message data, so the contract can match
+// the `ibc_lifecycle_complete` callback to the transfer.
+type IBCTransferCallbackHandler struct {
+	sdkHandler SDKMessageHandler
+}
+
+func NewIBCTransferCallbackHandler(sdkHandler SDKMessageHandler) IBCTransferCallbackHandler {
+	return IBCTransferCallbackHandler{
+		sdkHandler: sdkHandler,
+	}
+}
+
 // IBCRawPacketHandler handels IBC.SendPacket messages which are published to an IBC channel.
 type IBCRawPacketHandler struct {
 	channelKeeper    channelkeeper.Keeper
@@ -86,8 +101,10 @@ func NewMessageHandler(
 	unpacker codectypes.AnyUnpacker,
 ) Messenger {
 	encoders := DefaultEncoders(portSource, unpacker).Merge(customEncoders)
+	sdkHandler := NewSDKMessageHandler(msgRouter, encoders)
 	return NewMessageHandlerChain(
-		NewSDKMessageHandler(msgRouter, encoders),
+		NewIBCTransferCallbackHandler(sdkHandler),
+		sdkHandler,
 		NewIBCRawPacketHandler(channelKeeper, ics4Wrapper, capabilityKeeper),
 	)
 }
@@ -140,6 +157,36 @@ func (h IBCRawPacketHandler) DispatchMsg(ctx sdk.Context, _ sdk.AccAddress, cont
 	return nil, nil, err
 }
 
+// DispatchMsg sends the ICS-20 transfer and returns its packet sequence as a JSON encoded TransferResponse.
+// The callback itself is registered by ibc-hooks when the packet is sent, see EncodeIBCMsg.
+func (h IBCTransferCallbackHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg v1wasmTypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+	if msg.IBC == nil || msg.IBC.Transfer == nil || !msg.IBC.Transfer.Callback {
+		return nil, nil, types.ErrUnknownMsg
+	}
+
+	events, data, err = h.sdkHandler.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil {
+		return events, data, err
+	}
+	if len(data) != 1 {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalid, "expected a single transfer response, got %d", len(data))
+	}
+
+	var transferResponse ibctransfertypes.MsgTransferResponse
+	if err := transferResponse.Unmarshal(data[0]); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "transfer response")
+	}
+	bz, err := json.Marshal(v1wasmTypes.TransferResponse{
+		ChannelID: msg.IBC.Transfer.ChannelID,
+		Sequence:  transferResponse.Sequence,
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "transfer response")
+	}
+
+	return events, [][]byte{bz}, nil
+}
+
 type (
 	BankEncoder         func(sender sdk.AccAddress, msg *v1wasmTypes.BankMsg) ([]sdk.Msg, error)
 	CustomEncoder       func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
@@ -303,6 +350,14 @@ func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "amount")
 			}
+			memo := msg.Transfer.Memo
+			if msg.Transfer.Callback {
+				// ibc-hooks picks up the callback when the packet is sent and stores it with StorePacketCallback
+				memo, err = addIBCCallbackToMemo(memo, sender)
+				if err != nil {
+					return nil, errorsmod.Wrap(err, "memo")
+				}
+			}
 			msg := &ibctransfertypes.MsgTransfer{
 				SourcePort:       portSource.GetPort(ctx),
 				SourceChannel:    msg.Transfer.ChannelID,
@@ -311,9 +366,34 @@ func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context
 				Receiver:         msg.Transfer.ToAddress,
 				TimeoutHeight:    convertWasmIBCTimeoutHeightToCosmosHeight(msg.Transfer.Timeout.Block),
 				TimeoutTimestamp: msg.Transfer.Timeout.Timestamp,
-				Memo:             msg.Transfer.Memo,
+				Memo:             memo,
 			}
 			return []sdk.Msg{msg}, nil
+		case msg.PayPacketFee != nil:
+			fee, err := convertWasmIBCFeeToSdkFee(msg.PayPacketFee.Fee)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "fee")
+			}
+			return []sdk.Msg{&ibcfeetypes.MsgPayPacketFee{
+				Fee:             fee,
+				SourcePortId:    msg.PayPacketFee.PortID,
+				SourceChannelId: msg.PayPacketFee.ChannelID,
+				Signer:          sender.String(),
+				Relayers:        msg.PayPacketFee.Relayers,
+			}}, nil
+		case msg.PayPacketFeeAsync != nil:
+			fee, err := convertWasmIBCFeeToSdkFee(msg.PayPacketFeeAsync.Fee)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "fee")
+			}
+			return []sdk.Msg{&ibcfeetypes.MsgPayPacketFeeAsync{
+				PacketId: channeltypes.NewPacketID(
+					msg.PayPacketFeeAsync.PortID,
+					msg.PayPacketFeeAsync.ChannelID,
+					msg.PayPacketFeeAsync.Sequence,
+				),
+				PacketFee: ibcfeetypes.NewPacketFee(fee, sender.String(), msg.PayPacketFeeAsync.Relayers),
+			}}, nil
 		default:
 			return nil, errorsmod.Wrap(types.ErrUnknownMsg, "Unknown variant of IBC")
 		}
@@ -610,6 +690,22 @@ func convertWasmIBCTimeoutHeightToCosmosHeight(ibcTimeoutBlock *v1wasmTypes.IBCT
 	return ibcclienttypes.NewHeight(ibcTimeoutBlock.Revision, ibcTimeoutBlock.Height)
 }
 
+func convertWasmIBCFeeToSdkFee(fee v1wasmTypes.IBCFee) (ibcfeetypes.Fee, error) {
+	recvFee, err := convertWasmCoinsToSdkCoins(fee.ReceiveFee)
+	if err != nil {
+		return ibcfeetypes.Fee{}, errorsmod.Wrap(err, "receive fee")
+	}
+	ackFee, err := convertWasmCoinsToSdkCoins(fee.AckFee)
+	if err != nil {
+		return ibcfeetypes.Fee{}, errorsmod.Wrap(err, "ack fee")
+	}
+	timeoutFee, err := convertWasmCoinsToSdkCoins(fee.TimeoutFee)
+	if err != nil {
+		return ibcfeetypes.Fee{}, errorsmod.Wrap(err, "timeout fee")
+	}
+	return ibcfeetypes.NewFee(recvFee, ackFee, timeoutFee), nil
+}
+
 func convertWasmCoinsToSdkCoins(coins []wasmTypes.Coin) (sdk.Coins, error) {
 	var toSend sdk.Coins
 	for _, coin := range coins {
@@ -632,3 +728,32 @@ func convertWasmCoinToSdkCoin(coin wasmTypes.Coin) (sdk.Coin, error) {
 		Amount: amount,
 	}, nil
 }
+
+// addIBCCallbackToMemo sets contract as the ibc-hooks callback of an ICS-20 memo,
+// keeping any other keys the memo already has
+func addIBCCallbackToMemo(memo string, contract sdk.AccAddress) (string, error) {
+	var metadata map[string]json.RawMessage
+	if memo != "" {
+		if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+			return "", errorsmod.Wrap(types.ErrInvalid, "must be a JSON object to register a callback")
+		}
+	}
+	if metadata == nil {
+		metadata = make(map[string]json.RawMessage)
+	}
+	if _, found := metadata[ibchookstypes.IBCCallbackKey]; found {
+		return "", errorsmod.Wrapf(types.ErrInvalid, "%s is already set", ibchookstypes.IBCCallbackKey)
+	}
+
+	callback, err := json.Marshal(contract.String())
+	if err != nil {
+		return "", err
+	}
+	metadata[ibchookstypes.IBCCallbackKey] = callback
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
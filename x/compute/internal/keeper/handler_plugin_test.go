@@ -7,13 +7,18 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
@@ -283,3 +288,208 @@ func TestEncoding(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodeIBCFeeMsgs(t *testing.T) {
+	_, _, addr1 := keyPubAddr()
+
+	fee := v1wasmTypes.IBCFee{
+		ReceiveFee: []wasmTypes.Coin{{Denom: "uscrt", Amount: "100"}},
+		AckFee:     []wasmTypes.Coin{{Denom: "uscrt", Amount: "200"}},
+		TimeoutFee: []wasmTypes.Coin{{Denom: "uscrt", Amount: "300"}},
+	}
+	expectedFee := ibcfeetypes.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uscrt", 200)),
+		sdk.NewCoins(sdk.NewInt64Coin("uscrt", 300)),
+	)
+
+	cases := map[string]struct {
+		input *v1wasmTypes.IBCMsg
+		// set if valid
+		output []sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"pay packet fee": {
+			input: &v1wasmTypes.IBCMsg{
+				PayPacketFee: &v1wasmTypes.PayPacketFeeMsg{
+					PortID:    "transfer",
+					ChannelID: "channel-0",
+					Fee:       fee,
+				},
+			},
+			output: []sdk.Msg{
+				&ibcfeetypes.MsgPayPacketFee{
+					Fee:             expectedFee,
+					SourcePortId:    "transfer",
+					SourceChannelId: "channel-0",
+					Signer:          addr1.String(),
+				},
+			},
+		},
+		"pay packet fee async": {
+			input: &v1wasmTypes.IBCMsg{
+				PayPacketFeeAsync: &v1wasmTypes.PayPacketFeeAsyncMsg{
+					PortID:    "wasm.secret1contract",
+					ChannelID: "channel-1",
+					Sequence:  42,
+					Fee:       fee,
+				},
+			},
+			output: []sdk.Msg{
+				&ibcfeetypes.MsgPayPacketFeeAsync{
+					PacketId:  channeltypes.NewPacketID("wasm.secret1contract", "channel-1", 42),
+					PacketFee: ibcfeetypes.NewPacketFee(expectedFee, addr1.String(), nil),
+				},
+			},
+		},
+		"invalid fee amount": {
+			input: &v1wasmTypes.IBCMsg{
+				PayPacketFee: &v1wasmTypes.PayPacketFeeMsg{
+					PortID:    "transfer",
+					ChannelID: "channel-0",
+					Fee: v1wasmTypes.IBCFee{
+						AckFee: []wasmTypes.Coin{{Denom: "uscrt", Amount: "-x"}},
+					},
+				},
+			},
+			isError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			res, err := EncodeIBCMsg(nil)(ctx, addr1, "", tc.input)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
+
+func TestEncodeIBCTransferCallback(t *testing.T) {
+	_, _, addr1 := keyPubAddr()
+
+	portSource := MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "transfer"
+	}}
+	callback := `"ibc_callback":"` + addr1.String() + `"`
+
+	cases := map[string]struct {
+		memo string
+		// set if valid
+		expectedMemo string
+		// set if invalid
+		isError bool
+	}{
+		"empty memo": {
+			memo:         "",
+			expectedMemo: `{` + callback + `}`,
+		},
+		"memo with other keys": {
+			memo:         `{"wasm":{"contract":"secret1contract","msg":{}}}`,
+			expectedMemo: `{` + callback + `,"wasm":{"contract":"secret1contract","msg":{}}}`,
+		},
+		"memo not a json object": {
+			memo:    "hello",
+			isError: true,
+		},
+		"memo with a callback already": {
+			memo:    `{"ibc_callback":"secret1other"}`,
+			isError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			res, err := EncodeIBCMsg(portSource)(ctx, addr1, "", &v1wasmTypes.IBCMsg{
+				Transfer: &v1wasmTypes.TransferMsg{
+					ChannelID: "channel-0",
+					ToAddress: "cosmos1receiver",
+					Amount:    wasmTypes.Coin{Denom: "uscrt", Amount: "100"},
+					Memo:      tc.memo,
+					Callback:  true,
+				},
+			})
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				assert.Equal(t, tc.expectedMemo, res[0].(*ibctransfertypes.MsgTransfer).Memo)
+			}
+		})
+	}
+}
+
+// routerFunc routes all messages to the same handler
+type routerFunc func(msg sdk.Msg) baseapp.MsgServiceHandler
+
+func (f routerFunc) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return f(msg)
+}
+
+func TestIBCTransferCallbackHandler(t *testing.T) {
+	_, _, addr1 := keyPubAddr()
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+
+	var sent *ibctransfertypes.MsgTransfer
+	var transferErr error
+	router := routerFunc(func(sdk.Msg) baseapp.MsgServiceHandler {
+		return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if transferErr != nil {
+				return nil, transferErr
+			}
+			sent = msg.(*ibctransfertypes.MsgTransfer)
+			return sdk.WrapServiceResult(ctx, &ibctransfertypes.MsgTransferResponse{Sequence: 7}, nil)
+		}
+	})
+	portSource := MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "transfer"
+	}}
+	sdkHandler := NewSDKMessageHandler(router, DefaultEncoders(portSource, nil))
+	handler := NewIBCTransferCallbackHandler(sdkHandler)
+	chain := NewMessageHandlerChain(handler, sdkHandler)
+
+	transfer := func(callback bool) v1wasmTypes.CosmosMsg {
+		return v1wasmTypes.CosmosMsg{IBC: &v1wasmTypes.IBCMsg{Transfer: &v1wasmTypes.TransferMsg{
+			ChannelID: "channel-3",
+			ToAddress: "cosmos1receiver",
+			Amount:    wasmTypes.Coin{Denom: "uscrt", Amount: "100"},
+			Callback:  callback,
+		}}}
+	}
+
+	// with a callback the sequence is returned as JSON
+	_, data, err := chain.DispatchMsg(ctx, addr1, "", transfer(true))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte(`{"channel_id":"channel-3","sequence":7}`)}, data)
+	require.NotEmpty(t, sent.Memo)
+
+	// without a callback the transfer is left to the sdk handler and returns the proto response
+	_, _, err = handler.DispatchMsg(ctx, addr1, "", transfer(false))
+	require.ErrorIs(t, err, types.ErrUnknownMsg)
+	_, data, err = chain.DispatchMsg(ctx, addr1, "", transfer(false))
+	require.NoError(t, err)
+	expected, err := (&ibctransfertypes.MsgTransferResponse{Sequence: 7}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{expected}, data)
+	require.Empty(t, sent.Memo)
+
+	// other messages are left to the next handlers
+	_, _, err = handler.DispatchMsg(ctx, addr1, "", v1wasmTypes.CosmosMsg{Bank: &v1wasmTypes.BankMsg{}})
+	require.ErrorIs(t, err, types.ErrUnknownMsg)
+	_, _, err = handler.DispatchMsg(ctx, addr1, "", v1wasmTypes.CosmosMsg{IBC: &v1wasmTypes.IBCMsg{SendPacket: &v1wasmTypes.SendPacketMsg{}}})
+	require.ErrorIs(t, err, types.ErrUnknownMsg)
+
+	transferErr = ibctransfertypes.ErrSendDisabled
+	_, _, err = handler.DispatchMsg(ctx, addr1, "", transfer(true))
+	require.ErrorIs(t, err, ibctransfertypes.ErrSendDisabled)
+}
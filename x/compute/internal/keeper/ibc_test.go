@@ -258,6 +258,41 @@ func ibcChannelCloseHelper(
 	return ctx, wasmEvents, cosmwasm.StdError{}
 }
 
+func ibcChannelUpgradeHelper(
+	t *testing.T,
+	keeper Keeper,
+	ctx sdk.Context,
+	contract sdk.AccAddress,
+	relayer sdk.AccAddress,
+	relayerPrivkey crypto.PrivKey,
+	gas uint64,
+	ibcChannelUpgradeMsg v1types.IBCChannelUpgradeMsg,
+	sdkMsg sdk.Msg,
+) (sdk.Context, cosmwasm.StdError) {
+	// create new ctx with the same storage and a gas limit
+	// this is to reset the event manager, so we won't get
+	// events from past calls
+	gasMeter := &WasmCounterGasMeter{0, storetypes.NewGasMeter(gas)}
+	ctx = sdk.NewContext(
+		ctx.MultiStore(),
+		ctx.BlockHeader(),
+		ctx.IsCheckTx(),
+		log.NewNopLogger(),
+	).WithGasMeter(gasMeter)
+
+	ctx = PrepareSignedTx(t, keeper, ctx, relayer, relayerPrivkey, sdkMsg)
+
+	err := keeper.OnUpgradeChannel(ctx, contract, ibcChannelUpgradeMsg)
+
+	require.NotZero(t, gasMeter.GetWasmCounter(), err)
+
+	if err != nil {
+		return ctx, cosmwasm.StdError{GenericErr: &cosmwasm.GenericErr{Msg: err.Error()}}
+	}
+
+	return ctx, cosmwasm.StdError{}
+}
+
 func createIBCEndpoint(port string, channel string) v1types.IBCEndpoint {
 	return v1types.IBCEndpoint{
 		PortID:    port,
@@ -976,7 +1011,7 @@ func TestIBCPacketReceiveCallsV010Contract(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -1274,3 +1309,124 @@ func TestIBCPacketTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestIBCChannelUpgrade(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privkeyA, _, _ := setupTest(t, TestContractPaths[ibcContract], sdk.NewCoins())
+
+	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privkeyA, `{"init":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, err)
+
+	ibcChannel := v1types.IBCChannel{
+		Endpoint:             createIBCEndpoint(PortIDForContract(contractAddress), "channel.0"),
+		CounterpartyEndpoint: createIBCEndpoint(PortIDForContract(contractAddress), "channel.1"),
+		Order:                v1types.Unordered,
+		Version:              "ibc-v1",
+		ConnectionID:         "1",
+	}
+	proposed := v1types.IBCUpgradeFields{
+		Order:        v1types.Unordered,
+		Version:      "ibc-v2",
+		ConnectionID: "1",
+	}
+	upgradeFields := ibcchanneltypes.UpgradeFields{
+		Ordering:       v1types.IBCOrderToEnum(proposed.Order),
+		ConnectionHops: []string{proposed.ConnectionID},
+		Version:        proposed.Version,
+	}
+
+	for _, test := range []struct {
+		description   string
+		msg           v1types.IBCChannelUpgradeMsg
+		sdkMsg        sdk.Msg
+		expectedCount string
+	}{
+		{
+			description: "init",
+			msg:         (&v1types.IBCUpgradeInit{Channel: ibcChannel, Proposed: proposed}).ToMsg(),
+			sdkMsg: &ibcchanneltypes.MsgChannelUpgradeInit{
+				PortId:    ibcChannel.Endpoint.PortID,
+				ChannelId: ibcChannel.Endpoint.ChannelID,
+				Fields:    upgradeFields,
+				Signer:    walletA.String(),
+			},
+			expectedCount: "11",
+		},
+		{
+			description: "try",
+			msg:         (&v1types.IBCUpgradeTry{Channel: ibcChannel, Proposed: proposed}).ToMsg(),
+			sdkMsg: &ibcchanneltypes.MsgChannelUpgradeTry{
+				PortId:                        ibcChannel.Endpoint.PortID,
+				ChannelId:                     ibcChannel.Endpoint.ChannelID,
+				ProposedUpgradeConnectionHops: upgradeFields.ConnectionHops,
+				CounterpartyUpgradeFields:     upgradeFields,
+				Signer:                        walletA.String(),
+			},
+			expectedCount: "11",
+		},
+		{
+			description: "ack",
+			msg:         (&v1types.IBCUpgradeAck{Channel: ibcChannel, CounterpartyVersion: proposed.Version}).ToMsg(),
+			sdkMsg: &ibcchanneltypes.MsgChannelUpgradeAck{
+				PortId:    ibcChannel.Endpoint.PortID,
+				ChannelId: ibcChannel.Endpoint.ChannelID,
+				Signer:    walletA.String(),
+			},
+			expectedCount: "12",
+		},
+		{
+			description: "open",
+			msg:         (&v1types.IBCUpgradeOpen{Channel: ibcChannel}).ToMsg(),
+			sdkMsg: &ibcchanneltypes.MsgChannelUpgradeOpen{
+				PortId:    ibcChannel.Endpoint.PortID,
+				ChannelId: ibcChannel.Endpoint.ChannelID,
+				Signer:    walletA.String(),
+			},
+			expectedCount: "13",
+		},
+	} {
+		t.Run(test.description, func(t *testing.T) {
+			ctx, err := ibcChannelUpgradeHelper(t, keeper, ctx, contractAddress, walletA, privkeyA, defaultGasForTests, test.msg, test.sdkMsg)
+			require.Empty(t, err)
+
+			queryRes, err := queryHelper(t, keeper, ctx, contractAddress, `{"q":{}}`, true, true, math.MaxUint64)
+			require.Empty(t, err)
+
+			require.Equal(t, test.expectedCount, queryRes)
+		})
+	}
+}
+
+func TestIBCChannelUpgradeRejected(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privkeyA, _, _ := setupTest(t, TestContractPaths[ibcContract], sdk.NewCoins())
+
+	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privkeyA, `{"init":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, err)
+
+	ibcChannel := v1types.IBCChannel{
+		Endpoint:             createIBCEndpoint(PortIDForContract(contractAddress), "channel.0"),
+		CounterpartyEndpoint: createIBCEndpoint(PortIDForContract(contractAddress), "channel.1"),
+		Order:                v1types.Unordered,
+		Version:              "ibc-v1",
+		ConnectionID:         "1",
+	}
+	proposed := v1types.IBCUpgradeFields{
+		Order:        v1types.Ordered,
+		Version:      "ibc-v3",
+		ConnectionID: "1",
+	}
+
+	sdkMsg := ibcchanneltypes.MsgChannelUpgradeInit{
+		PortId:    ibcChannel.Endpoint.PortID,
+		ChannelId: ibcChannel.Endpoint.ChannelID,
+		Fields: ibcchanneltypes.UpgradeFields{
+			Ordering:       v1types.IBCOrderToEnum(proposed.Order),
+			ConnectionHops: []string{proposed.ConnectionID},
+			Version:        proposed.Version,
+		},
+		Signer: walletA.String(),
+	}
+	msg := (&v1types.IBCUpgradeInit{Channel: ibcChannel, Proposed: proposed}).ToMsg()
+
+	_, err = ibcChannelUpgradeHelper(t, keeper, ctx, contractAddress, walletA, privkeyA, defaultGasForTests, msg, &sdkMsg)
+	require.Contains(t, err.Error(), "Unsupported upgrade version")
+}
@@ -79,6 +79,7 @@ type Keeper struct {
 	portKeeper       portkeeper.Keeper
 	capabilityKeeper capabilitykeeper.ScopedKeeper
 	wasmer           wasm.Wasmer
+	enclave          api.Backend
 	queryPlugins     QueryPlugins
 	messenger        Messenger
 	// queryGasLimit is the max wasm gas that can be spent on executing a query with a contract
@@ -124,17 +125,13 @@ func NewKeeper(
 	customPlugins *QueryPlugins,
 	lastMsgManager *baseapp.LastMsgMarkerContainer,
 	authority string,
+	opts ...Option,
 ) Keeper {
-	wasmer, err := wasm.NewWasmer(filepath.Join(homeDir, "wasm"), supportedFeatures, wasmConfig.CacheSize, wasmConfig.EnclaveCacheSize, wasmConfig.InitEnclave)
-	if err != nil {
-		panic(err)
-	}
-
 	keeper := Keeper{
 		storeService:     storeService,
 		cdc:              cdc,
 		legacyAmino:      legacyAmino,
-		wasmer:           *wasmer,
+		enclave:          api.Enclave{},
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
 		portKeeper:       portKeeper,
@@ -154,6 +151,16 @@ func NewKeeper(
 		LastMsgManager: lastMsgManager,
 		authority:      authority,
 	}
+	for _, opt := range opts {
+		opt(&keeper)
+	}
+
+	wasmer, err := wasm.NewWasmerWithBackend(keeper.enclave, filepath.Join(homeDir, "wasm"), supportedFeatures, wasmConfig.CacheSize, wasmConfig.EnclaveCacheSize, wasmConfig.InitEnclave)
+	if err != nil {
+		panic(err)
+	}
+	keeper.wasmer = *wasmer
+
 	// always wrap the messenger, even if it was replaced by an option
 	keeper.messenger = callDepthMessageHandler{keeper.messenger, keeper.maxCallDepth}
 	keeper.queryPlugins = DefaultQueryPlugins(govKeeper, distKeeper, mintKeeper, bankKeeper, stakingKeeper, queryRouter, &keeper, channelKeeper).Merge(customPlugins)
@@ -165,17 +172,24 @@ func (k Keeper) SetValidatorSetEvidence(ctx sdk.Context) error {
 	store := k.storeService.OpenKVStore(ctx)
 	validator_set_evidence, err := store.Get(types.ValidatorSetEvidencePrefix)
 	if err == nil {
-		_ = api.SubmitValidatorSetEvidence(validator_set_evidence)
+		_ = k.enclave.SubmitValidatorSetEvidence(validator_set_evidence)
 	}
 	return nil
 }
 
+// SubmitBlockSignatures hands the signatures of a block to the enclave, which returns the random seed of the block
+// and the evidence of the validator set
+func (k Keeper) SubmitBlockSignatures(header []byte, commit []byte, txs []byte, encRandom []byte) ([]byte, []byte, error) {
+	return k.enclave.SubmitBlockSignatures(header, commit, txs, encRandom)
+}
+
 func (k Keeper) GetLastMsgMarkerContainer() *baseapp.LastMsgMarkerContainer {
 	return k.LastMsgManager
 }
 
-// Create uploads and compiles a WASM contract, returning a short identifier for the contract
-func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string) (codeID uint64, err error) {
+// Create uploads and compiles a WASM contract with the optional JSON schema of its messages, returning a short identifier
+// for the contract
+func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, schema []byte) (codeID uint64, err error) {
 	wasmCode, err = uncompress(wasmCode)
 	if err != nil {
 		return 0, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
@@ -185,6 +199,13 @@ func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 		return 0, types.ErrExceedMaxContractSize
 	}
 	ctx.GasMeter().ConsumeGas(uint64(params.CompileCost.MulInt64(int64(len(wasmCode))).RoundInt64()), "Compiling WASM Bytecode")
+	if len(schema) != 0 {
+		// MaxSchemaSize is 0 until the upgrade that enables schemas
+		if uint64(len(schema)) > params.MaxSchemaSize {
+			return 0, types.ErrExceedMaxSchemaSize
+		}
+		ctx.GasMeter().ConsumeGas(uint64(params.SchemaCost.MulInt64(int64(len(schema))).RoundInt64()), "Storing JSON schema")
+	}
 
 	codeHash, err := k.wasmer.Create(wasmCode)
 	if err != nil {
@@ -194,6 +215,12 @@ func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 
 	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder)
+	if len(schema) != 0 {
+		codeInfo.SchemaHash, err = k.setSchema(ctx, schema)
+		if err != nil {
+			return 0, err
+		}
+	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	err = store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&codeInfo))
 	if err != nil {
@@ -468,6 +495,8 @@ func V010MsgsToV1SubMsgs(contractAddr string, msgs []v010wasmTypes.CosmosMsg) ([
 // Instantiate creates an instance of a WASM contract
 func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, callbackSig []byte) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "instantiate")
+	gasProfile := types.GasProfileFromContext(ctx)
+	defer gasProfile.Start(ctx, types.GasProfileInstantiate, nil)()
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: init")
 
@@ -501,6 +530,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	}
 
 	contractAddress := k.generateContractAddress(ctx, codeID, creator)
+	gasProfile.SetContract(contractAddress)
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
 		return nil, nil, errorsmod.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
@@ -668,6 +698,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 // Execute executes the contract instance
 func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, callbackSig []byte, handleType wasmTypes.HandleType) (*sdk.Result, error) {
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "execute")
+	defer types.GasProfileFromContext(ctx).Start(ctx, types.GasProfileExecute, contractAddress)()
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading Compute module: execute")
 
@@ -788,6 +819,7 @@ func (k Keeper) querySmartImpl(ctx sdk.Context, contractAddress sdk.AccAddress,
 	if useDefaultGasLimit {
 		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(k.queryGasLimit))
 	}
+	defer types.GasProfileFromContext(ctx).Start(ctx, types.GasProfileQuery, contractAddress)()
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: query")
 
@@ -1322,6 +1354,8 @@ func (h ContractResponseHandler) Handle(ctx sdk.Context, contractAddr sdk.AccAdd
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1wasmTypes.Reply, ogTx []byte, ogSigInfo wasmTypes.SigInfo) ([]byte, error) {
+	defer types.GasProfileFromContext(ctx).Start(ctx, types.GasProfileReply, contractAddress)()
+
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -1455,6 +1489,7 @@ func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, ne
 
 func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, callbackSig []byte) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "migrate")
+	defer types.GasProfileFromContext(ctx).Start(ctx, types.GasProfileMigrate, contractAddress)()
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: migrate")
 
 	signBytes := []byte{}
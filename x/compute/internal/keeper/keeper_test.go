@@ -17,7 +17,6 @@ import (
 	stypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
-	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	eng "github.com/scrtlabs/SecretNetwork/types"
 	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
@@ -45,12 +44,18 @@ func init() {
 	fmt.Printf("This IS spid: %v\n", spid)
 	fmt.Printf("This IS api key: %v\n", apiKey)
 
-	_, err = api.InitBootstrap(spid, apiKey)
+	_, err = TestEnclave.InitBootstrap(spid, apiKey)
 	if err != nil {
 		panic(fmt.Sprintf("Error initializing the enclave: %v", err))
 	}
 
+	// the enclave writes the io master key when it bootstraps. Builds without the SGX enclave (e.g. with the secretcli
+	// tag) bootstrap nothing, and then only the tests on the mock enclave can run, see SkipEnclaveTestsEnv
 	b64Bz, err := os.ReadFile(filepath.Join(".", reg.IoExchMasterKeyPath))
+	if os.IsNotExist(err) {
+		TestEnclave = nil
+		return
+	}
 	if err != nil {
 		panic(fmt.Sprintf("Error reading 'io-master-key.txt': %v", err))
 	}
@@ -88,7 +93,7 @@ func TestCreate(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 	// and verify content
@@ -114,12 +119,12 @@ func TestCreateDuplicate(t *testing.T) {
 	require.NoError(t, err)
 
 	// create one copy
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 
 	// create second copy
-	duplicateID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	duplicateID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(2), duplicateID)
 
@@ -152,14 +157,14 @@ func TestCreateWithSimulation(t *testing.T) {
 	require.NoError(t, err)
 
 	// create this once in simulation mode
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 
 	// then try to create it in non-simulation mode (should not fail)
 	ctx, keepers = CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
 	accKeeper, keeper = keepers.AccountKeeper, keepers.WasmKeeper
-	contractID, err = keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err = keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 
@@ -210,7 +215,7 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	wasmCode, err := os.ReadFile(filepath.Join(".", contractPath, "test_gzip_contract.wasm.gz"))
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), contractID)
 	// and verify content
@@ -247,7 +252,7 @@ func TestInstantiate(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "https://github.com/scrtlabs/SecretNetwork/blob/master/cosmwasm/contracts/hackatom/src/contract.rs", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "https://github.com/scrtlabs/SecretNetwork/blob/master/cosmwasm/contracts/hackatom/src/contract.rs", "", nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -436,7 +441,7 @@ func TestExecute(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -668,7 +673,7 @@ func TestExecuteWithPanic(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -725,7 +730,7 @@ func TestExecuteWithCpuLoop(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -841,7 +846,7 @@ func TestExecuteWithStorageLoop(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
 	require.NoError(t, err)
 
-	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "")
+	contractID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, bob := keyPubAddr()
@@ -180,6 +180,16 @@ func (m Migrator) Migrate6to7(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate7to8 migrates from version 7 to 8. The migration enables storing code with the JSON schema of its messages,
+// which is off while the max schema size is 0
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxSchemaSize = types.DefaultMaxSchemaSize
+	params.SchemaCost = types.DefaultSchemaCost
+
+	return m.keeper.SetParams(ctx, params)
+}
+
 const progressPartSize = 1000
 
 func logMigrationProgress(ctx sdk.Context, formatter *message.Printer, migratedContracts uint64, totalContracts uint64, previousTime int64) {
@@ -65,7 +65,7 @@ func TestMintQuerier(t *testing.T) {
 	// upload staking derivates code
 	govCode, err := os.ReadFile("./testdata/mint.wasm")
 	require.NoError(t, err)
-	govId, err := keeper.Create(ctx, creator, govCode, "", "")
+	govId, err := keeper.Create(ctx, creator, govCode, "", "", nil)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), govId)
 
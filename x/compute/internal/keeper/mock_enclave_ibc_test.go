@@ -0,0 +1,381 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// These tests run the IBC entry points of contracts on the mock enclave, so they run on machines without SGX as well
+
+// mockIBCEntryPoint is an IBC entry point of a contract, as the IBC module calls it
+type mockIBCEntryPoint struct {
+	description string
+	// call is the name mockScript gives to the call
+	call   string
+	sdkMsg func(in mockScriptInput) sdk.Msg
+	run    func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error)
+}
+
+func mockIBCChannel(contract sdk.AccAddress) v1wasmTypes.IBCChannel {
+	return v1wasmTypes.IBCChannel{
+		Endpoint:             v1wasmTypes.IBCEndpoint{PortID: PortIDForContract(contract), ChannelID: "channel-0"},
+		CounterpartyEndpoint: v1wasmTypes.IBCEndpoint{PortID: "counterparty", ChannelID: "channel-1"},
+		Order:                v1wasmTypes.Unordered,
+		Version:              "ibc-v1",
+		ConnectionID:         "connection-0",
+	}
+}
+
+func mockIBCPacket(channel v1wasmTypes.IBCChannel) v1wasmTypes.IBCPacket {
+	return v1wasmTypes.IBCPacket{
+		Data:     []byte("packet"),
+		Src:      channel.CounterpartyEndpoint,
+		Dest:     channel.Endpoint,
+		Sequence: 7,
+	}
+}
+
+// mockIBCEntryPoints are the IBC entry points whose responses are handled like the ones of executions
+var mockIBCEntryPoints = []mockIBCEntryPoint{
+	{
+		description: "OpenAck",
+		call:        "channel connect channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelOpenAck{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnConnectChannel(ctx, contract, v1wasmTypes.IBCChannelConnectMsg{
+				OpenAck: &v1wasmTypes.IBCOpenAck{Channel: channel, CounterpartyVersion: channel.Version},
+			})
+		},
+	},
+	{
+		description: "OpenConfirm",
+		call:        "channel connect channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelOpenConfirm{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnConnectChannel(ctx, contract, v1wasmTypes.IBCChannelConnectMsg{
+				OpenConfirm: &v1wasmTypes.IBCOpenConfirm{Channel: channel},
+			})
+		},
+	},
+	{
+		description: "CloseInit",
+		call:        "channel close channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelCloseInit{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnCloseChannel(ctx, contract, v1wasmTypes.IBCChannelCloseMsg{
+				CloseInit: &v1wasmTypes.IBCCloseInit{Channel: channel},
+			})
+		},
+	},
+	{
+		description: "CloseConfirm",
+		call:        "channel close channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelCloseConfirm{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnCloseChannel(ctx, contract, v1wasmTypes.IBCChannelCloseMsg{
+				CloseConfirm: &v1wasmTypes.IBCCloseConfirm{Channel: channel},
+			})
+		},
+	},
+	{
+		description: "PacketReceive",
+		call:        "packet receive 7",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgRecvPacket{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return in.keeper.OnRecvPacket(ctx, contract, v1wasmTypes.IBCPacketReceiveMsg{
+				Packet:  mockIBCPacket(channel),
+				Relayer: in.creator.String(),
+			})
+		},
+	},
+	{
+		description: "PacketAck",
+		call:        "packet ack 7 ack",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgAcknowledgement{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnAckPacket(ctx, contract, v1wasmTypes.IBCPacketAckMsg{
+				Acknowledgement: v1wasmTypes.IBCAcknowledgement{Data: []byte("ack")},
+				OriginalPacket:  mockIBCPacket(channel),
+				Relayer:         in.creator.String(),
+			})
+		},
+	},
+	{
+		description: "PacketTimeout",
+		call:        "packet timeout 7",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgTimeout{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnTimeoutPacket(ctx, contract, v1wasmTypes.IBCPacketTimeoutMsg{
+				Packet:  mockIBCPacket(channel),
+				Relayer: in.creator.String(),
+			})
+		},
+	},
+	{
+		description: "UpgradeInit",
+		call:        "channel upgrade channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelUpgradeInit{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			proposed := v1wasmTypes.IBCUpgradeFields{Order: channel.Order, Version: "ibc-v2", ConnectionID: channel.ConnectionID}
+			return nil, in.keeper.OnUpgradeChannel(ctx, contract, (&v1wasmTypes.IBCUpgradeInit{Channel: channel, Proposed: proposed}).ToMsg())
+		},
+	},
+	{
+		description: "UpgradeTry",
+		call:        "channel upgrade channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelUpgradeTry{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			proposed := v1wasmTypes.IBCUpgradeFields{Order: channel.Order, Version: "ibc-v2", ConnectionID: channel.ConnectionID}
+			return nil, in.keeper.OnUpgradeChannel(ctx, contract, (&v1wasmTypes.IBCUpgradeTry{Channel: channel, Proposed: proposed}).ToMsg())
+		},
+	},
+	{
+		description: "UpgradeAck",
+		call:        "channel upgrade channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelUpgradeAck{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			return nil, in.keeper.OnUpgradeChannel(ctx, contract, (&v1wasmTypes.IBCUpgradeAck{Channel: channel, CounterpartyVersion: "ibc-v2"}).ToMsg())
+		},
+	},
+	{
+		description: "UpgradeOpen",
+		call:        "channel upgrade channel-0",
+		sdkMsg: func(in mockScriptInput) sdk.Msg {
+			return &ibcchanneltypes.MsgChannelUpgradeOpen{Signer: in.creator.String()}
+		},
+		run: func(ctx sdk.Context, in mockScriptInput, contract sdk.AccAddress, channel v1wasmTypes.IBCChannel) ([]byte, error) {
+			channel.Version = "ibc-v2"
+			return nil, in.keeper.OnUpgradeChannel(ctx, contract, (&v1wasmTypes.IBCUpgradeOpen{Channel: channel}).ToMsg())
+		},
+	},
+}
+
+func TestMockEnclaveIBCChannelOpen(t *testing.T) {
+	for _, test := range []struct {
+		description string
+		sdkMsg      sdk.Msg
+		msg         func(channel v1wasmTypes.IBCChannel) v1wasmTypes.IBCChannelOpenMsg
+	}{
+		{
+			description: "OpenInit",
+			sdkMsg:      &ibcchanneltypes.MsgChannelOpenInit{},
+			msg: func(channel v1wasmTypes.IBCChannel) v1wasmTypes.IBCChannelOpenMsg {
+				return v1wasmTypes.IBCChannelOpenMsg{OpenInit: &v1wasmTypes.IBCOpenInit{Channel: channel}}
+			},
+		},
+		{
+			description: "OpenTry",
+			sdkMsg:      &ibcchanneltypes.MsgChannelOpenTry{},
+			msg: func(channel v1wasmTypes.IBCChannel) v1wasmTypes.IBCChannelOpenMsg {
+				return v1wasmTypes.IBCChannelOpenMsg{OpenTry: &v1wasmTypes.IBCOpenTry{Channel: channel, CounterpartyVersion: channel.Version}}
+			},
+		},
+	} {
+		t.Run(test.description, func(t *testing.T) {
+			in := setupMockScript(t)
+			contract := in.instantiate(t, "contract", nil)
+			channel := mockIBCChannel(contract)
+
+			var version string
+			_, err := in.relay(t, test.sdkMsg, func(ctx sdk.Context) (err error) {
+				version, err = in.keeper.OnOpenChannel(ctx, contract, test.msg(channel))
+				return err
+			})
+			require.NoError(t, err)
+			require.Equal(t, "ibc-v1", version)
+			require.Equal(t, []string{"instantiate", "channel open channel-0"}, in.calls(t, contract))
+
+			_, _, err = in.execute(t, contract, mockAction{IBC: &mockAction{Fail: "Intentional"}})
+			require.NoError(t, err)
+			_, err = in.relay(t, test.sdkMsg, func(ctx sdk.Context) error {
+				_, err := in.keeper.OnOpenChannel(ctx, contract, test.msg(channel))
+				return err
+			})
+			require.ErrorContains(t, err, "Intentional")
+			require.Equal(t, []string{"instantiate", "channel open channel-0", "execute"}, in.calls(t, contract))
+		})
+	}
+}
+
+func TestMockEnclaveIBCEntryPoints(t *testing.T) {
+	for _, entryPoint := range mockIBCEntryPoints {
+		for _, test := range []struct {
+			description string
+			action      func(in mockScriptInput, callee sdk.AccAddress) mockAction
+			isSuccess   bool
+			// callerCalls are the calls of the contract after the IBC call, besides the ones of the setup
+			callerCalls []string
+			calleeCalls []string
+			hasAttrs    bool
+			hasEvents   bool
+		}{
+			{
+				description: "Default",
+				action: func(mockScriptInput, sdk.AccAddress) mockAction {
+					return mockAction{Data: []byte("out")}
+				},
+				isSuccess:   true,
+				callerCalls: []string{entryPoint.call},
+				calleeCalls: []string{"instantiate"},
+			},
+			{
+				description: "SubmessageNoReply",
+				action: func(in mockScriptInput, callee sdk.AccAddress) mockAction {
+					return mockAction{
+						Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "never", callee, mockAction{})},
+						Data:     []byte("out"),
+					}
+				},
+				isSuccess:   true,
+				callerCalls: []string{entryPoint.call},
+				calleeCalls: []string{"instantiate", "execute"},
+			},
+			{
+				description: "SubmessageWithReply",
+				action: func(in mockScriptInput, callee sdk.AccAddress) mockAction {
+					return mockAction{
+						Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "always", callee, mockAction{})},
+						Data:     []byte("out"),
+						Reply:    &mockAction{},
+					}
+				},
+				isSuccess:   true,
+				callerCalls: []string{entryPoint.call, "reply 1 ok"},
+				calleeCalls: []string{"instantiate", "execute"},
+			},
+			{
+				description: "SubmessageWithReplyThatCallsToSubmessage",
+				action: func(in mockScriptInput, callee sdk.AccAddress) mockAction {
+					return mockAction{
+						Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "always", callee, mockAction{})},
+						Data:     []byte("out"),
+						Reply: &mockAction{
+							Messages: []v1wasmTypes.SubMsg{in.submsg(t, 2, "always", callee, mockAction{})},
+						},
+					}
+				},
+				isSuccess:   true,
+				callerCalls: []string{entryPoint.call, "reply 1 ok", "reply 2 ok"},
+				calleeCalls: []string{"instantiate", "execute", "execute"},
+			},
+			{
+				description: "Attributes",
+				action: func(mockScriptInput, sdk.AccAddress) mockAction {
+					return mockAction{
+						Attributes: []v010wasmTypes.LogAttribute{{Key: "attr1", Value: "😗"}},
+						Data:       []byte("out"),
+					}
+				},
+				isSuccess:   true,
+				callerCalls: []string{entryPoint.call},
+				calleeCalls: []string{"instantiate"},
+				hasAttrs:    true,
+			},
+			{
+				description: "Events",
+				action: func(mockScriptInput, sdk.AccAddress) mockAction {
+					return mockAction{
+						Events: []v1wasmTypes.Event{{
+							Type:       "cyber1",
+							Attributes: []v010wasmTypes.LogAttribute{{Key: "attr1", Value: "🤯"}},
+						}},
+						Data: []byte("out"),
+					}
+				},
+				isSuccess:   true,
+				callerCalls: []string{entryPoint.call},
+				calleeCalls: []string{"instantiate"},
+				hasEvents:   true,
+			},
+			{
+				description: "Error",
+				action: func(in mockScriptInput, callee sdk.AccAddress) mockAction {
+					return mockAction{
+						Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "never", callee, mockAction{})},
+						Fail:     "Intentional",
+					}
+				},
+				isSuccess:   false,
+				calleeCalls: []string{"instantiate"},
+			},
+		} {
+			t.Run(entryPoint.description+"-"+test.description, func(t *testing.T) {
+				in := setupMockScript(t)
+				caller := in.instantiate(t, "caller", nil)
+				callee := in.instantiate(t, "callee", nil)
+				channel := mockIBCChannel(caller)
+
+				action := test.action(in, callee)
+				_, _, err := in.execute(t, caller, mockAction{IBC: &action})
+				require.NoError(t, err)
+
+				var ack []byte
+				ctx, err := in.relay(t, entryPoint.sdkMsg(in), func(ctx sdk.Context) (err error) {
+					ack, err = entryPoint.run(ctx, in, caller, channel)
+					return err
+				})
+				if !test.isSuccess {
+					require.ErrorContains(t, err, "Intentional")
+				} else {
+					require.NoError(t, err)
+				}
+				if entryPoint.description == "PacketReceive" && test.isSuccess {
+					require.Equal(t, "out", string(ack))
+				}
+
+				require.Equal(t, append([]string{"instantiate", "execute"}, test.callerCalls...), in.calls(t, caller))
+				require.Equal(t, test.calleeCalls, in.calls(t, callee))
+
+				var wasmEvents []map[string]string
+				var cyber1 map[string]string
+				for _, event := range ctx.EventManager().Events() {
+					attrs := map[string]string{}
+					for _, attr := range event.Attributes {
+						attrs[attr.Key] = attr.Value
+					}
+					switch event.Type {
+					case types.CustomEventType:
+						wasmEvents = append(wasmEvents, attrs)
+					case types.CustomContractEventPrefix + "cyber1":
+						cyber1 = attrs
+					}
+				}
+				if test.hasAttrs {
+					require.Equal(t, []map[string]string{{"contract_address": caller.String(), "attr1": "😗"}}, wasmEvents)
+				}
+				if test.hasEvents {
+					require.Equal(t, map[string]string{"contract_address": caller.String(), "attr1": "🤯"}, cyber1)
+				} else {
+					require.Nil(t, cyber1)
+				}
+			})
+		}
+	}
+}
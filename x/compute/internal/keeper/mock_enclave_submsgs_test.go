@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// These tests run the dispatcher of submessages on the mock enclave, so they run on machines without SGX as well
+
+func TestMockEnclaveSubmessageReplyOn(t *testing.T) {
+	for _, test := range []struct {
+		description string
+		replyOn     string
+		fails       bool
+		isSuccess   bool
+		callerCalls []string
+		calleeCalls []string
+	}{
+		{"always, succeeds", "always", false, true, []string{"instantiate", "execute", "reply 1 ok"}, []string{"instantiate", "execute"}},
+		{"always, fails", "always", true, true, []string{"instantiate", "execute", "reply 1 error"}, []string{"instantiate"}},
+		{"success, succeeds", "success", false, true, []string{"instantiate", "execute", "reply 1 ok"}, []string{"instantiate", "execute"}},
+		{"success, fails", "success", true, false, []string{"instantiate"}, []string{"instantiate"}},
+		{"error, succeeds", "error", false, true, []string{"instantiate", "execute"}, []string{"instantiate", "execute"}},
+		{"error, fails", "error", true, true, []string{"instantiate", "execute", "reply 1 error"}, []string{"instantiate"}},
+		{"never, succeeds", "never", false, true, []string{"instantiate", "execute"}, []string{"instantiate", "execute"}},
+		{"never, fails", "never", true, false, []string{"instantiate"}, []string{"instantiate"}},
+	} {
+		t.Run(test.description, func(t *testing.T) {
+			in := setupMockScript(t)
+			caller := in.instantiate(t, "caller", nil)
+			callee := in.instantiate(t, "callee", nil)
+
+			// the submessage changes the state of the callee before it fails
+			var calleeAction mockAction
+			if test.fails {
+				calleeAction.Messages = []v1wasmTypes.SubMsg{in.submsg(t, 1, "never", callee, mockAction{Fail: "Intentional"})}
+			}
+
+			_, _, err := in.execute(t, caller, mockAction{
+				Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, test.replyOn, callee, calleeAction)},
+			})
+			if test.isSuccess {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "Intentional")
+			}
+
+			// the state changes of failed submessages are reverted, and so are all the changes of a failed execution
+			require.Equal(t, test.callerCalls, in.calls(t, caller))
+			require.Equal(t, test.calleeCalls, in.calls(t, callee))
+		})
+	}
+}
+
+func TestMockEnclaveMultipleSubmessages(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+
+	_, _, err := in.execute(t, caller, mockAction{
+		Messages: []v1wasmTypes.SubMsg{
+			in.submsg(t, 1, "always", callee, mockAction{}),
+			in.submsg(t, 2, "never", callee, mockAction{}),
+			in.submsg(t, 3, "success", callee, mockAction{}),
+			in.submsg(t, 4, "error", callee, mockAction{}),
+		},
+	})
+	require.NoError(t, err)
+
+	// the submessages and their replies run in order
+	require.Equal(t, []string{"instantiate", "execute", "reply 1 ok", "reply 3 ok"}, in.calls(t, caller))
+	require.Equal(t, []string{"instantiate", "execute", "execute", "execute", "execute"}, in.calls(t, callee))
+}
+
+func TestMockEnclaveSubmessageOfReply(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+
+	// the reply sends a submessage of its own, which fails on the callee and gets a reply of its own
+	_, _, err := in.execute(t, caller, mockAction{
+		Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "success", callee, mockAction{})},
+		Reply: &mockAction{
+			Messages: []v1wasmTypes.SubMsg{in.submsg(t, 2, "error", callee, mockAction{Fail: "Intentional"})},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"instantiate", "execute", "reply 1 ok", "reply 2 error"}, in.calls(t, caller))
+	require.Equal(t, []string{"instantiate", "execute"}, in.calls(t, callee))
+}
+
+func TestMockEnclaveSubmessageStateRevertsIfCallerFails(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+
+	// the caller has no funds to send
+	failingSend := v1wasmTypes.SubMsg{
+		ID: 2,
+		Msg: v1wasmTypes.CosmosMsg{Bank: &v1wasmTypes.BankMsg{Send: &v1wasmTypes.SendMsg{
+			ToAddress: in.creator.String(),
+			Amount:    wasmTypes.Coins{{Denom: "denom", Amount: "1000"}},
+		}}},
+		ReplyOn: v1wasmTypes.ReplyNever,
+	}
+
+	for _, test := range []struct {
+		description string
+		action      mockAction
+	}{
+		{
+			description: "failing message after the submessage",
+			action: mockAction{
+				Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "always", callee, mockAction{}), failingSend},
+			},
+		},
+		{
+			description: "failing message from the reply",
+			action: mockAction{
+				Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "always", callee, mockAction{})},
+				Reply:    &mockAction{Messages: []v1wasmTypes.SubMsg{failingSend}},
+			},
+		},
+		{
+			description: "failing reply",
+			action: mockAction{
+				Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "always", callee, mockAction{})},
+				Reply:    &mockAction{Fail: "Intentional"},
+			},
+		},
+	} {
+		t.Run(test.description, func(t *testing.T) {
+			_, _, err := in.execute(t, caller, test.action)
+			require.Error(t, err)
+
+			// the submessage succeeded, but the execution failed as a whole
+			require.Equal(t, []string{"instantiate"}, in.calls(t, caller))
+			require.Equal(t, []string{"instantiate"}, in.calls(t, callee))
+		})
+	}
+}
+
+func TestMockEnclaveBankSubmessageFails(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+
+	send := v1wasmTypes.SubMsg{
+		ID: 1,
+		Msg: v1wasmTypes.CosmosMsg{Bank: &v1wasmTypes.BankMsg{Send: &v1wasmTypes.SendMsg{
+			ToAddress: in.creator.String(),
+			Amount:    wasmTypes.Coins{{Denom: "denom", Amount: "1000"}},
+		}}},
+		ReplyOn: v1wasmTypes.ReplyError,
+	}
+	_, _, err := in.execute(t, caller, mockAction{Messages: []v1wasmTypes.SubMsg{send}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"instantiate", "execute", "reply 1 error"}, in.calls(t, caller))
+
+	send.ReplyOn = v1wasmTypes.ReplyNever
+	_, _, err = in.execute(t, caller, mockAction{Messages: []v1wasmTypes.SubMsg{send}})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(t, []string{"instantiate", "execute", "reply 1 error"}, in.calls(t, caller))
+}
+
+func TestMockEnclaveSubmessageEvents(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+
+	for _, replyOn := range []string{"always", "never"} {
+		t.Run(replyOn, func(t *testing.T) {
+			ctx, _, err := in.execute(t, caller, mockAction{
+				Attributes: []v010wasmTypes.LogAttribute{{Key: "attr1", Value: "caller"}},
+				Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, replyOn, callee, mockAction{
+					Attributes: []v010wasmTypes.LogAttribute{{Key: "attr2", Value: "callee"}},
+					Events: []v1wasmTypes.Event{{
+						Type:       "cyber1",
+						Attributes: []v010wasmTypes.LogAttribute{{Key: "attr3", Value: "🤯"}},
+					}},
+					Data: []byte("out"),
+				})},
+				Reply: &mockAction{
+					Attributes: []v010wasmTypes.LogAttribute{{Key: "attr4", Value: "reply"}},
+				},
+			})
+			require.NoError(t, err)
+
+			var wasmEvents []map[string]string
+			var cyber1 map[string]string
+			for _, event := range ctx.EventManager().Events() {
+				attrs := map[string]string{}
+				for _, attr := range event.Attributes {
+					attrs[attr.Key] = attr.Value
+				}
+				switch event.Type {
+				case types.CustomEventType:
+					wasmEvents = append(wasmEvents, attrs)
+				case types.CustomContractEventPrefix + "cyber1":
+					require.Nil(t, cyber1)
+					cyber1 = attrs
+				}
+			}
+
+			// the attributes of every call are tagged with the contract that emitted them
+			expected := []map[string]string{
+				{"contract_address": caller.String(), "attr1": "caller"},
+				{"contract_address": callee.String(), "attr2": "callee"},
+			}
+			if replyOn == "always" {
+				expected = append(expected, map[string]string{"contract_address": caller.String(), "attr4": "reply"})
+			}
+			require.Equal(t, expected, wasmEvents)
+			require.Equal(t, map[string]string{"contract_address": callee.String(), "attr3": "🤯"}, cyber1)
+		})
+	}
+
+	// the reply gets the data of the submessage
+	replyData, err := in.keeper.QuerySmart(in.ctx, caller, mock.Msg([]byte(`{"reply_data":{}}`)), false)
+	require.NoError(t, err)
+	var executeResponse types.MsgExecuteContractResponse
+	require.NoError(t, executeResponse.Unmarshal(replyData))
+	require.Equal(t, "out", string(executeResponse.Data))
+}
+
+func TestMockEnclaveSubmessageGasLimit(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+	in.ctx = in.ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+	// a submessage that runs out of its own gas limit fails without failing the execution
+	gasLimit := uint64(100_000)
+	subMsg := in.submsg(t, 1, "error", callee, mockAction{Gas: 200_000 * types.GasMultiplier})
+	subMsg.GasLimit = &gasLimit
+	_, _, err := in.execute(t, caller, mockAction{Messages: []v1wasmTypes.SubMsg{subMsg}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"instantiate", "execute", "reply 1 error"}, in.calls(t, caller))
+	require.Equal(t, []string{"instantiate"}, in.calls(t, callee))
+}
+
+func TestMockEnclaveSubmessageGasExceedingMessageGas(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+	in.ctx = in.ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+	gasLimit := uint64(2_000_000)
+	subMsg := in.submsg(t, 1, "always", callee, mockAction{Gas: 1_500_000 * types.GasMultiplier})
+	subMsg.GasLimit = &gasLimit
+
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "wasm contract"}, func() {
+		_, _, _ = in.execute(t, caller, mockAction{Messages: []v1wasmTypes.SubMsg{subMsg}})
+	})
+}
+
+func TestMockEnclaveReplyGasExceedingMessageGas(t *testing.T) {
+	in := setupMockScript(t)
+	caller := in.instantiate(t, "caller", nil)
+	callee := in.instantiate(t, "callee", nil)
+	in.ctx = in.ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "wasm contract"}, func() {
+		_, _, _ = in.execute(t, caller, mockAction{
+			Messages: []v1wasmTypes.SubMsg{in.submsg(t, 1, "always", callee, mockAction{})},
+			Reply:    &mockAction{Gas: 1_500_000 * types.GasMultiplier},
+		})
+	})
+}
@@ -0,0 +1,549 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// mockCounter counts its executions
+type mockCounter struct{}
+
+func (mockCounter) Instantiate(deps *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1wasmTypes.Response, error) {
+	deps.Storage.Set([]byte("count"), []byte("0"))
+	return &v1wasmTypes.Response{}, nil
+}
+
+func (mockCounter) Execute(deps *mock.Deps, _ wasmTypes.Env, msg []byte) (*v1wasmTypes.Response, error) {
+	if string(msg) != `{"increment":{}}` {
+		return nil, errors.New("unknown message")
+	}
+	count, _ := strconv.Atoi(string(deps.Storage.Get([]byte("count"))))
+	deps.Storage.Set([]byte("count"), []byte(strconv.Itoa(count+1)))
+	return &v1wasmTypes.Response{Data: []byte(strconv.Itoa(count + 1))}, nil
+}
+
+func (mockCounter) Query(deps *mock.Deps, _ wasmTypes.Env, _ []byte) ([]byte, error) {
+	return deps.Storage.Get([]byte("count")), nil
+}
+
+// mockForwarder forwards its executions to a counter as submessages, with the gas limit of the execution if it has
+// one, and keeps the data of their replies, which hold the response of the execution
+type mockForwarder struct{}
+
+func (mockForwarder) Instantiate(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1wasmTypes.Response, error) {
+	return &v1wasmTypes.Response{}, nil
+}
+
+func (mockForwarder) Execute(_ *mock.Deps, _ wasmTypes.Env, msg []byte) (*v1wasmTypes.Response, error) {
+	var forward struct {
+		Contract string  `json:"contract"`
+		CodeHash string  `json:"code_hash"`
+		GasLimit *uint64 `json:"gas_limit,omitempty"`
+	}
+	if err := json.Unmarshal(msg, &forward); err != nil {
+		return nil, err
+	}
+
+	return &v1wasmTypes.Response{
+		Messages: []v1wasmTypes.SubMsg{{
+			ID:       1,
+			GasLimit: forward.GasLimit,
+			Msg: v1wasmTypes.CosmosMsg{Wasm: &v1wasmTypes.WasmMsg{Execute: &v010wasmTypes.ExecuteMsg{
+				ContractAddr:      forward.Contract,
+				CallbackCodeHash:  forward.CodeHash,
+				Msg:               mock.Msg([]byte(`{"increment":{}}`)),
+				CallbackSignature: []byte("callback"),
+			}}},
+			ReplyOn: v1wasmTypes.ReplyAlways,
+		}},
+	}, nil
+}
+
+func (mockForwarder) Query(deps *mock.Deps, _ wasmTypes.Env, _ []byte) ([]byte, error) {
+	return deps.Storage.Get([]byte("reply")), nil
+}
+
+func (mockForwarder) Reply(deps *mock.Deps, _ wasmTypes.Env, reply v1wasmTypes.Reply) (*v1wasmTypes.Response, error) {
+	if reply.Result.Ok == nil {
+		return nil, errors.New(reply.Result.Err)
+	}
+	deps.Storage.Set([]byte("reply"), reply.Result.Ok.Data)
+	return &v1wasmTypes.Response{}, nil
+}
+
+// mockPinger is an IBC contract that forwards the packets it receives to a counter as submessages, and keeps the
+// outcome of the last packet it sent
+type mockPinger struct{}
+
+func (mockPinger) Instantiate(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1wasmTypes.Response, error) {
+	return &v1wasmTypes.Response{}, nil
+}
+
+func (mockPinger) Execute(_ *mock.Deps, _ wasmTypes.Env, _ []byte) (*v1wasmTypes.Response, error) {
+	return nil, errors.New("unknown message")
+}
+
+func (mockPinger) Query(deps *mock.Deps, _ wasmTypes.Env, _ []byte) ([]byte, error) {
+	return deps.Storage.Get([]byte("outcome")), nil
+}
+
+func (mockPinger) IBCChannelOpen(_ *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelOpenMsg) (string, error) {
+	if msg.GetChannel().Version != "ping-1" {
+		return "", errors.New("unsupported version")
+	}
+	return "ping-1", nil
+}
+
+func (mockPinger) IBCChannelConnect(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelConnectMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	deps.Storage.Set([]byte("outcome"), []byte("connected "+msg.GetChannel().Endpoint.ChannelID))
+	return &v1wasmTypes.IBCBasicResponse{}, nil
+}
+
+func (mockPinger) IBCChannelClose(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelCloseMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	deps.Storage.Set([]byte("outcome"), []byte("closed "+msg.GetChannel().Endpoint.ChannelID))
+	return &v1wasmTypes.IBCBasicResponse{}, nil
+}
+
+func (mockPinger) IBCPacketReceive(_ *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCPacketReceiveMsg) (*v1wasmTypes.IBCReceiveResponse, error) {
+	var forward struct {
+		Contract string `json:"contract"`
+		CodeHash string `json:"code_hash"`
+	}
+	if err := json.Unmarshal(msg.Packet.Data, &forward); err != nil {
+		return nil, err
+	}
+
+	return &v1wasmTypes.IBCReceiveResponse{
+		Acknowledgement: []byte("pong"),
+		Messages: []v1wasmTypes.SubMsg{{
+			Msg: v1wasmTypes.CosmosMsg{Wasm: &v1wasmTypes.WasmMsg{Execute: &v010wasmTypes.ExecuteMsg{
+				ContractAddr:      forward.Contract,
+				CallbackCodeHash:  forward.CodeHash,
+				Msg:               mock.Msg([]byte(`{"increment":{}}`)),
+				CallbackSignature: []byte("callback"),
+			}}},
+			ReplyOn: v1wasmTypes.ReplyNever,
+		}},
+	}, nil
+}
+
+func (mockPinger) IBCPacketAck(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCPacketAckMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	deps.Storage.Set([]byte("outcome"), []byte("acked "+string(msg.Acknowledgement.Data)))
+	return &v1wasmTypes.IBCBasicResponse{}, nil
+}
+
+func (mockPinger) IBCPacketTimeout(deps *mock.Deps, _ wasmTypes.Env, _ v1wasmTypes.IBCPacketTimeoutMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	deps.Storage.Set([]byte("outcome"), []byte("timed out"))
+	return &v1wasmTypes.IBCBasicResponse{}, nil
+}
+
+func TestMockEnclave(t *testing.T) {
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("counter"), mockCounter{})
+	enclave.AddContract([]byte("forwarder"), mockForwarder{})
+
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil, WithEnclave(enclave))
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, _, _ := CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+
+	counterCodeID, err := keeper.Create(ctx, creator, []byte("counter"), "", "", nil)
+	require.NoError(t, err)
+	forwarderCodeID, err := keeper.Create(ctx, creator, []byte("forwarder"), "", "", nil)
+	require.NoError(t, err)
+
+	counter, _, err := keeper.Instantiate(ctx, counterCodeID, creator, nil, mock.Msg([]byte(`{}`)), "counter", nil, []byte("callback"))
+	require.NoError(t, err)
+	forwarder, _, err := keeper.Instantiate(ctx, forwarderCodeID, creator, nil, mock.Msg([]byte(`{}`)), "forwarder", nil, []byte("callback"))
+	require.NoError(t, err)
+
+	_, err = keeper.Execute(ctx, counter, creator, mock.Msg([]byte(`{"increment":{}}`)), nil, []byte("callback"), wasmTypes.HandleTypeExecute)
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, counterCodeID)
+	require.NoError(t, err)
+	counterCodeHash := hex.EncodeToString(codeInfo.CodeHash)
+	forwardMsg := `{"contract":"` + counter.String() + `","code_hash":"` + counterCodeHash + `"}`
+	_, err = keeper.Execute(ctx, forwarder, creator, mock.Msg([]byte(forwardMsg)), nil, []byte("callback"), wasmTypes.HandleTypeExecute)
+	require.NoError(t, err)
+
+	count, err := keeper.QuerySmart(ctx, counter, mock.Msg([]byte(`{}`)), false)
+	require.NoError(t, err)
+	require.Equal(t, "2", string(count))
+
+	replyData, err := keeper.QuerySmart(ctx, forwarder, mock.Msg([]byte(`{}`)), false)
+	require.NoError(t, err)
+	var executeResponse types.MsgExecuteContractResponse
+	require.NoError(t, executeResponse.Unmarshal(replyData))
+	require.Equal(t, "2", string(executeResponse.Data))
+
+	_, err = keeper.Execute(ctx, counter, creator, mock.Msg([]byte(`{"decrement":{}}`)), nil, []byte("callback"), wasmTypes.HandleTypeExecute)
+	require.ErrorContains(t, err, "unknown message")
+}
+
+func TestMockEnclaveIBC(t *testing.T) {
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("counter"), mockCounter{})
+	enclave.AddContract([]byte("pinger"), mockPinger{})
+
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil, WithEnclave(enclave))
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, creatorPrivKey, _ := CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+
+	counterCodeID, err := keeper.Create(ctx, creator, []byte("counter"), "", "", nil)
+	require.NoError(t, err)
+	pingerCodeID, err := keeper.Create(ctx, creator, []byte("pinger"), "", "", nil)
+	require.NoError(t, err)
+
+	counter, _, err := keeper.Instantiate(ctx, counterCodeID, creator, nil, mock.Msg([]byte(`{}`)), "counter", nil, []byte("callback"))
+	require.NoError(t, err)
+	pinger, _, err := keeper.Instantiate(ctx, pingerCodeID, creator, nil, mock.Msg([]byte(`{}`)), "pinger", nil, []byte("callback"))
+	require.NoError(t, err)
+
+	// only contracts with IBC entry points get a port
+	require.Empty(t, keeper.GetContractInfo(ctx, counter).IBCPortID)
+	portID := keeper.GetContractInfo(ctx, pinger).IBCPortID
+	require.Equal(t, PortIDForContract(pinger), portID)
+
+	channel := v1wasmTypes.IBCChannel{
+		Endpoint:             v1wasmTypes.IBCEndpoint{PortID: portID, ChannelID: "channel-0"},
+		CounterpartyEndpoint: v1wasmTypes.IBCEndpoint{PortID: "ping", ChannelID: "channel-7"},
+		Order:                v1wasmTypes.Unordered,
+		Version:              "ping-1",
+	}
+	// the contract calls of IBC messages read the signer of the transaction that relays them
+	ctx = PrepareSignedTx(t, keeper, ctx, creator, creatorPrivKey, &ibcchanneltypes.MsgRecvPacket{Signer: creator.String()})
+	queryOutcome := func() string {
+		outcome, err := keeper.QuerySmart(ctx, pinger, mock.Msg([]byte(`{}`)), false)
+		require.NoError(t, err)
+		return string(outcome)
+	}
+
+	version, err := keeper.OnOpenChannel(ctx, pinger, v1wasmTypes.IBCChannelOpenMsg{OpenInit: &v1wasmTypes.IBCOpenInit{Channel: channel}})
+	require.NoError(t, err)
+	require.Equal(t, "ping-1", version)
+
+	otherChannel := channel
+	otherChannel.Version = "ping-2"
+	_, err = keeper.OnOpenChannel(ctx, pinger, v1wasmTypes.IBCChannelOpenMsg{OpenInit: &v1wasmTypes.IBCOpenInit{Channel: otherChannel}})
+	require.ErrorContains(t, err, "unsupported version")
+
+	err = keeper.OnConnectChannel(ctx, pinger, v1wasmTypes.IBCChannelConnectMsg{OpenAck: &v1wasmTypes.IBCOpenAck{Channel: channel, CounterpartyVersion: "ping-1"}})
+	require.NoError(t, err)
+	require.Equal(t, "connected channel-0", queryOutcome())
+
+	// the submessages of a received packet are dispatched like the ones of an execution
+	codeInfo, err := keeper.GetCodeInfo(ctx, counterCodeID)
+	require.NoError(t, err)
+	packet := v1wasmTypes.IBCPacket{
+		Data:     []byte(`{"contract":"` + counter.String() + `","code_hash":"` + hex.EncodeToString(codeInfo.CodeHash) + `"}`),
+		Src:      channel.CounterpartyEndpoint,
+		Dest:     channel.Endpoint,
+		Sequence: 1,
+	}
+	ack, err := keeper.OnRecvPacket(ctx, pinger, v1wasmTypes.IBCPacketReceiveMsg{Packet: packet, Relayer: creator.String()})
+	require.NoError(t, err)
+	require.Equal(t, "pong", string(ack))
+	count, err := keeper.QuerySmart(ctx, counter, mock.Msg([]byte(`{}`)), false)
+	require.NoError(t, err)
+	require.Equal(t, "1", string(count))
+
+	err = keeper.OnAckPacket(ctx, pinger, v1wasmTypes.IBCPacketAckMsg{Acknowledgement: v1wasmTypes.IBCAcknowledgement{Data: []byte("pong")}, OriginalPacket: packet})
+	require.NoError(t, err)
+	require.Equal(t, "acked pong", queryOutcome())
+
+	err = keeper.OnTimeoutPacket(ctx, pinger, v1wasmTypes.IBCPacketTimeoutMsg{Packet: packet})
+	require.NoError(t, err)
+	require.Equal(t, "timed out", queryOutcome())
+
+	err = keeper.OnCloseChannel(ctx, pinger, v1wasmTypes.IBCChannelCloseMsg{CloseInit: &v1wasmTypes.IBCCloseInit{Channel: channel}})
+	require.NoError(t, err)
+	require.Equal(t, "closed channel-0", queryOutcome())
+
+	// contracts without IBC entry points can't be called over IBC
+	_, err = keeper.OnOpenChannel(ctx, counter, v1wasmTypes.IBCChannelOpenMsg{OpenInit: &v1wasmTypes.IBCOpenInit{Channel: channel}})
+	require.Error(t, err)
+}
+
+// mockAction is what a mockScript contract does when it is called
+type mockAction struct {
+	// Fail fails the call with the error
+	Fail string `json:"fail,omitempty"`
+	// Gas is the CosmWasm gas that the call consumes
+	Gas        uint64                       `json:"gas,omitempty"`
+	Attributes []v010wasmTypes.LogAttribute `json:"attributes,omitempty"`
+	Events     []v1wasmTypes.Event          `json:"events,omitempty"`
+	Messages   []v1wasmTypes.SubMsg         `json:"messages,omitempty"`
+	Data       []byte                       `json:"data,omitempty"`
+	// Reply is what the contract does on the replies to the submessages of the call
+	Reply *mockAction `json:"reply,omitempty"`
+	// IBC is what the contract does on the next calls to its IBC entry points
+	IBC *mockAction `json:"ibc,omitempty"`
+}
+
+func (a mockAction) Msg(t *testing.T) []byte {
+	bz, err := json.Marshal(a)
+	require.NoError(t, err)
+	return mock.Msg(bz)
+}
+
+// mockScript is an IBC contract that does what its messages tell it to, see mockAction. It keeps the list of its calls
+// that succeeded, which its queries return, so tests can tell which calls ran and which state changes were reverted
+type mockScript struct{}
+
+func (s mockScript) run(deps *mock.Deps, call string, action mockAction) (*v1wasmTypes.Response, error) {
+	deps.ConsumeGas(action.Gas)
+	if action.Fail != "" {
+		return nil, errors.New(action.Fail)
+	}
+
+	var calls []string
+	if bz := deps.Storage.Get([]byte("calls")); bz != nil {
+		if err := json.Unmarshal(bz, &calls); err != nil {
+			return nil, err
+		}
+	}
+	bz, err := json.Marshal(append(calls, call))
+	if err != nil {
+		return nil, err
+	}
+	deps.Storage.Set([]byte("calls"), bz)
+
+	if action.Reply != nil {
+		bz, err := json.Marshal(action.Reply)
+		if err != nil {
+			return nil, err
+		}
+		deps.Storage.Set([]byte("reply"), bz)
+	}
+	if action.IBC != nil {
+		bz, err := json.Marshal(action.IBC)
+		if err != nil {
+			return nil, err
+		}
+		deps.Storage.Set([]byte("ibc"), bz)
+	}
+
+	return &v1wasmTypes.Response{
+		Messages:   action.Messages,
+		Data:       action.Data,
+		Attributes: action.Attributes,
+		Events:     action.Events,
+	}, nil
+}
+
+// stored returns the action that was stored under key, or an action that does nothing
+func (mockScript) stored(deps *mock.Deps, key string) (mockAction, error) {
+	var action mockAction
+	bz := deps.Storage.Get([]byte(key))
+	if bz == nil {
+		return action, nil
+	}
+	return action, json.Unmarshal(bz, &action)
+}
+
+func (s mockScript) Instantiate(deps *mock.Deps, _ wasmTypes.Env, msg []byte) (*v1wasmTypes.Response, error) {
+	var action mockAction
+	if err := json.Unmarshal(msg, &action); err != nil {
+		return nil, err
+	}
+	return s.run(deps, "instantiate", action)
+}
+
+func (s mockScript) Execute(deps *mock.Deps, _ wasmTypes.Env, msg []byte) (*v1wasmTypes.Response, error) {
+	var action mockAction
+	if err := json.Unmarshal(msg, &action); err != nil {
+		return nil, err
+	}
+	return s.run(deps, "execute", action)
+}
+
+func (mockScript) Query(deps *mock.Deps, _ wasmTypes.Env, msg []byte) ([]byte, error) {
+	if string(msg) == `{"reply_data":{}}` {
+		return deps.Storage.Get([]byte("reply_data")), nil
+	}
+	return deps.Storage.Get([]byte("calls")), nil
+}
+
+func (s mockScript) Reply(deps *mock.Deps, _ wasmTypes.Env, reply v1wasmTypes.Reply) (*v1wasmTypes.Response, error) {
+	// a reply runs the stored action once, the action may store the one of the next reply
+	action, err := s.stored(deps, "reply")
+	if err != nil {
+		return nil, err
+	}
+	deps.Storage.Delete([]byte("reply"))
+
+	if reply.Result.Ok == nil {
+		return s.run(deps, fmt.Sprintf("reply %s error", reply.ID), action)
+	}
+	deps.Storage.Set([]byte("reply_data"), append([]byte{}, reply.Result.Ok.Data...))
+	return s.run(deps, fmt.Sprintf("reply %s ok", reply.ID), action)
+}
+
+func (s mockScript) runIBC(deps *mock.Deps, call string) (*v1wasmTypes.Response, error) {
+	action, err := s.stored(deps, "ibc")
+	if err != nil {
+		return nil, err
+	}
+	return s.run(deps, call, action)
+}
+
+func (s mockScript) ibcBasic(deps *mock.Deps, call string) (*v1wasmTypes.IBCBasicResponse, error) {
+	res, err := s.runIBC(deps, call)
+	if err != nil {
+		return nil, err
+	}
+	return &v1wasmTypes.IBCBasicResponse{Messages: res.Messages, Attributes: res.Attributes, Events: res.Events}, nil
+}
+
+func (s mockScript) IBCChannelOpen(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelOpenMsg) (string, error) {
+	_, err := s.runIBC(deps, "channel open "+msg.GetChannel().Endpoint.ChannelID)
+	return msg.GetChannel().Version, err
+}
+
+func (s mockScript) IBCChannelConnect(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelConnectMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	return s.ibcBasic(deps, "channel connect "+msg.GetChannel().Endpoint.ChannelID)
+}
+
+func (s mockScript) IBCChannelClose(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelCloseMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	return s.ibcBasic(deps, "channel close "+msg.GetChannel().Endpoint.ChannelID)
+}
+
+func (s mockScript) IBCChannelUpgrade(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCChannelUpgradeMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	var channel v1wasmTypes.IBCChannel
+	switch upgrade := msg.IBCChannelUpgrade; {
+	case upgrade.Init != nil:
+		channel = upgrade.Init.Channel
+	case upgrade.Try != nil:
+		channel = upgrade.Try.Channel
+	case upgrade.Ack != nil:
+		channel = upgrade.Ack.Channel
+	case upgrade.Open != nil:
+		channel = upgrade.Open.Channel
+	}
+	return s.ibcBasic(deps, "channel upgrade "+channel.Endpoint.ChannelID)
+}
+
+func (s mockScript) IBCPacketReceive(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCPacketReceiveMsg) (*v1wasmTypes.IBCReceiveResponse, error) {
+	res, err := s.runIBC(deps, fmt.Sprintf("packet receive %d", msg.Packet.Sequence))
+	if err != nil {
+		return nil, err
+	}
+	return &v1wasmTypes.IBCReceiveResponse{Acknowledgement: res.Data, Messages: res.Messages, Attributes: res.Attributes, Events: res.Events}, nil
+}
+
+func (s mockScript) IBCPacketAck(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCPacketAckMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	return s.ibcBasic(deps, fmt.Sprintf("packet ack %d %s", msg.OriginalPacket.Sequence, msg.Acknowledgement.Data))
+}
+
+func (s mockScript) IBCPacketTimeout(deps *mock.Deps, _ wasmTypes.Env, msg v1wasmTypes.IBCPacketTimeoutMsg) (*v1wasmTypes.IBCBasicResponse, error) {
+	return s.ibcBasic(deps, fmt.Sprintf("packet timeout %d", msg.Packet.Sequence))
+}
+
+// mockScriptInput is a keeper that runs mockScript contracts on the mock enclave
+type mockScriptInput struct {
+	ctx      sdk.Context
+	keeper   Keeper
+	keepers  TestKeepers
+	creator  sdk.AccAddress
+	privKey  crypto.PrivKey
+	codeID   uint64
+	codeHash string
+}
+
+func setupMockScript(t *testing.T) mockScriptInput {
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("script"), mockScript{})
+
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithEnclave(enclave))
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, privKey, _ := CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+
+	codeID, err := keeper.Create(ctx, creator, []byte("script"), "", "", nil)
+	require.NoError(t, err)
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+
+	return mockScriptInput{
+		ctx:      ctx,
+		keeper:   keeper,
+		keepers:  keepers,
+		creator:  creator,
+		privKey:  privKey,
+		codeID:   codeID,
+		codeHash: hex.EncodeToString(codeInfo.CodeHash),
+	}
+}
+
+func (in mockScriptInput) instantiate(t *testing.T, label string, funds sdk.Coins) sdk.AccAddress {
+	contract, _, err := in.keeper.Instantiate(in.ctx, in.codeID, in.creator, nil, mockAction{}.Msg(t), label, funds, []byte("callback"))
+	require.NoError(t, err)
+	return contract
+}
+
+// execute runs an execution in a transaction of its own, whose state changes are dropped if it fails
+func (in mockScriptInput) execute(t *testing.T, contract sdk.AccAddress, action mockAction) (sdk.Context, *sdk.Result, error) {
+	ctx, commit := in.ctx.WithEventManager(sdk.NewEventManager()).CacheContext()
+	res, err := in.keeper.Execute(ctx, contract, in.creator, action.Msg(t), nil, []byte("callback"), wasmTypes.HandleTypeExecute)
+	if err == nil {
+		commit()
+	}
+	return ctx, res, err
+}
+
+// relay runs an IBC entry point in a transaction of its own that relays sdkMsg, whose state changes are dropped if it fails
+func (in mockScriptInput) relay(t *testing.T, sdkMsg sdk.Msg, call func(ctx sdk.Context) error) (sdk.Context, error) {
+	ctx := PrepareSignedTx(t, in.keeper, in.ctx, in.creator, in.privKey, sdkMsg)
+	ctx, commit := ctx.WithEventManager(sdk.NewEventManager()).CacheContext()
+	err := call(ctx)
+	if err == nil {
+		commit()
+	}
+	return ctx, err
+}
+
+// calls returns the calls of a contract that succeeded
+func (in mockScriptInput) calls(t *testing.T, contract sdk.AccAddress) []string {
+	bz, err := in.keeper.QuerySmart(in.ctx, contract, mock.Msg([]byte(`{}`)), false)
+	require.NoError(t, err)
+
+	var calls []string
+	if len(bz) > 0 {
+		require.NoError(t, json.Unmarshal(bz, &calls))
+	}
+	return calls
+}
+
+// submsg executes action on contract as a submessage
+func (in mockScriptInput) submsg(t *testing.T, id uint64, replyOn string, contract sdk.AccAddress, action mockAction) v1wasmTypes.SubMsg {
+	var subMsg v1wasmTypes.SubMsg
+	require.NoError(t, json.Unmarshal([]byte(`{"reply_on":"`+replyOn+`"}`), &subMsg))
+	subMsg.ID = id
+	subMsg.Msg = v1wasmTypes.CosmosMsg{Wasm: &v1wasmTypes.WasmMsg{Execute: &v010wasmTypes.ExecuteMsg{
+		ContractAddr:      contract.String(),
+		CallbackCodeHash:  in.codeHash,
+		Msg:               action.Msg(t),
+		CallbackSignature: []byte("callback"),
+	}}}
+	return subMsg
+}
@@ -8,7 +8,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
-	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
@@ -33,7 +32,7 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
 	))
 
-	codeID, err := m.keeper.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder)
+	codeID, err := m.keeper.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder, msg.Schema)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +50,8 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 }
 
 func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInstantiateContract) (*types.MsgInstantiateContractResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, emitGasProfile := profileGas(sdk.UnwrapSDKContext(goCtx))
+	defer emitGasProfile()
 
 	var adminAddr sdk.AccAddress
 	var err error
@@ -79,7 +79,8 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 }
 
 func (m msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteContract) (*types.MsgExecuteContractResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, emitGasProfile := profileGas(sdk.UnwrapSDKContext(goCtx))
+	defer emitGasProfile()
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		sdk.EventTypeMessage,
@@ -105,7 +106,8 @@ func (m msgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateC
 		return nil, err
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, emitGasProfile := profileGas(sdk.UnwrapSDKContext(goCtx))
+	defer emitGasProfile()
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "sender")
@@ -217,9 +219,23 @@ func (m msgServer) UpgradeProposalPassed(goCtx context.Context, msg *types.MsgUp
 		sdk.NewAttribute("mrenclave", string(msg.MrEnclaveHash)),
 	))
 
-	if err := api.OnUpgradeProposalPassed(msg.MrEnclaveHash); err != nil {
+	if err := m.keeper.enclave.OnUpgradeProposalPassed(msg.MrEnclaveHash); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpgradeProposalPassedResponse{}, nil
 }
+
+// profileGas profiles the gas of the contract calls of simulated messages, and returns the function that emits the
+// calls as events when the message is done. Delivered messages aren't profiled, and messages that contracts send are
+// profiled by the profile of the message that called the contract
+func profileGas(ctx sdk.Context) (sdk.Context, func()) {
+	if ctx.ExecMode() != sdk.ExecModeSimulate || types.GasProfileFromContext(ctx) != nil {
+		return ctx, func() {}
+	}
+
+	profile := &types.GasProfile{}
+	return types.WithGasProfile(ctx, profile), func() {
+		ctx.EventManager().EmitEvents(profile.Events())
+	}
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+)
+
+// Option configures a Keeper
+type Option func(*Keeper)
+
+// WithEnclave makes the keeper run contracts on the given backend rather than on the enclave that this binary
+// links, like the in-memory mock of go-cosmwasm/api/mock in tests
+func WithEnclave(enclave api.Backend) Option {
+	return func(k *Keeper) {
+		k.enclave = enclave
+	}
+}
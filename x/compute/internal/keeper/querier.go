@@ -135,6 +135,22 @@ func (q GrpcQuerier) Codes(c context.Context, _ *empty.Empty) (*types.QueryCodes
 	return &types.QueryCodesResponse{CodeInfos: response}, nil
 }
 
+func (q GrpcQuerier) CodeSchema(c context.Context, req *types.QueryByCodeIdRequest) (*types.QueryCodeSchemaResponse, error) {
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+
+	schema, schemaHash, err := q.keeper.GetCodeSchema(sdk.UnwrapSDKContext(c), req.CodeId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, err.Error())
+	}
+
+	return &types.QueryCodeSchemaResponse{
+		SchemaHash: hex.EncodeToString(schemaHash),
+		Schema:     string(schema),
+	}, nil
+}
+
 func (q GrpcQuerier) CodeHashByContractAddress(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryCodeHashResponse, error) {
 	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
 	if err != nil {
@@ -269,11 +285,12 @@ func queryCode(ctx sdk.Context, codeId uint64, keeper Keeper) (*types.QueryCodeR
 	}
 
 	info := types.CodeInfoResponse{
-		CodeId:   codeId,
-		Creator:  codeInfo.Creator.String(),
-		CodeHash: hex.EncodeToString(codeInfo.CodeHash),
-		Source:   codeInfo.Source,
-		Builder:  codeInfo.Builder,
+		CodeId:     codeId,
+		Creator:    codeInfo.Creator.String(),
+		CodeHash:   hex.EncodeToString(codeInfo.CodeHash),
+		Source:     codeInfo.Source,
+		Builder:    codeInfo.Builder,
+		SchemaHash: hex.EncodeToString(codeInfo.SchemaHash),
 	}
 
 	wasmBz, err := keeper.GetWasm(ctx, codeId)
@@ -291,11 +308,12 @@ func queryCodeList(ctx sdk.Context, keeper Keeper) ([]types.CodeInfoResponse, er
 	var info []types.CodeInfoResponse
 	keeper.IterateCodeInfos(ctx, func(codeId uint64, res types.CodeInfo) bool {
 		info = append(info, types.CodeInfoResponse{
-			CodeId:   codeId,
-			Creator:  res.Creator.String(),
-			CodeHash: hex.EncodeToString(res.CodeHash),
-			Source:   res.Source,
-			Builder:  res.Builder,
+			CodeId:     codeId,
+			Creator:    res.Creator.String(),
+			CodeHash:   hex.EncodeToString(res.CodeHash),
+			Source:     res.Source,
+			Builder:    res.Builder,
+			SchemaHash: hex.EncodeToString(res.SchemaHash),
 		})
 		return false
 	})
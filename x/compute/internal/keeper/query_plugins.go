@@ -308,10 +308,10 @@ func IBCQuerier(wasm *Keeper, channelKeeper types.ChannelKeeper) func(ctx sdk.Co
 				portID = contractInfo.IBCPortID
 			}
 			got, found := channelKeeper.GetChannel(ctx, portID, channelID)
-			var channel *wasmTypes.IBCChannel
-			// it must be in open state
-			if found && got.State == channeltypes.OPEN {
-				channel = &wasmTypes.IBCChannel{
+			res := wasmTypes.ChannelResponse{}
+			// it must be in open state, or being upgraded from an open state
+			if found && (got.State == channeltypes.OPEN || got.State == channeltypes.FLUSHING || got.State == channeltypes.FLUSHCOMPLETE) {
+				res.Channel = &wasmTypes.IBCChannel{
 					Endpoint: wasmTypes.IBCEndpoint{
 						PortID:    portID,
 						ChannelID: channelID,
@@ -324,9 +324,16 @@ func IBCQuerier(wasm *Keeper, channelKeeper types.ChannelKeeper) func(ctx sdk.Co
 					Version:      got.Version,
 					ConnectionID: got.ConnectionHops[0],
 				}
-			}
-			res := wasmTypes.ChannelResponse{
-				Channel: channel,
+				res.State = got.State.String()
+				res.UpgradeSequence = got.UpgradeSequence
+
+				if upgrade, found := channelKeeper.GetUpgrade(ctx, portID, channelID); found {
+					res.Upgrade = &wasmTypes.IBCChannelUpgradeFields{
+						Order:        upgrade.Fields.Ordering.String(),
+						Version:      upgrade.Fields.Version,
+						ConnectionID: upgrade.Fields.ConnectionHops[0],
+					}
+				}
 			}
 			return json.Marshal(res)
 		}
@@ -29,6 +29,17 @@ func (k Keeper) ibcContractCall(ctx sdk.Context,
 
 	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
 
+	return k.ibcContractCallWithSigInfo(ctx, contractAddress, msgBz, callType, sigInfo)
+}
+
+// ibcContractCallWithSigInfo calls the contract with sigInfo instead of the signature info of the tx of ctx, for the
+// calls that don't run in a tx
+func (k Keeper) ibcContractCallWithSigInfo(ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	msgBz []byte,
+	callType wasmTypes.HandleType,
+	sigInfo wasmTypes.SigInfo,
+) (interface{}, error) {
 	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return "", err
@@ -180,6 +191,49 @@ func (k Keeper) OnCloseChannel(
 	return nil
 }
 
+// OnUpgradeChannel calls the contract's `sudo` entry point to let it take part in an ibc-go channel upgrade.
+// During the init, try and ack steps the contract rejects the upgrade by returning an error.
+// See https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics/UPGRADES.md
+func (k Keeper) OnUpgradeChannel(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	msg v1types.IBCChannelUpgradeMsg,
+) error {
+	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "ibc-upgrade-channel")
+
+	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading Compute module: ibc-upgrade-channel")
+
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		return errorsmod.Wrap(err, "ibc-upgrade-channel")
+	}
+
+	var res interface{}
+	if msg.IBCChannelUpgrade.Init != nil {
+		// only the authority of the IBC module starts an upgrade, in a governance proposal that runs in the end blocker
+		// and not in a tx. Like replies, the call is marked as not signed with SIGN_MODE_UNSPECIFIED
+		res, err = k.ibcContractCallWithSigInfo(ctx, contractAddress, msgBz, wasmTypes.HandleTypeIbcChannelUpgrade, wasmTypes.SigInfo{
+			TxBytes:   []byte{},
+			SignBytes: []byte{},
+			ModeInfo:  []byte{},
+			PublicKey: []byte{},
+			Signature: []byte{},
+			SignMode:  sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED.String(),
+		})
+	} else {
+		res, err = k.ibcContractCall(ctx, contractAddress, msgBz, wasmTypes.HandleTypeIbcChannelUpgrade)
+	}
+	if err != nil {
+		return errorsmod.Wrap(types.ErrExecuteFailed, err.Error())
+	}
+
+	err = k.parseThenHandleIBCBasicContractResponse(ctx, contractAddress, msgBz, res)
+	if err != nil {
+		return errorsmod.Wrap(err, "ibc-upgrade-channel")
+	}
+	return nil
+}
+
 // OnRecvPacket calls the contract to process the incoming IBC packet. The contract fully owns the data processing and
 // returns the acknowledgement data for the chain level. This allows custom applications and protocols on top
 // of IBC. Although it is recommended to use the standard acknowledgement envelope defined in
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// setSchema stores the JSON schema of the messages of a code and returns its hash, which the code info references it by.
+// Codes with the same schema share it
+func (k Keeper) setSchema(ctx sdk.Context, schema []byte) ([]byte, error) {
+	schemaHash := sha256.Sum256(schema)
+
+	store := k.storeService.OpenKVStore(ctx)
+	// 0x0C | schemaHash -> schema
+	if err := store.Set(types.GetCodeSchemaKey(schemaHash[:]), schema); err != nil {
+		return nil, err
+	}
+	return schemaHash[:], nil
+}
+
+// GetCodeSchema returns the JSON schema of a code and its hash, which are nil if the code was stored without a schema
+func (k Keeper) GetCodeSchema(ctx sdk.Context, codeID uint64) (schema []byte, schemaHash []byte, err error) {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(codeInfo.SchemaHash) == 0 {
+		return nil, nil, nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	schema, err = store.Get(types.GetCodeSchemaKey(codeInfo.SchemaHash))
+	if err != nil {
+		return nil, nil, err
+	}
+	if schema == nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrNotFound, "schema of code %d", codeID)
+	}
+	return schema, codeInfo.SchemaHash, nil
+}
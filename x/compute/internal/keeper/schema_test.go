@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api/mock"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestCodeSchema(t *testing.T) {
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("counter"), mockCounter{})
+	enclave.AddContract([]byte("counter v2"), mockCounter{})
+
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil, WithEnclave(enclave))
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, _, _ := CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+
+	// codes are stored without a schema
+	codeID, err := keeper.Create(ctx, creator, []byte("counter"), "", "", nil)
+	require.NoError(t, err)
+	schema, schemaHash, err := keeper.GetCodeSchema(ctx, codeID)
+	require.NoError(t, err)
+	require.Nil(t, schema)
+	require.Nil(t, schemaHash)
+
+	schema = []byte(`{"execute":{"type":"object"}}`)
+	expectedHash := sha256.Sum256(schema)
+	res, err := NewMsgServerImpl(keeper).StoreCode(ctx, &types.MsgStoreCode{
+		Sender:       creator,
+		WASMByteCode: []byte("counter v2"),
+		Schema:       schema,
+	})
+	require.NoError(t, err)
+	codeID = res.CodeID
+
+	storedSchema, schemaHash, err := keeper.GetCodeSchema(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, schema, storedSchema)
+	require.Equal(t, expectedHash[:], schemaHash)
+
+	q := NewGrpcQuerier(keeper)
+	schemaRes, err := q.CodeSchema(ctx, &types.QueryByCodeIdRequest{CodeId: codeID})
+	require.NoError(t, err)
+	require.Equal(t, string(schema), schemaRes.Schema)
+	require.Equal(t, hex.EncodeToString(expectedHash[:]), schemaRes.SchemaHash)
+
+	codeRes, err := q.Code(ctx, &types.QueryByCodeIdRequest{CodeId: codeID})
+	require.NoError(t, err)
+	require.Equal(t, schemaRes.SchemaHash, codeRes.SchemaHash)
+
+	_, err = q.CodeSchema(ctx, &types.QueryByCodeIdRequest{CodeId: codeID + 1})
+	require.Error(t, err)
+
+	// schemas survive genesis
+	genesis := ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.Codes, 2)
+	require.Nil(t, genesis.Codes[0].Schema)
+	require.Equal(t, schema, genesis.Codes[1].Schema)
+	require.NoError(t, genesis.ValidateBasic())
+}
+
+func TestCodeSchemaUpgrade(t *testing.T) {
+	enclave := mock.NewBackend()
+	enclave.AddContract([]byte("counter"), mockCounter{})
+
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil, WithEnclave(enclave))
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, _, _ := CreateFakeFundedAccount(ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+	schema := []byte(`{"execute":{"type":"object"}}`)
+
+	// before the upgrade the params have no max schema size, so code can't be stored with a schema
+	params := keeper.GetParams(ctx)
+	params.MaxSchemaSize = 0
+	require.NoError(t, keeper.SetParams(ctx, params))
+	_, err := keeper.Create(ctx, creator, []byte("counter"), "", "", schema)
+	require.ErrorIs(t, err, types.ErrExceedMaxSchemaSize)
+	codeID, err := keeper.Create(ctx, creator, []byte("counter"), "", "", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, NewMigrator(keeper).Migrate7to8(ctx))
+	params = keeper.GetParams(ctx)
+	require.Equal(t, uint64(types.DefaultMaxSchemaSize), params.MaxSchemaSize)
+	require.Equal(t, types.DefaultSchemaCost, params.SchemaCost)
+
+	// the schema costs gas per byte on top of the code
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = keeper.Create(ctx, creator, []byte("counter"), "", "", nil)
+	require.NoError(t, err)
+	gasWithoutSchema := ctx.GasMeter().GasConsumed()
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	schemaCodeID, err := keeper.Create(ctx, creator, []byte("counter"), "", "", schema)
+	require.NoError(t, err)
+	schemaGas := uint64(params.SchemaCost.MulInt64(int64(len(schema))).RoundInt64())
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), gasWithoutSchema+schemaGas)
+
+	// code stored before the upgrade stays without a schema
+	storedSchema, _, err := keeper.GetCodeSchema(ctx, codeID)
+	require.NoError(t, err)
+	require.Nil(t, storedSchema)
+	storedSchema, _, err = keeper.GetCodeSchema(ctx, schemaCodeID)
+	require.NoError(t, err)
+	require.Equal(t, schema, storedSchema)
+
+	_, err = keeper.Create(ctx, creator, []byte("counter"), "", "", make([]byte, types.DefaultMaxSchemaSize+1))
+	require.ErrorIs(t, err, types.ErrExceedMaxSchemaSize)
+}
@@ -1506,7 +1506,7 @@ func TestWasmMsgStructure(t *testing.T) {
 											wasmCode, err := os.ReadFile(to.WasmFilePath)
 											require.NoError(t, err)
 
-											toCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+											toCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 											codeInfo, err := keeper.GetCodeInfo(ctx, toCodeID)
 											require.NoError(t, err)
 											toCodeHash := hex.EncodeToString(codeInfo.CodeHash)
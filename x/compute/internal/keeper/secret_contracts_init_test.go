@@ -538,7 +538,7 @@ func TestWasmTooHighInitialMemoryStaticFail(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[staticTooHighMemoryContract])
 	require.NoError(t, err)
 
-	_, err = keeper.Create(ctx, walletA, wasmCode, "", "")
+	_, err = keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Error during static Wasm validation: Wasm contract memory's minimum must not exceed 512 pages")
 }
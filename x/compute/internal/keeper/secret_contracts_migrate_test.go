@@ -1458,7 +1458,7 @@ func TestWasmMsgStructureAfterMigrate(t *testing.T) {
 											wasmCode, err := os.ReadFile(to.WasmFilePath)
 											require.NoError(t, err)
 
-											toCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+											toCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 											codeInfo, err := keeper.GetCodeInfo(ctx, toCodeID)
 											require.NoError(t, err)
 											toCodeHash := hex.EncodeToString(codeInfo.CodeHash)
@@ -3810,7 +3810,7 @@ func TestWasmMsgStructureDuringMigrate(t *testing.T) {
 											wasmCode, err := os.ReadFile(to.WasmFilePathBefore)
 											require.NoError(t, err)
 
-											toCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+											toCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 											codeInfo, err := keeper.GetCodeInfo(ctx, toCodeID)
 											require.NoError(t, err)
 											toCodeHash := hex.EncodeToString(codeInfo.CodeHash)
@@ -5507,7 +5507,7 @@ func TestV1QueryV010ContractAfterMigrate(t *testing.T) {
 			wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 			require.NoError(t, err)
 
-			v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+			v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 			require.NoError(t, err)
 
 			codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -5545,7 +5545,7 @@ func TestV1QueryV010ContractDuringMigrate(t *testing.T) {
 			wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 			require.NoError(t, err)
 
-			v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+			v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 			require.NoError(t, err)
 
 			codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
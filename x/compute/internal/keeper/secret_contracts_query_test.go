@@ -704,7 +704,7 @@ func TestV1QueryV010Contract(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
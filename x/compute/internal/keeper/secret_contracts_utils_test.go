@@ -90,7 +90,7 @@ func uploadCode(ctx sdk.Context, t *testing.T, keeper Keeper, wasmPath string, w
 	wasmCode, err := os.ReadFile(wasmPath)
 	require.NoError(t, err)
 
-	codeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	codeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
@@ -110,7 +110,7 @@ func uploadChainCode(ctx sdk.Context, t *testing.T, keeper Keeper, wasmPath stri
 
 	require.NoError(t, err)
 
-	codeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	codeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
@@ -21,7 +21,7 @@ func TestV1InitV010ContractWithReply(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -52,7 +52,7 @@ func TestV1ExecuteV010ContractWithReply(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -78,7 +78,7 @@ func TestV1InitV010ContractNoReply(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -119,7 +119,7 @@ func TestV1ExecuteV010ContractNoReply(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -150,7 +150,7 @@ func TestV1InitV010ContractWithReplyWithError(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -171,7 +171,7 @@ func TestV1ExecuteV010ContractWithReplyWithError(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -196,7 +196,7 @@ func TestV1InitV010ContractNoReplyWithError(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -218,7 +218,7 @@ func TestV1ExecuteV010ContractNoReplyWithError(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -244,7 +244,7 @@ func TestV1QueryV010ContractWithError(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -270,7 +270,7 @@ func TestV010InitV1ContractFromInitWithOkResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, initEvents, err := initHelper(t, keeper, ctx, v010CodeID, walletA, nil, privKeyA, fmt.Sprintf(`{"callback_to_init":{"code_id":%d, "code_hash":"%s"}}`, codeID, codeHash), true, true, defaultGasForTests)
@@ -303,7 +303,7 @@ func TestV010InitV1ContractFromExecuteWithOkResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, v010CodeID, walletA, nil, privKeyA, `{"nop":{}}`, true, false, defaultGasForTests)
@@ -341,7 +341,7 @@ func TestV010ExecuteV1ContractFromInitWithOkResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":199, "expires":100}}`, true, true, defaultGasForTests)
@@ -365,7 +365,7 @@ func TestV010ExecuteV1ContractFromExecuteWithOkResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":299, "expires":100}}`, true, true, defaultGasForTests)
@@ -390,7 +390,7 @@ func TestV010QueryV1ContractFromInitWithOkResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
@@ -413,7 +413,7 @@ func TestV010QueryV1ContractFromExecuteWithOkResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
@@ -438,7 +438,7 @@ func TestV010InitV1ContractFromInitWithErrResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, _, _, err = initHelper(t, keeper, ctx, v010CodeID, walletA, nil, privKeyA, fmt.Sprintf(`{"call_to_init":{"code_id":%d, "code_hash":"%s","label":"blabla", "msg":"%s"}}`, codeID, codeHash, `{\"counter\":{\"counter\":0, \"expires\":100}}`), true, true, defaultGasForTests)
@@ -451,7 +451,7 @@ func TestV010InitV1ContractFromExecuteWithErrResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, v010CodeID, walletA, nil, privKeyA, `{"nop":{}}`, true, false, defaultGasForTests)
@@ -471,7 +471,7 @@ func TestV010ExecuteV1ContractFromInitWithErrResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":199, "expires":100}}`, true, true, defaultGasForTests)
@@ -486,7 +486,7 @@ func TestV010ExecuteV1ContractFromExecuteWithErrResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":299, "expires":100}}`, true, true, defaultGasForTests)
@@ -502,7 +502,7 @@ func TestV010QueryV1ContractFromInitWithErrResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
@@ -516,7 +516,7 @@ func TestV010QueryV1ContractFromExecuteWithErrResponse(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
@@ -532,7 +532,7 @@ func TestV1SendsLogsMixedWithV010WithoutReply(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -594,7 +594,7 @@ func TestV1SendsLogsMixedWithV010WithReply(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	codeInfo, err := keeper.GetCodeInfo(ctx, v010CodeID)
@@ -680,7 +680,7 @@ func TestV010SendsLogsMixedWithV1(t *testing.T) {
 	wasmCode, err := os.ReadFile(TestContractPaths[v010Contract])
 	require.NoError(t, err)
 
-	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	v010CodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "", nil)
 	require.NoError(t, err)
 
 	_, _, v010ContractAddress, _, err := initHelper(t, keeper, ctx, v010CodeID, walletA, nil, privKeyA, `{"nop":{}}`, true, false, defaultGasForTests)
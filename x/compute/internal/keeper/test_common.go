@@ -20,7 +20,7 @@ import (
 	authz "github.com/cosmos/cosmos-sdk/x/authz/module"
 
 	"github.com/cosmos/gogoproto/proto"
-	// "github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	scrt "github.com/scrtlabs/SecretNetwork/types"
 
 	cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
@@ -40,6 +40,7 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 
 	ibchost "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
@@ -235,6 +236,7 @@ var ModuleBasics = module.NewBasicManager(
 	upgrade.AppModuleBasic{},
 	evidence.AppModuleBasic{},
 	transfer.AppModuleBasic{},
+	ibc.AppModuleBasic{},
 	vesting.AppModuleBasic{},
 	feegrantmodule.AppModuleBasic{},
 
@@ -293,6 +295,7 @@ type TestKeepers struct {
 	GovKeeper     govkeeper.Keeper
 	BankKeeper    bankkeeper.Keeper
 	MintKeeper    mintkeeper.Keeper
+	IBCKeeper     *ibckeeper.Keeper
 }
 
 var TestConfig = TestConfigType{
@@ -303,8 +306,31 @@ type TestConfigType struct {
 	ChainID string
 }
 
-// encoders can be nil to accept the defaults, or set it to override some of the message handlers (like default)
-func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, encoders *MessageEncoders, queriers *QueryPlugins) (sdk.Context, TestKeepers) {
+// TestEnclave is the enclave that CreateTestInput runs contracts on when a test doesn't pick one with WithEnclave.
+// It is nil on machines without the SGX enclave, and then the tests that need the enclave fail, unless
+// SkipEnclaveTestsEnv is set to TRUE to run the tests on the mock enclave alone
+var TestEnclave api.Backend = api.Enclave{}
+
+// SkipEnclaveTestsEnv is the environment variable that opts in to skipping the tests that need the SGX enclave
+const SkipEnclaveTestsEnv = "SKIP_ENCLAVE_TESTS"
+
+// encoders can be nil to accept the defaults, or set it to override some of the message handlers (like default).
+// opts are passed to the keeper, e.g. WithEnclave to run contracts on the mock enclave backend
+func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, encoders *MessageEncoders, queriers *QueryPlugins, opts ...Option) (sdk.Context, TestKeepers) {
+	var picked Keeper
+	for _, opt := range opts {
+		opt(&picked)
+	}
+	if picked.enclave == nil {
+		if TestEnclave == nil {
+			if os.Getenv(SkipEnclaveTestsEnv) == "TRUE" {
+				t.Skip("this test runs contracts on the SGX enclave, which isn't available")
+			}
+			t.Fatalf("this test runs contracts on the SGX enclave, which isn't available. Set %s=TRUE to skip it", SkipEnclaveTestsEnv)
+		}
+		opts = append([]Option{WithEnclave(TestEnclave)}, opts...)
+	}
+
 	tempDir, err := os.MkdirTemp("", "wasm")
 	require.NoError(t, err)
 	t.Cleanup(func() { os.RemoveAll(tempDir) })
@@ -596,6 +622,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		queriers,
 		&bappTxMngr,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		opts...,
 	)
 	// keeper.setParams(ctx, wasmtypes.DefaultParams())
 	// add wasm handler so we can loop-back (contracts calling contracts)
@@ -626,6 +653,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		GovKeeper:     *govKeeper,
 		BankKeeper:    bankKeeper,
 		MintKeeper:    mintKeeper,
+		IBCKeeper:     ibcKeeper,
 	}
 
 	return ctx, keepers
@@ -1063,70 +1091,6 @@ func MakeCommit(blockID tmtypes.BlockID, height int64, round int32, valSet *tmty
 	return &tmtypes.Commit{Height: height, Round: round, BlockID: blockID, Signatures: sigs}, nil
 }
 
-/*
-func updateLightClientHelper(t *testing.T, ctx sdk.Context) {
-	blockData := tmproto.Data{
-		Txs: [][]byte{ctx.TxBytes()},
-	}
-	dataBz, err := blockData.Marshal()
-	require.NoError(t, err)
-
-	blockHeader := ctx.BlockHeader()
-
-	blockId := makeBlockIDRandom()
-
-	valSet, privValidators := tmtypes.RandValidatorSet(1, 1)
-	commit, err := MakeCommit(blockId, blockHeader.Height, 0, valSet, privValidators, ctx.ChainID(), time.Now())
-	require.NoError(t, err)
-
-	commitBz, err := commit.ToProto().Marshal()
-	require.NoError(t, err)
-
-	blockHeader.ProposerAddress = valSet.Proposer.Address
-
-	blockHeader.DataHash = tmtypes.Txs{ctx.TxBytes()}.Hash()
-	blockHeader.AppHash = make([]byte, sha256.Size) // make it up just to pass the length check
-	blockHeader.ValidatorsHash = valSet.Hash()      // unnecessary really
-
-	headerBz, err := blockHeader.Marshal()
-	require.NoError(t, err)
-
-	valSetProto, err := valSet.ToProto()
-	require.NoError(t, err)
-
-	valSetBytes, err := valSetProto.Marshal()
-	require.NoError(t, err)
-
-	// Note: SubmitValidatorSet must come before GetRandom, as the valSetHash is used
-	// in the random number encryption, and later on in the verification
-	err = tmenclave.SubmitValidatorSet(valSetBytes, uint64(blockHeader.Height))
-	require.NoError(t, err)
-
-	random, proof, err := tmenclave.GetRandom(blockHeader.AppHash, uint64(blockHeader.Height))
-	require.NoError(t, err)
-
-	randomAndProofBz := append(random, proof...)
-
-	_, _, err = api.SubmitBlockSignatures(headerBz, commitBz, dataBz, randomAndProofBz)
-	require.NoError(t, err)
-}*/
-
-func makeBlockIDRandom() tmtypes.BlockID {
-	var (
-		blockHash   = make([]byte, sha256.Size)
-		partSetHash = make([]byte, sha256.Size)
-	)
-	rand.Read(blockHash)
-	rand.Read(partSetHash)
-	return tmtypes.BlockID{
-		Hash: blockHash,
-		PartSetHeader: tmtypes.PartSetHeader{
-			Total: 123,
-			Hash:  partSetHash,
-		},
-	}
-}
-
 func txhash(t *testing.T, ctx sdk.Context) string {
 	require.NotEmpty(t, ctx.TxBytes())
 	txhashBz := sha256.Sum256(ctx.TxBytes())
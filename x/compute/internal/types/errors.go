@@ -78,6 +78,9 @@ var (
 
 	// ErrExceedMaxContractSize error if max contract size is exceeded
 	ErrExceedMaxContractSize = errors.Register(DefaultCodespace, 31, "max contract size exceeded")
+
+	// ErrExceedMaxSchemaSize error if max schema size is exceeded
+	ErrExceedMaxSchemaSize = errors.Register(DefaultCodespace, 32, "max schema size exceeded")
 )
 
 func IsEncryptedErrorCode(code uint32) bool {
@@ -22,4 +22,5 @@ type ChannelKeeper interface {
 	GetAllChannels(ctx sdk.Context) (channels []channeltypes.IdentifiedChannel)
 	IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
 	SetChannel(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
+	GetUpgrade(ctx sdk.Context, portID, channelID string) (channeltypes.Upgrade, bool)
 }
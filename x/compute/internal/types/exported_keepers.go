@@ -23,6 +23,11 @@ type IBCContractKeeper interface {
 		contractAddr sdk.AccAddress,
 		msg v1types.IBCChannelCloseMsg,
 	) error
+	OnUpgradeChannel(
+		ctx sdk.Context,
+		contractAddr sdk.AccAddress,
+		msg v1types.IBCChannelUpgradeMsg,
+	) error
 	OnRecvPacket(
 		ctx sdk.Context,
 		contractAddr sdk.AccAddress,
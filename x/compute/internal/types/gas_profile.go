@@ -0,0 +1,104 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The kinds of the calls of a GasProfile
+const (
+	GasProfileInstantiate = "instantiate"
+	GasProfileExecute     = "execute"
+	GasProfileMigrate     = "migrate"
+	GasProfileReply       = "reply"
+	GasProfileSubMsg      = "submsg"
+	GasProfileQuery       = "query"
+)
+
+// EventTypeGasProfile is the type of the events of the calls of a GasProfile, which only simulations emit
+const EventTypeGasProfile = "compute_gas"
+
+// event attributes of the calls of a GasProfile
+const (
+	AttributeKeyCallKind  = "kind"
+	AttributeKeyCallDepth = "depth"
+	AttributeKeyGasUsed   = "gas_used"
+)
+
+// GasProfile records the gas used by the contract calls, sub-messages and contract queries of a simulated message.
+// Calls are recorded in the order they start, and the gas they use includes the gas of the calls they make
+type GasProfile struct {
+	Calls []GasProfileCall
+	// open are the indexes of the calls that haven't ended, innermost last
+	open []int
+}
+
+type GasProfileCall struct {
+	Kind     string `json:"kind"`
+	Contract string `json:"contract_address"`
+	// Depth is the number of calls that the call was made by
+	Depth   uint32 `json:"depth"`
+	GasUsed uint64 `json:"gas_used"`
+}
+
+type gasProfileKey struct{}
+
+// WithGasProfile stores a gas profile in the context, which the calls of the context record their gas in
+func WithGasProfile(ctx sdk.Context, profile *GasProfile) sdk.Context {
+	return ctx.WithValue(gasProfileKey{}, profile)
+}
+
+// GasProfileFromContext returns the gas profile of the context, or nil if it has none
+func GasProfileFromContext(ctx sdk.Context) *GasProfile {
+	profile, _ := ctx.Value(gasProfileKey{}).(*GasProfile)
+	return profile
+}
+
+// Start records the start of a call of a contract, and returns the function that records its end, with the gas that
+// the gas meter of ctx used since. The gas is capped at the limit of the meter, so a call that runs out of the gas limit
+// of its sub-message uses no more gas than the sub-message. Profiles that are nil record nothing
+func (p *GasProfile) Start(ctx sdk.Context, kind string, contract sdk.AccAddress) func() {
+	if p == nil {
+		return func() {}
+	}
+
+	i := len(p.Calls)
+	call := GasProfileCall{Kind: kind, Depth: uint32(len(p.open))}
+	if contract != nil {
+		call.Contract = contract.String()
+	}
+	p.Calls = append(p.Calls, call)
+	p.open = append(p.open, i)
+
+	meter := ctx.GasMeter()
+	start := meter.GasConsumedToLimit()
+	return func() {
+		p.open = p.open[:len(p.open)-1]
+		p.Calls[i].GasUsed = meter.GasConsumedToLimit() - start
+	}
+}
+
+// SetContract sets the contract of the innermost call that hasn't ended, for calls that only know their contract
+// after they start, like instantiations
+func (p *GasProfile) SetContract(contract sdk.AccAddress) {
+	if p == nil || len(p.open) == 0 {
+		return
+	}
+	p.Calls[p.open[len(p.open)-1]].Contract = contract.String()
+}
+
+// Events returns the calls of the profile as events, in order
+func (p *GasProfile) Events() sdk.Events {
+	events := make(sdk.Events, len(p.Calls))
+	for i, call := range p.Calls {
+		events[i] = sdk.NewEvent(
+			EventTypeGasProfile,
+			sdk.NewAttribute(AttributeKeyCallKind, call.Kind),
+			sdk.NewAttribute(AttributeKeyContractAddr, call.Contract),
+			sdk.NewAttribute(AttributeKeyCallDepth, strconv.FormatUint(uint64(call.Depth), 10)),
+			sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(call.GasUsed, 10)),
+		)
+	}
+	return events
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasProfile(t *testing.T) {
+	forwarder := sdk.AccAddress(strings.Repeat("f", 20))
+	counter := sdk.AccAddress(strings.Repeat("c", 20))
+	ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	meter := ctx.GasMeter()
+
+	profile := &GasProfile{}
+	ctx = WithGasProfile(ctx, profile)
+	require.Same(t, profile, GasProfileFromContext(ctx))
+
+	meter.ConsumeGas(1000, "before")
+	endExecute := profile.Start(ctx, GasProfileExecute, forwarder)
+	meter.ConsumeGas(10, "execute")
+	endSubMsg := profile.Start(ctx, GasProfileSubMsg, forwarder)
+	meter.ConsumeGas(20, "submsg")
+	endInstantiate := profile.Start(ctx, GasProfileInstantiate, nil)
+	meter.ConsumeGas(30, "instantiate")
+	// the contract of an instantiation is only known once it's created
+	profile.SetContract(counter)
+	endInstantiate()
+	endSubMsg()
+	endReply := profile.Start(ctx, GasProfileReply, forwarder)
+	meter.ConsumeGas(40, "reply")
+	endReply()
+	endExecute()
+	meter.ConsumeGas(1000, "after")
+
+	// calls are in the order they started, and their gas includes the gas of the calls they made
+	require.Equal(t, []GasProfileCall{
+		{Kind: GasProfileExecute, Contract: forwarder.String(), Depth: 0, GasUsed: 100},
+		{Kind: GasProfileSubMsg, Contract: forwarder.String(), Depth: 1, GasUsed: 50},
+		{Kind: GasProfileInstantiate, Contract: counter.String(), Depth: 2, GasUsed: 30},
+		{Kind: GasProfileReply, Contract: forwarder.String(), Depth: 1, GasUsed: 40},
+	}, profile.Calls)
+
+	// without open calls there is no call to set the contract of
+	profile.SetContract(forwarder)
+	require.Equal(t, counter.String(), profile.Calls[2].Contract)
+
+	events := profile.Events()
+	require.Len(t, events, 4)
+	require.Equal(t, sdk.NewEvent(EventTypeGasProfile,
+		sdk.NewAttribute(AttributeKeyCallKind, GasProfileInstantiate),
+		sdk.NewAttribute(AttributeKeyContractAddr, counter.String()),
+		sdk.NewAttribute(AttributeKeyCallDepth, "2"),
+		sdk.NewAttribute(AttributeKeyGasUsed, "30"),
+	), events[2])
+}
+
+func TestGasProfileOutOfGas(t *testing.T) {
+	profile := &GasProfile{}
+	ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	endSubMsg := profile.Start(ctx, GasProfileSubMsg, nil)
+	limitedCtx := ctx.WithGasMeter(storetypes.NewGasMeter(100))
+	require.Panics(t, func() {
+		defer profile.Start(limitedCtx, GasProfileExecute, nil)()
+		limitedCtx.GasMeter().ConsumeGas(150, "execute")
+	})
+	// the parent is charged the whole gas limit, like a sub-message that runs out of gas
+	ctx.GasMeter().ConsumeGas(100, "limit")
+	endSubMsg()
+
+	require.Equal(t, uint64(100), profile.Calls[0].GasUsed)
+	require.Equal(t, uint64(100), profile.Calls[1].GasUsed)
+}
+
+func TestGasProfileNil(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.Nil(t, GasProfileFromContext(ctx))
+
+	// contexts without a profile record nothing
+	var profile *GasProfile
+	end := GasProfileFromContext(ctx).Start(ctx, GasProfileExecute, nil)
+	ctx.GasMeter().ConsumeGas(10, "execute")
+	end()
+	profile.SetContract(sdk.AccAddress("contract"))
+	require.Nil(t, profile)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -41,6 +44,17 @@ func (c Code) ValidateBasic() error {
 	if err := validateWasmCode(c.CodeBytes); err != nil {
 		return errors.Wrap(err, "code bytes")
 	}
+	if err := validateSchema(c.Schema); err != nil {
+		return errors.Wrap(err, "schema")
+	}
+	if (len(c.Schema) == 0) != (len(c.CodeInfo.SchemaHash) == 0) {
+		return errors.Wrap(ErrInvalid, "schema and schema hash must be set together")
+	}
+	if len(c.Schema) != 0 {
+		if schemaHash := sha256.Sum256(c.Schema); !bytes.Equal(schemaHash[:], c.CodeInfo.SchemaHash) {
+			return errors.Wrap(ErrInvalid, "schema hash does not match the schema")
+		}
+	}
 	return nil
 }
 
@@ -99,6 +99,7 @@ type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 	CodeInfo  CodeInfo `protobuf:"bytes,2,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
 	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	Schema    []byte   `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -155,6 +156,13 @@ func (m *Code) GetCodeBytes() []byte {
 	return nil
 }
 
+func (m *Code) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"contract_address,omitempty"`
@@ -289,43 +297,44 @@ func init() {
 }
 
 var fileDescriptor_e737d858048ffc2a = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0x2e, 0x2d, 0x9d, 0x57, 0x18, 0x32, 0x15, 0x44, 0x85, 0xa5, 0x51, 0xb7, 0x43,
-	0x85, 0x58, 0xa3, 0x8e, 0x1b, 0xe2, 0xb2, 0x74, 0x12, 0x2a, 0x15, 0x7f, 0x94, 0x72, 0x82, 0x49,
-	0x55, 0xea, 0xbc, 0x2b, 0x51, 0x9b, 0xb8, 0xc4, 0xee, 0x20, 0x9f, 0x02, 0x3e, 0xd6, 0x8e, 0x93,
-	0xb8, 0x70, 0xaa, 0x50, 0x7b, 0xe3, 0x23, 0x70, 0x42, 0x76, 0xdc, 0x2c, 0x12, 0xeb, 0x76, 0x6a,
-	0xf3, 0xfa, 0x79, 0x7f, 0x7e, 0xfc, 0xf8, 0x35, 0x3a, 0x60, 0x40, 0x62, 0xe0, 0x36, 0xa1, 0xe1,
-	0x6c, 0xce, 0xc1, 0x3e, 0xef, 0x8c, 0x80, 0x7b, 0x1d, 0x7b, 0x0c, 0x11, 0xb0, 0x80, 0xb5, 0x67,
-	0x31, 0xe5, 0x14, 0x3f, 0x4c, 0x55, 0x6d, 0xa5, 0x6a, 0x2b, 0x55, 0xbd, 0x36, 0xa6, 0x63, 0x2a,
-	0x25, 0xb6, 0xf8, 0x97, 0xaa, 0xeb, 0xfb, 0x1b, 0x98, 0x33, 0x2f, 0xf6, 0x42, 0x85, 0xac, 0x37,
-	0x37, 0x88, 0x78, 0x32, 0x03, 0xa5, 0x69, 0xfe, 0x2c, 0xa2, 0xea, 0xab, 0xd4, 0xc8, 0x80, 0x7b,
-	0x1c, 0x70, 0x1f, 0x95, 0x08, 0xf5, 0x81, 0x19, 0x45, 0x6b, 0xab, 0xb5, 0x73, 0xf4, 0xa4, 0x7d,
-	0xbd, 0xaf, 0x76, 0x97, 0xfa, 0xe0, 0x3c, 0xba, 0x58, 0x34, 0x0a, 0x7f, 0x16, 0x8d, 0x5d, 0xd9,
-	0xf2, 0x8c, 0x86, 0x01, 0x87, 0x70, 0xc6, 0x13, 0x37, 0x65, 0xe0, 0x4f, 0x68, 0x9b, 0xd0, 0x88,
-	0xc7, 0x1e, 0xe1, 0xcc, 0xd8, 0x92, 0x40, 0x6b, 0x33, 0x30, 0x15, 0x3a, 0x8f, 0x15, 0xf4, 0x41,
-	0xd6, 0x9a, 0x03, 0x5f, 0xf1, 0x04, 0x9c, 0xc1, 0x97, 0x39, 0x44, 0x04, 0x98, 0xa1, 0xdf, 0x0c,
-	0x1f, 0x28, 0xe1, 0x15, 0x3c, 0x6b, 0xcd, 0xc3, 0xb3, 0x22, 0x7e, 0x89, 0xca, 0x69, 0x96, 0x46,
-	0xc9, 0xd2, 0x5a, 0x3b, 0x47, 0xe6, 0x26, 0xf2, 0x7b, 0xa9, 0x72, 0x74, 0xc1, 0x75, 0x55, 0x4f,
-	0xf3, 0xbb, 0x86, 0x74, 0x11, 0x10, 0xde, 0x47, 0x77, 0x44, 0x12, 0xc3, 0xc0, 0x37, 0x34, 0x4b,
-	0x6b, 0xe9, 0x0e, 0x5a, 0x2e, 0x1a, 0x65, 0xb1, 0xd4, 0x3b, 0x71, 0xcb, 0x62, 0xa9, 0xe7, 0xe3,
-	0xae, 0x48, 0x49, 0x88, 0xa2, 0x33, 0x6a, 0x14, 0xe5, 0x76, 0xd6, 0x4d, 0xb1, 0xf7, 0xa2, 0x33,
-	0xaa, 0x36, 0xac, 0x10, 0xf5, 0x8d, 0xf7, 0x10, 0x92, 0x90, 0x51, 0xc2, 0x41, 0x64, 0xad, 0xb5,
-	0xaa, 0xae, 0xc4, 0x3a, 0xa2, 0xd0, 0x5c, 0x15, 0x51, 0x65, 0x9d, 0x30, 0x3e, 0x45, 0xf7, 0xd7,
-	0x31, 0x0e, 0x3d, 0xdf, 0x8f, 0x81, 0x31, 0x69, 0xaf, 0xea, 0x74, 0xfe, 0x2e, 0x1a, 0x87, 0xe3,
-	0x80, 0x7f, 0x9e, 0x8f, 0xc4, 0xd6, 0x36, 0xa1, 0x2c, 0xa4, 0x4c, 0xfd, 0x1c, 0x32, 0x7f, 0xa2,
-	0x86, 0xe7, 0x98, 0x90, 0xe3, 0xb4, 0xd1, 0xdd, 0x5d, 0xa3, 0x54, 0x01, 0xbf, 0x43, 0x77, 0x33,
-	0x7a, 0xee, 0x48, 0x07, 0xb7, 0x5d, 0x7c, 0xee, 0x58, 0x55, 0x92, 0xab, 0xe1, 0xd7, 0xe8, 0x5e,
-	0x06, 0x64, 0x62, 0x48, 0xd5, 0x28, 0xed, 0x6d, 0x22, 0xbe, 0xa1, 0x3e, 0x4c, 0x15, 0x2a, 0xf3,
-	0x92, 0x8e, 0xf7, 0x29, 0xaa, 0x65, 0x2c, 0x32, 0x67, 0x9c, 0x86, 0xa9, 0x47, 0x5d, 0x7a, 0x7c,
-	0x7a, 0x9b, 0xc7, 0xae, 0x6c, 0x11, 0xae, 0x5c, 0x4c, 0xfe, 0xab, 0x35, 0x1d, 0x54, 0x59, 0x4f,
-	0x1a, 0xb6, 0x50, 0x39, 0xf0, 0x87, 0x13, 0x48, 0x54, 0xb4, 0xdb, 0xcb, 0x45, 0xa3, 0xd4, 0x3b,
-	0xe9, 0x43, 0xe2, 0x96, 0x02, 0xbf, 0x0f, 0x09, 0xae, 0xa1, 0xd2, 0xb9, 0x37, 0x9d, 0x83, 0x0c,
-	0x48, 0x77, 0xd3, 0x0f, 0xe7, 0xc3, 0xc5, 0xd2, 0xd4, 0x2e, 0x97, 0xa6, 0xf6, 0x7b, 0x69, 0x6a,
-	0x3f, 0x56, 0x66, 0xe1, 0x72, 0x65, 0x16, 0x7e, 0xad, 0xcc, 0xc2, 0xc7, 0x17, 0xb9, 0x8b, 0x61,
-	0x24, 0xe6, 0x53, 0x6f, 0xc4, 0xec, 0x81, 0x34, 0xfc, 0x16, 0xf8, 0x57, 0x1a, 0x4f, 0xec, 0x6f,
-	0xd9, 0x63, 0x0f, 0x22, 0x0e, 0x71, 0xe4, 0x4d, 0xd3, 0x0b, 0x1b, 0x95, 0xe5, 0x73, 0x7f, 0xfe,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x03, 0xc1, 0x17, 0x25, 0x8d, 0x04, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0xd4, 0xf1, 0xbf, 0x9d, 0xe6, 0x4f, 0xd1, 0x50, 0x15, 0xab, 0x50, 0xc7, 0x4a,
+	0xbb, 0x88, 0x10, 0x8d, 0xd5, 0xb2, 0x43, 0x6c, 0xea, 0x56, 0x42, 0x25, 0xe2, 0x43, 0x0e, 0x2b,
+	0xa8, 0x14, 0x39, 0xe3, 0xdb, 0xd4, 0x4a, 0xec, 0x09, 0x9e, 0x49, 0xc1, 0x6f, 0xc1, 0x5b, 0xf0,
+	0x2a, 0x5d, 0x56, 0x62, 0xc3, 0x2a, 0x42, 0xc9, 0x8e, 0x47, 0x60, 0x85, 0xe6, 0x23, 0xae, 0x25,
+	0x48, 0xbb, 0x4a, 0xe6, 0xce, 0xb9, 0x3f, 0x9f, 0x39, 0x73, 0x35, 0x68, 0x8f, 0x01, 0xc9, 0x80,
+	0x7b, 0x84, 0x26, 0xe3, 0x09, 0x07, 0xef, 0xf2, 0xa0, 0x0f, 0x3c, 0x3c, 0xf0, 0x06, 0x90, 0x02,
+	0x8b, 0x59, 0x7b, 0x9c, 0x51, 0x4e, 0xf1, 0x96, 0x52, 0xb5, 0xb5, 0xaa, 0xad, 0x55, 0xdb, 0x9b,
+	0x03, 0x3a, 0xa0, 0x52, 0xe2, 0x89, 0x7f, 0x4a, 0xbd, 0xbd, 0xbb, 0x84, 0x39, 0x0e, 0xb3, 0x30,
+	0xd1, 0xc8, 0xed, 0xe6, 0x12, 0x11, 0xcf, 0xc7, 0xa0, 0x35, 0xcd, 0xef, 0x55, 0x54, 0x7f, 0xa9,
+	0x8c, 0x74, 0x79, 0xc8, 0x01, 0x77, 0x50, 0x8d, 0xd0, 0x08, 0x98, 0x5d, 0x75, 0x57, 0x5a, 0xeb,
+	0x87, 0x8f, 0xdb, 0xff, 0xf6, 0xd5, 0x3e, 0xa6, 0x11, 0xf8, 0x0f, 0xaf, 0xa6, 0x8d, 0xca, 0xaf,
+	0x69, 0x63, 0x43, 0xb6, 0x3c, 0xa5, 0x49, 0xcc, 0x21, 0x19, 0xf3, 0x3c, 0x50, 0x0c, 0xfc, 0x11,
+	0xad, 0x11, 0x9a, 0xf2, 0x2c, 0x24, 0x9c, 0xd9, 0x2b, 0x12, 0xe8, 0x2e, 0x07, 0x2a, 0xa1, 0xff,
+	0x48, 0x43, 0x1f, 0x14, 0xad, 0x25, 0xf0, 0x0d, 0x4f, 0xc0, 0x19, 0x7c, 0x9a, 0x40, 0x4a, 0x80,
+	0xd9, 0xe6, 0xed, 0xf0, 0xae, 0x16, 0xde, 0xc0, 0x8b, 0xd6, 0x32, 0xbc, 0x28, 0xe2, 0x17, 0xc8,
+	0x52, 0x59, 0xda, 0x35, 0xd7, 0x68, 0xad, 0x1f, 0x3a, 0xcb, 0xc8, 0xef, 0xa4, 0xca, 0x37, 0x05,
+	0x37, 0xd0, 0x3d, 0xcd, 0x6f, 0x06, 0x32, 0x45, 0x40, 0x78, 0x17, 0xfd, 0x27, 0x92, 0xe8, 0xc5,
+	0x91, 0x6d, 0xb8, 0x46, 0xcb, 0xf4, 0xd1, 0x6c, 0xda, 0xb0, 0xc4, 0xd6, 0xe9, 0x49, 0x60, 0x89,
+	0xad, 0xd3, 0x08, 0x1f, 0xa3, 0x35, 0x25, 0x4a, 0xcf, 0xa9, 0x5d, 0x75, 0x8d, 0xdb, 0x0e, 0x22,
+	0x5b, 0xd3, 0x73, 0xaa, 0x3f, 0xb8, 0x4a, 0xf4, 0x1a, 0xef, 0x20, 0x24, 0x21, 0xfd, 0x9c, 0x83,
+	0xc8, 0xda, 0x68, 0xd5, 0x03, 0x89, 0xf5, 0x45, 0x01, 0x6f, 0x21, 0x8b, 0x91, 0x0b, 0x48, 0x42,
+	0xdb, 0x94, 0x5b, 0x7a, 0xd5, 0x9c, 0x57, 0xd1, 0xea, 0x22, 0x79, 0x7c, 0x86, 0xee, 0x2f, 0xe2,
+	0xed, 0x85, 0x51, 0x94, 0x01, 0x63, 0xd2, 0x76, 0xdd, 0x3f, 0xf8, 0x3d, 0x6d, 0xec, 0x0f, 0x62,
+	0x7e, 0x31, 0xe9, 0x0b, 0x4b, 0x1e, 0xa1, 0x2c, 0xa1, 0x4c, 0xff, 0xec, 0xb3, 0x68, 0xa8, 0x87,
+	0xea, 0x88, 0x90, 0x23, 0xd5, 0x18, 0x6c, 0x2c, 0x50, 0xba, 0x80, 0xdf, 0xa2, 0xff, 0x0b, 0x7a,
+	0xe9, 0xa8, 0x7b, 0x77, 0x0d, 0x44, 0xe9, 0xb8, 0x75, 0x52, 0xaa, 0xe1, 0x57, 0xe8, 0x5e, 0x01,
+	0x64, 0x62, 0x78, 0xf5, 0x88, 0xed, 0x2c, 0x23, 0xbe, 0xa6, 0x11, 0x8c, 0x34, 0xaa, 0xf0, 0xa2,
+	0xc6, 0xfe, 0x0c, 0x6d, 0x16, 0x2c, 0x32, 0x61, 0x9c, 0x26, 0xca, 0xa3, 0x29, 0x3d, 0x3e, 0xb9,
+	0xcb, 0xe3, 0xb1, 0x6c, 0x11, 0xae, 0x02, 0x4c, 0xfe, 0xaa, 0x35, 0x7d, 0xb4, 0xba, 0x98, 0x40,
+	0xec, 0x22, 0x2b, 0x8e, 0x7a, 0x43, 0xc8, 0x75, 0xb4, 0x6b, 0xb3, 0x69, 0xa3, 0x76, 0x7a, 0xd2,
+	0x81, 0x3c, 0xa8, 0xc5, 0x51, 0x07, 0x72, 0xbc, 0x89, 0x6a, 0x97, 0xe1, 0x68, 0x02, 0x32, 0x20,
+	0x33, 0x50, 0x0b, 0xff, 0xfd, 0xd5, 0xcc, 0x31, 0xae, 0x67, 0x8e, 0xf1, 0x73, 0xe6, 0x18, 0x5f,
+	0xe7, 0x4e, 0xe5, 0x7a, 0xee, 0x54, 0x7e, 0xcc, 0x9d, 0xca, 0x87, 0xe7, 0xa5, 0x8b, 0x61, 0x24,
+	0xe3, 0xa3, 0xb0, 0xcf, 0xbc, 0xae, 0x34, 0xfc, 0x06, 0xf8, 0x67, 0x9a, 0x0d, 0xbd, 0x2f, 0xc5,
+	0x23, 0x10, 0xa7, 0x1c, 0xb2, 0x34, 0x1c, 0xa9, 0x0b, 0xeb, 0x5b, 0xf2, 0x19, 0x78, 0xf6, 0x67,
+	0x00, 0x93, 0x4f, 0x7c, 0x59, 0xa5, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -423,6 +432,13 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -604,6 +620,10 @@ func (m *Code) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -954,6 +974,40 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema[:0], dAtA[iNdEx:postIndex]...)
+			if m.Schema == nil {
+				m.Schema = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -91,6 +92,39 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"with schema": {
+			srcMutator: func(c *Code) {
+				c.Schema = []byte(`{"execute": {}}`)
+				schemaHash := sha256.Sum256(c.Schema)
+				c.CodeInfo.SchemaHash = schemaHash[:]
+			},
+		},
+		"schema hash not of the schema": {
+			srcMutator: func(c *Code) {
+				c.Schema = []byte(`{"execute": {}}`)
+				c.CodeInfo.SchemaHash = bytes.Repeat([]byte{0x1}, 32)
+			},
+			expError: true,
+		},
+		"schema without schema hash": {
+			srcMutator: func(c *Code) {
+				c.Schema = []byte(`{"execute": {}}`)
+			},
+			expError: true,
+		},
+		"schema hash without schema": {
+			srcMutator: func(c *Code) {
+				c.CodeInfo.SchemaHash = bytes.Repeat([]byte{0x1}, 32)
+			},
+			expError: true,
+		},
+		"schema invalid": {
+			srcMutator: func(c *Code) {
+				c.Schema = []byte(`{`)
+				c.CodeInfo.SchemaHash = bytes.Repeat([]byte{0x1}, 32)
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -34,6 +34,7 @@ var (
 	ContractCodeHistoryElementPrefix               = []byte{0x09}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x0A}
 	ParamsKey                                      = []byte{0x0B}
+	CodeSchemaPrefix                               = []byte{0x0C}
 	RandomPrefix                                   = []byte{0xFF}
 	ValidatorSetEvidencePrefix                     = []byte{0xFE}
 
@@ -47,6 +48,11 @@ func GetCodeKey(codeID uint64) []byte {
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetCodeSchemaKey returns the key of a code schema by its hash, which codes with the same schema share
+func GetCodeSchemaKey(schemaHash []byte) []byte {
+	return append(CodeSchemaPrefix, schemaHash...)
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
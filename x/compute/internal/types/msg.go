@@ -33,6 +33,10 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("builder %s", err.Error())
 	}
 
+	if err := validateSchema(msg.Schema); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("schema %s", err.Error())
+	}
+
 	return nil
 }
 
@@ -43,6 +43,9 @@ type MsgStoreCode struct {
 	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	// Builder is a valid docker image name with tag, optional
 	Builder string `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`
+	// Schema is the JSON schema of the messages of the code, like the api.json
+	// bundle of cosmwasm-schema, optional
+	Schema []byte `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
 func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
@@ -868,83 +871,83 @@ func init() {
 func init() { proto.RegisterFile("secret/compute/v1beta1/msg.proto", fileDescriptor_6815433faf72a133) }
 
 var fileDescriptor_6815433faf72a133 = []byte{
-	// 1203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xd6, 0x8e, 0x1d, 0xbf, 0xb8, 0x49, 0xba, 0x4d, 0x93, 0xcd, 0x56, 0xd8, 0xd1, 0x86,
-	0xb4, 0x51, 0x68, 0xbc, 0x4d, 0x90, 0x22, 0x30, 0x5c, 0xe2, 0xd0, 0x8a, 0x1c, 0x5c, 0x45, 0x1b,
-	0x10, 0x12, 0x17, 0x6b, 0xbc, 0x3b, 0xac, 0x57, 0xb1, 0x77, 0xcd, 0xce, 0x38, 0x69, 0x0e, 0x48,
-	0x15, 0x5c, 0x50, 0x25, 0x24, 0xce, 0x70, 0xe1, 0xc0, 0x01, 0x71, 0xca, 0x81, 0x13, 0xbf, 0xa0,
-	0xc7, 0xaa, 0x27, 0x4e, 0x01, 0x39, 0x42, 0x91, 0xf8, 0x09, 0x9c, 0xd0, 0xcc, 0xce, 0xae, 0x37,
-	0xcb, 0xda, 0x75, 0xa3, 0xc2, 0x25, 0xd9, 0x99, 0xf9, 0xe6, 0xbd, 0xef, 0xbd, 0xf7, 0xcd, 0x9b,
-	0x31, 0x2c, 0x13, 0x6c, 0xfa, 0x98, 0xea, 0xa6, 0xd7, 0xe9, 0xf6, 0x28, 0xd6, 0x8f, 0x36, 0x9b,
-	0x98, 0xa2, 0x4d, 0xbd, 0x43, 0xec, 0x4a, 0xd7, 0xf7, 0xa8, 0x27, 0x2f, 0x04, 0x88, 0x8a, 0x40,
-	0x54, 0x04, 0x42, 0x9d, 0xb7, 0x3d, 0xdb, 0xe3, 0x10, 0x9d, 0x7d, 0x05, 0x68, 0x75, 0xd1, 0xf4,
-	0x48, 0xc7, 0x23, 0x6c, 0xbf, 0x7e, 0x14, 0x33, 0xa3, 0x2e, 0x05, 0x0b, 0x8d, 0x60, 0x47, 0x30,
-	0x10, 0x4b, 0x25, 0xb1, 0xa7, 0x89, 0xc8, 0x80, 0x80, 0xe9, 0x39, 0xae, 0x58, 0xbf, 0x81, 0x3a,
-	0x8e, 0xeb, 0xe9, 0xfc, 0xaf, 0x98, 0x5a, 0x19, 0x42, 0xbb, 0x8b, 0x7c, 0xd4, 0x11, 0x76, 0xb5,
-	0xbf, 0x24, 0x28, 0xd6, 0x89, 0x7d, 0x40, 0x3d, 0x1f, 0xef, 0x7a, 0x16, 0x96, 0xf7, 0x20, 0x47,
-	0xb0, 0x6b, 0x61, 0x5f, 0x91, 0x96, 0xa5, 0xb5, 0x62, 0x6d, 0xf3, 0xef, 0xb3, 0xf2, 0x86, 0xed,
-	0xd0, 0x56, 0xaf, 0xc9, 0xc2, 0x13, 0xac, 0xc4, 0xbf, 0x0d, 0x62, 0x1d, 0xea, 0xf4, 0xa4, 0x8b,
-	0x49, 0x65, 0xc7, 0x34, 0x77, 0x2c, 0xcb, 0xc7, 0x84, 0x18, 0xc2, 0x80, 0xbc, 0x0d, 0x33, 0xc7,
-	0x88, 0x74, 0x1a, 0xcd, 0x13, 0x8a, 0x1b, 0xa6, 0x67, 0x61, 0xe5, 0x1a, 0x37, 0x39, 0xd7, 0x3f,
-	0x2b, 0x17, 0x3f, 0xd9, 0x39, 0xa8, 0xd7, 0x4e, 0x28, 0x77, 0x6a, 0x14, 0x19, 0x2e, 0x1c, 0xc9,
-	0x0b, 0x90, 0x23, 0x5e, 0xcf, 0x37, 0xb1, 0x92, 0x59, 0x96, 0xd6, 0x0a, 0x86, 0x18, 0xc9, 0x0a,
-	0xe4, 0x9b, 0x3d, 0xa7, 0xcd, 0xb8, 0x65, 0xf9, 0x42, 0x38, 0xac, 0xae, 0x7e, 0xfd, 0x43, 0x79,
-	0xe2, 0xcb, 0x8b, 0xd3, 0x75, 0xe1, 0xfa, 0xe9, 0xc5, 0xe9, 0xfa, 0x0d, 0x66, 0x53, 0x8f, 0xc7,
-	0xa6, 0xbd, 0x07, 0xf3, 0xf1, 0xb1, 0x81, 0x49, 0xd7, 0x73, 0x09, 0x96, 0x57, 0x20, 0xcf, 0xe8,
-	0x35, 0x1c, 0x8b, 0x07, 0x9d, 0xad, 0x41, 0xff, 0xac, 0x9c, 0x63, 0x90, 0xbd, 0x0f, 0x8c, 0x1c,
-	0x5b, 0xda, 0xb3, 0xb4, 0x3f, 0x33, 0xb0, 0x50, 0x27, 0xf6, 0x9e, 0x4b, 0x28, 0x72, 0xa9, 0x83,
-	0x18, 0x59, 0x97, 0xfa, 0xc8, 0xa4, 0xaf, 0x33, 0x67, 0xf7, 0x40, 0x36, 0x51, 0xbb, 0xdd, 0x44,
-	0xe6, 0x21, 0x4f, 0x59, 0xa3, 0x85, 0x48, 0x8b, 0xe7, 0xad, 0x60, 0xcc, 0x85, 0x2b, 0x8c, 0xd9,
-	0x87, 0x88, 0xb4, 0xe2, 0xc4, 0x33, 0xc3, 0x88, 0xcb, 0xf3, 0x30, 0xd9, 0x46, 0x4d, 0xdc, 0x16,
-	0x49, 0x0b, 0x06, 0xf2, 0x12, 0x4c, 0x39, 0xae, 0x43, 0x1b, 0x1d, 0x62, 0x2b, 0x93, 0x8c, 0xb5,
-	0x91, 0x67, 0xe3, 0x3a, 0xb1, 0xe5, 0x27, 0x12, 0x00, 0x5f, 0xfb, 0xac, 0xe7, 0x5a, 0x44, 0xc9,
-	0x2d, 0x67, 0xd6, 0xa6, 0xb7, 0x96, 0x2a, 0x42, 0x8f, 0x4c, 0x81, 0xa1, 0xc0, 0x2b, 0xbb, 0x9e,
-	0xe3, 0xd6, 0x1e, 0x3e, 0x3b, 0x2b, 0x4f, 0xfc, 0xfc, 0x7b, 0x79, 0x6d, 0x8c, 0x90, 0xd9, 0x06,
-	0xf2, 0xdd, 0xc5, 0xe9, 0x7a, 0xb1, 0x8d, 0x6d, 0x64, 0x9e, 0x34, 0x98, 0x86, 0xc9, 0x4f, 0x17,
-	0xa7, 0xeb, 0x92, 0x51, 0x60, 0x4e, 0x1f, 0x32, 0x9f, 0xf2, 0x16, 0x14, 0xa3, 0x34, 0x10, 0xc7,
-	0x56, 0xf2, 0x3c, 0xaf, 0xb3, 0xfd, 0xb3, 0xf2, 0xf4, 0xae, 0x98, 0x3f, 0x70, 0x6c, 0x63, 0xda,
-	0x1c, 0x0c, 0x58, 0x9c, 0xc8, 0xea, 0x38, 0xae, 0x32, 0x15, 0xc4, 0xc9, 0x07, 0x55, 0x3d, 0x45,
-	0x1a, 0xb7, 0x43, 0x69, 0xa4, 0x14, 0x53, 0x7b, 0x04, 0xa5, 0xf4, 0x95, 0x48, 0x2e, 0x0a, 0xe4,
-	0x51, 0x50, 0x36, 0x5e, 0xef, 0x82, 0x11, 0x0e, 0x65, 0x19, 0xb2, 0x16, 0xa2, 0x28, 0xd0, 0xb9,
-	0xc1, 0xbf, 0xb5, 0x17, 0x19, 0x90, 0xeb, 0xc4, 0x7e, 0xf0, 0x18, 0x9b, 0xbd, 0xff, 0x46, 0x33,
-	0x75, 0x98, 0x32, 0x85, 0x59, 0x71, 0xc2, 0xae, 0x60, 0x2c, 0x32, 0x21, 0xcf, 0x41, 0x86, 0x89,
-	0x22, 0xc3, 0x63, 0x60, 0x9f, 0x43, 0x44, 0x99, 0x1d, 0x22, 0x4a, 0x26, 0x1f, 0x82, 0xdd, 0x50,
-	0x3e, 0x93, 0xff, 0x9b, 0x7c, 0x98, 0xd3, 0x74, 0xf9, 0xe4, 0x5e, 0x2e, 0x9f, 0xea, 0x5b, 0x29,
-	0x42, 0x59, 0x0c, 0x85, 0x92, 0xa8, 0x9e, 0x76, 0x1f, 0xd4, 0x7f, 0xcf, 0x46, 0x02, 0x09, 0x65,
-	0x20, 0xc5, 0x64, 0xf0, 0xf4, 0x1a, 0x97, 0x41, 0xdd, 0xb1, 0xfd, 0x78, 0xeb, 0x58, 0xb8, 0x24,
-	0x83, 0x42, 0x54, 0x53, 0x35, 0x51, 0xd3, 0x42, 0xac, 0x40, 0x63, 0x9d, 0x7a, 0x51, 0xc5, 0xec,
-	0xa0, 0x8a, 0x57, 0x39, 0x53, 0xe9, 0x95, 0x9f, 0x4a, 0xaf, 0x7c, 0xf5, 0xee, 0xb0, 0xf4, 0x25,
-	0xa2, 0x16, 0xe9, 0x4b, 0xcc, 0x8e, 0x4c, 0xdf, 0xaf, 0x12, 0xcc, 0xd4, 0x89, 0xfd, 0x71, 0xd7,
-	0x42, 0x14, 0xef, 0xb0, 0x93, 0x3d, 0x34, 0x75, 0xb7, 0xa1, 0xe0, 0xe2, 0xe3, 0x46, 0xd0, 0x0b,
-	0x44, 0xee, 0x5c, 0x7c, 0x1c, 0x6c, 0x8a, 0xe7, 0x35, 0x93, 0xc8, 0xeb, 0x15, 0x12, 0x54, 0x5d,
-	0x49, 0x84, 0x7c, 0x33, 0x0c, 0x39, 0xc6, 0x54, 0x53, 0xf8, 0xcd, 0x11, 0x9b, 0x09, 0x43, 0xd5,
-	0xbe, 0x97, 0xe0, 0x7a, 0x9d, 0xd8, 0xbb, 0x6d, 0x8c, 0xfc, 0xd1, 0x51, 0xbd, 0x6e, 0xe2, 0x5a,
-	0x82, 0xb8, 0x1c, 0x12, 0x1f, 0x70, 0xd1, 0x16, 0xe1, 0xd6, 0xa5, 0x89, 0x88, 0xf6, 0xa9, 0x04,
-	0xb3, 0x51, 0x44, 0xfb, 0xfc, 0x3d, 0x21, 0x6f, 0x43, 0x01, 0xf5, 0x68, 0xcb, 0xf3, 0x1d, 0x7a,
-	0x12, 0x70, 0xaf, 0x29, 0x2f, 0x7e, 0xd9, 0x98, 0x17, 0xe7, 0x5e, 0xf4, 0x99, 0x03, 0xea, 0x3b,
-	0xae, 0x6d, 0x0c, 0xa0, 0xf2, 0xfb, 0x90, 0x0b, 0x5e, 0x24, 0xbc, 0x56, 0xd3, 0x5b, 0xa5, 0x4a,
-	0xfa, 0x63, 0xaa, 0x12, 0xf8, 0xa9, 0x65, 0x59, 0xbb, 0x30, 0xc4, 0x9e, 0x40, 0x72, 0x03, 0x6b,
-	0x2c, 0x92, 0xf9, 0xcb, 0x25, 0x08, 0xb6, 0x69, 0x4b, 0xb0, 0x98, 0x98, 0x8a, 0xa2, 0xf9, 0x51,
-	0x02, 0x85, 0xaf, 0xd9, 0x3e, 0xb2, 0xf0, 0xbe, 0xef, 0x75, 0x3d, 0x82, 0xda, 0xfb, 0x88, 0x10,
-	0x6c, 0xc9, 0xab, 0x30, 0x13, 0x24, 0xa9, 0x71, 0xb9, 0xe7, 0x5f, 0x0f, 0x66, 0x45, 0x58, 0xf2,
-	0x1d, 0x98, 0xed, 0xf8, 0x0d, 0xec, 0x9a, 0x6d, 0x74, 0x14, 0xbb, 0xb4, 0x8b, 0xc6, 0xf5, 0x8e,
-	0xff, 0x20, 0x98, 0xe5, 0x47, 0xe4, 0xdd, 0xb0, 0xcb, 0x24, 0xac, 0x32, 0xe2, 0x6f, 0x0c, 0x88,
-	0xa7, 0x30, 0xd1, 0x34, 0x58, 0x1e, 0xb6, 0x16, 0x86, 0xb2, 0xf5, 0x4d, 0x1e, 0x32, 0xec, 0x0a,
-	0x6f, 0x40, 0x61, 0xf0, 0xa4, 0x7b, 0x73, 0x58, 0x46, 0xe3, 0x8f, 0x21, 0xf5, 0xde, 0x38, 0xa8,
-	0xe8, 0x8c, 0x7e, 0x01, 0x37, 0xd3, 0x5e, 0x42, 0x95, 0x11, 0x46, 0x52, 0xf0, 0xea, 0xf6, 0xab,
-	0xe1, 0x23, 0xf7, 0x9f, 0xc3, 0x6c, 0xf2, 0x42, 0x5d, 0x1f, 0x61, 0x2a, 0x81, 0x55, 0xb7, 0xc6,
-	0xc7, 0xc6, 0x5d, 0x26, 0x9b, 0xf7, 0x28, 0x97, 0x09, 0xec, 0x48, 0x97, 0xc3, 0x1a, 0x21, 0x86,
-	0xe9, 0x78, 0xc3, 0xbb, 0x33, 0xc2, 0x44, 0x0c, 0xa7, 0x56, 0xc6, 0xc3, 0x45, 0x6e, 0x9a, 0x00,
-	0xb1, 0x06, 0xb4, 0x3a, 0x62, 0xf7, 0x00, 0xa6, 0x6e, 0x8c, 0x05, 0x8b, 0x7c, 0xb4, 0xa0, 0x78,
-	0xa9, 0x5b, 0xdc, 0x7d, 0x29, 0xc7, 0x00, 0xa8, 0xea, 0x63, 0x02, 0x23, 0x4f, 0x5f, 0x49, 0x70,
-	0x2b, 0xfd, 0x28, 0xdf, 0x1f, 0x69, 0x2a, 0x65, 0x87, 0xfa, 0xce, 0xab, 0xee, 0x08, 0x59, 0xa8,
-	0x93, 0x4f, 0xd8, 0x9b, 0xa4, 0xf6, 0xd1, 0xb3, 0x7e, 0x49, 0x7a, 0xde, 0x2f, 0x49, 0x7f, 0xf4,
-	0x4b, 0xd2, 0xb7, 0xe7, 0xa5, 0x89, 0xe7, 0xe7, 0xa5, 0x89, 0xdf, 0xce, 0x4b, 0x13, 0x9f, 0x56,
-	0x63, 0xaf, 0x1d, 0x62, 0xfa, 0xb4, 0x8d, 0x9a, 0x44, 0x3f, 0xe0, 0xde, 0x1e, 0x61, 0x7a, 0xec,
-	0xf9, 0x87, 0xfa, 0xe3, 0xe8, 0xa7, 0x9b, 0xe3, 0x52, 0xec, 0xbb, 0xa8, 0x1d, 0xbc, 0x82, 0x9a,
-	0x39, 0xfe, 0xdb, 0xed, 0xed, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xa6, 0x92, 0xa3, 0x99,
-	0x0e, 0x00, 0x00,
+	// 1207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x2d, 0x59, 0xb2, 0x9e, 0x95, 0xd8, 0x61, 0x1c, 0x9b, 0x66, 0x50, 0xc9, 0xa0, 0xeb,
+	0xc4, 0x70, 0x63, 0x31, 0x56, 0x01, 0xa3, 0x55, 0xbb, 0x58, 0x6e, 0x82, 0x7a, 0x50, 0x60, 0xd0,
+	0x2d, 0x0a, 0x74, 0x11, 0x4e, 0xe4, 0x95, 0x22, 0x2c, 0x92, 0x2a, 0x8f, 0xb2, 0xe3, 0xa1, 0x40,
+	0x90, 0x2e, 0x45, 0x80, 0x02, 0x9d, 0xdb, 0xa5, 0x43, 0x87, 0xa2, 0x93, 0x87, 0x4e, 0xfd, 0x05,
+	0x19, 0x83, 0x4c, 0x9d, 0xdc, 0x42, 0x46, 0xe1, 0xff, 0xd0, 0xa9, 0xb8, 0xe3, 0x91, 0xa2, 0x58,
+	0x4a, 0x51, 0x8c, 0xb4, 0x8b, 0xad, 0x77, 0xf7, 0xbd, 0xf7, 0xbe, 0xf7, 0xde, 0x77, 0xa7, 0x13,
+	0xac, 0x12, 0xac, 0x7b, 0xd8, 0x57, 0x75, 0xd7, 0xee, 0xf6, 0x7c, 0xac, 0x1e, 0x6f, 0xb7, 0xb0,
+	0x8f, 0xb6, 0x55, 0x9b, 0x98, 0x95, 0xae, 0xe7, 0xfa, 0xae, 0xb8, 0x14, 0x20, 0x2a, 0x1c, 0x51,
+	0xe1, 0x08, 0x79, 0xd1, 0x74, 0x4d, 0x97, 0x41, 0x54, 0xfa, 0x29, 0x40, 0xcb, 0xcb, 0xba, 0x4b,
+	0x6c, 0x97, 0x50, 0x7f, 0xf5, 0x38, 0x16, 0x46, 0x5e, 0x09, 0x36, 0x9a, 0x81, 0x47, 0x60, 0xf0,
+	0xad, 0x12, 0xf7, 0x69, 0x21, 0x32, 0x20, 0xa0, 0xbb, 0x96, 0xc3, 0xf7, 0x6f, 0x20, 0xdb, 0x72,
+	0x5c, 0x95, 0xfd, 0xe5, 0x4b, 0x6b, 0x23, 0x68, 0x77, 0x91, 0x87, 0x6c, 0x1e, 0x57, 0x79, 0x3a,
+	0x0d, 0xc5, 0x06, 0x31, 0x0f, 0x7d, 0xd7, 0xc3, 0x7b, 0xae, 0x81, 0xc5, 0x7d, 0xc8, 0x11, 0xec,
+	0x18, 0xd8, 0x93, 0x84, 0x55, 0x61, 0xa3, 0x58, 0xdf, 0xfe, 0xfb, 0xbc, 0xbc, 0x65, 0x5a, 0x7e,
+	0xbb, 0xd7, 0xa2, 0xe5, 0x71, 0x56, 0xfc, 0xdf, 0x16, 0x31, 0x8e, 0x54, 0xff, 0xb4, 0x8b, 0x49,
+	0x65, 0x57, 0xd7, 0x77, 0x0d, 0xc3, 0xc3, 0x84, 0x68, 0x3c, 0x80, 0xb8, 0x03, 0xd7, 0x4f, 0x10,
+	0xb1, 0x9b, 0xad, 0x53, 0x1f, 0x37, 0x75, 0xd7, 0xc0, 0xd2, 0x34, 0x0b, 0xb9, 0xd0, 0x3f, 0x2f,
+	0x17, 0x3f, 0xdb, 0x3d, 0x6c, 0xd4, 0x4f, 0x7d, 0x96, 0x54, 0x2b, 0x52, 0x5c, 0x68, 0x89, 0x4b,
+	0x90, 0x23, 0x6e, 0xcf, 0xd3, 0xb1, 0x94, 0x59, 0x15, 0x36, 0x0a, 0x1a, 0xb7, 0x44, 0x09, 0xf2,
+	0xad, 0x9e, 0xd5, 0xa1, 0xdc, 0xb2, 0x6c, 0x23, 0x34, 0x99, 0x87, 0xde, 0xc6, 0x36, 0x92, 0x66,
+	0x68, 0x06, 0x8d, 0x5b, 0xb5, 0xf5, 0x6f, 0x7e, 0x2c, 0x4f, 0x3d, 0xbd, 0x3c, 0xdb, 0xe4, 0x94,
+	0x9e, 0x5d, 0x9e, 0x6d, 0xde, 0xa0, 0xb9, 0xd4, 0x78, 0xcd, 0xca, 0x07, 0xb0, 0x18, 0xb7, 0x35,
+	0x4c, 0xba, 0xae, 0x43, 0xb0, 0xb8, 0x06, 0x79, 0x4a, 0xbb, 0x69, 0x19, 0xac, 0x19, 0xd9, 0x3a,
+	0xf4, 0xcf, 0xcb, 0x39, 0x0a, 0xd9, 0xff, 0x48, 0xcb, 0xd1, 0xad, 0x7d, 0x43, 0xf9, 0x2b, 0x03,
+	0x4b, 0x0d, 0x62, 0xee, 0x3b, 0xc4, 0x47, 0x8e, 0x6f, 0x21, 0x5a, 0x84, 0xe3, 0x7b, 0x48, 0xf7,
+	0xdf, 0x64, 0x2f, 0xef, 0x81, 0xa8, 0xa3, 0x4e, 0xa7, 0x85, 0xf4, 0x23, 0xd6, 0xca, 0x66, 0x1b,
+	0x91, 0x36, 0xeb, 0x67, 0x41, 0x5b, 0x08, 0x77, 0x28, 0xb3, 0x8f, 0x11, 0x69, 0xc7, 0x89, 0x67,
+	0x46, 0x11, 0x17, 0x17, 0x61, 0xa6, 0x83, 0x5a, 0xb8, 0xc3, 0x9b, 0x19, 0x18, 0xe2, 0x0a, 0xcc,
+	0x5a, 0x8e, 0xe5, 0x37, 0x6d, 0x62, 0xf2, 0x66, 0xe6, 0xa9, 0xdd, 0x20, 0xa6, 0xf8, 0x44, 0x00,
+	0x60, 0x7b, 0x5f, 0xf4, 0x1c, 0x83, 0x48, 0xb9, 0xd5, 0xcc, 0xc6, 0x5c, 0x75, 0xa5, 0xc2, 0x75,
+	0x4a, 0x95, 0x19, 0x0a, 0xbf, 0xb2, 0xe7, 0x5a, 0x4e, 0xfd, 0xe1, 0xf3, 0xf3, 0xf2, 0xd4, 0x2f,
+	0x7f, 0x94, 0x37, 0x26, 0x28, 0x99, 0x3a, 0x90, 0xef, 0x2f, 0xcf, 0x36, 0x8b, 0x1d, 0x6c, 0x22,
+	0xfd, 0xb4, 0x49, 0xb5, 0x4d, 0x7e, 0xbe, 0x3c, 0xdb, 0x14, 0xb4, 0x02, 0x4d, 0xfa, 0x90, 0xe6,
+	0x14, 0xab, 0x50, 0x8c, 0xda, 0x40, 0x2c, 0x53, 0xca, 0xb3, 0xbe, 0xce, 0xf7, 0xcf, 0xcb, 0x73,
+	0x7b, 0x7c, 0xfd, 0xd0, 0x32, 0xb5, 0x39, 0x7d, 0x60, 0xd0, 0x3a, 0x91, 0x61, 0x5b, 0x8e, 0x34,
+	0x1b, 0xd4, 0xc9, 0x8c, 0x9a, 0x9a, 0x22, 0x8d, 0xdb, 0xa1, 0x34, 0x52, 0x86, 0xa9, 0x3c, 0x82,
+	0x52, 0xfa, 0x4e, 0x24, 0x17, 0x09, 0xf2, 0x28, 0x18, 0x1b, 0x9b, 0x77, 0x41, 0x0b, 0x4d, 0x51,
+	0x84, 0xac, 0x81, 0x7c, 0x14, 0xe8, 0x5f, 0x63, 0x9f, 0x95, 0x97, 0x19, 0x10, 0x1b, 0xc4, 0x7c,
+	0xf0, 0x18, 0xeb, 0xbd, 0xff, 0x46, 0x33, 0x0d, 0x98, 0xd5, 0x79, 0x58, 0x69, 0xfa, 0xaa, 0xc1,
+	0xa2, 0x10, 0xe2, 0x02, 0x64, 0xa8, 0x28, 0x32, 0xac, 0x06, 0xfa, 0x71, 0x84, 0x28, 0xb3, 0x23,
+	0x44, 0x49, 0xe5, 0x43, 0xb0, 0x13, 0xca, 0x67, 0xe6, 0x7f, 0x93, 0x0f, 0x4d, 0x9a, 0x2e, 0x9f,
+	0xdc, 0xab, 0xe5, 0x53, 0x7b, 0x27, 0x45, 0x28, 0xcb, 0xa1, 0x50, 0x12, 0xd3, 0x53, 0xee, 0x83,
+	0xfc, 0xef, 0xd5, 0x48, 0x20, 0xa1, 0x0c, 0x84, 0x98, 0x0c, 0x9e, 0x4d, 0x33, 0x19, 0x34, 0x2c,
+	0xd3, 0x8b, 0x5f, 0x1d, 0x4b, 0x43, 0x32, 0x28, 0x44, 0x33, 0x95, 0x13, 0x33, 0x2d, 0xc4, 0x06,
+	0x34, 0xd1, 0xa9, 0xe7, 0x53, 0xcc, 0x0e, 0xa6, 0x78, 0x95, 0x33, 0x95, 0x3e, 0xf9, 0xd9, 0xf4,
+	0xc9, 0xd7, 0xee, 0x8e, 0x6a, 0x5f, 0xa2, 0x6a, 0xde, 0xbe, 0xc4, 0xea, 0xd8, 0xf6, 0xfd, 0x26,
+	0xc0, 0xf5, 0x06, 0x31, 0x3f, 0xed, 0x1a, 0xc8, 0xc7, 0xbb, 0xf4, 0x64, 0x8f, 0x6c, 0xdd, 0x6d,
+	0x28, 0x38, 0xf8, 0xa4, 0x19, 0xdc, 0x05, 0xbc, 0x77, 0x0e, 0x3e, 0x09, 0x9c, 0xe2, 0x7d, 0xcd,
+	0x24, 0xfa, 0x7a, 0x85, 0x06, 0xd5, 0xd6, 0x12, 0x25, 0xdf, 0x0c, 0x4b, 0x8e, 0x31, 0x55, 0x24,
+	0x58, 0x1a, 0x5e, 0x09, 0x4b, 0x55, 0x7e, 0x10, 0xe0, 0x5a, 0x83, 0x98, 0x7b, 0x1d, 0x8c, 0xbc,
+	0xf1, 0x55, 0xbd, 0x69, 0xe2, 0x4a, 0x82, 0xb8, 0x18, 0x12, 0x1f, 0x70, 0x51, 0x96, 0xe1, 0xd6,
+	0xd0, 0x42, 0x44, 0xfb, 0x4c, 0x80, 0xf9, 0xa8, 0xa2, 0x03, 0xf6, 0xce, 0x10, 0x77, 0xa0, 0x80,
+	0x7a, 0x7e, 0xdb, 0xf5, 0x2c, 0xff, 0x34, 0xe0, 0x5e, 0x97, 0x5e, 0xfe, 0xba, 0xb5, 0xc8, 0xcf,
+	0x3d, 0xbf, 0x67, 0x0e, 0x7d, 0xcf, 0x72, 0x4c, 0x6d, 0x00, 0x15, 0x3f, 0x84, 0x5c, 0xf0, 0x52,
+	0x61, 0xb3, 0x9a, 0xab, 0x96, 0x2a, 0xe9, 0x8f, 0xac, 0x4a, 0x90, 0xa7, 0x9e, 0xa5, 0xd7, 0x85,
+	0xc6, 0x7d, 0x02, 0xc9, 0x0d, 0xa2, 0xd1, 0x4a, 0x16, 0x87, 0x47, 0x10, 0xb8, 0x29, 0x2b, 0xb0,
+	0x9c, 0x58, 0x8a, 0xaa, 0xf9, 0x49, 0x00, 0x89, 0xed, 0x99, 0x1e, 0x32, 0xf0, 0x81, 0xe7, 0x76,
+	0x5d, 0x82, 0x3a, 0x07, 0x88, 0x10, 0x6c, 0x88, 0xeb, 0x70, 0x3d, 0x68, 0x52, 0x73, 0xf8, 0xce,
+	0xbf, 0x16, 0xac, 0xf2, 0xb2, 0xc4, 0x3b, 0x30, 0x6f, 0x7b, 0x4d, 0xec, 0xe8, 0x1d, 0x74, 0x1c,
+	0xfb, 0xd2, 0x2e, 0x6a, 0xd7, 0x6c, 0xef, 0x41, 0xb0, 0xca, 0x8e, 0xc8, 0xfb, 0xe1, 0x2d, 0x93,
+	0x88, 0x4a, 0x89, 0xbf, 0x35, 0x20, 0x9e, 0xc2, 0x44, 0x51, 0x60, 0x75, 0xd4, 0x5e, 0x58, 0x4a,
+	0xf5, 0xdb, 0x3c, 0x64, 0xe8, 0x57, 0x78, 0x13, 0x0a, 0x83, 0xa7, 0xde, 0xdb, 0xa3, 0x3a, 0x1a,
+	0x7f, 0x0c, 0xc9, 0xf7, 0x26, 0x41, 0x45, 0x67, 0xf4, 0x2b, 0xb8, 0x99, 0xf6, 0x12, 0xaa, 0x8c,
+	0x09, 0x92, 0x82, 0x97, 0x77, 0x5e, 0x0f, 0x1f, 0xa5, 0xff, 0x12, 0xe6, 0x93, 0x5f, 0xa8, 0x9b,
+	0x63, 0x42, 0x25, 0xb0, 0x72, 0x75, 0x72, 0x6c, 0x3c, 0x65, 0xf2, 0xf2, 0x1e, 0x97, 0x32, 0x81,
+	0x95, 0xab, 0x93, 0x63, 0xa3, 0x94, 0x18, 0xe6, 0xe2, 0x17, 0xde, 0x9d, 0x31, 0x21, 0x62, 0x38,
+	0xb9, 0x32, 0x19, 0x2e, 0x4a, 0xd3, 0x02, 0x88, 0x5d, 0x40, 0xeb, 0x63, 0xbc, 0x07, 0x30, 0x79,
+	0x6b, 0x22, 0x58, 0x94, 0xa3, 0x0d, 0xc5, 0xa1, 0xdb, 0xe2, 0xee, 0x2b, 0x39, 0x06, 0x40, 0x59,
+	0x9d, 0x10, 0x18, 0x65, 0xfa, 0x5a, 0x80, 0x5b, 0xe9, 0x47, 0xf9, 0xfe, 0xd8, 0x50, 0x29, 0x1e,
+	0xf2, 0x7b, 0xaf, 0xeb, 0x11, 0xb2, 0x90, 0x67, 0x9e, 0xd0, 0x37, 0x49, 0xfd, 0x93, 0xe7, 0xfd,
+	0x92, 0xf0, 0xa2, 0x5f, 0x12, 0xfe, 0xec, 0x97, 0x84, 0xef, 0x2e, 0x4a, 0x53, 0x2f, 0x2e, 0x4a,
+	0x53, 0xbf, 0x5f, 0x94, 0xa6, 0x3e, 0xaf, 0xc5, 0x5e, 0x3b, 0x44, 0xf7, 0xfc, 0x0e, 0x6a, 0x11,
+	0xf5, 0x90, 0x65, 0x7b, 0x84, 0xfd, 0x13, 0xd7, 0x3b, 0x52, 0x1f, 0x47, 0x3f, 0xe9, 0x2c, 0xc7,
+	0xc7, 0x9e, 0x83, 0x3a, 0xc1, 0x2b, 0xa8, 0x95, 0x63, 0xbf, 0xe9, 0xde, 0xfd, 0x67, 0x00, 0xa5,
+	0x9b, 0x5a, 0xef, 0xb1, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -961,7 +964,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// StoreCode to submit Wasm code to the system
 	StoreCode(ctx context.Context, in *MsgStoreCode, opts ...grpc.CallOption) (*MsgStoreCodeResponse, error)
-	// Instantiate creates a new smart contract instance for the given code id.
+	//  Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error)
 	// Execute submits the given message data to a smart contract
 	ExecuteContract(ctx context.Context, in *MsgExecuteContract, opts ...grpc.CallOption) (*MsgExecuteContractResponse, error)
@@ -1060,7 +1063,7 @@ func (c *msgClient) UpgradeProposalPassed(ctx context.Context, in *MsgUpgradePro
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
 	StoreCode(context.Context, *MsgStoreCode) (*MsgStoreCodeResponse, error)
-	// Instantiate creates a new smart contract instance for the given code id.
+	//  Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(context.Context, *MsgInstantiateContract) (*MsgInstantiateContractResponse, error)
 	// Execute submits the given message data to a smart contract
 	ExecuteContract(context.Context, *MsgExecuteContract) (*MsgExecuteContractResponse, error)
@@ -1313,6 +1316,13 @@ func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Builder) > 0 {
 		i -= len(m.Builder)
 		copy(dAtA[i:], m.Builder)
@@ -1985,6 +1995,10 @@ func (m *MsgStoreCode) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsg(uint64(l))
 	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
 	return n
 }
 
@@ -2433,6 +2447,40 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 			}
 			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema[:0], dAtA[iNdEx:postIndex]...)
+			if m.Schema == nil {
+				m.Schema = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsg(dAtA[iNdEx:])
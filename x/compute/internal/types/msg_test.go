@@ -105,6 +105,30 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"correct with schema": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Schema:       []byte(`{"execute": {"type": "object"}}`),
+			},
+			valid: true,
+		},
+		"schema not an object": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Schema:       []byte(`["execute"]`),
+			},
+			valid: false,
+		},
+		"schema greater limit": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Schema:       []byte(`{"a": "` + strings.Repeat("a", MaxSchemaSize) + `"}`),
+			},
+			valid: false,
+		},
 		/*
 			"invalid InstantiatePermission": {
 				msg: MsgStoreCode{
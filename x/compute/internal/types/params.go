@@ -6,18 +6,26 @@ import (
 
 const DefaultMaxContractSize = 2 * 1024 * 1024
 
-var DefaultCompileCost = math.LegacyNewDecWithPrec(8, 1)
+// DefaultMaxSchemaSize is the maximum size of the JSON schema to store with code, up to the MaxSchemaSize of MsgStoreCode
+const DefaultMaxSchemaSize = MaxSchemaSize
 
-func NewParams(maxContractSize uint64, compileCost math.LegacyDec) Params {
+var (
+	DefaultCompileCost = math.LegacyNewDecWithPrec(8, 1)
+	DefaultSchemaCost  = math.LegacyNewDecWithPrec(8, 1)
+)
+
+func NewParams(maxContractSize uint64, compileCost math.LegacyDec, maxSchemaSize uint64, schemaCost math.LegacyDec) Params {
 	return Params{
 		MaxContractSize: maxContractSize,
 		CompileCost:     compileCost,
+		MaxSchemaSize:   maxSchemaSize,
+		SchemaCost:      schemaCost,
 	}
 }
 
 // default module parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultMaxContractSize, DefaultCompileCost)
+	return NewParams(DefaultMaxContractSize, DefaultCompileCost, DefaultMaxSchemaSize, DefaultSchemaCost)
 }
 
 // validate params.
@@ -33,6 +33,12 @@ type Params struct {
 	CompileCost cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=compile_cost,json=compileCost,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"compile_cost"`
 	// MaxContractSize is the maximum size of contract to store in bytes.
 	MaxContractSize uint64 `protobuf:"varint,2,opt,name=max_contract_size,json=maxContractSize,proto3" json:"max_contract_size,omitempty"`
+	// MaxSchemaSize is the maximum size of the JSON schema to store with code in
+	// bytes. Code can't be stored with a schema while it is 0.
+	MaxSchemaSize uint64 `protobuf:"varint,3,opt,name=max_schema_size,json=maxSchemaSize,proto3" json:"max_schema_size,omitempty"`
+	// SchemaCost is how much SDK gas we charge *per byte* for storing the JSON
+	// schema of code.
+	SchemaCost cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=schema_cost,json=schemaCost,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"schema_cost"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,6 +81,13 @@ func (m *Params) GetMaxContractSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxSchemaSize() uint64 {
+	if m != nil {
+		return m.MaxSchemaSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "secret.compute.v1beta1.Params")
 }
@@ -84,27 +97,30 @@ func init() {
 }
 
 var fileDescriptor_631b2d12372d9a02 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4a, 0x03, 0x41,
-	0x10, 0x86, 0x6f, 0x45, 0x03, 0x9e, 0x82, 0x24, 0x88, 0xc4, 0x08, 0x97, 0xa0, 0x4d, 0x10, 0xbc,
-	0x25, 0x08, 0x16, 0x96, 0x49, 0x4a, 0x11, 0x31, 0x36, 0xda, 0x1c, 0x7b, 0xeb, 0x70, 0x59, 0x92,
-	0xbd, 0x39, 0x76, 0x27, 0x9a, 0xe4, 0x29, 0xac, 0x7c, 0x06, 0x4b, 0x0b, 0x1f, 0x22, 0x65, 0xb0,
-	0x12, 0x8b, 0x20, 0x49, 0xe1, 0x6b, 0x48, 0x6e, 0x0f, 0x9b, 0x65, 0x67, 0xfe, 0x8f, 0xff, 0x67,
-	0x7e, 0xff, 0xc4, 0x82, 0x34, 0x40, 0x5c, 0xa2, 0xce, 0x46, 0x04, 0xfc, 0xa9, 0x15, 0x03, 0x89,
-	0x16, 0xcf, 0x84, 0x11, 0xda, 0x86, 0x99, 0x41, 0xc2, 0xca, 0x81, 0x83, 0xc2, 0x02, 0x0a, 0x0b,
-	0xa8, 0xb6, 0x9f, 0x60, 0x82, 0x39, 0xc2, 0xd7, 0x3f, 0x47, 0xd7, 0x0e, 0x25, 0x5a, 0x8d, 0x36,
-	0x72, 0x82, 0x1b, 0x0a, 0xa9, 0x2c, 0xb4, 0x4a, 0x91, 0xe7, 0xaf, 0x5b, 0x1d, 0xbf, 0x32, 0xbf,
-	0x74, 0x93, 0x87, 0x55, 0xee, 0xfd, 0xdd, 0x75, 0x82, 0x1a, 0x42, 0x24, 0xd1, 0x52, 0x95, 0x35,
-	0x58, 0x73, 0xbb, 0x7d, 0x31, 0x5b, 0xd4, 0xbd, 0xef, 0x45, 0xfd, 0xc8, 0x39, 0xd9, 0xc7, 0x41,
-	0xa8, 0x90, 0x6b, 0x41, 0xfd, 0xf0, 0x0a, 0x12, 0x21, 0x27, 0x5d, 0x90, 0x9f, 0x1f, 0x67, 0x7e,
-	0x11, 0xd4, 0x05, 0xf9, 0xf6, 0xfb, 0x7e, 0xca, 0x6e, 0x77, 0x0a, 0xaf, 0x0e, 0x5a, 0xaa, 0xb4,
-	0xfc, 0xb2, 0x16, 0xe3, 0x48, 0x62, 0x4a, 0x46, 0x48, 0x8a, 0xac, 0x9a, 0x42, 0x75, 0xa3, 0xc1,
-	0x9a, 0x9b, 0xed, 0x2d, 0x87, 0xef, 0x69, 0x31, 0xee, 0x14, 0x72, 0x4f, 0x4d, 0xa1, 0x7d, 0x37,
-	0x5b, 0x06, 0x6c, 0xbe, 0x0c, 0xd8, 0xcf, 0x32, 0x60, 0x2f, 0xab, 0xc0, 0x9b, 0xaf, 0x02, 0xef,
-	0x6b, 0x15, 0x78, 0x0f, 0x97, 0x89, 0xa2, 0xfe, 0x28, 0x5e, 0xd7, 0xc1, 0xad, 0x34, 0x34, 0x14,
-	0xb1, 0xe5, 0xbd, 0xbc, 0xa2, 0x6b, 0xa0, 0x67, 0x34, 0x03, 0x3e, 0xfe, 0x2f, 0x54, 0xa5, 0x04,
-	0x26, 0x15, 0x43, 0x4e, 0x93, 0x0c, 0x6c, 0x5c, 0xca, 0xaf, 0x3e, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x9d, 0xbb, 0x8e, 0x78, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x93, 0xde, 0xde, 0xc2, 0x9d, 0xde, 0xcb, 0xa5, 0x41, 0xa4, 0x56, 0x48, 0x8b, 0x6e,
+	0x8a, 0xd0, 0x0c, 0x45, 0x70, 0xe1, 0xb2, 0xed, 0x52, 0x44, 0xac, 0x20, 0xba, 0x09, 0x93, 0xf1,
+	0x90, 0x86, 0x76, 0x32, 0x21, 0x73, 0xaa, 0x69, 0x9f, 0xc2, 0x27, 0x70, 0xed, 0xd2, 0x85, 0x0f,
+	0xd1, 0x65, 0x71, 0x25, 0x2e, 0x8a, 0xb4, 0x0b, 0x5f, 0x43, 0x32, 0x13, 0xc4, 0xbd, 0x9b, 0x61,
+	0xe6, 0xfc, 0xdf, 0x39, 0xe7, 0x67, 0x7e, 0xb2, 0xaf, 0x80, 0xa7, 0x80, 0x94, 0x4b, 0x91, 0x4c,
+	0x11, 0xe8, 0x6d, 0x37, 0x00, 0x64, 0x5d, 0x9a, 0xb0, 0x94, 0x09, 0xe5, 0x25, 0xa9, 0x44, 0xe9,
+	0x6c, 0x1b, 0xc8, 0x2b, 0x20, 0xaf, 0x80, 0x1a, 0x5b, 0xa1, 0x0c, 0xa5, 0x46, 0x68, 0x7e, 0x33,
+	0x74, 0x63, 0x87, 0x4b, 0x25, 0xa4, 0xf2, 0x8d, 0x60, 0x1e, 0x85, 0x54, 0x63, 0x22, 0x8a, 0x25,
+	0xd5, 0xa7, 0x29, 0xed, 0x3d, 0x94, 0x48, 0xe5, 0x4c, 0x2f, 0x73, 0xae, 0xc8, 0xdf, 0x7c, 0x43,
+	0x34, 0x01, 0x9f, 0x4b, 0x85, 0x75, 0xbb, 0x65, 0xb7, 0xff, 0xf4, 0x8e, 0x16, 0xab, 0xa6, 0xf5,
+	0xb6, 0x6a, 0xee, 0x9a, 0x49, 0xea, 0x66, 0xec, 0x45, 0x92, 0x0a, 0x86, 0x23, 0xef, 0x04, 0x42,
+	0xc6, 0x67, 0x03, 0xe0, 0x2f, 0xcf, 0x1d, 0x52, 0x2c, 0x1a, 0x00, 0x7f, 0xfc, 0x78, 0x3a, 0xb0,
+	0xcf, 0xab, 0xc5, 0xac, 0xbe, 0x54, 0xe8, 0x74, 0x49, 0x4d, 0xb0, 0xcc, 0xe7, 0x32, 0xc6, 0x94,
+	0x71, 0xf4, 0x55, 0x34, 0x87, 0x7a, 0xa9, 0x65, 0xb7, 0xcb, 0xbd, 0xdf, 0x06, 0xff, 0x2f, 0x58,
+	0xd6, 0x2f, 0xe4, 0x61, 0x34, 0x07, 0xa7, 0x43, 0xf2, 0x92, 0xaf, 0xf8, 0x08, 0x04, 0x33, 0x0d,
+	0xbf, 0xbe, 0x37, 0xfc, 0x13, 0x2c, 0x1b, 0x6a, 0x51, 0xe3, 0x97, 0xa4, 0x5a, 0xa0, 0xda, 0x7b,
+	0xf9, 0x47, 0xde, 0x89, 0x19, 0x95, 0x5b, 0xef, 0x5d, 0x2c, 0xd6, 0xae, 0xbd, 0x5c, 0xbb, 0xf6,
+	0xfb, 0xda, 0xb5, 0xef, 0x37, 0xae, 0xb5, 0xdc, 0xb8, 0xd6, 0xeb, 0xc6, 0xb5, 0xae, 0x8f, 0xc3,
+	0x08, 0x47, 0xd3, 0x20, 0x8f, 0x85, 0x2a, 0x9e, 0xe2, 0x84, 0x05, 0x8a, 0x0e, 0x75, 0x54, 0xa7,
+	0x80, 0x77, 0x32, 0x1d, 0xd3, 0xec, 0x2b, 0xd8, 0x28, 0x46, 0x48, 0x63, 0x36, 0xa1, 0x38, 0x4b,
+	0x40, 0x05, 0x15, 0xfd, 0xfb, 0x87, 0x9f, 0x03, 0x00, 0xd0, 0xa7, 0x0a, 0xfb, 0x00, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -127,6 +143,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SchemaCost.Size()
+		i -= size
+		if _, err := m.SchemaCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxSchemaSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSchemaSize))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MaxContractSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxContractSize))
 		i--
@@ -167,6 +198,11 @@ func (m *Params) Size() (n int) {
 	if m.MaxContractSize != 0 {
 		n += 1 + sovParams(uint64(m.MaxContractSize))
 	}
+	if m.MaxSchemaSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxSchemaSize))
+	}
+	l = m.SchemaCost.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -258,6 +294,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchemaSize", wireType)
+			}
+			m.MaxSchemaSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchemaSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaCost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SchemaCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
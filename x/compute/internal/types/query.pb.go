@@ -419,10 +419,11 @@ var xxx_messageInfo_QueryContractsByCodeIdResponse proto.InternalMessageInfo
 type CodeInfoResponse struct {
 	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 	// creator is the bech32 human readable address of the contract
-	Creator  string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
-	Source   string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	Builder  string `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
+	Creator    string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	CodeHash   string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Source     string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Builder    string `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
+	SchemaHash string `protobuf:"bytes,6,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
 }
 
 func (m *CodeInfoResponse) Reset()         { *m = CodeInfoResponse{} }
@@ -645,6 +646,46 @@ func (m *QueryCodeHashResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCodeHashResponse proto.InternalMessageInfo
 
+type QueryCodeSchemaResponse struct {
+	// schema_hash is the hex SHA-256 hash of the schema
+	SchemaHash string `protobuf:"bytes,1,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	// schema is the JSON schema of the code, empty if the code has none
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *QueryCodeSchemaResponse) Reset()         { *m = QueryCodeSchemaResponse{} }
+func (m *QueryCodeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeSchemaResponse) ProtoMessage()    {}
+func (*QueryCodeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{16}
+}
+func (m *QueryCodeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeSchemaResponse.Merge(m, src)
+}
+func (m *QueryCodeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeSchemaResponse proto.InternalMessageInfo
+
 // DecryptedAnswer is a struct that represents a decrypted tx-query
 type DecryptedAnswer struct {
 	Type               string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -657,7 +698,7 @@ func (m *DecryptedAnswer) Reset()         { *m = DecryptedAnswer{} }
 func (m *DecryptedAnswer) String() string { return proto.CompactTextString(m) }
 func (*DecryptedAnswer) ProtoMessage()    {}
 func (*DecryptedAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{16}
+	return fileDescriptor_7735281c5fa969d4, []int{17}
 }
 func (m *DecryptedAnswer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +738,7 @@ func (m *DecryptedAnswers) Reset()         { *m = DecryptedAnswers{} }
 func (m *DecryptedAnswers) String() string { return proto.CompactTextString(m) }
 func (*DecryptedAnswers) ProtoMessage()    {}
 func (*DecryptedAnswers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{17}
+	return fileDescriptor_7735281c5fa969d4, []int{18}
 }
 func (m *DecryptedAnswers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +778,7 @@ func (m *QueryContractHistoryRequest) Reset()         { *m = QueryContractHistor
 func (m *QueryContractHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractHistoryRequest) ProtoMessage()    {}
 func (*QueryContractHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{18}
+	return fileDescriptor_7735281c5fa969d4, []int{19}
 }
 func (m *QueryContractHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +817,7 @@ func (m *QueryContractHistoryResponse) Reset()         { *m = QueryContractHisto
 func (m *QueryContractHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractHistoryResponse) ProtoMessage()    {}
 func (*QueryContractHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{19}
+	return fileDescriptor_7735281c5fa969d4, []int{20}
 }
 func (m *QueryContractHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,6 +863,7 @@ func init() {
 	proto.RegisterType((*QueryContractAddressResponse)(nil), "secret.compute.v1beta1.QueryContractAddressResponse")
 	proto.RegisterType((*QueryContractLabelResponse)(nil), "secret.compute.v1beta1.QueryContractLabelResponse")
 	proto.RegisterType((*QueryCodeHashResponse)(nil), "secret.compute.v1beta1.QueryCodeHashResponse")
+	proto.RegisterType((*QueryCodeSchemaResponse)(nil), "secret.compute.v1beta1.QueryCodeSchemaResponse")
 	proto.RegisterType((*DecryptedAnswer)(nil), "secret.compute.v1beta1.DecryptedAnswer")
 	proto.RegisterType((*DecryptedAnswers)(nil), "secret.compute.v1beta1.DecryptedAnswers")
 	proto.RegisterType((*QueryContractHistoryRequest)(nil), "secret.compute.v1beta1.QueryContractHistoryRequest")
@@ -833,89 +875,93 @@ func init() {
 }
 
 var fileDescriptor_7735281c5fa969d4 = []byte{
-	// 1311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xb6, 0x4e, 0xd2, 0xbc, 0xa4, 0x4e, 0x3b, 0xa4, 0xa9, 0xeb, 0x16, 0xbb, 0x5d, 0xfa,
-	0x91, 0x8f, 0xe2, 0xad, 0x9d, 0x50, 0xa4, 0xaa, 0x97, 0x24, 0x8d, 0xd4, 0xa0, 0x50, 0x8a, 0x83,
-	0x84, 0x84, 0x8a, 0xac, 0xf1, 0x7a, 0x62, 0xaf, 0xea, 0xec, 0xb8, 0x3b, 0xe3, 0x24, 0x16, 0x0a,
-	0x07, 0x4e, 0x1c, 0x91, 0xa0, 0x07, 0xc4, 0x85, 0x13, 0x54, 0x1c, 0x90, 0xb8, 0xf2, 0x17, 0xe4,
-	0xc0, 0x21, 0x12, 0x17, 0x4e, 0x15, 0x24, 0x1c, 0x10, 0x77, 0xee, 0x68, 0xe7, 0x63, 0xb3, 0xb6,
-	0xd7, 0x5f, 0xe5, 0xc0, 0xcd, 0x33, 0xf3, 0xde, 0xfb, 0xfd, 0xe6, 0xf7, 0x66, 0xde, 0x9b, 0x35,
-	0x98, 0x8c, 0xd8, 0x1e, 0xe1, 0x96, 0x4d, 0xb7, 0xeb, 0x0d, 0x4e, 0xac, 0x9d, 0x5c, 0x89, 0x70,
-	0x9c, 0xb3, 0x9e, 0x35, 0x88, 0xd7, 0xcc, 0xd6, 0x3d, 0xca, 0x29, 0x9a, 0x91, 0x36, 0x59, 0x65,
-	0x93, 0x55, 0x36, 0xa9, 0xe9, 0x0a, 0xad, 0x50, 0x61, 0x62, 0xf9, 0xbf, 0xa4, 0x75, 0xaa, 0x5b,
-	0x44, 0xde, 0xac, 0x13, 0xa6, 0x6c, 0xde, 0xe8, 0x62, 0x53, 0xc7, 0x1e, 0xde, 0xd6, 0x46, 0x97,
-	0x2b, 0x94, 0x56, 0x6a, 0xc4, 0x12, 0xa3, 0x52, 0x63, 0xcb, 0x22, 0xdb, 0x75, 0xae, 0x38, 0xa5,
-	0xae, 0xa8, 0x45, 0x5c, 0x77, 0x2c, 0xec, 0xba, 0x94, 0x63, 0xee, 0x50, 0x37, 0x88, 0x6f, 0x53,
-	0xb6, 0x4d, 0x99, 0x55, 0xc2, 0x8c, 0x58, 0xb8, 0x64, 0x3b, 0x01, 0x82, 0x3f, 0x50, 0x46, 0xf3,
-	0x61, 0x23, 0xb1, 0xdf, 0x10, 0x8f, 0x8a, 0xe3, 0x8a, 0x88, 0xd2, 0xd6, 0x9c, 0x82, 0xb3, 0x8f,
-	0x05, 0xb7, 0x02, 0x79, 0xd6, 0x20, 0x8c, 0x9b, 0x1f, 0x40, 0x42, 0x4f, 0xb0, 0x3a, 0x75, 0x19,
-	0x41, 0xf7, 0x61, 0x54, 0xd2, 0x4f, 0x1a, 0x57, 0x8d, 0xd9, 0x89, 0x7c, 0x3a, 0x1b, 0x2d, 0x5b,
-	0x56, 0xfa, 0xad, 0xc4, 0x0f, 0x5e, 0x66, 0x62, 0x05, 0xe5, 0x73, 0x2f, 0xfe, 0xd7, 0xb7, 0x99,
-	0x98, 0xf9, 0x31, 0xa4, 0xde, 0xf7, 0x89, 0x6c, 0x0a, 0xcf, 0x55, 0xea, 0x72, 0x0f, 0xdb, 0x5c,
-	0x61, 0xa2, 0x39, 0x38, 0x67, 0xab, 0xa9, 0x22, 0x2e, 0x97, 0x3d, 0xc2, 0x24, 0xd6, 0x78, 0x61,
-	0x4a, 0xcf, 0x2f, 0xcb, 0x69, 0x34, 0x0d, 0x23, 0x62, 0x47, 0xc9, 0x53, 0x57, 0x8d, 0xd9, 0xc9,
-	0x82, 0x1c, 0x98, 0x0b, 0xf0, 0x9a, 0x08, 0xbf, 0xd2, 0xdc, 0xc0, 0x25, 0x52, 0xd3, 0x71, 0xa7,
-	0x61, 0xa4, 0xe6, 0x8f, 0x55, 0x30, 0x39, 0x30, 0xdf, 0x81, 0xd7, 0x95, 0xf1, 0x6a, 0x6b, 0xf0,
-	0xe1, 0xe9, 0x98, 0x16, 0x4c, 0x07, 0xb1, 0xca, 0x64, 0xbd, 0xac, 0x43, 0x5c, 0x84, 0x31, 0x9b,
-	0x96, 0x49, 0xd1, 0x29, 0x0b, 0xcf, 0x78, 0x61, 0xd4, 0x16, 0xeb, 0x66, 0x0e, 0x2e, 0x47, 0x0a,
-	0xa1, 0xb4, 0x46, 0x10, 0x2f, 0x63, 0x8e, 0x85, 0xd3, 0x64, 0x41, 0xfc, 0x36, 0xbf, 0x31, 0xe0,
-	0x92, 0xf0, 0xd1, 0xd6, 0xeb, 0xee, 0x16, 0x0d, 0x3c, 0x86, 0xd0, 0x6e, 0x13, 0xce, 0x06, 0xa6,
-	0x8e, 0xbb, 0x45, 0x85, 0x86, 0x13, 0xf9, 0xeb, 0xdd, 0xf2, 0x19, 0xc6, 0x5b, 0x39, 0x73, 0xf8,
-	0x32, 0x63, 0xfc, 0xed, 0x67, 0x76, 0xd2, 0x0e, 0xcd, 0x9b, 0x5f, 0x1b, 0x70, 0x31, 0x6c, 0xf8,
-	0xa1, 0xc3, 0xab, 0x1a, 0xf0, 0xff, 0xe6, 0xf6, 0x29, 0xa4, 0x5b, 0x84, 0x63, 0x27, 0x69, 0x52,
-	0xea, 0x3d, 0x81, 0x44, 0x0b, 0xac, 0xcf, 0xef, 0xf4, 0xec, 0x44, 0xde, 0x1a, 0x04, 0x37, 0xb4,
-	0x55, 0x75, 0xe8, 0xcf, 0x86, 0xe1, 0x99, 0xf9, 0x95, 0x01, 0xe7, 0x04, 0x60, 0x38, 0x61, 0xdd,
-	0x8e, 0x06, 0x4a, 0xc2, 0x98, 0xed, 0x11, 0xcc, 0xa9, 0x27, 0x36, 0x3f, 0x5e, 0xd0, 0x43, 0x74,
-	0x19, 0xc6, 0x85, 0x4b, 0x15, 0xb3, 0x6a, 0xf2, 0xb4, 0x58, 0x3b, 0xe3, 0x4f, 0x3c, 0xc4, 0xac,
-	0x8a, 0x66, 0x60, 0x94, 0xd1, 0x86, 0x67, 0x93, 0x64, 0x5c, 0xac, 0xa8, 0x91, 0x1f, 0xae, 0xd4,
-	0x70, 0x6a, 0x65, 0xe2, 0x25, 0x47, 0x64, 0x38, 0x35, 0x34, 0xf7, 0xe0, 0xbc, 0x92, 0xa5, 0x4c,
-	0x02, 0x5a, 0xef, 0x29, 0x0c, 0x21, 0xbe, 0xbc, 0xe8, 0xb3, 0xdd, 0x45, 0x68, 0xdd, 0x53, 0x28,
-	0x01, 0x82, 0x97, 0xbf, 0xe6, 0x1f, 0xe5, 0x5d, 0xcc, 0xb6, 0xd5, 0x45, 0x15, 0xbf, 0x4d, 0x1b,
-	0x50, 0x80, 0x7c, 0x52, 0x60, 0xde, 0x05, 0x08, 0xa0, 0x75, 0x02, 0x06, 0xc7, 0x96, 0xca, 0x8f,
-	0x6b, 0x5c, 0x66, 0xae, 0xc3, 0x95, 0x96, 0xac, 0x07, 0xb7, 0x7b, 0xe8, 0x1b, 0x63, 0xe6, 0x55,
-	0xd9, 0xd2, 0xa1, 0x54, 0x75, 0x51, 0x81, 0xa2, 0xcb, 0xcb, 0x12, 0x5c, 0x08, 0xf6, 0xe8, 0x27,
-	0x28, 0x30, 0x6f, 0xc9, 0xa2, 0xd1, 0x9a, 0x45, 0xf3, 0xb9, 0x01, 0x53, 0x0f, 0x88, 0xed, 0x35,
-	0xeb, 0x9c, 0x94, 0x97, 0x5d, 0xb6, 0x4b, 0x3c, 0x5f, 0x41, 0xbf, 0xb7, 0x28, 0x5b, 0xf1, 0xdb,
-	0xc7, 0x74, 0xdc, 0x7a, 0x83, 0xab, 0x23, 0x22, 0x07, 0x28, 0x03, 0x13, 0xb4, 0xc1, 0xeb, 0x0d,
-	0x5e, 0x14, 0xd5, 0x43, 0x1e, 0x11, 0x90, 0x53, 0x0f, 0x30, 0xc7, 0x28, 0x07, 0x17, 0x42, 0x06,
-	0x45, 0xcc, 0x8a, 0x8c, 0x7b, 0x8e, 0x5b, 0x51, 0x67, 0x06, 0x9d, 0x98, 0x2e, 0xb3, 0x4d, 0xb1,
-	0xa2, 0x0a, 0xf7, 0x3f, 0x06, 0x9c, 0x6b, 0xe3, 0xc5, 0xd0, 0x32, 0x8c, 0x61, 0xf9, 0x53, 0x65,
-	0xeb, 0x56, 0xb7, 0x6c, 0xb5, 0xb9, 0x16, 0xb4, 0x1f, 0xda, 0x08, 0x18, 0xd7, 0x68, 0x85, 0x25,
-	0x4f, 0x89, 0x30, 0x37, 0xb2, 0xb2, 0x73, 0x65, 0xfd, 0xce, 0x95, 0x15, 0x1d, 0x4d, 0x07, 0x92,
-	0xa4, 0xd6, 0x76, 0x88, 0xcb, 0x55, 0xc6, 0xd5, 0xf6, 0x36, 0x68, 0x85, 0xa1, 0x6b, 0x30, 0xa9,
-	0xa2, 0x11, 0xcf, 0xa3, 0x9e, 0x12, 0x40, 0x21, 0xac, 0xf9, 0x53, 0xe8, 0x16, 0x4c, 0xd5, 0x6b,
-	0xd8, 0x71, 0x39, 0xd9, 0xd3, 0x56, 0x72, 0xef, 0x89, 0x60, 0x5a, 0x18, 0xaa, 0x7d, 0x3f, 0x52,
-	0x75, 0x5a, 0x67, 0xfe, 0xa1, 0xc3, 0x38, 0xf5, 0x9a, 0xc3, 0xb7, 0x08, 0x15, 0x6f, 0xa7, 0xed,
-	0x50, 0x06, 0xf1, 0xd4, 0xe1, 0x78, 0x0c, 0x63, 0xc4, 0xe5, 0x9e, 0x43, 0xb4, 0xa4, 0x77, 0xfa,
-	0x55, 0x20, 0x71, 0xbe, 0x64, 0x94, 0x35, 0x97, 0x7b, 0x4d, 0x25, 0x8b, 0x0e, 0x23, 0x71, 0xf3,
-	0xcf, 0x13, 0x30, 0x22, 0x80, 0xd1, 0x0f, 0x06, 0x4c, 0x86, 0xab, 0x17, 0x7a, 0xab, 0x1b, 0x42,
-	0xcf, 0xee, 0x98, 0xca, 0xf5, 0x74, 0x8b, 0xea, 0x51, 0xe6, 0x9d, 0xcf, 0x7e, 0xfd, 0xf3, 0xcb,
-	0x53, 0xf3, 0x68, 0xb6, 0xe3, 0x5d, 0xe4, 0x5f, 0x79, 0xeb, 0x93, 0x76, 0x29, 0xf7, 0xd1, 0xf7,
-	0x06, 0x9c, 0xef, 0xa8, 0xda, 0xe8, 0x76, 0x5f, 0xc6, 0xa1, 0x1e, 0x9c, 0xba, 0x3b, 0x10, 0xd1,
-	0x8e, 0x9e, 0x60, 0xde, 0x16, 0x6c, 0x6f, 0xa2, 0xeb, 0x1d, 0x6c, 0x35, 0x4f, 0xe6, 0x53, 0x16,
-	0x25, 0x7c, 0x1f, 0xfd, 0x64, 0xa8, 0xb7, 0x47, 0x6b, 0x47, 0x47, 0xf9, 0x9e, 0xe8, 0x91, 0xef,
-	0xa0, 0xd4, 0xe2, 0x50, 0x3e, 0x8a, 0x6e, 0x4e, 0xd0, 0x5d, 0x40, 0x73, 0xd1, 0x4f, 0xdd, 0x28,
-	0x75, 0x3f, 0x37, 0x20, 0xee, 0x6f, 0x7a, 0x48, 0x41, 0xe7, 0xfa, 0x08, 0x7a, 0xd2, 0x4d, 0xcc,
-	0x5b, 0x82, 0xd4, 0x35, 0x94, 0x89, 0xd0, 0xb0, 0x4c, 0x42, 0xf2, 0x3d, 0x85, 0x11, 0xd1, 0x0c,
-	0xd0, 0x4c, 0x56, 0x3e, 0x7c, 0xb3, 0xfa, 0x55, 0x9c, 0x5d, 0xf3, 0x5f, 0xc5, 0xa9, 0xf9, 0xbe,
-	0xa0, 0x41, 0x65, 0x37, 0xd3, 0x02, 0x35, 0x89, 0x66, 0x22, 0x51, 0x19, 0xfa, 0xc5, 0x80, 0x4b,
-	0xba, 0x2c, 0x77, 0x9c, 0xef, 0x57, 0xbd, 0x0f, 0x6f, 0xf6, 0x25, 0x18, 0xee, 0x02, 0xe6, 0xba,
-	0xe0, 0xb8, 0x8a, 0x96, 0x23, 0x39, 0x8a, 0xe6, 0x60, 0x95, 0x9a, 0xc5, 0xf6, 0xa4, 0x45, 0xa5,
-	0xf1, 0x85, 0x7a, 0x5e, 0xe8, 0xed, 0xbc, 0xc2, 0x1d, 0x19, 0x92, 0xfc, 0xdb, 0x82, 0x7c, 0x0e,
-	0x59, 0xfd, 0xc8, 0x8b, 0xec, 0x86, 0xd2, 0xfc, 0xa3, 0x01, 0x09, 0xd1, 0x3c, 0x57, 0x9a, 0xff,
-	0x51, 0xee, 0xfc, 0x40, 0xb7, 0xba, 0xa5, 0x51, 0xf7, 0xb8, 0x22, 0xa2, 0x65, 0x47, 0x69, 0xfb,
-	0x9d, 0x01, 0x09, 0xfd, 0xb6, 0x93, 0x1f, 0x15, 0x68, 0xa1, 0x0f, 0xe1, 0xf0, 0xa7, 0x47, 0x6a,
-	0x69, 0x20, 0x9a, 0x6d, 0x4f, 0x93, 0x1e, 0x44, 0x3b, 0xcf, 0x83, 0xa0, 0xbe, 0x8f, 0x7e, 0x36,
-	0x60, 0xaa, 0xad, 0xa9, 0xa0, 0xc5, 0x81, 0xc0, 0x5b, 0x5b, 0xda, 0x80, 0x8c, 0xdb, 0xfa, 0x96,
-	0x79, 0x5f, 0x30, 0xbe, 0x8b, 0x96, 0xba, 0x33, 0xae, 0x4a, 0x97, 0x28, 0x95, 0xf7, 0x60, 0x54,
-	0x7e, 0x34, 0xa2, 0x1b, 0xbd, 0x3f, 0x2a, 0x35, 0xc9, 0x9b, 0xfd, 0xcc, 0x14, 0xad, 0x8c, 0xa0,
-	0x75, 0x09, 0x5d, 0xec, 0xf2, 0x25, 0xbe, 0xf2, 0xe4, 0xe0, 0x8f, 0x74, 0xec, 0xc5, 0x51, 0xda,
-	0x38, 0x38, 0x4a, 0x1b, 0x87, 0x47, 0x69, 0xe3, 0xf7, 0xa3, 0xb4, 0xf1, 0xc5, 0x71, 0x3a, 0x76,
-	0x78, 0x9c, 0x8e, 0xfd, 0x76, 0x9c, 0x8e, 0x7d, 0x74, 0xaf, 0xe2, 0xf0, 0x6a, 0xa3, 0xe4, 0x23,
-	0x59, 0xcc, 0xf6, 0x78, 0x0d, 0x97, 0x98, 0x25, 0x6b, 0xf0, 0x23, 0xc2, 0x77, 0xa9, 0xf7, 0xd4,
-	0xda, 0x0b, 0xa2, 0xfb, 0x0f, 0x08, 0xcf, 0xc5, 0x35, 0xf9, 0x67, 0x40, 0x69, 0x54, 0x14, 0xb1,
-	0xc5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xbf, 0x66, 0x60, 0x85, 0x10, 0x00, 0x00,
+	// 1376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x14, 0x47,
+	0x13, 0x77, 0x83, 0x1f, 0xb8, 0x6c, 0x6c, 0x68, 0x8c, 0xbd, 0xac, 0xf9, 0xd6, 0x30, 0x1f, 0x0f,
+	0xf3, 0xc8, 0x0c, 0xbb, 0x38, 0x44, 0x42, 0x5c, 0x6c, 0xb0, 0x84, 0x23, 0x42, 0xc8, 0x3a, 0x52,
+	0xa4, 0x88, 0x68, 0xd5, 0x3b, 0xd3, 0xec, 0x8e, 0x58, 0x4f, 0x2f, 0xd3, 0xbd, 0xe0, 0x15, 0x22,
+	0x87, 0x9c, 0x72, 0x8c, 0x14, 0xe5, 0x10, 0x71, 0xc9, 0x29, 0x41, 0x39, 0x44, 0x8a, 0xa2, 0x5c,
+	0xf2, 0x17, 0x70, 0xc8, 0x01, 0x29, 0x97, 0x9c, 0x50, 0x62, 0x72, 0x88, 0x72, 0xcf, 0x3d, 0xea,
+	0xc7, 0x8c, 0x67, 0xd6, 0xb3, 0x2f, 0x72, 0xc8, 0x6d, 0xba, 0xbb, 0x1e, 0xbf, 0xfe, 0x55, 0x75,
+	0x55, 0xed, 0x82, 0xc5, 0xa9, 0x1b, 0x52, 0xe1, 0xb8, 0x6c, 0xab, 0xd9, 0x12, 0xd4, 0x79, 0x58,
+	0xac, 0x52, 0x41, 0x8a, 0xce, 0x83, 0x16, 0x0d, 0xdb, 0x76, 0x33, 0x64, 0x82, 0xe1, 0x79, 0x2d,
+	0x63, 0x1b, 0x19, 0xdb, 0xc8, 0xe4, 0xe7, 0x6a, 0xac, 0xc6, 0x94, 0x88, 0x23, 0xbf, 0xb4, 0x74,
+	0xbe, 0x9b, 0x45, 0xd1, 0x6e, 0x52, 0x6e, 0x64, 0xfe, 0xdf, 0x45, 0xa6, 0x49, 0x42, 0xb2, 0x15,
+	0x09, 0x2d, 0xd6, 0x18, 0xab, 0x35, 0xa8, 0xa3, 0x56, 0xd5, 0xd6, 0x3d, 0x87, 0x6e, 0x35, 0x85,
+	0xc1, 0x94, 0x3f, 0x6e, 0x0e, 0x49, 0xd3, 0x77, 0x48, 0x10, 0x30, 0x41, 0x84, 0xcf, 0x82, 0xd8,
+	0xbe, 0xcb, 0xf8, 0x16, 0xe3, 0x4e, 0x95, 0x70, 0xea, 0x90, 0xaa, 0xeb, 0xc7, 0x1e, 0xe4, 0xc2,
+	0x08, 0x9d, 0x4f, 0x0a, 0xa9, 0xfb, 0x26, 0x70, 0xd4, 0xfc, 0x40, 0x59, 0xd4, 0xb2, 0xd6, 0x2c,
+	0x1c, 0xbc, 0xa3, 0xb0, 0x95, 0xe9, 0x83, 0x16, 0xe5, 0xc2, 0x7a, 0x1f, 0x66, 0xa2, 0x0d, 0xde,
+	0x64, 0x01, 0xa7, 0xf8, 0x1a, 0x8c, 0x6b, 0xf8, 0x39, 0x74, 0x02, 0x2d, 0x4f, 0x95, 0x0a, 0x76,
+	0x36, 0x6d, 0xb6, 0xd6, 0x5b, 0x1b, 0x7d, 0xfe, 0x72, 0x69, 0xa4, 0x6c, 0x74, 0xae, 0x8e, 0xfe,
+	0xf9, 0xd5, 0xd2, 0x88, 0xf5, 0x11, 0xe4, 0xdf, 0x93, 0x40, 0x36, 0x95, 0xe6, 0x75, 0x16, 0x88,
+	0x90, 0xb8, 0xc2, 0xf8, 0xc4, 0xe7, 0xe0, 0x90, 0x6b, 0xb6, 0x2a, 0xc4, 0xf3, 0x42, 0xca, 0xb5,
+	0xaf, 0xc9, 0xf2, 0x6c, 0xb4, 0xbf, 0xaa, 0xb7, 0xf1, 0x1c, 0x8c, 0xa9, 0x1b, 0xe5, 0xf6, 0x9d,
+	0x40, 0xcb, 0xd3, 0x65, 0xbd, 0xb0, 0x2e, 0xc0, 0x11, 0x65, 0x7e, 0xad, 0x7d, 0x8b, 0x54, 0x69,
+	0x23, 0xb2, 0x3b, 0x07, 0x63, 0x0d, 0xb9, 0x36, 0xc6, 0xf4, 0xc2, 0x7a, 0x1b, 0xfe, 0x67, 0x84,
+	0xaf, 0xa7, 0x8d, 0x0f, 0x0f, 0xc7, 0x72, 0x60, 0x2e, 0xb6, 0xe5, 0xd1, 0x0d, 0x2f, 0x32, 0xb1,
+	0x00, 0x13, 0x2e, 0xf3, 0x68, 0xc5, 0xf7, 0x94, 0xe6, 0x68, 0x79, 0xdc, 0x55, 0xe7, 0x56, 0x11,
+	0x16, 0x33, 0x89, 0x30, 0x5c, 0x63, 0x18, 0xf5, 0x88, 0x20, 0x4a, 0x69, 0xba, 0xac, 0xbe, 0xad,
+	0xa7, 0x08, 0x8e, 0x29, 0x9d, 0x48, 0x7a, 0x23, 0xb8, 0xc7, 0x62, 0x8d, 0x21, 0xb8, 0xdb, 0x84,
+	0x83, 0xb1, 0xa8, 0x1f, 0xdc, 0x63, 0x8a, 0xc3, 0xa9, 0xd2, 0xa9, 0x6e, 0xf1, 0x4c, 0xfa, 0x5b,
+	0x3b, 0xf0, 0xe2, 0xe5, 0x12, 0xfa, 0x4b, 0x46, 0x76, 0xda, 0x4d, 0xec, 0x5b, 0x5f, 0x22, 0x58,
+	0x48, 0x0a, 0x7e, 0xe0, 0x8b, 0x7a, 0xe4, 0xf0, 0xbf, 0xc6, 0xf6, 0x31, 0x14, 0x52, 0xc4, 0xf1,
+	0xdd, 0x30, 0x19, 0xf6, 0xee, 0xc2, 0x4c, 0xca, 0xad, 0xc4, 0xb7, 0x7f, 0x79, 0xaa, 0xe4, 0x0c,
+	0xe2, 0x37, 0x71, 0x55, 0x93, 0xf4, 0x07, 0x93, 0xee, 0xb9, 0xf5, 0x23, 0x82, 0x43, 0xca, 0x61,
+	0x32, 0x60, 0xdd, 0x52, 0x03, 0xe7, 0x60, 0xc2, 0x0d, 0x29, 0x11, 0x2c, 0x54, 0x97, 0x9f, 0x2c,
+	0x47, 0x4b, 0xbc, 0x08, 0x93, 0x4a, 0xa5, 0x4e, 0x78, 0x3d, 0xb7, 0x5f, 0x9d, 0x1d, 0x90, 0x1b,
+	0x37, 0x09, 0xaf, 0xe3, 0x79, 0x18, 0xe7, 0xac, 0x15, 0xba, 0x34, 0x37, 0xaa, 0x4e, 0xcc, 0x4a,
+	0x9a, 0xab, 0xb6, 0xfc, 0x86, 0x47, 0xc3, 0xdc, 0x98, 0x36, 0x67, 0x96, 0x78, 0x09, 0xa6, 0xb8,
+	0x5b, 0xa7, 0x5b, 0x44, 0x1b, 0x1c, 0x57, 0xa7, 0xa0, 0xb7, 0xa4, 0x49, 0x6b, 0x1b, 0x0e, 0x1b,
+	0xde, 0x3c, 0x1a, 0xe3, 0x7e, 0xd7, 0x80, 0x50, 0xd1, 0xd1, 0x95, 0x60, 0xb9, 0x3b, 0x4b, 0xe9,
+	0x4b, 0x27, 0x22, 0x74, 0xc0, 0x35, 0x67, 0x32, 0xd7, 0x1f, 0x11, 0xbe, 0x65, 0x5e, 0xb2, 0xfa,
+	0xb6, 0x5c, 0xc0, 0xb1, 0xe7, 0xdd, 0x0a, 0xf4, 0x0e, 0x40, 0xec, 0x3a, 0x8a, 0xd0, 0xe0, 0xbe,
+	0x75, 0x68, 0x26, 0x23, 0xbf, 0xdc, 0xda, 0x80, 0xe3, 0xa9, 0xb4, 0x88, 0x9f, 0xff, 0xd0, 0x4f,
+	0xca, 0x2a, 0x41, 0x3e, 0x65, 0xca, 0x94, 0x1f, 0x63, 0x28, 0xbb, 0xfe, 0xac, 0xc0, 0xd1, 0xf8,
+	0x8e, 0x92, 0xee, 0x58, 0x3c, 0x15, 0x66, 0x94, 0x0e, 0xb3, 0x55, 0x86, 0x85, 0x58, 0x6b, 0x53,
+	0x85, 0x2a, 0xd6, 0xeb, 0x88, 0x27, 0xea, 0x8c, 0xa7, 0x4a, 0x11, 0xb5, 0x32, 0x89, 0x65, 0x56,
+	0xd6, 0x17, 0x08, 0x66, 0x6f, 0x50, 0x37, 0x6c, 0x37, 0x05, 0xf5, 0x56, 0x03, 0xfe, 0x88, 0x86,
+	0x32, 0x2a, 0xb2, 0xa1, 0x19, 0x2b, 0xea, 0x5b, 0xde, 0xc3, 0x0f, 0x9a, 0x2d, 0x61, 0xd4, 0xf5,
+	0x42, 0xba, 0x65, 0x2d, 0xd1, 0x6c, 0x89, 0x8a, 0x2a, 0x59, 0x3a, 0x2f, 0x41, 0x6f, 0xdd, 0x20,
+	0x82, 0xe0, 0x22, 0x1c, 0x4d, 0x08, 0x54, 0x08, 0xaf, 0x70, 0x11, 0xfa, 0x41, 0xcd, 0x24, 0x2a,
+	0xde, 0x15, 0x5d, 0xe5, 0x9b, 0xea, 0xc4, 0x74, 0x8b, 0xbf, 0x11, 0x1c, 0xea, 0xc0, 0xc5, 0xf1,
+	0x2a, 0x4c, 0x10, 0xfd, 0x69, 0x32, 0xe0, 0x6c, 0xb7, 0x0c, 0xe8, 0x50, 0x2d, 0x47, 0x7a, 0xf8,
+	0x56, 0x8c, 0xb8, 0xc1, 0x6a, 0x3c, 0xb7, 0x4f, 0x99, 0x39, 0x6d, 0xeb, 0x76, 0x69, 0xcb, 0x76,
+	0x69, 0xab, 0x36, 0x1a, 0x19, 0xd2, 0xa0, 0xd6, 0x1f, 0xd2, 0x40, 0x98, 0x2c, 0x32, 0xd7, 0xbb,
+	0xc5, 0x6a, 0x1c, 0x9f, 0x84, 0x69, 0x63, 0x8d, 0x86, 0x21, 0x0b, 0x0d, 0x01, 0xc6, 0xc3, 0xba,
+	0xdc, 0xc2, 0x67, 0x61, 0xb6, 0xd9, 0x20, 0x7e, 0x20, 0xe8, 0x76, 0x24, 0xa5, 0xef, 0x3e, 0x13,
+	0x6f, 0x2b, 0x41, 0x73, 0xef, 0xdb, 0xb0, 0x98, 0xca, 0xa6, 0x9b, 0x3e, 0x17, 0x2c, 0x6c, 0x0f,
+	0xdf, 0x97, 0x8c, 0xbd, 0x87, 0x70, 0x3c, 0xdb, 0x9e, 0x49, 0x9c, 0x3b, 0x30, 0x41, 0x03, 0x11,
+	0xfa, 0x34, 0xa2, 0xf4, 0x52, 0xbf, 0xb2, 0xa7, 0x72, 0x56, 0x5b, 0x59, 0x0f, 0x44, 0xd8, 0x36,
+	0xb4, 0x44, 0x66, 0xb4, 0xdf, 0xd2, 0x0f, 0xb3, 0x30, 0xa6, 0x1c, 0xe3, 0x6f, 0x11, 0x4c, 0x27,
+	0x4b, 0x26, 0x7e, 0xb3, 0x9b, 0x87, 0x9e, 0x2d, 0x39, 0x5f, 0xec, 0xa9, 0x96, 0xd5, 0x18, 0xad,
+	0x4b, 0x9f, 0xfc, 0xf2, 0xc7, 0xe7, 0xfb, 0xce, 0xe3, 0xe5, 0x3d, 0xc3, 0x98, 0x2c, 0x23, 0xce,
+	0xe3, 0x4e, 0x2a, 0x9f, 0xe0, 0x6f, 0x10, 0x1c, 0xde, 0xd3, 0x2a, 0xf0, 0xc5, 0xbe, 0x88, 0x13,
+	0x8d, 0x3f, 0x7f, 0x65, 0x20, 0xa0, 0x7b, 0x1a, 0x91, 0x75, 0x51, 0xa1, 0x3d, 0x83, 0x4f, 0xed,
+	0x41, 0x1b, 0xe1, 0xe4, 0xce, 0x63, 0x5d, 0x04, 0xbd, 0x27, 0xf8, 0x7b, 0x04, 0x47, 0x32, 0xc6,
+	0x08, 0x5c, 0xea, 0xe9, 0x3d, 0x73, 0xf8, 0xca, 0x5f, 0x1e, 0x4a, 0xc7, 0xc0, 0x2d, 0x2a, 0xb8,
+	0x17, 0xf0, 0xb9, 0xec, 0xf9, 0x3a, 0x8b, 0xdd, 0x4f, 0x11, 0x8c, 0xca, 0x4b, 0x0f, 0x49, 0xe8,
+	0xb9, 0x3e, 0x84, 0xee, 0x76, 0x28, 0xeb, 0xac, 0x02, 0x75, 0x12, 0x2f, 0x65, 0x70, 0xe8, 0xd1,
+	0x04, 0x7d, 0xf7, 0x61, 0x4c, 0x2a, 0x72, 0x3c, 0x6f, 0xeb, 0x69, 0xdb, 0x8e, 0x46, 0x71, 0x7b,
+	0x5d, 0x8e, 0xe2, 0xf9, 0xf3, 0x7d, 0x9d, 0xc6, 0xdd, 0xc2, 0x2a, 0x28, 0xaf, 0x39, 0x3c, 0x9f,
+	0xe9, 0x95, 0xe3, 0xa7, 0x08, 0x60, 0xb7, 0x68, 0x0f, 0x79, 0x7b, 0xa7, 0x2f, 0x90, 0x74, 0x2f,
+	0xb0, 0x6c, 0x85, 0x66, 0x19, 0x9f, 0xc9, 0x44, 0x53, 0xd1, 0x85, 0x3f, 0x41, 0xc5, 0xcf, 0x08,
+	0x8e, 0x45, 0x8d, 0x68, 0xcf, 0xeb, 0x7b, 0xdd, 0xd7, 0xfa, 0x46, 0x5f, 0xd4, 0xc9, 0xbe, 0x67,
+	0x6d, 0x28, 0xcc, 0xd7, 0xf1, 0x6a, 0x36, 0x66, 0xd9, 0xd4, 0x9c, 0x6a, 0xbb, 0xd2, 0x99, 0x52,
+	0x59, 0x49, 0xf6, 0xcc, 0x4c, 0x5c, 0xd1, 0x75, 0x5e, 0xe3, 0x05, 0x0f, 0x09, 0xfe, 0x2d, 0x05,
+	0xbe, 0x88, 0x9d, 0x7e, 0xe0, 0x15, 0xe1, 0x09, 0xe6, 0xbf, 0x43, 0x30, 0xa3, 0xc6, 0x85, 0xb5,
+	0xf6, 0xbf, 0xa4, 0xbb, 0x34, 0x50, 0xcd, 0x49, 0x8d, 0x26, 0x3d, 0x1e, 0xb0, 0x1a, 0x52, 0xb2,
+	0xb8, 0xfd, 0x1a, 0xc1, 0x4c, 0x34, 0xee, 0xea, 0xdf, 0x59, 0xf8, 0x42, 0x1f, 0xc0, 0xc9, 0x5f,
+	0x63, 0xf9, 0x95, 0x81, 0x60, 0x76, 0x0c, 0x63, 0x3d, 0x80, 0xee, 0xcd, 0x07, 0x05, 0xfd, 0x09,
+	0xfe, 0x09, 0xc1, 0x6c, 0x47, 0xcb, 0xc3, 0x97, 0x07, 0x72, 0x9e, 0x6e, 0xb8, 0xf9, 0x95, 0xe1,
+	0x94, 0x0c, 0xe2, 0x6b, 0x0a, 0xf1, 0x15, 0xbc, 0xd2, 0x1d, 0x71, 0x5d, 0xab, 0x64, 0xb1, 0xbc,
+	0x0d, 0xe3, 0xfa, 0x77, 0x34, 0x3e, 0xdd, 0xfb, 0x77, 0x76, 0x04, 0xf2, 0x4c, 0x3f, 0x31, 0x03,
+	0x6b, 0x49, 0xc1, 0x3a, 0x86, 0x17, 0xba, 0xfc, 0x39, 0xb1, 0x76, 0xf7, 0xf9, 0xef, 0x85, 0x91,
+	0x67, 0x3b, 0x05, 0xf4, 0x7c, 0xa7, 0x80, 0x5e, 0xec, 0x14, 0xd0, 0x6f, 0x3b, 0x05, 0xf4, 0xd9,
+	0xab, 0xc2, 0xc8, 0x8b, 0x57, 0x85, 0x91, 0x5f, 0x5f, 0x15, 0x46, 0x3e, 0xbc, 0x5a, 0xf3, 0x45,
+	0xbd, 0x55, 0x95, 0x9e, 0x1c, 0xee, 0x86, 0xa2, 0x41, 0xaa, 0xdc, 0xd1, 0x1d, 0xe2, 0x36, 0x15,
+	0x8f, 0x58, 0x78, 0xdf, 0xd9, 0x8e, 0xad, 0xcb, 0xf1, 0x26, 0x0c, 0x48, 0x43, 0xff, 0x3f, 0x52,
+	0x1d, 0x57, 0x25, 0xf6, 0xf2, 0x3f, 0x03, 0x00, 0xa7, 0xce, 0xa7, 0x6c, 0x98, 0x11, 0x00, 0x00,
 }
 
 func (this *ParamsRequest) Equal(that interface{}) bool {
@@ -1179,6 +1225,9 @@ func (this *CodeInfoResponse) Equal(that interface{}) bool {
 	if this.Builder != that1.Builder {
 		return false
 	}
+	if this.SchemaHash != that1.SchemaHash {
+		return false
+	}
 	return true
 }
 func (this *QueryCodeResponse) Equal(that interface{}) bool {
@@ -1309,6 +1358,33 @@ func (this *QueryCodeHashResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueryCodeSchemaResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryCodeSchemaResponse)
+	if !ok {
+		that2, ok := that.(QueryCodeSchemaResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SchemaHash != that1.SchemaHash {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1332,6 +1408,8 @@ type QueryClient interface {
 	Code(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Query all contract codes on-chain
 	Codes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryCodesResponse, error)
+	// Query the JSON schema of the messages of a code
+	CodeSchema(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeSchemaResponse, error)
 	// Query code hash by contract address
 	CodeHashByContractAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
 	// Query code hash by code id
@@ -1400,6 +1478,15 @@ func (c *queryClient) Codes(ctx context.Context, in *emptypb.Empty, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) CodeSchema(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeSchemaResponse, error) {
+	out := new(QueryCodeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CodeHashByContractAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error) {
 	out := new(QueryCodeHashResponse)
 	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeHashByContractAddress", in, out, opts...)
@@ -1466,6 +1553,8 @@ type QueryServer interface {
 	Code(context.Context, *QueryByCodeIdRequest) (*QueryCodeResponse, error)
 	// Query all contract codes on-chain
 	Codes(context.Context, *emptypb.Empty) (*QueryCodesResponse, error)
+	// Query the JSON schema of the messages of a code
+	CodeSchema(context.Context, *QueryByCodeIdRequest) (*QueryCodeSchemaResponse, error)
 	// Query code hash by contract address
 	CodeHashByContractAddress(context.Context, *QueryByContractAddressRequest) (*QueryCodeHashResponse, error)
 	// Query code hash by code id
@@ -1500,6 +1589,9 @@ func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryByCodeIdReq
 func (*UnimplementedQueryServer) Codes(ctx context.Context, req *emptypb.Empty) (*QueryCodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Codes not implemented")
 }
+func (*UnimplementedQueryServer) CodeSchema(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeSchema not implemented")
+}
 func (*UnimplementedQueryServer) CodeHashByContractAddress(ctx context.Context, req *QueryByContractAddressRequest) (*QueryCodeHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CodeHashByContractAddress not implemented")
 }
@@ -1613,6 +1705,24 @@ func _Query_Codes_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CodeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByCodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CodeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeSchema(ctx, req.(*QueryByCodeIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CodeHashByContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryByContractAddressRequest)
 	if err := dec(in); err != nil {
@@ -1745,6 +1855,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Codes",
 			Handler:    _Query_Codes_Handler,
 		},
+		{
+			MethodName: "CodeSchema",
+			Handler:    _Query_CodeSchema_Handler,
+		},
 		{
 			MethodName: "CodeHashByContractAddress",
 			Handler:    _Query_CodeHashByContractAddress_Handler,
@@ -2126,6 +2240,13 @@ func (m *CodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Builder) > 0 {
 		i -= len(m.Builder)
 		copy(dAtA[i:], m.Builder)
@@ -2331,6 +2452,43 @@ func (m *QueryCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCodeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecryptedAnswer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2687,6 +2845,10 @@ func (m *CodeInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2761,6 +2923,23 @@ func (m *QueryCodeHashResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCodeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *DecryptedAnswer) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3911,6 +4090,38 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4382,6 +4593,120 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCodeSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -132,6 +132,9 @@ type CodeInfo struct {
 	Creator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
 	Source   string                                        `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	Builder  string                                        `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`
+	// schema_hash is the SHA-256 hash of the JSON schema of the code, if it was
+	// stored with one
+	SchemaHash []byte `protobuf:"bytes,5,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
@@ -438,73 +441,74 @@ func init() {
 }
 
 var fileDescriptor_8ba7f40a6d1951b3 = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf7, 0xc6, 0x8e, 0x1d, 0x8f, 0xdd, 0xd6, 0x1a, 0x42, 0xeb, 0x1a, 0xc9, 0x36, 0x5b, 0x54,
-	0x4a, 0x43, 0xec, 0xa4, 0x70, 0x40, 0xe1, 0xe4, 0xc7, 0x26, 0x59, 0x42, 0xd6, 0xd6, 0xd8, 0x09,
-	0x0a, 0x02, 0xad, 0xf6, 0x31, 0xb1, 0x57, 0x59, 0xef, 0x58, 0x33, 0xe3, 0xe0, 0xbd, 0x71, 0x44,
-	0x3e, 0x71, 0xe4, 0x62, 0x09, 0x89, 0xaa, 0xea, 0x17, 0xe0, 0x0b, 0x70, 0xea, 0xb1, 0x47, 0x4e,
-	0x16, 0x38, 0x1f, 0x00, 0x89, 0x63, 0x4f, 0x68, 0xc7, 0xeb, 0xd8, 0xa5, 0x8d, 0x12, 0x24, 0x4e,
-	0xf9, 0x3f, 0x7f, 0xff, 0xd7, 0x6f, 0x27, 0x06, 0x32, 0xc3, 0x16, 0xc5, 0xbc, 0x6c, 0x91, 0x5e,
-	0x7f, 0xc0, 0x71, 0xf9, 0x7c, 0xdb, 0xc4, 0xdc, 0xd8, 0x2e, 0x73, 0xbf, 0x8f, 0x59, 0xa9, 0x4f,
-	0x09, 0x27, 0xf0, 0xee, 0x2c, 0xa6, 0x14, 0xc6, 0x94, 0xc2, 0x98, 0xdc, 0x7a, 0x87, 0x74, 0x88,
-	0x08, 0x29, 0x07, 0xd2, 0x2c, 0x5a, 0xb6, 0xc0, 0x9d, 0x8a, 0x65, 0x61, 0xc6, 0xda, 0x7e, 0x1f,
-	0x37, 0x0d, 0x6a, 0xf4, 0xe0, 0x17, 0x60, 0xf5, 0xdc, 0x70, 0x07, 0x38, 0x2b, 0x15, 0xa5, 0x47,
-	0xb7, 0x9f, 0xc8, 0xa5, 0xb7, 0x03, 0x96, 0x16, 0x79, 0xd5, 0xcc, 0xdf, 0x93, 0x42, 0xda, 0x37,
-	0x7a, 0xee, 0x8e, 0x2c, 0x52, 0x65, 0x34, 0x83, 0xd8, 0x89, 0xfd, 0xf4, 0x73, 0x41, 0x92, 0x9f,
-	0x49, 0x60, 0xad, 0x46, 0x6c, 0xac, 0x7a, 0xa7, 0x04, 0xbe, 0x07, 0x92, 0x16, 0xb1, 0xb1, 0xde,
-	0x35, 0x58, 0x57, 0x94, 0x48, 0xa3, 0xb5, 0xc0, 0xb0, 0x6f, 0xb0, 0x2e, 0x3c, 0x00, 0x09, 0x8b,
-	0x62, 0x83, 0x13, 0x9a, 0x5d, 0x09, 0x5c, 0xd5, 0xed, 0x57, 0x93, 0xc2, 0x66, 0xc7, 0xe1, 0xdd,
-	0x81, 0x19, 0x34, 0x50, 0xb6, 0x08, 0xeb, 0x11, 0x16, 0xfe, 0xd9, 0x64, 0xf6, 0x59, 0x38, 0x7b,
-	0xc5, 0xb2, 0x2a, 0xb6, 0x4d, 0x31, 0x63, 0x68, 0x8e, 0x00, 0xef, 0x82, 0x38, 0x23, 0x03, 0x6a,
-	0xe1, 0x6c, 0xb4, 0x28, 0x3d, 0x4a, 0xa2, 0x50, 0x83, 0x59, 0x90, 0x30, 0x07, 0x8e, 0x6b, 0x63,
-	0x9a, 0x8d, 0x09, 0xc7, 0x5c, 0x95, 0x9f, 0x4a, 0x20, 0x55, 0x23, 0x1e, 0xa7, 0x86, 0xc5, 0x0f,
-	0xb0, 0x0f, 0x1f, 0x82, 0x3b, 0xa4, 0xa3, 0x5b, 0xa1, 0x45, 0x3f, 0xc3, 0x7e, 0xd8, 0xf1, 0x2d,
-	0xd2, 0x59, 0x8e, 0xdb, 0x02, 0xeb, 0xd6, 0x80, 0x52, 0xec, 0xf1, 0xd7, 0x83, 0xc5, 0x0c, 0x08,
-	0x86, 0xbe, 0xe5, 0x8c, 0xcf, 0x41, 0xee, 0x6d, 0x19, 0x7a, 0x9f, 0x12, 0x72, 0x2a, 0xfa, 0x4d,
-	0xa3, 0x7b, 0x6f, 0xe6, 0x35, 0x03, 0xb7, 0xfc, 0xbd, 0x04, 0xe0, 0xdc, 0x58, 0x1b, 0x30, 0x4e,
-	0x7a, 0x62, 0xb3, 0x6d, 0x90, 0xc2, 0x9e, 0xe5, 0x1a, 0xe7, 0xf8, 0xb2, 0xd3, 0xd4, 0x93, 0x07,
-	0x57, 0x9d, 0x6f, 0x09, 0xb5, 0x7a, 0x7b, 0x3a, 0x29, 0x00, 0x65, 0x96, 0x7b, 0x80, 0x7d, 0x04,
-	0xf0, 0xa5, 0x0c, 0xd7, 0xc1, 0xaa, 0x6b, 0x98, 0xd8, 0x15, 0xc3, 0x24, 0xd1, 0x4c, 0x91, 0x7f,
-	0x5b, 0x01, 0xe9, 0x39, 0x82, 0x28, 0xfe, 0x00, 0x24, 0xc4, 0x59, 0x1d, 0x5b, 0x14, 0x8e, 0x55,
-	0xc1, 0x74, 0x52, 0x88, 0x8b, 0xab, 0xd7, 0x51, 0x3c, 0x70, 0xa9, 0xf6, 0xff, 0x7b, 0xde, 0xcb,
-	0xc6, 0x62, 0x4b, 0x8d, 0xc1, 0x7a, 0x58, 0x02, 0xdb, 0xd9, 0x55, 0xb1, 0x80, 0xc7, 0x57, 0xf2,
-	0xd7, 0x64, 0xc4, 0x1d, 0x70, 0xdc, 0x1e, 0x36, 0x09, 0x73, 0xb8, 0x43, 0x3c, 0x34, 0x4f, 0x85,
-	0x9b, 0x20, 0xe5, 0x98, 0x96, 0xde, 0x27, 0x94, 0x07, 0x13, 0xc5, 0x83, 0x0a, 0xd5, 0x5b, 0xd3,
-	0x49, 0x21, 0xa9, 0x56, 0x6b, 0x4d, 0x42, 0xb9, 0x5a, 0x47, 0x49, 0xc7, 0xb4, 0x84, 0x68, 0x07,
-	0xad, 0x18, 0x76, 0xcf, 0xf1, 0xb2, 0x89, 0x59, 0x2b, 0x42, 0x81, 0x05, 0x90, 0x12, 0x42, 0x78,
-	0xd4, 0x35, 0x71, 0x54, 0x20, 0x4c, 0xb3, 0x3b, 0x22, 0x00, 0xdf, 0x6c, 0x02, 0xbe, 0x0f, 0xd2,
-	0xa6, 0x4b, 0xac, 0x33, 0xbd, 0x8b, 0x9d, 0x4e, 0x97, 0x8b, 0x75, 0x46, 0x51, 0x4a, 0xd8, 0xf6,
-	0x85, 0x09, 0xde, 0x07, 0x6b, 0x7c, 0xa8, 0x3b, 0x9e, 0x8d, 0x87, 0x62, 0x91, 0x31, 0x94, 0xe0,
-	0x43, 0x35, 0x50, 0x65, 0x0c, 0x56, 0x0f, 0x89, 0x8d, 0x5d, 0xb8, 0x0b, 0xa2, 0x07, 0x73, 0xbe,
-	0x56, 0x3f, 0x7d, 0x35, 0x29, 0x6c, 0xbd, 0xb6, 0xe7, 0x1e, 0xe6, 0xe6, 0x29, 0x5f, 0x08, 0xae,
-	0x63, 0xb2, 0xb2, 0xe9, 0x73, 0xcc, 0x4a, 0xfb, 0x78, 0x58, 0x0d, 0x04, 0x14, 0x0d, 0xef, 0x7f,
-	0x2c, 0x9e, 0x83, 0x19, 0x99, 0x67, 0x8a, 0xfc, 0x97, 0x04, 0xb2, 0x97, 0x14, 0x0c, 0xbe, 0x5e,
-	0x87, 0x71, 0x42, 0x7d, 0xc5, 0xe3, 0xd4, 0x87, 0xc7, 0x20, 0x49, 0xfa, 0x98, 0x1a, 0xc1, 0x38,
-	0xe1, 0x2b, 0xf2, 0xd9, 0x75, 0x34, 0x5c, 0x02, 0x69, 0xcc, 0x73, 0x83, 0xb7, 0x05, 0x2d, 0xa0,
-	0x96, 0x39, 0xb6, 0x72, 0x25, 0xc7, 0xea, 0x20, 0x31, 0xe8, 0xdb, 0x82, 0x00, 0xd1, 0xff, 0x4e,
-	0x80, 0x30, 0x15, 0x66, 0x40, 0xb4, 0xc7, 0x3a, 0x82, 0x5a, 0x69, 0x14, 0x88, 0x8f, 0x7f, 0x95,
-	0x00, 0x58, 0x3c, 0x79, 0xf0, 0x21, 0x48, 0x1e, 0x69, 0x75, 0x65, 0x57, 0xd5, 0x94, 0x7a, 0x26,
-	0x92, 0xbb, 0x37, 0x1a, 0x17, 0xdf, 0x59, 0xb8, 0x8f, 0x3c, 0x1b, 0x9f, 0x3a, 0x1e, 0xb6, 0x61,
-	0x11, 0xc4, 0xb5, 0x46, 0xb5, 0x51, 0x3f, 0xc9, 0x48, 0xb9, 0xf5, 0xd1, 0xb8, 0x98, 0x59, 0x04,
-	0x69, 0xc4, 0x24, 0xb6, 0x0f, 0x37, 0x40, 0xba, 0xa1, 0x7d, 0x79, 0xa2, 0x57, 0xea, 0x75, 0xa4,
-	0xb4, 0x5a, 0x99, 0x95, 0xdc, 0xfd, 0xd1, 0xb8, 0xf8, 0xee, 0x22, 0xae, 0xe1, 0xb9, 0x7e, 0xc8,
-	0xfe, 0xa0, 0xac, 0x72, 0xac, 0xa0, 0x13, 0x81, 0x18, 0xfd, 0x77, 0x59, 0xe5, 0x1c, 0x53, 0x3f,
-	0x00, 0xcd, 0xad, 0xfd, 0xf0, 0x4b, 0x3e, 0xf2, 0xfc, 0x69, 0x3e, 0xf2, 0xf8, 0x59, 0x14, 0x14,
-	0xaf, 0x5b, 0x32, 0xc4, 0x60, 0xab, 0xd6, 0xd0, 0xda, 0xa8, 0x52, 0x6b, 0xeb, 0xb5, 0x46, 0x5d,
-	0xd1, 0xf7, 0xd5, 0x56, 0xbb, 0x81, 0x4e, 0xf4, 0x46, 0x53, 0x41, 0x95, 0xb6, 0xda, 0xd0, 0xf4,
-	0xf6, 0x49, 0x53, 0xd1, 0x8f, 0xb4, 0x56, 0x53, 0xa9, 0xa9, 0xbb, 0xaa, 0x18, 0xba, 0x3c, 0x1a,
-	0x17, 0x37, 0xae, 0xc3, 0x3e, 0xf2, 0x58, 0x1f, 0x5b, 0xce, 0xa9, 0x83, 0x6d, 0xf8, 0x15, 0xf8,
-	0xe8, 0x46, 0x65, 0x54, 0x4d, 0x6d, 0x67, 0xa4, 0xdc, 0xa3, 0xd1, 0xb8, 0xf8, 0xc1, 0x75, 0xf8,
-	0xaa, 0xe7, 0x70, 0xf8, 0x2d, 0xf8, 0xf8, 0x46, 0xc0, 0x87, 0xea, 0x1e, 0xaa, 0xb4, 0x95, 0xcc,
-	0x4a, 0x6e, 0x63, 0x34, 0x2e, 0x7e, 0x78, 0x1d, 0xf6, 0xa1, 0xd3, 0xa1, 0x06, 0xc7, 0x37, 0x86,
-	0xdf, 0x53, 0x34, 0xa5, 0xa5, 0xb6, 0x32, 0xd1, 0x9b, 0xc1, 0xef, 0x61, 0x0f, 0x33, 0x87, 0xe5,
-	0x62, 0xc1, 0xb1, 0xaa, 0xdf, 0xbc, 0xf8, 0x33, 0x1f, 0x79, 0x3e, 0xcd, 0x4b, 0x2f, 0xa6, 0x79,
-	0xe9, 0xe5, 0x34, 0x2f, 0xfd, 0x31, 0xcd, 0x4b, 0x3f, 0x5e, 0xe4, 0x23, 0x2f, 0x2f, 0xf2, 0x91,
-	0xdf, 0x2f, 0xf2, 0x91, 0xaf, 0x77, 0x96, 0xbe, 0x60, 0x66, 0x51, 0xee, 0x1a, 0x26, 0x2b, 0xb7,
-	0x04, 0xb9, 0x35, 0xcc, 0xbf, 0x23, 0xf4, 0xac, 0x3c, 0xbc, 0xfc, 0x6d, 0xe0, 0x78, 0x1c, 0x53,
-	0xcf, 0x70, 0x67, 0x2f, 0xa8, 0x19, 0x17, 0xff, 0xef, 0x3f, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x7f, 0x72, 0x40, 0x8b, 0x43, 0x08, 0x00, 0x00,
+	// 1063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x8e, 0x1d, 0x8f, 0xdd, 0xd6, 0x1a, 0x42, 0xeb, 0x1a, 0xc9, 0x36, 0x2e, 0x2a,
+	0xa5, 0x25, 0x76, 0x12, 0x38, 0xa0, 0x70, 0xf2, 0x9f, 0x4d, 0xb2, 0x84, 0xac, 0xad, 0xb1, 0x13,
+	0x14, 0x04, 0x5a, 0xed, 0x9f, 0x89, 0xbd, 0xca, 0x7a, 0xc7, 0x9a, 0x19, 0x07, 0xef, 0x8d, 0x23,
+	0xf2, 0x89, 0x23, 0x17, 0x4b, 0x48, 0x54, 0xa8, 0x5f, 0x80, 0x2f, 0xc0, 0x85, 0x1e, 0x7b, 0xe4,
+	0x64, 0x81, 0xf3, 0x01, 0x90, 0x38, 0xf6, 0x84, 0x76, 0x76, 0x1d, 0xbb, 0xb4, 0x51, 0x82, 0xc4,
+	0xc9, 0xef, 0xef, 0xef, 0xbd, 0x37, 0xef, 0xe7, 0xa7, 0x05, 0x25, 0x86, 0x4d, 0x8a, 0x79, 0xc5,
+	0x24, 0xfd, 0xc1, 0x90, 0xe3, 0xca, 0xf9, 0x96, 0x81, 0xb9, 0xbe, 0x55, 0xe1, 0xde, 0x00, 0xb3,
+	0xf2, 0x80, 0x12, 0x4e, 0xe0, 0xdd, 0x20, 0xa6, 0x1c, 0xc6, 0x94, 0xc3, 0x98, 0xdc, 0x7a, 0x97,
+	0x74, 0x89, 0x08, 0xa9, 0xf8, 0x52, 0x10, 0x5d, 0x32, 0xc1, 0x9d, 0xaa, 0x69, 0x62, 0xc6, 0x3a,
+	0xde, 0x00, 0xb7, 0x74, 0xaa, 0xf7, 0xe1, 0x67, 0x60, 0xf5, 0x5c, 0x77, 0x86, 0x38, 0x2b, 0x15,
+	0xa5, 0x47, 0xb7, 0xb7, 0x4b, 0xe5, 0x37, 0x03, 0x96, 0x17, 0x79, 0xb5, 0xcc, 0xdf, 0xd3, 0x42,
+	0xda, 0xd3, 0xfb, 0xce, 0x4e, 0x49, 0xa4, 0x96, 0x50, 0x00, 0xb1, 0x13, 0xfb, 0xe1, 0xc7, 0x82,
+	0x54, 0xfa, 0x4d, 0x02, 0x6b, 0x75, 0x62, 0x61, 0xc5, 0x3d, 0x25, 0xf0, 0x1d, 0x90, 0x34, 0x89,
+	0x85, 0xb5, 0x9e, 0xce, 0x7a, 0xa2, 0x44, 0x1a, 0xad, 0xf9, 0x86, 0x7d, 0x9d, 0xf5, 0xe0, 0x01,
+	0x48, 0x98, 0x14, 0xeb, 0x9c, 0xd0, 0xec, 0x8a, 0xef, 0xaa, 0x6d, 0xbd, 0x9c, 0x16, 0x36, 0xba,
+	0x36, 0xef, 0x0d, 0x0d, 0xbf, 0x81, 0x8a, 0x49, 0x58, 0x9f, 0xb0, 0xf0, 0x67, 0x83, 0x59, 0x67,
+	0xe1, 0xec, 0x55, 0xd3, 0xac, 0x5a, 0x16, 0xc5, 0x8c, 0xa1, 0x39, 0x02, 0xbc, 0x0b, 0xe2, 0x8c,
+	0x0c, 0xa9, 0x89, 0xb3, 0xd1, 0xa2, 0xf4, 0x28, 0x89, 0x42, 0x0d, 0x66, 0x41, 0xc2, 0x18, 0xda,
+	0x8e, 0x85, 0x69, 0x36, 0x26, 0x1c, 0x73, 0x15, 0x16, 0x40, 0x8a, 0x99, 0x3d, 0xdc, 0xd7, 0x83,
+	0xee, 0x56, 0x45, 0x77, 0x20, 0x30, 0xf9, 0xfd, 0x95, 0x9e, 0x4a, 0x20, 0x55, 0x27, 0x2e, 0xa7,
+	0xba, 0xc9, 0x0f, 0xb0, 0x07, 0x1f, 0x82, 0x3b, 0xa4, 0xab, 0x99, 0xa1, 0x45, 0x3b, 0xc3, 0x5e,
+	0x38, 0xd2, 0x2d, 0xd2, 0x5d, 0x8e, 0xdb, 0x04, 0xeb, 0xe6, 0x90, 0x52, 0xec, 0xf2, 0x57, 0x83,
+	0xc5, 0x90, 0x08, 0x86, 0xbe, 0xe5, 0x8c, 0x4f, 0x41, 0xee, 0x4d, 0x19, 0xda, 0x80, 0x12, 0x72,
+	0x2a, 0x06, 0x4a, 0xa3, 0x7b, 0xaf, 0xe7, 0xb5, 0x7c, 0x77, 0xe9, 0x5b, 0x09, 0xc0, 0xb9, 0xb1,
+	0x3e, 0x64, 0x9c, 0xf4, 0xc5, 0xd3, 0x77, 0x40, 0x0a, 0xbb, 0xa6, 0xa3, 0x9f, 0xe3, 0xcb, 0x4e,
+	0x53, 0xdb, 0x0f, 0xae, 0xda, 0xef, 0x12, 0x6a, 0xed, 0xf6, 0x6c, 0x5a, 0x00, 0x72, 0x90, 0x7b,
+	0x80, 0x3d, 0x04, 0xf0, 0xa5, 0x0c, 0xd7, 0xc1, 0xaa, 0xa3, 0x1b, 0xd8, 0x11, 0xc3, 0x24, 0x51,
+	0xa0, 0x94, 0x7e, 0x5d, 0x01, 0xe9, 0x39, 0x82, 0x28, 0xfe, 0x00, 0x24, 0xc4, 0xde, 0x6d, 0x4b,
+	0x14, 0x8e, 0xd5, 0xc0, 0x6c, 0x5a, 0x88, 0x0b, 0x5a, 0x34, 0x50, 0xdc, 0x77, 0x29, 0xd6, 0xff,
+	0xbb, 0xff, 0xcb, 0xc6, 0x62, 0x4b, 0x8d, 0xc1, 0x46, 0x58, 0x02, 0x5b, 0x62, 0xbf, 0xa9, 0xed,
+	0xc7, 0x57, 0x12, 0xdc, 0x60, 0xc4, 0x19, 0x72, 0xdc, 0x19, 0xb5, 0x08, 0xb3, 0xb9, 0x4d, 0x5c,
+	0x34, 0x4f, 0x85, 0x1b, 0x20, 0x65, 0x1b, 0xa6, 0x36, 0x20, 0x94, 0xfb, 0x13, 0xc5, 0xfd, 0x0a,
+	0xb5, 0x5b, 0xb3, 0x69, 0x21, 0xa9, 0xd4, 0xea, 0x2d, 0x42, 0xb9, 0xd2, 0x40, 0x49, 0xdb, 0x30,
+	0x85, 0x68, 0xf9, 0xad, 0xe8, 0x56, 0xdf, 0x76, 0xb3, 0x89, 0xa0, 0x15, 0xa1, 0xf8, 0x74, 0x13,
+	0x42, 0xb8, 0xd4, 0xb5, 0x80, 0x6e, 0xc2, 0x14, 0xec, 0x11, 0x01, 0xf8, 0x7a, 0x13, 0xf0, 0x5d,
+	0x90, 0x36, 0x1c, 0x62, 0x9e, 0x69, 0x3d, 0x6c, 0x77, 0x7b, 0x5c, 0x3c, 0x67, 0x14, 0xa5, 0x84,
+	0x6d, 0x5f, 0x98, 0xe0, 0x7d, 0xb0, 0xc6, 0x47, 0x9a, 0xed, 0x5a, 0x78, 0x24, 0x1e, 0x32, 0x86,
+	0x12, 0x7c, 0xa4, 0xf8, 0x6a, 0x09, 0x83, 0xd5, 0x43, 0x62, 0x61, 0x07, 0xee, 0x82, 0xe8, 0xc1,
+	0x9c, 0xaf, 0xb5, 0x8f, 0x5f, 0x4e, 0x0b, 0x9b, 0xaf, 0xbc, 0x73, 0x1f, 0x73, 0xe3, 0x94, 0x2f,
+	0x04, 0xc7, 0x36, 0x58, 0xc5, 0xf0, 0x38, 0x66, 0xe5, 0x7d, 0x3c, 0xaa, 0xf9, 0x02, 0x8a, 0x86,
+	0xfb, 0x3f, 0x16, 0xf7, 0x22, 0x20, 0x73, 0xa0, 0x94, 0xfe, 0x92, 0x40, 0xf6, 0x92, 0x82, 0xfe,
+	0xdf, 0xdb, 0x66, 0x9c, 0x50, 0x4f, 0x76, 0x39, 0xf5, 0xe0, 0x31, 0x48, 0x92, 0x01, 0xa6, 0xba,
+	0x3f, 0x4e, 0x78, 0x66, 0x3e, 0xb9, 0x8e, 0x86, 0x4b, 0x20, 0xcd, 0x79, 0xae, 0x7f, 0x7c, 0xd0,
+	0x02, 0x6a, 0x99, 0x63, 0x2b, 0x57, 0x72, 0xac, 0x01, 0x12, 0xc3, 0x81, 0x25, 0x08, 0x10, 0xfd,
+	0xef, 0x04, 0x08, 0x53, 0x61, 0x06, 0x44, 0xfb, 0xac, 0x2b, 0xa8, 0x95, 0x46, 0xbe, 0xf8, 0xf8,
+	0x17, 0x09, 0x80, 0xc5, 0x4d, 0x84, 0x0f, 0x41, 0xf2, 0x48, 0x6d, 0xc8, 0xbb, 0x8a, 0x2a, 0x37,
+	0x32, 0x91, 0xdc, 0xbd, 0xf1, 0xa4, 0xf8, 0xd6, 0xc2, 0x7d, 0xe4, 0x5a, 0xf8, 0xd4, 0x76, 0xb1,
+	0x05, 0x8b, 0x20, 0xae, 0x36, 0x6b, 0xcd, 0xc6, 0x49, 0x46, 0xca, 0xad, 0x8f, 0x27, 0xc5, 0xcc,
+	0x22, 0x48, 0x25, 0x06, 0xb1, 0x3c, 0xf8, 0x04, 0xa4, 0x9b, 0xea, 0xe7, 0x27, 0x5a, 0xb5, 0xd1,
+	0x40, 0x72, 0xbb, 0x9d, 0x59, 0xc9, 0xdd, 0x1f, 0x4f, 0x8a, 0x6f, 0x2f, 0xe2, 0x9a, 0xae, 0xe3,
+	0x85, 0xec, 0xf7, 0xcb, 0xca, 0xc7, 0x32, 0x3a, 0x11, 0x88, 0xd1, 0x7f, 0x97, 0x95, 0xcf, 0x31,
+	0xf5, 0x7c, 0xd0, 0xdc, 0xda, 0x77, 0x3f, 0xe5, 0x23, 0xcf, 0x9e, 0xe6, 0x23, 0x8f, 0x7f, 0x8e,
+	0x82, 0xe2, 0x75, 0x8f, 0x0c, 0x31, 0xd8, 0xac, 0x37, 0xd5, 0x0e, 0xaa, 0xd6, 0x3b, 0x5a, 0xbd,
+	0xd9, 0x90, 0xb5, 0x7d, 0xa5, 0xdd, 0x69, 0xa2, 0x13, 0xad, 0xd9, 0x92, 0x51, 0xb5, 0xa3, 0x34,
+	0x55, 0xad, 0x73, 0xd2, 0x92, 0xb5, 0x23, 0xb5, 0xdd, 0x92, 0xeb, 0xca, 0xae, 0x22, 0x86, 0xae,
+	0x8c, 0x27, 0xc5, 0x27, 0xd7, 0x61, 0x1f, 0xb9, 0x6c, 0x80, 0x4d, 0xfb, 0xd4, 0xc6, 0x16, 0xfc,
+	0x02, 0x7c, 0x70, 0xa3, 0x32, 0x8a, 0xaa, 0x74, 0x32, 0x52, 0xee, 0xd1, 0x78, 0x52, 0x7c, 0xef,
+	0x3a, 0x7c, 0xc5, 0xb5, 0x39, 0xfc, 0x1a, 0x7c, 0x78, 0x23, 0xe0, 0x43, 0x65, 0x0f, 0x55, 0x3b,
+	0x72, 0x66, 0x25, 0xf7, 0x64, 0x3c, 0x29, 0xbe, 0x7f, 0x1d, 0xf6, 0xa1, 0xdd, 0xa5, 0x3a, 0xc7,
+	0x37, 0x86, 0xdf, 0x93, 0x55, 0xb9, 0xad, 0xb4, 0x33, 0xd1, 0x9b, 0xc1, 0xef, 0x61, 0x17, 0x33,
+	0x9b, 0xe5, 0x62, 0xfe, 0xb2, 0x6a, 0x5f, 0x3d, 0xff, 0x33, 0x1f, 0x79, 0x36, 0xcb, 0x4b, 0xcf,
+	0x67, 0x79, 0xe9, 0xc5, 0x2c, 0x2f, 0xfd, 0x31, 0xcb, 0x4b, 0xdf, 0x5f, 0xe4, 0x23, 0x2f, 0x2e,
+	0xf2, 0x91, 0xdf, 0x2f, 0xf2, 0x91, 0x2f, 0x77, 0x96, 0xfe, 0xc1, 0xcc, 0xa4, 0xdc, 0xd1, 0x0d,
+	0x56, 0x69, 0x0b, 0x72, 0xab, 0x98, 0x7f, 0x43, 0xe8, 0x59, 0x65, 0x74, 0xf9, 0xf1, 0x60, 0xbb,
+	0x1c, 0x53, 0x57, 0x77, 0x82, 0x0b, 0x6a, 0xc4, 0xc5, 0x07, 0xc1, 0x47, 0xff, 0x0c, 0x00, 0x73,
+	0x4d, 0x28, 0xb9, 0x64, 0x08, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -562,6 +566,9 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	if this.Builder != that1.Builder {
 		return false
 	}
+	if !bytes.Equal(this.SchemaHash, that1.SchemaHash) {
+		return false
+	}
 	return true
 }
 func (this *ContractKey) Equal(that interface{}) bool {
@@ -798,6 +805,13 @@ func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Builder) > 0 {
 		i -= len(m.Builder)
 		copy(dAtA[i:], m.Builder)
@@ -1157,6 +1171,10 @@ func (m *CodeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1525,6 +1543,40 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = append(m.SchemaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SchemaHash == nil {
+				m.SchemaHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"net/url"
 	"regexp"
 
@@ -27,6 +28,9 @@ const (
 	BuildTagRegexp = "^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$"
 
 	MaxBuildTagSize = 128
+
+	// MaxSchemaSize is the largest JSON schema that can be stored with code
+	MaxSchemaSize = 256 * 1024
 )
 
 func validateSourceURL(source string) error {
@@ -69,6 +73,20 @@ func validateWasmCode(s []byte) error {
 	return nil
 }
 
+func validateSchema(schema []byte) error {
+	if len(schema) == 0 {
+		return nil
+	}
+	if len(schema) > MaxSchemaSize {
+		return errors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSchemaSize)
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(schema, &object); err != nil {
+		return errors.Wrap(ErrInvalid, "must be a JSON object")
+	}
+	return nil
+}
+
 func validateLabel(label string) error {
 	if label == "" {
 		return errors.Wrap(ErrEmpty, "is required")
@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -38,6 +40,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdWasmSender(),
+		GetCmdPacketCallbacks(),
 	)
 	return cmd
 }
@@ -74,3 +77,55 @@ $ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqw
 
 	return cmd
 }
+
+// GetCmdPacketCallbacks lists the packet callbacks a contract is still waiting for.
+func GetCmdPacketCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-callbacks <contractAddress>",
+		Short: "List the pending ibc callbacks of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the packets for which a contract is still waiting for an ibc_lifecycle_complete callback.
+Example:
+$ %s query ibchooks packet-callbacks secret1k0jntykt7e4g3y88ltc60czgjuqdy4c9e8fzek
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketCallbacks(
+				context.Background(),
+				&types.QueryPacketCallbacksRequest{
+					ContractAddress: args[0],
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet callbacks")
+	return cmd
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+// InitGenesis initializes the x/ibc-hooks module's state from a provided genesis
+// state, which holds the packet callbacks that are still pending.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, callback := range genState.Callbacks {
+		k.SetPacketCallback(ctx, callback)
+	}
+}
+
+// ExportGenesis returns the x/ibc-hooks module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	k.IteratePacketCallbacks(ctx, func(callback types.PacketCallback) bool {
+		genState.Callbacks = append(genState.Callbacks, callback)
+		return false
+	})
+	return genState
+}
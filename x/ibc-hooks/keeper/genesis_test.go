@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	alice, bob := testContract("a"), testContract("b")
+
+	require.Equal(t, types.DefaultGenesis(), k.ExportGenesis(ctx))
+
+	genState := types.GenesisState{Callbacks: []types.PacketCallback{
+		{Port: "transfer", Channel: "channel-0", Sequence: 1, Contract: alice, TimeoutHeight: clienttypes.NewHeight(1, 100)},
+		{Port: "transfer", Channel: "channel-0", Sequence: 2, Contract: bob, TimeoutTimestamp: 42},
+		{Port: "transfer", Channel: "channel-1", Sequence: 1, Contract: alice},
+	}}
+	require.NoError(t, genState.Validate())
+	k.InitGenesis(ctx, genState)
+
+	// the callbacks are indexed by contract again
+	res, err := NewQuerier(k).PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 2)
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, genState, *exported)
+
+	// an exported genesis imports into the same state
+	ctx2, k2, _, _ := setupKeeper(t)
+	k2.InitGenesis(ctx2, *exported)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	alice := testContract("a")
+
+	valid := types.PacketCallback{Port: "transfer", Channel: "channel-0", Sequence: 1, Contract: alice}
+	k.SetPacketCallback(ctx, valid)
+	// entries that version 2 could store, but that don't pass genesis validation
+	k.SetPacketCallback(ctx, types.PacketCallback{Port: "transfer", Channel: "channel-0", Sequence: 0, Contract: alice})
+	k.SetPacketCallback(ctx, types.PacketCallback{Port: "", Channel: "channel-1", Sequence: 1, Contract: alice})
+	k.SetPacketCallback(ctx, types.PacketCallback{Port: "transfer", Channel: "bad channel", Sequence: 1, Contract: alice})
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, []types.PacketCallback{valid}, exported.Callbacks)
+	require.NoError(t, exported.Validate())
+
+	// the index entries of the dropped callbacks are gone too
+	res, err := NewQuerier(k).PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: alice})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{valid}, res.Callbacks)
+}
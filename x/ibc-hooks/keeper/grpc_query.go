@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the ibc-hooks gRPC query service
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns a new Querier wrapping the given keeper
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// PacketCallbacks lists the callbacks still waiting for an ack or a timeout for a contract
+func (q Querier) PacketCallbacks(c context.Context, req *types.QueryPacketCallbacksRequest) (*types.QueryPacketCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, types.GetContractCallbacksPrefix(contractAddr))
+	callbackStore := prefix.NewStore(store, types.PacketCallbackPrefix)
+
+	var callbacks []types.PacketCallback
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		value := callbackStore.Get(key)
+		if value == nil {
+			return nil
+		}
+		var callback types.PacketCallback
+		if err := q.cdc.Unmarshal(value, &callback); err != nil {
+			return err
+		}
+		callbacks = append(callbacks, callback)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPacketCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+func TestQueryPacketCallbacks(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	q := NewQuerier(k)
+	alice, bob := testContract("a"), testContract("b")
+
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 1, alice, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 2, bob, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 3, alice, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-1", 1, alice, clienttypes.NewHeight(1, 10), 7)
+
+	res, err := q.PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 3)
+	for _, callback := range res.Callbacks {
+		require.Equal(t, alice, callback.Contract)
+	}
+	require.Equal(t, clienttypes.NewHeight(1, 10), res.Callbacks[2].TimeoutHeight)
+	require.Equal(t, uint64(7), res.Callbacks[2].TimeoutTimestamp)
+
+	// callbacks are paginated
+	res, err = q.PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: alice, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res, err = q.PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: alice, Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 1)
+	require.Equal(t, "channel-1", res.Callbacks[0].Channel)
+
+	// processed callbacks aren't pending anymore
+	k.DeletePacketCallback(ctx, "channel-0", 2)
+	res, err = q.PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: bob})
+	require.NoError(t, err)
+	require.Empty(t, res.Callbacks)
+
+	res, err = q.PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: sdk.AccAddress("nobody").String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Callbacks)
+
+	_, err = q.PacketCallbacks(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = q.PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: "not a contract"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
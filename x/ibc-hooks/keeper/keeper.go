@@ -7,6 +7,10 @@ import (
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
 	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
 
@@ -15,51 +19,121 @@ import (
 
 type (
 	Keeper struct {
-		storeService store.KVStoreService
+		cdc           codec.BinaryCodec
+		storeService  store.KVStoreService
+		channelKeeper types.ChannelKeeper
 	}
 )
 
 // NewKeeper returns a new instance of the x/ibchooks keeper
 func NewKeeper(
+	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	channelKeeper types.ChannelKeeper,
 ) Keeper {
 	return Keeper{
-		storeService: storeService,
+		cdc:           cdc,
+		storeService:  storeService,
+		channelKeeper: channelKeeper,
 	}
 }
 
-// Logger returns a logger for the x/tokenfactory module
+// Logger returns a logger for the x/ibchooks module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func GetPacketKey(channel string, packetSequence uint64) []byte {
-	return []byte(fmt.Sprintf("%s::%d", channel, packetSequence))
+// StorePacketCallback stores which contract will be listening for the ack or timeout of a packet,
+// together with the packet's timeout so that stale callbacks can be pruned later on
+func (k Keeper) StorePacketCallback(ctx sdk.Context, port string, channel string, packetSequence uint64, contract string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64) {
+	k.SetPacketCallback(ctx, types.PacketCallback{
+		Port:             port,
+		Channel:          channel,
+		Sequence:         packetSequence,
+		Contract:         contract,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	})
 }
 
-// StorePacketCallback stores which contract will be listening for the ack or timeout of a packet
-func (k Keeper) StorePacketCallback(ctx sdk.Context, channel string, packetSequence uint64, contract string) {
+// SetPacketCallback writes a callback and its per-contract index entry
+func (k Keeper) SetPacketCallback(ctx sdk.Context, callback types.PacketCallback) {
+	contractAddr, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		ctx.Logger().Error("store callback", "contract", err.Error())
+		return
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
-	err := store.Set(GetPacketKey(channel, packetSequence), []byte(contract))
+	err = store.Set(types.GetPacketKey(callback.Channel, callback.Sequence), k.cdc.MustMarshal(&callback))
 	if err != nil {
 		ctx.Logger().Error("store callback", "store", err.Error())
+		return
+	}
+	err = store.Set(types.GetContractCallbackKey(contractAddr, callback.Channel, callback.Sequence), []byte{})
+	if err != nil {
+		ctx.Logger().Error("store callback index", "store", err.Error())
 	}
 }
 
 // GetPacketCallback returns the bech32 addr of the contract that is expecting a callback from a packet
 func (k Keeper) GetPacketCallback(ctx sdk.Context, channel string, packetSequence uint64) string {
+	callback, found := k.GetPacketCallbackInfo(ctx, channel, packetSequence)
+	if !found {
+		return ""
+	}
+	return callback.Contract
+}
+
+// GetPacketCallbackInfo returns the full callback entry stored for a packet
+func (k Keeper) GetPacketCallbackInfo(ctx sdk.Context, channel string, packetSequence uint64) (types.PacketCallback, bool) {
+	var callback types.PacketCallback
+
 	store := k.storeService.OpenKVStore(ctx)
-	value, _ := store.Get(GetPacketKey(channel, packetSequence))
-	return string(value)
+	value, err := store.Get(types.GetPacketKey(channel, packetSequence))
+	if err != nil || value == nil {
+		return callback, false
+	}
+	k.cdc.MustUnmarshal(value, &callback)
+	return callback, true
 }
 
 // DeletePacketCallback deletes the callback from storage once it has been processed
 func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) {
+	callback, found := k.GetPacketCallbackInfo(ctx, channel, packetSequence)
+	if !found {
+		return
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
-	err := store.Delete(GetPacketKey(channel, packetSequence))
+	err := store.Delete(types.GetPacketKey(channel, packetSequence))
 	if err != nil {
 		ctx.Logger().Error("delete callback", "store", err.Error())
 	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return
+	}
+	err = store.Delete(types.GetContractCallbackKey(contractAddr, channel, packetSequence))
+	if err != nil {
+		ctx.Logger().Error("delete callback index", "store", err.Error())
+	}
+}
+
+// IteratePacketCallbacks iterates over all the stored callbacks until cb returns true
+func (k Keeper) IteratePacketCallbacks(ctx sdk.Context, cb func(types.PacketCallback) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PacketCallbackPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var callback types.PacketCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		if cb(callback) {
+			break
+		}
+	}
 }
 
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
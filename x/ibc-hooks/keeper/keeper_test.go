@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+// mockChannelKeeper holds the channels of the transfer port and the latest height of their clients
+type mockChannelKeeper struct {
+	channels      map[string]channeltypes.State
+	clientHeights map[string]clienttypes.Height
+	// lookups counts the calls, to check how much work a sweep does
+	lookups int
+}
+
+func newMockChannelKeeper() *mockChannelKeeper {
+	return &mockChannelKeeper{channels: map[string]channeltypes.State{}, clientHeights: map[string]clienttypes.Height{}}
+}
+
+func (m *mockChannelKeeper) GetChannel(_ sdk.Context, _, channel string) (channeltypes.Channel, bool) {
+	m.lookups++
+	state, found := m.channels[channel]
+	return channeltypes.Channel{State: state}, found
+}
+
+func (m *mockChannelKeeper) GetChannelClientState(_ sdk.Context, _, channel string) (string, ibcexported.ClientState, error) {
+	m.lookups++
+	height, found := m.clientHeights[channel]
+	if !found {
+		return "", nil, fmt.Errorf("no client for %s", channel)
+	}
+	return "07-tendermint-0", &ibctm.ClientState{LatestHeight: height}, nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *mockChannelKeeper, *storetypes.KVStoreKey) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	channelKeeper := newMockChannelKeeper()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := NewKeeper(cdc, runtime.NewKVStoreService(key), channelKeeper)
+	return testCtx.Ctx, k, channelKeeper, key
+}
+
+func testContract(name string) string {
+	return sdk.AccAddress(strings.Repeat(name, 20)[:20]).String()
+}
+
+func TestPacketCallbackIndex(t *testing.T) {
+	ctx, k, _, key := setupKeeper(t)
+	alice, bob := testContract("a"), testContract("b")
+
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 1, alice, clienttypes.NewHeight(1, 100), 5)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 2, bob, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-1", 1, alice, clienttypes.ZeroHeight(), 0)
+
+	callback, found := k.GetPacketCallbackInfo(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, types.PacketCallback{
+		Port:             "transfer",
+		Channel:          "channel-0",
+		Sequence:         1,
+		Contract:         alice,
+		TimeoutHeight:    clienttypes.NewHeight(1, 100),
+		TimeoutTimestamp: 5,
+	}, callback)
+	require.Equal(t, bob, k.GetPacketCallback(ctx, "channel-0", 2))
+	require.Empty(t, k.GetPacketCallback(ctx, "channel-0", 3))
+
+	store := ctx.KVStore(key)
+	aliceAddr, err := sdk.AccAddressFromBech32(alice)
+	require.NoError(t, err)
+	require.True(t, store.Has(types.GetContractCallbackKey(aliceAddr, "channel-0", 1)))
+	require.True(t, store.Has(types.GetContractCallbackKey(aliceAddr, "channel-1", 1)))
+
+	// deleting a callback deletes its index entry
+	k.DeletePacketCallback(ctx, "channel-0", 1)
+	require.Empty(t, k.GetPacketCallback(ctx, "channel-0", 1))
+	require.False(t, store.Has(types.GetContractCallbackKey(aliceAddr, "channel-0", 1)))
+	require.True(t, store.Has(types.GetContractCallbackKey(aliceAddr, "channel-1", 1)))
+
+	// callbacks of invalid contracts aren't stored
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 4, "not a contract", clienttypes.ZeroHeight(), 0)
+	require.Empty(t, k.GetPacketCallback(ctx, "channel-0", 4))
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. Version 1 stored the bare contract address under
+// "<channel>::<sequence>". Version 2 stores a PacketCallback under PacketCallbackPrefix and indexes it
+// by contract.
+// The timeout of packets sent before the upgrade is unknown, so these callbacks are only pruned once
+// their channel is closed. Only ICS20 packets get callbacks, so the port is always the transfer port.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+
+	var legacyKeys [][]byte
+	var callbacks []types.PacketCallback
+
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if bytes.HasPrefix(key, types.PacketCallbackPrefix) || bytes.HasPrefix(key, types.ContractCallbackPrefix) {
+			continue
+		}
+
+		sep := strings.LastIndex(string(key), "::")
+		if sep < 0 {
+			continue
+		}
+		sequence, err := strconv.ParseUint(string(key[sep+2:]), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		legacyKeys = append(legacyKeys, key)
+		callbacks = append(callbacks, types.PacketCallback{
+			Port:     ibctransfertypes.PortID,
+			Channel:  string(key[:sep]),
+			Sequence: sequence,
+			Contract: string(iter.Value()),
+		})
+	}
+	iter.Close()
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+	for _, callback := range callbacks {
+		if _, err := sdk.AccAddressFromBech32(callback.Contract); err != nil {
+			m.keeper.Logger(ctx).Error("dropping ibc callback with an invalid contract address", "channel", callback.Channel, "sequence", callback.Sequence)
+			continue
+		}
+		m.keeper.SetPacketCallback(ctx, callback)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3. Version 3 imports and exports the pending callbacks in
+// genesis. Callbacks whose stored entry would not pass genesis validation are dropped here, so that
+// an export of the migrated state can always be imported again.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var invalid []types.PacketCallback
+	m.keeper.IteratePacketCallbacks(ctx, func(callback types.PacketCallback) bool {
+		if err := callback.Validate(); err != nil {
+			m.keeper.Logger(ctx).Error("dropping invalid ibc callback", "channel", callback.Channel, "sequence", callback.Sequence, "error", err.Error())
+			invalid = append(invalid, callback)
+		}
+		return false
+	})
+
+	for _, callback := range invalid {
+		m.keeper.DeletePacketCallback(ctx, callback.Channel, callback.Sequence)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, k, _, key := setupKeeper(t)
+	alice, bob := testContract("a"), testContract("b")
+
+	// version 1 stored the bare contract address under "<channel>::<sequence>"
+	store := ctx.KVStore(key)
+	store.Set([]byte("channel-0::1"), []byte(alice))
+	store.Set([]byte("channel-0::2"), []byte(bob))
+	store.Set([]byte("channel-12::300"), []byte(alice))
+	store.Set([]byte("channel-0::3"), []byte("not a contract"))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	callback, found := k.GetPacketCallbackInfo(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, types.PacketCallback{Port: "transfer", Channel: "channel-0", Sequence: 1, Contract: alice}, callback)
+	require.Equal(t, bob, k.GetPacketCallback(ctx, "channel-0", 2))
+	require.Equal(t, alice, k.GetPacketCallback(ctx, "channel-12", 300))
+	// callbacks of invalid contracts are dropped
+	_, found = k.GetPacketCallbackInfo(ctx, "channel-0", 3)
+	require.False(t, found)
+
+	// the legacy keys are gone, and the callbacks are indexed by contract
+	for _, legacyKey := range []string{"channel-0::1", "channel-0::2", "channel-12::300", "channel-0::3"} {
+		require.False(t, store.Has([]byte(legacyKey)), legacyKey)
+	}
+	res, err := NewQuerier(k).PacketCallbacks(ctx, &types.QueryPacketCallbacksRequest{ContractAddress: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 2)
+
+	// migrating migrated callbacks changes nothing
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 4, bob, clienttypes.NewHeight(1, 5), 9)
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	callback, found = k.GetPacketCallbackInfo(ctx, "channel-0", 4)
+	require.True(t, found)
+	require.Equal(t, uint64(9), callback.TimeoutTimestamp)
+	require.Equal(t, alice, k.GetPacketCallback(ctx, "channel-0", 1))
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+// PruneStaleCallbacks runs every CallbackSweepInterval blocks and removes callbacks that can never be
+// triggered anymore: the packet's channel is gone or closed, or the packet has timed out a long time ago
+// and nobody relayed the timeout.
+// A sweep visits at most MaxCallbacksVisitedPerSweep callbacks, starting after the last callback visited
+// by the previous sweep, and starts over from the first callback once it has visited them all.
+func (k Keeper) PruneStaleCallbacks(ctx sdk.Context) {
+	if ctx.BlockHeight()%types.CallbackSweepInterval != 0 {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	callbackStore := prefix.NewStore(store, types.PacketCallbackPrefix)
+
+	var start []byte
+	if cursor := store.Get(types.PruneCursorKey); cursor != nil {
+		// the cursor was visited already, start right after it
+		start = append(cursor, 0x00)
+	}
+
+	var stale []types.PacketCallback
+	var last []byte
+	visited := 0
+	iter := callbackStore.Iterator(start, nil)
+	for ; iter.Valid() && visited < types.MaxCallbacksVisitedPerSweep; iter.Next() {
+		var callback types.PacketCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		if k.isStaleCallback(ctx, callback) {
+			stale = append(stale, callback)
+		}
+		last = append([]byte{}, iter.Key()...)
+		visited++
+	}
+	done := !iter.Valid()
+	iter.Close()
+
+	if done {
+		store.Delete(types.PruneCursorKey)
+	} else {
+		store.Set(types.PruneCursorKey, last)
+	}
+
+	for _, callback := range stale {
+		k.DeletePacketCallback(ctx, callback.Channel, callback.Sequence)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"ibc-callback-pruned",
+				sdk.NewAttribute("contract", callback.Contract),
+				sdk.NewAttribute("port", callback.Port),
+				sdk.NewAttribute("channel", callback.Channel),
+				sdk.NewAttribute("sequence", strconv.FormatUint(callback.Sequence, 10)),
+			),
+		)
+	}
+
+	if len(stale) > 0 {
+		k.Logger(ctx).Info("pruned stale ibc callbacks", "count", len(stale))
+	}
+}
+
+func (k Keeper) isStaleCallback(ctx sdk.Context, callback types.PacketCallback) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, callback.Port, callback.Channel)
+	if !found || channel.State == channeltypes.CLOSED {
+		return true
+	}
+
+	if callback.TimeoutTimestamp != 0 {
+		deadline := callback.TimeoutTimestamp + uint64(types.CallbackTimeoutGracePeriod.Nanoseconds())
+		if uint64(ctx.BlockTime().UnixNano()) > deadline {
+			return true
+		}
+	}
+
+	if !callback.TimeoutHeight.IsZero() {
+		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, callback.Port, callback.Channel)
+		if err != nil {
+			return false
+		}
+		// the timeout height is expressed in terms of the counterparty chain
+		latest := clientState.GetLatestHeight()
+		if latest.GetRevisionNumber() > callback.TimeoutHeight.RevisionNumber {
+			return true
+		}
+		if latest.GetRevisionNumber() == callback.TimeoutHeight.RevisionNumber &&
+			latest.GetRevisionHeight() > callback.TimeoutHeight.RevisionHeight+types.CallbackTimeoutGraceBlocks {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+func TestPruneStaleCallbacks(t *testing.T) {
+	ctx, k, channelKeeper, _ := setupKeeper(t)
+	contract := testContract("c")
+	now := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(types.CallbackSweepInterval)
+
+	channelKeeper.channels["channel-0"] = channeltypes.OPEN
+	channelKeeper.channels["channel-1"] = channeltypes.CLOSED
+	channelKeeper.clientHeights["channel-0"] = clienttypes.NewHeight(1, 1_000_000)
+
+	expired := uint64(now.Add(-types.CallbackTimeoutGracePeriod - time.Second).UnixNano())
+	pending := uint64(now.Add(-types.CallbackTimeoutGracePeriod + time.Second).UnixNano())
+
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 1, contract, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 2, contract, clienttypes.ZeroHeight(), expired)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 3, contract, clienttypes.ZeroHeight(), pending)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 4, contract, clienttypes.NewHeight(1, 1_000_000-types.CallbackTimeoutGraceBlocks-1), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 5, contract, clienttypes.NewHeight(1, 1_000_000-types.CallbackTimeoutGraceBlocks), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 6, contract, clienttypes.NewHeight(0, 2_000_000), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-1", 1, contract, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-2", 1, contract, clienttypes.ZeroHeight(), 0)
+
+	// callbacks are only swept every CallbackSweepInterval blocks
+	k.PruneStaleCallbacks(ctx.WithBlockHeight(types.CallbackSweepInterval + 1))
+	require.Zero(t, channelKeeper.lookups)
+
+	k.PruneStaleCallbacks(ctx)
+	for _, tc := range []struct {
+		channel  string
+		sequence uint64
+		pruned   bool
+	}{
+		{"channel-0", 1, false},
+		{"channel-0", 2, true},  // timed out by timestamp
+		{"channel-0", 3, false}, // still in its grace period
+		{"channel-0", 4, true},  // timed out by height
+		{"channel-0", 5, false}, // still in its grace period
+		{"channel-0", 6, true},  // timed out in an older revision
+		{"channel-1", 1, true},  // closed channel
+		{"channel-2", 1, true},  // missing channel
+	} {
+		_, found := k.GetPacketCallbackInfo(ctx, tc.channel, tc.sequence)
+		require.Equal(t, tc.pruned, !found, "%s %d", tc.channel, tc.sequence)
+	}
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 5)
+	require.Equal(t, "ibc-callback-pruned", events[0].Type)
+}
+
+func TestPruneStaleCallbacksIsBounded(t *testing.T) {
+	ctx, k, channelKeeper, key := setupKeeper(t)
+	contract := testContract("c")
+	ctx = ctx.WithBlockHeight(types.CallbackSweepInterval)
+
+	channelKeeper.channels["channel-0"] = channeltypes.OPEN
+	live := types.MaxCallbacksVisitedPerSweep*2 + 50
+	for sequence := uint64(1); sequence <= uint64(live); sequence++ {
+		k.StorePacketCallback(ctx, "transfer", "channel-0", sequence, contract, clienttypes.ZeroHeight(), 0)
+	}
+	// the stale callbacks sort after all the live ones
+	k.StorePacketCallback(ctx, "transfer", "channel-9", 1, contract, clienttypes.ZeroHeight(), 0)
+	k.StorePacketCallback(ctx, "transfer", "channel-9", 2, contract, clienttypes.ZeroHeight(), 0)
+
+	countCallbacks := func() int {
+		count := 0
+		k.IteratePacketCallbacks(ctx, func(types.PacketCallback) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// every sweep visits at most MaxCallbacksVisitedPerSweep callbacks and carries on from the previous one
+	for sweep := 0; sweep < 2; sweep++ {
+		channelKeeper.lookups = 0
+		k.PruneStaleCallbacks(ctx)
+		require.Equal(t, types.MaxCallbacksVisitedPerSweep, channelKeeper.lookups)
+		require.Equal(t, live+2, countCallbacks())
+		require.NotNil(t, ctx.KVStore(key).Get(types.PruneCursorKey))
+	}
+
+	channelKeeper.lookups = 0
+	k.PruneStaleCallbacks(ctx)
+	require.Equal(t, 52, channelKeeper.lookups)
+	require.Equal(t, live, countCallbacks())
+	// the whole store was swept, the next sweep starts over
+	require.Nil(t, ctx.KVStore(key).Get(types.PruneCursorKey))
+
+	channelKeeper.lookups = 0
+	k.PruneStaleCallbacks(ctx)
+	require.Equal(t, types.MaxCallbacksVisitedPerSweep, channelKeeper.lookups)
+}
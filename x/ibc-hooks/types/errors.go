@@ -12,4 +12,5 @@ var (
 	ErrBadResponse   = errors.Register("wasm-hooks", 5, "cannot create response")
 	ErrWasmError     = errors.Register("wasm-hooks", 6, "wasm error")
 	ErrBadSender     = errors.Register("wasm-hooks", 7, "bad sender")
+	ErrBadCallback   = errors.Register("wasm-hooks", 8, "invalid packet callback")
 )
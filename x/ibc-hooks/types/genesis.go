@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesis creates a default GenesisState object.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Callbacks: []PacketCallback{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Callbacks))
+	for i, callback := range gs.Callbacks {
+		if err := callback.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "callback %d", i)
+		}
+
+		key := string(GetPacketKey(callback.Channel, callback.Sequence))
+		if seen[key] {
+			return errorsmod.Wrapf(ErrBadCallback, "duplicate callback for packet %d on %s", callback.Sequence, callback.Channel)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a packet callback.
+func (c PacketCallback) Validate() error {
+	if err := host.PortIdentifierValidator(c.Port); err != nil {
+		return errorsmod.Wrap(ErrBadCallback, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(c.Channel); err != nil {
+		return errorsmod.Wrap(ErrBadCallback, err.Error())
+	}
+	if c.Sequence == 0 {
+		return errorsmod.Wrap(ErrBadCallback, "packet sequence cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return errorsmod.Wrapf(ErrBadCallback, "contract: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := sdk.AccAddress(strings.Repeat("c", 20)).String()
+	valid := PacketCallback{
+		Port:             "transfer",
+		Channel:          "channel-0",
+		Sequence:         1,
+		Contract:         contract,
+		TimeoutHeight:    clienttypes.NewHeight(1, 100),
+		TimeoutTimestamp: 5,
+	}
+
+	for name, tc := range map[string]struct {
+		modify func(*GenesisState)
+		err    string
+	}{
+		"default":   {modify: func(*GenesisState) {}},
+		"callbacks": {modify: func(gs *GenesisState) { gs.Callbacks = append(gs.Callbacks, valid) }},
+		"same sequence on other channels": {modify: func(gs *GenesisState) {
+			other := valid
+			other.Channel = "channel-1"
+			gs.Callbacks = append(gs.Callbacks, valid, other)
+		}},
+		"duplicate packet": {
+			modify: func(gs *GenesisState) { gs.Callbacks = append(gs.Callbacks, valid, valid) },
+			err:    "duplicate callback for packet 1 on channel-0",
+		},
+		"invalid port": {
+			modify: func(gs *GenesisState) {
+				callback := valid
+				callback.Port = "x"
+				gs.Callbacks = append(gs.Callbacks, callback)
+			},
+			err: "callback 0",
+		},
+		"invalid channel": {
+			modify: func(gs *GenesisState) {
+				callback := valid
+				callback.Channel = "channel/0"
+				gs.Callbacks = append(gs.Callbacks, valid, callback)
+			},
+			err: "callback 1",
+		},
+		"zero sequence": {
+			modify: func(gs *GenesisState) {
+				callback := valid
+				callback.Sequence = 0
+				gs.Callbacks = append(gs.Callbacks, callback)
+			},
+			err: "packet sequence cannot be 0",
+		},
+		"invalid contract": {
+			modify: func(gs *GenesisState) {
+				callback := valid
+				callback.Contract = "secret1invalid"
+				gs.Callbacks = append(gs.Callbacks, callback)
+			},
+			err: "contract",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gs := DefaultGenesis()
+			tc.modify(gs)
+			err := gs.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrBadCallback)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
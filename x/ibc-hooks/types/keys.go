@@ -1,8 +1,57 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName     = "ibchooks"
 	StoreKey       = "hooks-for-ibc" // not using the module name because of collisions with key "ibc"
 	IBCCallbackKey = "ibc_callback"
 	SenderPrefix   = "ibc-wasm-hook-intermediary"
 )
+
+var (
+	// PacketCallbackPrefix prefixes the PacketCallback stored for every (channel, sequence)
+	PacketCallbackPrefix = []byte{0x01}
+	// ContractCallbackPrefix prefixes the (contract, channel, sequence) index used to list the
+	// callbacks of a single contract
+	ContractCallbackPrefix = []byte{0x02}
+	// PruneCursorKey stores the key, relative to PacketCallbackPrefix, of the last callback visited by
+	// the previous sweep, so that the next sweep carries on from there
+	PruneCursorKey = []byte{0x03}
+)
+
+const (
+	// CallbackSweepInterval is the number of blocks between two sweeps of the stored packet callbacks
+	CallbackSweepInterval = 10
+	// MaxCallbacksVisitedPerSweep bounds the amount of work a single sweep can do. A sweep visits at
+	// most this many callbacks, and the next sweep carries on after the last one visited
+	MaxCallbacksVisitedPerSweep = 200
+
+	// CallbackTimeoutGracePeriod is how long a callback is kept after its packet's timeout timestamp
+	// has passed, so that relayers still have a chance to relay the timeout
+	CallbackTimeoutGracePeriod = 7 * 24 * time.Hour
+	// CallbackTimeoutGraceBlocks is the same grace period for packets that only have a timeout
+	// height, counted in blocks of the counterparty chain
+	CallbackTimeoutGraceBlocks = 100_000
+)
+
+// GetPacketKey returns the key under which the callback of the packet (channel, sequence) is stored
+func GetPacketKey(channel string, packetSequence uint64) []byte {
+	return append(PacketCallbackPrefix, []byte(fmt.Sprintf("%s::%d", channel, packetSequence))...)
+}
+
+// GetContractCallbacksPrefix returns the prefix of the index entries of all the callbacks of a contract
+func GetContractCallbacksPrefix(contract sdk.AccAddress) []byte {
+	return append(ContractCallbackPrefix, address.MustLengthPrefix(contract)...)
+}
+
+// GetContractCallbackKey returns the index key of the callback of the packet (channel, sequence) for a contract
+func GetContractCallbackKey(contract sdk.AccAddress, channel string, packetSequence uint64) []byte {
+	return append(GetContractCallbacksPrefix(contract), []byte(fmt.Sprintf("%s::%d", channel, packetSequence))...)
+}
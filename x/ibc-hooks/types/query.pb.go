@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/ibchooks/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPacketCallbacksRequest is the request type for the
+// Query/PacketCallbacks RPC method.
+type QueryPacketCallbacksRequest struct {
+	// contract_address is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketCallbacksRequest) Reset()         { *m = QueryPacketCallbacksRequest{} }
+func (m *QueryPacketCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketCallbacksRequest) ProtoMessage()    {}
+func (*QueryPacketCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e69f72ed2860cdd, []int{0}
+}
+func (m *QueryPacketCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketCallbacksRequest.Merge(m, src)
+}
+func (m *QueryPacketCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketCallbacksRequest proto.InternalMessageInfo
+
+// QueryPacketCallbacksResponse is the response type for the
+// Query/PacketCallbacks RPC method.
+type QueryPacketCallbacksResponse struct {
+	Callbacks []PacketCallback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketCallbacksResponse) Reset()         { *m = QueryPacketCallbacksResponse{} }
+func (m *QueryPacketCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketCallbacksResponse) ProtoMessage()    {}
+func (*QueryPacketCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e69f72ed2860cdd, []int{1}
+}
+func (m *QueryPacketCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketCallbacksResponse.Merge(m, src)
+}
+func (m *QueryPacketCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketCallbacksResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryPacketCallbacksRequest)(nil), "secret.ibchooks.v1beta1.QueryPacketCallbacksRequest")
+	proto.RegisterType((*QueryPacketCallbacksResponse)(nil), "secret.ibchooks.v1beta1.QueryPacketCallbacksResponse")
+}
+
+func init() {
+	proto.RegisterFile("secret/ibchooks/v1beta1/query.proto", fileDescriptor_3e69f72ed2860cdd)
+}
+
+var fileDescriptor_3e69f72ed2860cdd = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0xcb, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0xaf, 0x7f, 0xe0, 0xdd, 0x1e, 0x2a, 0x8b, 0x60, 0xa9, 0x25, 0x96, 0x0a, 0xb6,
+	0x0a, 0xee, 0xd2, 0xd6, 0xe2, 0xc9, 0x83, 0x15, 0xf4, 0x20, 0x48, 0x1b, 0x6f, 0x5e, 0xca, 0x66,
+	0xbb, 0xa4, 0x21, 0x69, 0x26, 0xcd, 0x6e, 0xd5, 0x22, 0x5e, 0xfc, 0x04, 0x05, 0xbf, 0x8a, 0x57,
+	0xcf, 0xf6, 0x58, 0xf0, 0xe2, 0x49, 0xb4, 0xf5, 0x83, 0x48, 0xb2, 0xe9, 0x1f, 0x6b, 0xab, 0xbc,
+	0xb7, 0x30, 0x79, 0x9e, 0x79, 0x7e, 0x3b, 0x33, 0xf8, 0xb6, 0x92, 0x22, 0x91, 0x9a, 0xf9, 0xae,
+	0x18, 0x01, 0x04, 0x8a, 0xbd, 0x6e, 0xba, 0x52, 0xf3, 0x26, 0x9b, 0x4c, 0x65, 0x32, 0xa3, 0x71,
+	0x02, 0x1a, 0xc8, 0x0d, 0x23, 0xa2, 0x1b, 0x11, 0xcd, 0x45, 0xe5, 0xeb, 0x1e, 0x78, 0x90, 0x69,
+	0x58, 0xfa, 0x65, 0xe4, 0xe5, 0x8a, 0x07, 0xe0, 0x85, 0x92, 0xf1, 0xd8, 0x67, 0x3c, 0x8a, 0x40,
+	0x73, 0xed, 0x43, 0xa4, 0xf2, 0xbf, 0xf7, 0x04, 0xa8, 0x31, 0x28, 0xe6, 0x72, 0x25, 0x4d, 0xca,
+	0x36, 0x33, 0xe6, 0x9e, 0x1f, 0x65, 0xe2, 0x5c, 0x7b, 0x92, 0x4e, 0xcf, 0x62, 0x99, 0x37, 0xac,
+	0xcd, 0x11, 0xbe, 0xd9, 0x4f, 0xfb, 0xf4, 0xb8, 0x08, 0xa4, 0x7e, 0xc2, 0xc3, 0xd0, 0xe5, 0x22,
+	0x50, 0x8e, 0x9c, 0x4c, 0xa5, 0xd2, 0xe4, 0x2e, 0xbe, 0x26, 0x20, 0xd2, 0x09, 0x17, 0x7a, 0xc0,
+	0x87, 0xc3, 0x44, 0x2a, 0x55, 0x42, 0x55, 0xd4, 0x38, 0x77, 0x8a, 0x9b, 0xfa, 0x63, 0x53, 0x26,
+	0x4f, 0x31, 0xde, 0x31, 0x94, 0xce, 0xaa, 0xa8, 0x51, 0x68, 0xdd, 0xa1, 0x06, 0x98, 0xa6, 0xc0,
+	0xd4, 0x8c, 0x25, 0xc7, 0xa0, 0x3d, 0xee, 0xc9, 0x3c, 0xc6, 0xd9, 0x73, 0xd6, 0x3e, 0x21, 0x5c,
+	0x39, 0x8e, 0xa4, 0x62, 0x88, 0x94, 0x24, 0xcf, 0xf1, 0xb9, 0xd8, 0x14, 0x4b, 0xa8, 0x7a, 0xa9,
+	0x51, 0x68, 0xd5, 0xe9, 0x89, 0x29, 0xd3, 0x3f, 0x9b, 0x74, 0x2f, 0x2f, 0xbe, 0xdf, 0xb2, 0x9c,
+	0x9d, 0x9f, 0x3c, 0x3b, 0x42, 0x5d, 0xff, 0x2f, 0xb5, 0x21, 0xd9, 0xc7, 0x6e, 0x7d, 0x41, 0xf8,
+	0x4a, 0x86, 0x4d, 0x3e, 0x23, 0x5c, 0x3c, 0x60, 0x27, 0x0f, 0x4e, 0x02, 0xfe, 0x63, 0xfa, 0xe5,
+	0xce, 0x05, 0x5d, 0x06, 0xab, 0xf6, 0xe8, 0xc3, 0xd7, 0x5f, 0x1f, 0xcf, 0x1e, 0x92, 0xce, 0xdf,
+	0xbb, 0x8f, 0x33, 0xcb, 0x60, 0xfb, 0x7e, 0xf6, 0xee, 0x70, 0xbd, 0xef, 0xbb, 0xfd, 0xc5, 0x4f,
+	0xdb, 0x5a, 0xac, 0x6c, 0xb4, 0x5c, 0xd9, 0xe8, 0xc7, 0xca, 0x46, 0xf3, 0xb5, 0x6d, 0x2d, 0xd7,
+	0xb6, 0xf5, 0x6d, 0x6d, 0x5b, 0xaf, 0xda, 0x9e, 0xaf, 0x47, 0x53, 0x97, 0x0a, 0x18, 0x33, 0x25,
+	0x12, 0x1d, 0x72, 0x57, 0xb1, 0x97, 0x19, 0xe6, 0x0b, 0xa9, 0xdf, 0x40, 0x12, 0xb0, 0xb7, 0x69,
+	0xee, 0x7d, 0x13, 0x9c, 0x1d, 0x9b, 0x7b, 0x35, 0xbb, 0xb6, 0xf6, 0xef, 0x01, 0x00, 0xd9, 0xd2,
+	0x6d, 0x6a, 0x32, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PacketCallbacks lists the packets for which a contract is still waiting
+	// for an ack or a timeout
+	PacketCallbacks(ctx context.Context, in *QueryPacketCallbacksRequest, opts ...grpc.CallOption) (*QueryPacketCallbacksResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PacketCallbacks(ctx context.Context, in *QueryPacketCallbacksRequest, opts ...grpc.CallOption) (*QueryPacketCallbacksResponse, error) {
+	out := new(QueryPacketCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/secret.ibchooks.v1beta1.Query/PacketCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PacketCallbacks lists the packets for which a contract is still waiting
+	// for an ack or a timeout
+	PacketCallbacks(context.Context, *QueryPacketCallbacksRequest) (*QueryPacketCallbacksResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PacketCallbacks(ctx context.Context, req *QueryPacketCallbacksRequest) (*QueryPacketCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketCallbacks not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PacketCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.ibchooks.v1beta1.Query/PacketCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketCallbacks(ctx, req.(*QueryPacketCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.ibchooks.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PacketCallbacks",
+			Handler:    _Query_PacketCallbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/ibchooks/v1beta1/query.proto",
+}
+
+func (m *QueryPacketCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPacketCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPacketCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, PacketCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
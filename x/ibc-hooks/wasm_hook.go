@@ -264,7 +264,7 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 		return 0, nil
 	}
 
-	h.ibcHooksKeeper.StorePacketCallback(ctx, sourceChannel, seq, contract)
+	h.ibcHooksKeeper.StorePacketCallback(ctx, sourcePort, sourceChannel, seq, contract, timeoutHeight, timeoutTimestamp)
 	return seq, nil
 }
 
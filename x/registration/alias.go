@@ -41,11 +41,17 @@ var (
 	InitGenesis                 = keeper.InitGenesis
 	ExportGenesis               = keeper.ExportGenesis
 	NewKeeper                   = keeper.NewKeeper
+	NewNodeSeedListener         = keeper.NewNodeSeedListener
+	NewEnclaveApi               = enclave.NewApi
 	NewQuerier                  = keeper.NewQuerier
 	GetGenesisStateFromAppState = keeper.GetGenesisStateFromAppState
 	IsHexString                 = keeper.IsHexString
 	GetApiKey                   = types.GetApiKey
 	GetSpid                     = types.GetSpid
+	DefaultParams               = types.DefaultParams
+	EncryptedSeedLength         = types.EncryptedSeedLength
+	NewQueryClient              = types.NewQueryClient
+	RegisterQueryServer         = types.RegisterQueryServer
 	// variable aliases
 	ModuleCdc               = types.ModuleCdc
 	DefaultCodespace        = types.DefaultCodespace
@@ -65,4 +71,15 @@ type (
 	MasterKey            = types.MasterKey
 	Key                  = types.Key
 	RegistrationNodeInfo = types.RegistrationNodeInfo 
+	AllowedEnclave       = types.AllowedEnclave
+
+	MsgUpdateEnclaveAllowlist = types.MsgUpdateEnclaveAllowlist
+	MsgRevokeNodes            = types.MsgRevokeNodes
+	MsgUpdateParams           = types.MsgUpdateParams
+	MsgScheduleSeedRotation   = types.MsgScheduleSeedRotation
+	SeedRotation              = types.SeedRotation
+	Params                    = types.Params
+	QueryClient               = types.QueryClient
+	QueryServer               = types.QueryServer
+	UnimplementedQueryServer  = types.UnimplementedQueryServer
 )
@@ -29,16 +29,24 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdEncryptedSeed(),
 		GetCmdMasterParams(),
+		GetCmdEnclaveAllowlist(),
+		GetCmdRegisteredNodes(),
+		GetCmdRegisteredNode(),
+		GetCmdQueryParams(),
+		GetCmdSeedRotation(),
 	)
 	return queryCmd
 }
 
+const flagSeedVersion = "seed-version"
+
 func GetCmdEncryptedSeed() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "seed [node-id]",
 		Short: "Get encrypted seed for a node",
-		Long:  "Get encrypted seed for a node",
-		Args:  cobra.ExactArgs(1),
+		Long: "Get encrypted seed for a node. After a seed rotation, use --seed-version to get the seed of the new " +
+			"version, and configure the node with it",
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			grpcCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -55,19 +63,203 @@ func GetCmdEncryptedSeed() *cobra.Command {
 				return fmt.Errorf("failed to decode node id %s as string", nodeId)
 			}
 
+			seedVersion, err := cmd.Flags().GetUint32(flagSeedVersion)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(grpcCtx)
-			res, err := queryClient.EncryptedSeed(
+			var res *types.QueryEncryptedSeedResponse
+			if seedVersion == 0 {
+				res, err = queryClient.EncryptedSeed(
+					context.Background(),
+					&types.QueryEncryptedSeedRequest{
+						PubKey: pubKey,
+					},
+				)
+			} else {
+				res, err = queryClient.EncryptedSeedByVersion(
+					context.Background(),
+					&types.QueryEncryptedSeedByVersionRequest{
+						PubKey:  pubKey,
+						Version: seedVersion,
+					},
+				)
+			}
+			if err != nil {
+				return sdkerrors.ErrNotFound.Wrapf("Failed to query seed for %s. Error: %s", args[0], err)
+			}
+
+			fmt.Printf("0x%s\n", hex.EncodeToString(res.EncryptedSeed))
+			return nil
+		},
+	}
+	cmd.Flags().Uint32(flagSeedVersion, 0, "Consensus seed version to get the seed of (default: the version the node registered with)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSeedRotation prints the consensus seed version of the network, and the scheduled rotation if any
+func GetCmdSeedRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seed-rotation",
+		Short: "Get the consensus seed version of the network",
+		Long: "Get the consensus seed version of the network, and the rotation to the next version that governance " +
+			"scheduled, if any. Seed rotation is not supported by the enclave yet, so governance can't schedule rotations " +
+			"until an enclave release supports them",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SeedRotation(
 				context.Background(),
-				&types.QueryEncryptedSeedRequest{
+				&types.QuerySeedRotationRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams prints the parameters of the registration module
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the parameters of the registration module",
+		Long:  "Get the parameters of the registration module, such as the attestation policy that new registrations must satisfy",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(
+				context.Background(),
+				&types.QueryParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRegisteredNodes lists the registered nodes
+func GetCmdRegisteredNodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodes",
+		Short: "List the registered nodes",
+		Long:  "List the registered nodes, with their registration height, the account that registered them and a summary of their attestation",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RegisteredNodes(
+				context.Background(),
+				&types.QueryRegisteredNodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nodes")
+	return cmd
+}
+
+// GetCmdRegisteredNode prints a registered node
+func GetCmdRegisteredNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node [node-id]",
+		Short: "Get a registered node",
+		Long:  "Get a registered node by its node id, with its registration height, the account that registered it and a summary of its attestation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeId := args[0]
+			if len(nodeId) != types.PublicKeyLength {
+				return fmt.Errorf("invalid Node ID format (req: hex string of length %d)", types.PublicKeyLength)
+			}
+
+			pubKey, err := hex.DecodeString(nodeId)
+			if err != nil {
+				return fmt.Errorf("failed to decode node id %s as string", nodeId)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RegisteredNode(
+				context.Background(),
+				&types.QueryRegisteredNodeRequest{
 					PubKey: pubKey,
 				},
 			)
 			if err != nil {
-				return sdkerrors.ErrNotFound.Wrapf("Failed to query seed for %s. Error: %s", args[0], err)
+				return err
 			}
 
-			fmt.Printf("0x%s\n", hex.EncodeToString(res.EncryptedSeed))
-			return nil
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdEnclaveAllowlist prints the enclaves that may register
+func GetCmdEnclaveAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enclave-allowlist",
+		Short: "Get the allowlist of enclaves that may register",
+		Long:  "Get the MRENCLAVE/MRSIGNER allowlist of enclaves that may register, with the minimum ISVSVN of each entry. An empty allowlist accepts any enclave",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EnclaveAllowlist(
+				context.Background(),
+				&emptypb.Empty{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Registration services of the public networks
+const (
+	MainnetURL = "https://mainnet-register.scrtlabs.com/api/registernode"
+	PulsarURL  = "https://registration-service-testnet.azurewebsites.net/api/registernode"
+)
+
+const (
+	defaultRetries      = 3
+	defaultBackoff      = 2 * time.Second
+	defaultMaxBackoff   = 30 * time.Second
+	defaultPollInterval = 3 * time.Second
+	defaultPollTimeout  = 2 * time.Minute
+)
+
+// SeedQuerier queries the encrypted seeds of registered nodes
+type SeedQuerier interface {
+	EncryptedSeed(ctx context.Context, in *types.QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*types.QueryEncryptedSeedResponse, error)
+}
+
+// Querier queries the registration module for what the registration service responds with
+type Querier interface {
+	SeedQuerier
+	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.Key, error)
+}
+
+// NewQuerier returns a Querier of the registration module through conn, e.g. a client.Context
+func NewQuerier(conn gogogrpc.ClientConn) Querier {
+	return types.NewQueryClient(conn)
+}
+
+// Client registers nodes with a registration service, which submits their certificates to the network on their behalf
+type Client struct {
+	url        string
+	httpClient *http.Client
+
+	retries    int
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client that requests are sent with
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries sets how many times a failed request is retried, and the backoff before the first retry. The backoff
+// doubles on every retry, up to maxBackoff
+func WithRetries(retries int, backoff time.Duration, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.backoff = backoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithPolling sets how often, and for how long, VerifyRegistered polls the network
+func WithPolling(interval time.Duration, timeout time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = interval
+		c.pollTimeout = timeout
+	}
+}
+
+// NewClient returns a Client of the registration service at url
+func NewClient(url string, opts ...Option) *Client {
+	c := &Client{
+		url:          url,
+		httpClient:   http.DefaultClient,
+		retries:      defaultRetries,
+		backoff:      defaultBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		pollInterval: defaultPollInterval,
+		pollTimeout:  defaultPollTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Register asks the registration service to register the node with the given certificate. Requests that fail because
+// of the network or of the service are retried, requests that the service rejects are not. Registering is
+// idempotent, as the network returns the seed of a node that is already registered
+func (c *Client) Register(ctx context.Context, certificate []byte) (*RegisterResponse, error) {
+	body, err := json.Marshal(RegisterRequest{Certificate: certificate})
+	if err != nil {
+		return nil, err
+	}
+
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		res, err := c.register(ctx, body)
+		if err == nil || attempt >= c.retries || !isRetryable(err) {
+			return res, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+}
+
+func (c *Client) register(ctx context.Context, body []byte) (*RegisterResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		errResp := &ErrorResponse{}
+		if err := json.Unmarshal(respBody, errResp); err != nil || errResp.Details == "" {
+			errResp.Details = http.StatusText(resp.StatusCode)
+		}
+		errResp.StatusCode = resp.StatusCode
+		return nil, errResp
+	}
+
+	res := &RegisterResponse{}
+	if err := json.Unmarshal(respBody, res); err != nil {
+		return nil, fmt.Errorf("invalid response from registration service: %w", err)
+	}
+	if _, err := res.EncryptedSeed(); err != nil {
+		return nil, fmt.Errorf("invalid response from registration service: %w", err)
+	}
+
+	return res, nil
+}
+
+// isRetryable returns false for requests that the service rejected, and so would be rejected again
+func isRetryable(err error) bool {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode >= http.StatusInternalServerError || errResp.StatusCode == http.StatusTooManyRequests
+	}
+
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// VerifyRegistered polls the network until it holds the expected encrypted seed for the node with the given public
+// key, which means the registration transaction was committed
+func (c *Client) VerifyRegistered(ctx context.Context, querier SeedQuerier, pubKey []byte, encryptedSeed string) error {
+	expected, err := hex.DecodeString(encryptedSeed)
+	if err != nil {
+		return fmt.Errorf("invalid encrypted seed: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.pollTimeout)
+	defer cancel()
+
+	return pollEncryptedSeed(ctx, querier, pubKey, c.pollInterval, func(seed []byte) bool {
+		return bytes.Equal(seed, expected)
+	})
+}
+
+// pollEncryptedSeed queries the encrypted seed of a node until done accepts it, or ctx is done
+func pollEncryptedSeed(ctx context.Context, querier SeedQuerier, pubKey []byte, interval time.Duration, done func([]byte) bool) error {
+	var lastErr error
+	for {
+		res, err := querier.EncryptedSeed(ctx, &types.QueryEncryptedSeedRequest{PubKey: pubKey})
+		switch {
+		case err != nil:
+			lastErr = err
+		case done(res.EncryptedSeed):
+			return nil
+		default:
+			lastErr = fmt.Errorf("unexpected encrypted seed 0x%x", res.EncryptedSeed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("node 0x%x is not registered: %w", pubKey, lastErr)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxRequestSize bounds the body of registration requests, which is mostly the certificate
+const maxRequestSize = 1 << 20
+
+// Submitter submits a registration message to the network
+type Submitter func(ctx context.Context, msg *types.RaAuthenticate) error
+
+// NewTxSubmitter returns a Submitter that signs registration messages with the key of clientCtx, and broadcasts them
+// in transactions built with txf
+func NewTxSubmitter(clientCtx client.Context, txf tx.Factory) Submitter {
+	var mtx sync.Mutex
+
+	return func(ctx context.Context, msg *types.RaAuthenticate) error {
+		// transactions are built one at a time, so that each one gets the next account sequence
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		txf, err := txf.Prepare(clientCtx)
+		if err != nil {
+			return err
+		}
+
+		if txf.SimulateAndExecute() {
+			_, adjusted, err := tx.CalculateGas(clientCtx, txf, msg)
+			if err != nil {
+				return err
+			}
+			txf = txf.WithGas(adjusted)
+		}
+
+		builder, err := txf.BuildUnsignedTx(msg)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Sign(ctx, txf, clientCtx.GetFromName(), builder, true)
+		if err != nil {
+			return err
+		}
+
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		res, err := clientCtx.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("registration transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+
+		return nil
+	}
+}
+
+// Server is a reference registration service. It registers nodes by submitting their certificates from the account
+// of sender, and responds with their encrypted seeds once the network holds them. It is an http.Handler, meant for
+// local networks and tests
+type Server struct {
+	sender  sdk.AccAddress
+	submit  Submitter
+	querier Querier
+
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+}
+
+var _ http.Handler = &Server{}
+
+// NewServer returns a Server that submits registrations from the account of sender
+func NewServer(sender sdk.AccAddress, submit Submitter, querier Querier) *Server {
+	return &Server{
+		sender:       sender,
+		submit:       submit,
+		querier:      querier,
+		pollInterval: defaultPollInterval,
+		pollTimeout:  defaultPollTimeout,
+	}
+}
+
+// WithPolling sets how often, and for how long, the server polls the network for the seeds of registered nodes
+func (s *Server) WithPolling(interval time.Duration, timeout time.Duration) *Server {
+	s.pollInterval = interval
+	s.pollTimeout = timeout
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed", r.Method))
+		return
+	}
+
+	var req RegisterRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request: %s", err))
+		return
+	}
+
+	// stale collateral would be rejected on chain, so it is rejected before the registration is submitted
+	pubKey, _, err := ra.VerifyCombinedCertReport(req.Certificate, time.Now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid certificate: %s", err))
+		return
+	}
+
+	msg := &types.RaAuthenticate{
+		Sender:      s.sender,
+		Certificate: req.Certificate,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// failures from here on may be transient, and registering again is harmless, so they are reported as server
+	// errors that clients retry
+	if err := s.submit(r.Context(), msg); err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to submit registration: %s", err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.pollTimeout)
+	defer cancel()
+
+	var seed []byte
+	err = pollEncryptedSeed(ctx, s.querier, pubKey, s.pollInterval, func(encSeed []byte) bool {
+		seed = encSeed
+		return len(encSeed) > 0
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	key, err := s.querier.RegistrationKey(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to query registration key: %s", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, RegisterResponse{
+		Status: StatusSuccess,
+		Details: KeyVal{
+			Key:   EncryptedSeedKey,
+			Value: fmt.Sprintf("0x%x", seed),
+		},
+		RegistrationKey: base64.StdEncoding.EncodeToString(key.Key),
+	})
+}
+
+func writeError(w http.ResponseWriter, statusCode int, details string) {
+	writeJSON(w, statusCode, ErrorResponse{
+		Status:  StatusError,
+		Details: details,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	testSeed            = bytes.Repeat([]byte{0xab}, types.EncryptedKeyLength/2)
+	testRegistrationKey = bytes.Repeat([]byte{0x01}, 32)
+)
+
+// mockNetwork registers the nodes of the messages it is submitted, as the registration module would
+type mockNetwork struct {
+	mtx   sync.Mutex
+	msgs  []*types.RaAuthenticate
+	seeds map[string][]byte
+}
+
+func newMockNetwork() *mockNetwork {
+	return &mockNetwork{seeds: map[string][]byte{}}
+}
+
+func (n *mockNetwork) submit(_ context.Context, msg *types.RaAuthenticate) error {
+	pubKey, err := ra.VerifyCombinedCert(msg.Certificate)
+	if err != nil {
+		return err
+	}
+
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.msgs = append(n.msgs, msg)
+	n.seeds[string(pubKey)] = testSeed
+	return nil
+}
+
+func (n *mockNetwork) EncryptedSeed(_ context.Context, in *types.QueryEncryptedSeedRequest, _ ...grpc.CallOption) (*types.QueryEncryptedSeedResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	seed, ok := n.seeds[string(in.PubKey)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "node not registered")
+	}
+	return &types.QueryEncryptedSeedResponse{EncryptedSeed: seed}, nil
+}
+
+func (n *mockNetwork) RegistrationKey(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*types.Key, error) {
+	return &types.Key{Key: testRegistrationKey}, nil
+}
+
+func readTestCert(t *testing.T) ([]byte, []byte) {
+	require.NoError(t, os.Setenv("SGX_MODE", "SW"))
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+
+	pubKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+
+	return cert, pubKey
+}
+
+func newTestServer(network *mockNetwork) *Server {
+	return NewServer(sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20)), network.submit, network).
+		WithPolling(time.Millisecond, time.Second)
+}
+
+func TestRegisterAndVerify(t *testing.T) {
+	cert, pubKey := readTestCert(t)
+	network := newMockNetwork()
+
+	srv := httptest.NewServer(newTestServer(network))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, WithPolling(time.Millisecond, time.Second))
+
+	res, err := client.Register(context.Background(), cert)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, res.Status)
+
+	seed, err := res.EncryptedSeed()
+	require.NoError(t, err)
+	require.Len(t, seed, types.EncryptedKeyLength)
+	require.Equal(t, "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=", res.RegistrationKey)
+
+	require.Len(t, network.msgs, 1)
+	require.Equal(t, cert, []byte(network.msgs[0].Certificate))
+
+	require.NoError(t, client.VerifyRegistered(context.Background(), network, pubKey, seed))
+}
+
+func TestVerifyRegisteredTimesOut(t *testing.T) {
+	_, pubKey := readTestCert(t)
+
+	client := NewClient("", WithPolling(time.Millisecond, 20*time.Millisecond))
+
+	err := client.VerifyRegistered(context.Background(), newMockNetwork(), pubKey, "abab")
+	require.ErrorContains(t, err, "is not registered")
+}
+
+func TestRegisterRetriesServerErrors(t *testing.T) {
+	cert, _ := readTestCert(t)
+	handler := newTestServer(newMockNetwork())
+
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			writeError(w, http.StatusServiceUnavailable, "busy")
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, WithRetries(3, time.Millisecond, 2*time.Millisecond))
+
+	_, err := client.Register(context.Background(), cert)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, attempts)
+
+	// give up after the configured retries
+	atomic.StoreInt32(&attempts, -10)
+	_, err = client.Register(context.Background(), cert)
+
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	require.Equal(t, http.StatusServiceUnavailable, errResp.StatusCode)
+	require.EqualValues(t, -6, attempts)
+}
+
+func TestRegisterDoesNotRetryRejections(t *testing.T) {
+	network := newMockNetwork()
+	handler := newTestServer(network)
+
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		handler.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, WithRetries(3, time.Millisecond, time.Millisecond))
+
+	_, err := client.Register(context.Background(), []byte("not a certificate"))
+
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	require.Equal(t, http.StatusBadRequest, errResp.StatusCode)
+	require.Equal(t, StatusError, errResp.Status)
+	require.ErrorContains(t, err, "invalid certificate")
+	require.EqualValues(t, 1, attempts)
+	require.Empty(t, network.msgs)
+}
+
+func TestRegisterRejectsInvalidSeed(t *testing.T) {
+	cert, _ := readTestCert(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, RegisterResponse{
+			Status:  StatusSuccess,
+			Details: KeyVal{Key: EncryptedSeedKey, Value: "0x1234"},
+		})
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL, WithRetries(0, 0, 0)).Register(context.Background(), cert)
+	require.ErrorContains(t, err, "invalid encrypted seed length")
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+)
+
+// Statuses of registration service responses
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+)
+
+// EncryptedSeedKey is the key of the encrypted seed in the details of a RegisterResponse
+const EncryptedSeedKey = "encrypted_seed"
+
+// RegisterRequest is the body of a registration request. The certificate is the attestation_combined.bin of the
+// node, and is base64 encoded on the wire
+type RegisterRequest struct {
+	Certificate []byte `json:"certificate"`
+}
+
+// KeyVal is a key-value pair of a RegisterResponse
+type KeyVal struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisterResponse is the body of a successful registration
+type RegisterResponse struct {
+	Status string `json:"status"`
+	// Details holds the encrypted seed of the node, hex encoded with a 0x prefix
+	Details KeyVal `json:"details"`
+	// RegistrationKey is the base64 encoded registration master key of the network
+	RegistrationKey string `json:"registration_key"`
+}
+
+// EncryptedSeed returns the hex encoded encrypted seed of the response, without the 0x prefix
+func (r RegisterResponse) EncryptedSeed() (string, error) {
+	seed := strings.TrimPrefix(r.Details.Value, "0x")
+
+	if len(seed) != types.EncryptedKeyLength {
+		return "", fmt.Errorf("invalid encrypted seed length %d, expected %d", len(seed), types.EncryptedKeyLength)
+	}
+	if _, err := hex.DecodeString(seed); err != nil {
+		return "", fmt.Errorf("invalid encrypted seed: %w", err)
+	}
+
+	return seed, nil
+}
+
+// ErrorResponse is the body of a failed registration
+type ErrorResponse struct {
+	Status  string `json:"status"`
+	Details string `json:"details"`
+
+	// StatusCode is the HTTP status code the response came with
+	StatusCode int `json:"-"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("registration failed with status %d: %s", e.StatusCode, e.Details)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// GetEnclaveAllowlist returns the enclaves that may register. An empty allowlist accepts any enclave
+func (k Keeper) GetEnclaveAllowlist(ctx sdk.Context) []types.AllowedEnclave {
+	store := k.storeService.OpenKVStore(ctx)
+	var allowlist types.EnclaveAllowlist
+	bz, _ := store.Get(types.EnclaveAllowlistKey)
+	if bz == nil {
+		return nil
+	}
+	k.cdc.MustUnmarshal(bz, &allowlist)
+
+	return allowlist.Entries
+}
+
+func (k Keeper) SetEnclaveAllowlist(ctx sdk.Context, entries []types.AllowedEnclave) error {
+	if err := types.ValidateEnclaveAllowlist(entries); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if len(entries) == 0 {
+		return store.Delete(types.EnclaveAllowlistKey)
+	}
+	return store.Set(types.EnclaveAllowlistKey, k.cdc.MustMarshal(&types.EnclaveAllowlist{Entries: entries}))
+}
+
+// checkEnclaveAllowlist fails unless the attested enclave matches an entry of the allowlist. In software mode there is
+// no attested report, and the allowlist is not enforced
+func (k Keeper) checkEnclaveAllowlist(ctx sdk.Context, report *ra.SgxReportBody) error {
+	allowlist := k.GetEnclaveAllowlist(ctx)
+	if len(allowlist) == 0 || report == nil {
+		return nil
+	}
+
+	for _, entry := range allowlist {
+		if entry.Matches(report.MrEnclave[:], report.MrSigner[:], uint32(report.IsvSvn)) {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(types.ErrEnclaveNotAllowed, "mr_enclave %s, mr_signer %s, isv_svn %d",
+		hex.EncodeToString(report.MrEnclave[:]), hex.EncodeToString(report.MrSigner[:]), report.IsvSvn)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+)
+
+func testReportBody(mrEnclave byte, mrSigner byte, isvSvn uint16) *ra.SgxReportBody {
+	var report ra.SgxReportBody
+	for i := range report.MrEnclave {
+		report.MrEnclave[i] = mrEnclave
+		report.MrSigner[i] = mrSigner
+	}
+	report.IsvSvn = isvSvn
+	return &report
+}
+
+func measurement(b byte) []byte {
+	m := make([]byte, types.MeasurementLength)
+	for i := range m {
+		m[i] = b
+	}
+	return m
+}
+
+func TestKeeper_CheckEnclaveAllowlist(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	// an empty allowlist accepts any enclave
+	require.NoError(t, keeper.checkEnclaveAllowlist(ctx, testReportBody(1, 2, 0)))
+
+	err = keeper.SetEnclaveAllowlist(ctx, []types.AllowedEnclave{
+		{MrEnclave: measurement(1), MinIsvSvn: 3},
+		{MrSigner: measurement(9), MinIsvSvn: 5},
+		{MrEnclave: measurement(4), MrSigner: measurement(5)},
+	})
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		report  *ra.SgxReportBody
+		allowed bool
+	}{
+		"mr_enclave match":                  {testReportBody(1, 2, 3), true},
+		"mr_enclave match, isv_svn too low": {testReportBody(1, 2, 2), false},
+		"mr_signer match":                   {testReportBody(7, 9, 6), true},
+		"mr_signer match, isv_svn too low":  {testReportBody(7, 9, 4), false},
+		"both match":                        {testReportBody(4, 5, 0), true},
+		"only mr_enclave of pair matches":   {testReportBody(4, 6, 0), false},
+		"no match":                          {testReportBody(2, 2, 10), false},
+		"software mode":                     {nil, true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := keeper.checkEnclaveAllowlist(ctx, tc.report)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrEnclaveNotAllowed)
+			}
+		})
+	}
+}
+
+func TestMsgServer_UpdateEnclaveAllowlist(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	msgServer := NewMsgServerImpl(keeper, types.ModuleName)
+	querier := NewQuerier(keeper)
+	allowlist := []types.AllowedEnclave{{MrSigner: measurement(9), MinIsvSvn: 5}}
+
+	_, err = msgServer.UpdateEnclaveAllowlist(ctx, &types.MsgUpdateEnclaveAllowlist{
+		Authority: sdk.AccAddress("not the gov account").String(),
+		Allowlist: allowlist,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateEnclaveAllowlist(ctx, &types.MsgUpdateEnclaveAllowlist{
+		Authority: keeper.GetAuthority(),
+		Allowlist: []types.AllowedEnclave{{MinIsvSvn: 5}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	_, err = msgServer.UpdateEnclaveAllowlist(ctx, &types.MsgUpdateEnclaveAllowlist{
+		Authority: keeper.GetAuthority(),
+		Allowlist: allowlist,
+	})
+	require.NoError(t, err)
+
+	res, err := querier.EnclaveAllowlist(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, allowlist, res.Allowlist)
+
+	_, err = msgServer.UpdateEnclaveAllowlist(ctx, &types.MsgUpdateEnclaveAllowlist{
+		Authority: keeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+
+	res, err = querier.EnclaveAllowlist(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Empty(t, res.Allowlist)
+}
+
+func TestExportGenesis_EnclaveAllowlist(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	key, err := FetchRawPubKeyFromLegacyCert(cert)
+	require.NoError(t, err)
+
+	data := types.GenesisState{
+		IoMasterKey:       &types.MasterKey{Bytes: key},
+		NodeExchMasterKey: &types.MasterKey{Bytes: key},
+		EnclaveAllowlist: []types.AllowedEnclave{
+			{MrEnclave: measurement(1), MinIsvSvn: 3},
+			{MrSigner: measurement(9)},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(data))
+
+	InitGenesis(ctx, keeper, data)
+
+	data2 := ExportGenesis(ctx, keeper)
+	require.Equal(t, data.EnclaveAllowlist, data2.EnclaveAllowlist)
+}
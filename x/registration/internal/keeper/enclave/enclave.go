@@ -1,19 +1,45 @@
 package enclave
 
 import (
+	"fmt"
+
 	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 )
 
-type Api struct{}
+// Api is the EnclaveInterface of the registration keeper on top of an enclave backend
+type Api struct {
+	backend api.Backend
+}
+
+func NewApi(backend api.Backend) Api {
+	return Api{backend: backend}
+}
+
+func (e Api) LoadSeed(masterKey []byte, seed []byte, apiKey []byte) (bool, error) {
+	return e.backend.LoadSeedToEnclave(masterKey, seed, apiKey)
+}
+
+func (e Api) GetEncryptedSeed(masterCert []byte) ([]byte, error) {
+	return e.backend.GetEncryptedSeed(masterCert)
+}
 
-func (Api) LoadSeed(masterKey []byte, seed []byte, apiKey []byte) (bool, error) {
-	return api.LoadSeedToEnclave(masterKey, seed, apiKey)
+func (e Api) GetEncryptedGenesisSeed(pk []byte) ([]byte, error) {
+	return e.backend.GetEncryptedGenesisSeed(pk)
 }
 
-func (Api) GetEncryptedSeed(masterCert []byte) ([]byte, error) {
-	return api.GetEncryptedSeed(masterCert)
+// GetEncryptedSeedForVersion encrypts the seed of a consensus seed version for a node
+func (e Api) GetEncryptedSeedForVersion(masterCert []byte, version uint32) ([]byte, error) {
+	if version != e.ServedSeedVersion() {
+		return nil, fmt.Errorf("the enclave does not serve seed version %d", version)
+	}
+	return e.backend.GetEncryptedSeed(masterCert)
 }
 
-func (Api) GetEncryptedGenesisSeed(pk []byte) ([]byte, error) {
-	return api.GetEncryptedGenesisSeed(pk)
+// ServedSeedVersion is the seed version the enclave was built with. The enclave can't derive the seeds of later
+// versions: ecall_init_node only takes the seed of this version, and there is no ecall for the seed of a given
+// version. Seed rotation is therefore not supported yet, and rotations are rejected until an enclave release adds
+// version aware seed derivation
+func (Api) ServedSeedVersion() uint32 {
+	return types.SeedConfigVersion
 }
@@ -4,4 +4,7 @@ type EnclaveInterface interface {
 	LoadSeed(masterKey []byte, seed []byte, apiKey []byte) (bool, error)
 	GetEncryptedSeed(masterCert []byte) ([]byte, error)
 	GetEncryptedGenesisSeed(pk []byte) ([]byte, error)
+	GetEncryptedSeedForVersion(masterCert []byte, version uint32) ([]byte, error)
+	// ServedSeedVersion returns the last consensus seed version the enclave serves seeds of
+	ServedSeedVersion() uint32
 }
@@ -14,7 +14,49 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetMasterKey(ctx, *data.IoMasterKey, types.MasterIoKeyId)
 		keeper.SetMasterKey(ctx, *data.NodeExchMasterKey, types.MasterNodeKeyId)
 		for _, storedRegInfo := range data.Registration {
-			if err := keeper.SetRegistrationInfo(ctx, *storedRegInfo); err != nil {
+			// the attestation collateral of registered nodes expires, so their certificates are parsed rather than
+			// verified again
+			publicKey, err := types.RegistrationPubKey(*storedRegInfo)
+			if err != nil {
+				panic(err)
+			}
+			if err := keeper.SetRegistrationInfo_Verified(ctx, *storedRegInfo, publicKey); err != nil {
+				panic(err)
+			}
+		}
+		if err := keeper.SetEnclaveAllowlist(ctx, data.EnclaveAllowlist); err != nil {
+			panic(err)
+		}
+		if err := keeper.SetParams(ctx, data.Params); err != nil {
+			panic(err)
+		}
+		for _, publicKey := range data.RevokedNodes {
+			if err := keeper.SetNodeRevoked(ctx, publicKey); err != nil {
+				panic(err)
+			}
+		}
+		for _, platformInfo := range data.RevokedPlatformInfos {
+			if err := keeper.SetPlatformInfoRevoked(ctx, platformInfo); err != nil {
+				panic(err)
+			}
+		}
+		for _, tcbStatus := range data.RevokedTcbStatuses {
+			if err := keeper.SetTcbStatusRevoked(ctx, tcbStatus); err != nil {
+				panic(err)
+			}
+		}
+		if data.SeedVersion != 0 {
+			if err := keeper.setSeedVersion(ctx, data.SeedVersion); err != nil {
+				panic(err)
+			}
+		}
+		if data.ScheduledSeedRotation != nil {
+			if err := keeper.setScheduledSeedRotation(ctx, *data.ScheduledSeedRotation); err != nil {
+				panic(err)
+			}
+		}
+		for _, seed := range data.VersionedSeeds {
+			if err := keeper.SetVersionedEncryptedSeed(ctx, seed.Version, seed.PubKey, seed.EncryptedSeed); err != nil {
 				panic(err)
 			}
 		}
@@ -29,16 +71,52 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 
 	genState.NodeExchMasterKey = keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
 	genState.IoMasterKey = keeper.GetMasterKey(ctx, types.MasterIoKeyId)
+	genState.EnclaveAllowlist = keeper.GetEnclaveAllowlist(ctx)
+	genState.Params = keeper.GetParams(ctx)
+	genState.SeedVersion = keeper.getStoredSeedVersion(ctx)
+	genState.ScheduledSeedRotation = keeper.GetScheduledSeedRotation(ctx)
 
+	// the store iterates registrations by public key, so that exports of all nodes are byte-stable
 	keeper.ListRegistrationInfo(
 		ctx,
 		func(_ []byte, regInfo types.RegistrationNodeInfo) bool {
-			// unused param pubkey
 			genState.Registration = append(genState.Registration, &regInfo)
 			return false
 		},
 	)
 
+	keeper.ListRevokedNodes(
+		ctx,
+		func(publicKey types.NodeID) bool {
+			genState.RevokedNodes = append(genState.RevokedNodes, publicKey)
+			return false
+		},
+	)
+
+	keeper.ListRevokedPlatformInfos(
+		ctx,
+		func(platformInfo string) bool {
+			genState.RevokedPlatformInfos = append(genState.RevokedPlatformInfos, platformInfo)
+			return false
+		},
+	)
+
+	keeper.ListRevokedTcbStatuses(
+		ctx,
+		func(tcbStatus string) bool {
+			genState.RevokedTcbStatuses = append(genState.RevokedTcbStatuses, tcbStatus)
+			return false
+		},
+	)
+
+	keeper.ListVersionedEncryptedSeeds(
+		ctx,
+		func(seed types.VersionedEncryptedSeed) bool {
+			genState.VersionedSeeds = append(genState.VersionedSeeds, seed)
+			return false
+		},
+	)
+
 	return &genState
 }
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"os"
 	"testing"
 
@@ -71,3 +72,50 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, string(data.NodeExchMasterKey.Bytes), string(data2.NodeExchMasterKey.Bytes))
 	require.Equal(t, data2.Registration, data2.Registration)
 }
+
+func TestExportGenesis_Deterministic(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	var registrations []*types.RegistrationNodeInfo
+	for i, name := range []string{"attestation_cert_sw", "attestation_cert_hw_v2", "attestation_cert_hw_old"} {
+		cert, err := os.ReadFile("../../testdata/" + name)
+		require.NoError(t, err)
+		registrations = append(registrations, &types.RegistrationNodeInfo{
+			Certificate:   cert,
+			EncryptedSeed: bytes.Repeat([]byte{byte(i)}, types.EncryptedKeyLength/2),
+		})
+	}
+
+	export := func(registrations []*types.RegistrationNodeInfo) *types.GenesisState {
+		ctx, keeper := CreateTestInput(t, false, tempDir, true)
+		data := types.GenesisState{
+			Registration:      registrations,
+			IoMasterKey:       &types.MasterKey{Bytes: bytes.Repeat([]byte{1}, types.MasterKeyLength)},
+			NodeExchMasterKey: &types.MasterKey{Bytes: bytes.Repeat([]byte{2}, types.MasterKeyLength)},
+			Params:            types.DefaultParams(),
+		}
+		require.NoError(t, types.ValidateGenesis(data))
+
+		InitGenesis(ctx, keeper, data)
+		return ExportGenesis(ctx, keeper)
+	}
+
+	exported := export(registrations)
+	require.Len(t, exported.Registration, len(registrations))
+	require.NoError(t, types.ValidateGenesis(*exported))
+
+	// registrations are exported by public key
+	for i := 1; i < len(exported.Registration); i++ {
+		prev, err := types.RegistrationPubKey(*exported.Registration[i-1])
+		require.NoError(t, err)
+		next, err := types.RegistrationPubKey(*exported.Registration[i])
+		require.NoError(t, err)
+		require.Negative(t, bytes.Compare(prev, next))
+	}
+
+	// whatever order the nodes were imported in
+	reversed := []*types.RegistrationNodeInfo{registrations[2], registrations[1], registrations[0]}
+	require.Equal(t, exported, export(reversed))
+}
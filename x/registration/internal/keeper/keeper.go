@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,12 +24,28 @@ type Keeper struct {
 	cdc          codec.Codec
 	enclave      EnclaveInterface
 	router       baseapp.MessageRouter
+	authority    string
+
+	// node is the seed this node loaded into its enclave, nil when bootstrapping
+	node *nodeSeed
+}
+
+// nodeSeed is shared by the copies of the keeper, so that the seed versions the node loads later are seen by all
+type nodeSeed struct {
+	homeDir string
+	version uint32
+	// failedVersion is the last seed version that the node failed to load
+	failedVersion uint32
 }
 
 // NewKeeper creates a new contract Keeper instance
-func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, router baseapp.MessageRouter, enclave EnclaveInterface, homeDir string, bootstrap bool) Keeper {
+func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, router baseapp.MessageRouter, enclave EnclaveInterface, homeDir string, bootstrap bool, authority string, logger log.Logger) Keeper {
+	var node *nodeSeed
 	if !bootstrap {
-		InitializeNode(homeDir, enclave)
+		node = &nodeSeed{
+			homeDir: homeDir,
+			version: initializeNode(homeDir, enclave, logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))),
+		}
 	}
 
 	return Keeper{
@@ -36,9 +53,20 @@ func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, router baseap
 		cdc:          cdc,
 		router:       router,
 		enclave:      enclave,
+		authority:    authority,
+		node:         node,
 	}
 }
 
+func moduleLogger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/registration module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func getSizedEncSeed(seed []byte) []byte {
 	// Add size indicator infront of the seed
 	// Size can always be represented by 1 byte as it can contain 2 seeds at most
@@ -51,7 +79,7 @@ func getSizedEncSeed(seed []byte) []byte {
 	return newEnc
 }
 
-func getNewSeedParams(path string) ([]byte, []byte) {
+func getNewSeedParams(path string) ([]byte, []byte, uint32) {
 	jsonContent, err := getFile(path)
 	if err != nil {
 		panic(errorsmod.Wrap(types.ErrSeedInitFailed, err.Error()))
@@ -68,7 +96,13 @@ func getNewSeedParams(path string) ([]byte, []byte) {
 		panic(errorsmod.Wrap(types.ErrSeedInitFailed, err.Error()))
 	}
 
-	return enc, pk
+	// configurations written before seed versions were tracked are of the first version with a seed config
+	version := seedCfg.Version
+	if version == 0 {
+		version = types.SeedConfigVersion
+	}
+
+	return enc, pk, version
 }
 
 func getLegacySeedParams(path string) ([]byte, []byte) {
@@ -122,7 +156,13 @@ func createOldSecret(key []byte, seedFilePath string, enclave EnclaveInterface)
 	return nil
 }
 
-func InitializeNode(homeDir string, enclave EnclaveInterface) {
+func InitializeNode(homeDir string, enclave EnclaveInterface, logger log.Logger) {
+	initializeNode(homeDir, enclave, logger)
+}
+
+// initializeNode loads the seed of the node into the enclave, and returns its seed version. The legacy seed
+// configuration has the genesis seed alone, which is version 1
+func initializeNode(homeDir string, enclave EnclaveInterface, logger log.Logger) uint32 {
 	apiKey, err := types.GetApiKey()
 	if err != nil {
 		panic(errorsmod.Wrap(types.ErrSeedInitFailed, err.Error()))
@@ -131,6 +171,7 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	var (
 		encSeed []byte
 		pk      []byte
+		version uint32 = 1
 	)
 
 	nodeDir := filepath.Join(homeDir, types.SecretNodeCfgFolder)
@@ -145,14 +186,15 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 		}
 		encSeed, pk = getLegacySeedParams(legacySeedPath)
 	} else {
-		encSeed, pk = getNewSeedParams(seedPath)
+		encSeed, pk, version = getNewSeedParams(seedPath)
 	}
 
 	sizedEndSeed := getSizedEncSeed(encSeed)
-	err = validateEncryptedSeed(hex.EncodeToString(sizedEndSeed))
+	err = validateEncryptedSeed(hex.EncodeToString(sizedEndSeed), version)
 	if err != nil {
 		panic(errorsmod.Wrap(types.ErrSeedInitFailed, err.Error()))
 	}
+	logger.Info("Loading consensus seed", "version", version)
 
 	// On upgrade LoadSeed will write the new seed to "SeedPath -- seed.txt" which then will be parsed by the upgrade handler to create new_seed.json
 	// On registration both seed.jsםn and new_seed.json will be created by 'secretd q register secret-network-params' on manual flow or by auto-registration flow"
@@ -162,15 +204,10 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	}
 
 	if !fileExists(legacySeedPath) {
-		sgxSecretsFolder := os.Getenv("SCRT_SGX_STORAGE")
-		if sgxSecretsFolder == "" {
-			sgxSecretsFolder = os.ExpandEnv("/opt/secret/.sgx_secrets")
-		}
-
-		sgxAttestationCertPath := filepath.Join(sgxSecretsFolder, types.AttestationCertPath)
+		sgxAttestationCertPath := filepath.Join(sgxSecretsFolder(), types.AttestationCertPath)
 		if !fileExists(sgxAttestationCertPath) {
 			fmt.Printf("Failed to create legacy seed file. Attestation certificate does not exist in %s. Try to re-initialize the enclave\n", sgxAttestationCertPath)
-			return
+			return version
 		}
 
 		cert, err := os.ReadFile(sgxAttestationCertPath)
@@ -188,25 +225,48 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 			panic(errorsmod.Wrap(types.ErrSeedInitFailed, fmt.Sprintf("%s was not found and could not be created", legacySeedPath)))
 		}
 	}
+
+	return version
 }
 
-func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byte, error) {
+func sgxSecretsFolder() string {
+	folder := os.Getenv("SCRT_SGX_STORAGE")
+	if folder == "" {
+		folder = os.ExpandEnv("/opt/secret/.sgx_secrets")
+	}
+	return folder
+}
+
+func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate, sender sdk.AccAddress) ([]byte, error) {
 	// fmt.Println("RegisterNode")
 	var encSeed []byte
 	var publicKey []byte
+	attestation := parseNodeAttestation(ctx, certificate)
 
 	if isSimulationMode(ctx) {
 		// any sha256 hash is good enough
 		encSeed = make([]byte, 32)
 	} else {
 
-		publicKey_, err := ra.VerifyCombinedCert(certificate)
+		publicKey_, report, err := ra.VerifyCombinedCertReport(certificate, ctx.BlockTime())
 		if err != nil {
 			return nil, errorsmod.Wrap(types.ErrAuthenticateFailed, err.Error())
 		}
 
 		publicKey = publicKey_
 
+		err = k.checkEnclaveAllowlist(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.IsNodeRevoked(ctx, publicKey) {
+			return nil, errorsmod.Wrapf(types.ErrNodeRevoked, "node %s", hex.EncodeToString(publicKey))
+		}
+		if k.IsAttestationRevoked(ctx, attestation) {
+			return nil, errorsmod.Wrapf(types.ErrNodeRevoked, "the platform or TCB status of node %s", hex.EncodeToString(publicKey))
+		}
+
 		isAuth, err := k.isNodeAuthenticated(ctx, publicKey)
 		if err != nil {
 			return nil, errorsmod.Wrap(types.ErrAuthenticateFailed, err.Error())
@@ -215,6 +275,13 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 			return k.getRegistrationInfo(ctx, publicKey).EncryptedSeed, nil
 		}
 
+		// an authenticated node keeps fetching its seed with the certificate it registered with, so only new
+		// registrations must satisfy the policy
+		err = k.checkAttestationPolicy(ctx, certificate)
+		if err != nil {
+			return nil, err
+		}
+
 		encSeed, err = k.enclave.GetEncryptedSeed(certificate)
 		if err != nil {
 			// return 0, errorsmod.Wrap(err, "cosmwasm create")
@@ -223,8 +290,11 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 	}
 
 	regInfo := types.RegistrationNodeInfo{
-		Certificate:   certificate,
-		EncryptedSeed: encSeed,
+		Certificate:        certificate,
+		EncryptedSeed:      encSeed,
+		RegistrationHeight: ctx.BlockHeight(),
+		Sender:             sender,
+		Attestation:        attestation,
 	}
 
 	var err error
@@ -232,6 +302,12 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 		err = k.SetRegistrationInfo(ctx, regInfo)
 	} else {
 		err = k.SetRegistrationInfo_Verified(ctx, regInfo, publicKey)
+		if err == nil {
+			// nodes that register after a seed rotation get the seeds of the versions it introduced as well
+			if rotationErr := k.setRotatedSeeds(ctx, publicKey, certificate); rotationErr != nil {
+				return nil, errorsmod.Wrap(types.ErrAuthenticateFailed, rotationErr.Error())
+			}
+		}
 	}
 
 	if err != nil {
@@ -243,6 +319,36 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 	return encSeed, nil
 }
 
+// parseNodeAttestation summarizes the attestation of a certificate for the registry, or returns nil if it can't be parsed
+func parseNodeAttestation(ctx sdk.Context, certificate ra.Certificate) *types.NodeAttestation {
+	summary, err := ra.ParseAttestation(certificate)
+	if err != nil {
+		ctx.Logger().Info("Failed to parse attestation of registered node", "error", err.Error())
+		return nil
+	}
+
+	return types.NewNodeAttestation(summary)
+}
+
+// checkAttestationPolicy fails unless the attestation of a verified certificate satisfies the attestation policy.
+// Software mode certificates carry no report, and the policy is not enforced for them
+func (k Keeper) checkAttestationPolicy(ctx sdk.Context, certificate ra.Certificate) error {
+	policy := k.GetParams(ctx).AttestationPolicy
+	if policy.Equal(types.AttestationPolicy{}) {
+		return nil
+	}
+
+	summary, err := ra.ParseAttestation(certificate)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrAuthenticateFailed, err.Error())
+	}
+	if summary.Report == nil {
+		return nil
+	}
+
+	return policy.Check(summary, ctx.BlockTime())
+}
+
 // returns true when simulation mode used by gas=auto queries
 func isSimulationMode(ctx sdk.Context) bool {
 	return ctx.GasMeter().Limit() == 0 && ctx.BlockHeight() != 0
@@ -266,10 +372,17 @@ func FetchRawPubKeyFromLegacyCert(cert []byte) ([]byte, error) {
 	return pk, nil
 }
 
-func validateEncryptedSeed(encSeed string) error {
+// validateEncryptedSeed checks a size prefixed encrypted seed. Seeds of the first versions may be of either length,
+// as early seed configurations of version 2 hold the legacy seed
+func validateEncryptedSeed(encSeed string, version uint32) error {
 	lenKey := len(encSeed) - 2
 
-	if (lenKey != types.EncryptedKeyLength && lenKey != types.LegacyEncryptedKeyLength) || !IsHexString(encSeed) {
+	validLength := lenKey == types.EncryptedSeedLength(version)
+	if version <= types.SeedConfigVersion {
+		validLength = lenKey == types.EncryptedKeyLength || lenKey == types.LegacyEncryptedKeyLength
+	}
+
+	if !validLength || !IsHexString(encSeed) {
 		return errorsmod.Wrap(types.ErrSeedValidationParams, "Invalid parameter: `seed` in seed parameters. Did you initialize the node?")
 	}
 	return nil
@@ -87,6 +87,31 @@ func TestKeeper_RegisterNode(t *testing.T) {
 
 	regKeeper.SetRegistrationInfo(ctx, regInfo)
 
-	_, err = regKeeper.RegisterNode(ctx, cert)
+	_, err = regKeeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
 	require.NoError(t, err)
 }
+
+func TestKeeper_RegisterNodeRecordsMetadata(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, regKeeper := CreateTestInput(t, false, tempDir, true)
+	ctx = ctx.WithBlockHeight(42)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+
+	sender := sdk.AccAddress("sender")
+	_, err = regKeeper.RegisterNode(ctx, cert, sender)
+	require.NoError(t, err)
+
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+
+	regInfo := regKeeper.getRegistrationInfo(ctx, publicKey)
+	require.NotNil(t, regInfo)
+	require.Equal(t, int64(42), regInfo.RegistrationHeight)
+	require.Equal(t, sender, regInfo.Sender)
+	require.NotNil(t, regInfo.Attestation)
+	require.Equal(t, ra.AttestationTypeSoftware, regInfo.Attestation.Type)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The migration backfills the attestation summary of the registered nodes
+// from their certificates. The registration height and the sender of these nodes were never recorded, and stay empty
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	type registeredNode struct {
+		publicKey []byte
+		regInfo   types.RegistrationNodeInfo
+	}
+
+	var nodes []registeredNode
+	m.keeper.ListRegistrationInfo(ctx, func(publicKey []byte, regInfo types.RegistrationNodeInfo) bool {
+		if regInfo.Attestation == nil {
+			nodes = append(nodes, registeredNode{publicKey: publicKey, regInfo: regInfo})
+		}
+		return false
+	})
+
+	backfilled := 0
+	for _, node := range nodes {
+		node.regInfo.Attestation = parseNodeAttestation(ctx, node.regInfo.Certificate)
+		if node.regInfo.Attestation == nil {
+			continue
+		}
+
+		if err := m.keeper.SetRegistrationInfo_Verified(ctx, node.regInfo, node.publicKey); err != nil {
+			return err
+		}
+		backfilled++
+	}
+
+	ctx.Logger().Info("Backfilled attestation of registered nodes", "nodes", len(nodes), "backfilled", backfilled)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	regInfo := types.RegistrationNodeInfo{
+		Certificate:   cert,
+		EncryptedSeed: []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+	}
+	require.NoError(t, keeper.SetRegistrationInfo(ctx, regInfo))
+
+	unparsable := types.RegistrationNodeInfo{
+		Certificate:   []byte("not a certificate"),
+		EncryptedSeed: []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+	}
+	require.NoError(t, keeper.SetRegistrationInfo_Verified(ctx, unparsable, []byte("unparsable")))
+
+	require.NoError(t, NewMigrator(keeper).Migrate1to2(ctx))
+
+	publicKey, err := ra.VerifyRaCert(cert)
+	require.NoError(t, err)
+
+	migrated := keeper.getRegistrationInfo(ctx, publicKey)
+	require.NotNil(t, migrated.Attestation)
+	require.Equal(t, ra.AttestationTypeSoftware, migrated.Attestation.Type)
+	require.Equal(t, regInfo.EncryptedSeed, migrated.EncryptedSeed)
+	require.Zero(t, migrated.RegistrationHeight)
+
+	require.Nil(t, keeper.getRegistrationInfo(ctx, []byte("unparsable")).Attestation)
+}
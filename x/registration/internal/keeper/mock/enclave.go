@@ -1,5 +1,11 @@
 package mock
 
+import (
+	"bytes"
+
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+)
+
 // To be able to run unit tests without needing the enclave
 
 type MockEnclaveApi struct{} 
@@ -15,3 +21,13 @@ func (MockEnclaveApi) GetEncryptedSeed(_ []byte) ([]byte, error) {
 func (MockEnclaveApi) GetEncryptedGenesisSeed(_ []byte) ([]byte, error) {
 	return []byte(""), nil
 }
+
+// GetEncryptedSeedForVersion returns a seed of the length of the version, made of the version number
+func (MockEnclaveApi) GetEncryptedSeedForVersion(_ []byte, version uint32) ([]byte, error) {
+	return bytes.Repeat([]byte{byte(version)}, int(version)*48), nil
+}
+
+// ServedSeedVersion serves all the versions the network can have, so that rotations can be tested
+func (MockEnclaveApi) ServedSeedVersion() uint32 {
+	return types.MaxSeedVersion
+}
@@ -6,12 +6,16 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 )
 
 const (
+	EventTypeRevokeNode = "revoke_node"
+
 	AttributeSigner        = "signer"
 	AttributeEncryptedSeed = "encrypted_seed"
 	AttributeNodeID        = "node_id"
@@ -41,7 +45,7 @@ func (m msgServer) RegisterAuth(goCtx context.Context, msg *types.RaAuthenticate
 		return nil, err
 	}
 
-	encSeed, err := m.keeper.RegisterNode(ctx, msg.Certificate)
+	encSeed, err := m.keeper.RegisterNode(ctx, msg.Certificate, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
@@ -67,3 +71,72 @@ func (m msgServer) RegisterAuth(goCtx context.Context, msg *types.RaAuthenticate
 		Events: string(events),
 	}, nil
 }
+
+func (m msgServer) UpdateEnclaveAllowlist(goCtx context.Context, msg *types.MsgUpdateEnclaveAllowlist) (*types.MsgUpdateEnclaveAllowlistResponse, error) {
+	if m.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.SetEnclaveAllowlist(ctx, msg.Allowlist); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEnclaveAllowlistResponse{}, nil
+}
+
+func (m msgServer) RevokeNodes(goCtx context.Context, msg *types.MsgRevokeNodes) (*types.MsgRevokeNodesResponse, error) {
+	if m.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	revoked, err := m.keeper.RevokeNodes(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, publicKey := range revoked {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeRevokeNode,
+				sdk.NewAttribute(sdk.AttributeKeyModule, m.module),
+				sdk.NewAttribute(AttributeNodeID, fmt.Sprintf("0x%s", hex.EncodeToString(publicKey))),
+			),
+		)
+	}
+
+	return &types.MsgRevokeNodesResponse{RevokedPubKeys: revoked}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.keeper.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ScheduleSeedRotation schedules a rotation of the consensus seed. The enclave of this release can't derive the seeds
+// of versions after SeedConfigVersion, so the proposal fails until an enclave release that does is deployed
+func (m msgServer) ScheduleSeedRotation(goCtx context.Context, msg *types.MsgScheduleSeedRotation) (*types.MsgScheduleSeedRotationResponse, error) {
+	if m.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.ScheduleSeedRotation(ctx, msg.Rotation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleSeedRotationResponse{}, nil
+}
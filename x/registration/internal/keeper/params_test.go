@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	msgServer := NewMsgServerImpl(keeper, types.ModuleName)
+	querier := NewQuerier(keeper)
+
+	res, err := querier.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+
+	params := types.Params{AttestationPolicy: types.AttestationPolicy{
+		MaxReportAge:         24 * time.Hour,
+		AllowedQuoteStatuses: []string{"OK", "SW_HARDENING_NEEDED"},
+		DeniedAdvisoryIds:    []string{"INTEL-SA-00615"},
+	}}
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("not the gov account").String(),
+		Params:    params,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: keeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	res, err = querier.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func TestKeeper_CheckAttestationPolicy(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	// the recorded report is from 2020-05-09
+	cert, err := os.ReadFile("../../testdata/attestation_cert_hw_old")
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Date(2020, 5, 10, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, keeper.checkAttestationPolicy(ctx, cert))
+
+	require.NoError(t, keeper.SetParams(ctx, types.Params{AttestationPolicy: types.AttestationPolicy{MaxReportAge: 24 * time.Hour}}))
+	require.NoError(t, keeper.checkAttestationPolicy(ctx, cert))
+
+	stale := bytes.Replace(cert, []byte(`"timestamp":"2020-05-09`), []byte(`"timestamp":"2020-05-07`), 1)
+	require.ErrorIs(t, keeper.checkAttestationPolicy(ctx, stale), types.ErrAttestationPolicy)
+
+	// the report is SW_HARDENING_NEEDED and affected by INTEL-SA-00334. EPID reports only verify in hardware mode, so
+	// the modified reports are checked against the policy directly
+	require.NoError(t, keeper.SetParams(ctx, types.Params{AttestationPolicy: types.AttestationPolicy{
+		AllowedQuoteStatuses: []string{"OK", "SW_HARDENING_NEEDED"},
+		DeniedAdvisoryIds:    []string{"INTEL-SA-00615"},
+	}}))
+	require.NoError(t, keeper.checkAttestationPolicy(ctx, cert))
+
+	outOfDate := bytes.Replace(cert, []byte(`"isvEnclaveQuoteStatus":"SW_HARDENING_NEEDED"`), []byte(`"isvEnclaveQuoteStatus":"GROUP_OUT_OF_DATE"`), 1)
+	require.NotEqual(t, cert, outOfDate)
+	require.ErrorIs(t, keeper.checkAttestationPolicy(ctx, outOfDate), types.ErrAttestationPolicy)
+
+	denied := bytes.Replace(cert, []byte(`"INTEL-SA-00334"`), []byte(`"INTEL-SA-00615"`), 1)
+	require.NotEqual(t, cert, denied)
+	require.ErrorIs(t, keeper.checkAttestationPolicy(ctx, denied), types.ErrAttestationPolicy)
+	require.NoError(t, keeper.SetParams(ctx, types.DefaultParams()))
+
+	// software mode certificates carry no report
+	swCert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	require.NoError(t, keeper.checkAttestationPolicy(ctx, swCert))
+
+	_, err = keeper.RegisterNode(ctx, swCert, sdk.AccAddress("sender"))
+	require.NoError(t, err)
+}
+
+func TestExportGenesis_Params(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	key, err := FetchRawPubKeyFromLegacyCert(cert)
+	require.NoError(t, err)
+
+	data := types.GenesisState{
+		IoMasterKey:       &types.MasterKey{Bytes: key},
+		NodeExchMasterKey: &types.MasterKey{Bytes: key},
+		Params: types.Params{AttestationPolicy: types.AttestationPolicy{
+			MaxReportAge:      time.Hour,
+			DeniedAdvisoryIds: []string{"INTEL-SA-00334"},
+		}},
+	}
+	require.NoError(t, types.ValidateGenesis(data))
+
+	InitGenesis(ctx, keeper, data)
+
+	data2 := ExportGenesis(ctx, keeper)
+	require.Equal(t, data.Params, data2.Params)
+}
+
+// TestKeeper_RegisterNodeAttestationPolicy registers the recorded Intel DCAP quote, of TCB status SWHardeningNeeded,
+// affected by INTEL-SA-00615 and INTEL-SA-00657 and verified with TCB info issued 2022-12-19T09:40:10Z
+func TestKeeper_RegisterNodeAttestationPolicy(t *testing.T) {
+	cert, err := os.ReadFile("../../testdata/dcap/intel/attestation_cert.combined")
+	require.NoError(t, err)
+	blockTime := time.Unix(1671497404, 0)
+
+	for name, tc := range map[string]struct {
+		policy  types.AttestationPolicy
+		allowed bool
+	}{
+		"allowed status":        {types.AttestationPolicy{AllowedQuoteStatuses: []string{ra.TcbStatusUpToDate, ra.TcbStatusSWHardeningNeeded}}, true},
+		"disallowed status":     {types.AttestationPolicy{AllowedQuoteStatuses: []string{ra.TcbStatusUpToDate}}, false},
+		"other advisory denied": {types.AttestationPolicy{DeniedAdvisoryIds: []string{"INTEL-SA-00334"}}, true},
+		"advisory denied":       {types.AttestationPolicy{DeniedAdvisoryIds: []string{"INTEL-SA-00657"}}, false},
+		"recent TCB info":       {types.AttestationPolicy{MaxReportAge: 24 * time.Hour}, true},
+		"stale TCB info":        {types.AttestationPolicy{MaxReportAge: time.Hour}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tempDir, err := os.MkdirTemp("", "wasm")
+			require.NoError(t, err)
+			defer os.RemoveAll(tempDir)
+			ctx, keeper := CreateTestInput(t, false, tempDir, true)
+			ctx = ctx.WithBlockTime(blockTime)
+
+			require.NoError(t, keeper.SetParams(ctx, types.Params{AttestationPolicy: tc.policy}))
+
+			_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+			publicKey, verifyErr := ra.VerifyCombinedCert(cert)
+			require.NoError(t, verifyErr)
+			if !tc.allowed {
+				require.ErrorIs(t, err, types.ErrAttestationPolicy)
+				require.Nil(t, keeper.getRegistrationInfo(ctx, publicKey))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, keeper.getRegistrationInfo(ctx, publicKey))
+		})
+	}
+}
@@ -6,9 +6,15 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 )
 
@@ -71,6 +77,82 @@ func (q GrpcQuerier) EncryptedSeed(c context.Context, req *types.QueryEncryptedS
 	return &types.QueryEncryptedSeedResponse{EncryptedSeed: rsp}, nil
 }
 
+func (q GrpcQuerier) EnclaveAllowlist(c context.Context, _ *empty.Empty) (*types.QueryEnclaveAllowlistResponse, error) {
+	return &types.QueryEnclaveAllowlistResponse{
+		Allowlist: q.keeper.GetEnclaveAllowlist(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (q GrpcQuerier) RegisteredNodes(c context.Context, req *types.QueryRegisteredNodesRequest) (*types.QueryRegisteredNodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var nodes []types.RegisteredNode
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.keeper.storeService.OpenKVStore(ctx)), types.RegistrationStorePrefix)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var regInfo types.RegistrationNodeInfo
+		if err := q.keeper.cdc.Unmarshal(value, &regInfo); err != nil {
+			return err
+		}
+		nodes = append(nodes, types.NewRegisteredNode(key, regInfo, q.keeper.IsNodeRevoked(ctx, key)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRegisteredNodesResponse{Nodes: nodes, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) RegisteredNode(c context.Context, req *types.QueryRegisteredNodeRequest) (*types.QueryRegisteredNodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PubKey == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "public key")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	regInfo := q.keeper.getRegistrationInfo(ctx, req.PubKey)
+	if regInfo == nil {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryRegisteredNodeResponse{Node: types.NewRegisteredNode(req.PubKey, *regInfo, q.keeper.IsNodeRevoked(ctx, req.PubKey))}, nil
+}
+
+func (q GrpcQuerier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.keeper.GetParams(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (q GrpcQuerier) SeedRotation(c context.Context, _ *types.QuerySeedRotationRequest) (*types.QuerySeedRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySeedRotationResponse{
+		CurrentVersion: q.keeper.GetSeedVersion(ctx),
+		Scheduled:      q.keeper.GetScheduledSeedRotation(ctx),
+	}, nil
+}
+
+func (q GrpcQuerier) EncryptedSeedByVersion(c context.Context, req *types.QueryEncryptedSeedByVersionRequest) (*types.QueryEncryptedSeedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PubKey == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "public key")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if q.keeper.IsNodeRevoked(ctx, req.PubKey) {
+		return nil, errorsmod.Wrap(types.ErrNodeRevoked, "Node was revoked")
+	}
+
+	seed, err := q.keeper.GetEncryptedSeedForVersion(ctx, req.PubKey, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEncryptedSeedResponse{EncryptedSeed: seed}, nil
+}
+
 func queryMasterKey(ctx sdk.Context, keeper Keeper) (*types.GenesisState, error) {
 	ioKey := keeper.GetMasterKey(ctx, types.MasterIoKeyId)
 	nodeKey := keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
@@ -88,6 +170,10 @@ func queryMasterKey(ctx sdk.Context, keeper Keeper) (*types.GenesisState, error)
 }
 
 func queryEncryptedSeed(ctx sdk.Context, pubkeyBytes []byte, keeper Keeper) ([]byte, error) {
+	if keeper.IsNodeRevoked(ctx, pubkeyBytes) {
+		return nil, errorsmod.Wrap(types.ErrNodeRevoked, "Node was revoked")
+	}
+
 	seed := keeper.getRegistrationInfo(ctx, pubkeyBytes)
 	if seed == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownAddress, "Node has not been authenticated yet")
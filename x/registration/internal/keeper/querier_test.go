@@ -1,15 +1,20 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"os"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // //
@@ -188,3 +193,57 @@ func TestNewQuerier_ValidNodeID(t *testing.T) {
 // 	require.NoError(t, err)
 // 	require.Equal(t, string(binResult), string(expectedSecretParams))
 // }
+
+func TestNewQuerier_RegisteredNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	querier := NewQuerier(keeper)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+
+	sender := sdk.AccAddress("sender")
+	for i := byte(1); i <= 3; i++ {
+		regInfo := types.RegistrationNodeInfo{
+			Certificate:        cert,
+			EncryptedSeed:      []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+			RegistrationHeight: int64(i),
+			Sender:             sender,
+			Attestation:        &types.NodeAttestation{Type: ra.AttestationTypeSoftware},
+		}
+		require.NoError(t, keeper.SetRegistrationInfo_Verified(ctx, regInfo, bytes.Repeat([]byte{i}, 32)))
+	}
+
+	res, err := querier.RegisteredNodes(ctx, &types.QueryRegisteredNodesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, bytes.Repeat([]byte{1}, 32), res.Nodes[0].PubKey)
+	require.Equal(t, int64(1), res.Nodes[0].RegistrationHeight)
+	require.Equal(t, sender.String(), res.Nodes[0].Sender)
+	require.Equal(t, ra.AttestationTypeSoftware, res.Nodes[0].Attestation.Type)
+
+	res, err = querier.RegisteredNodes(ctx, &types.QueryRegisteredNodesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 1)
+	require.Equal(t, bytes.Repeat([]byte{3}, 32), res.Nodes[0].PubKey)
+
+	node, err := querier.RegisteredNode(ctx, &types.QueryRegisteredNodeRequest{PubKey: bytes.Repeat([]byte{2}, 32)})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), node.Node.RegistrationHeight)
+
+	_, err = querier.RegisteredNode(ctx, &types.QueryRegisteredNodeRequest{PubKey: bytes.Repeat([]byte{4}, 32)})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	_, err = querier.RegisteredNode(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = querier.RegisteredNodes(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -43,8 +43,13 @@ func (k Keeper) getRegistrationInfo(ctx sdk.Context, publicKey types.NodeID) *ty
 }
 
 func (k Keeper) ListRegistrationInfo(ctx sdk.Context, cb func([]byte, types.RegistrationNodeInfo) bool) {
+	k.listRegistrationInfoFrom(ctx, nil, cb)
+}
+
+// listRegistrationInfoFrom iterates the registrations by public key, from the given public key on
+func (k Keeper) listRegistrationInfoFrom(ctx sdk.Context, start []byte, cb func([]byte, types.RegistrationNodeInfo) bool) {
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RegistrationStorePrefix)
-	iter := prefixStore.Iterator(nil, nil)
+	iter := prefixStore.Iterator(start, nil)
 	for ; iter.Valid(); iter.Next() {
 		var regInfo types.RegistrationNodeInfo
 		k.cdc.MustUnmarshal(iter.Value(), &regInfo)
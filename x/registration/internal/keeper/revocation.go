@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+)
+
+func (k Keeper) IsNodeRevoked(ctx sdk.Context, publicKey types.NodeID) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	revoked, _ := store.Has(types.RevokedNodeKey(publicKey))
+	return revoked
+}
+
+func (k Keeper) SetNodeRevoked(ctx sdk.Context, publicKey types.NodeID) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.RevokedNodeKey(publicKey), []byte{1})
+}
+
+func (k Keeper) ListRevokedNodes(ctx sdk.Context, cb func(types.NodeID) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RevokedNodePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetPlatformInfoRevoked(ctx sdk.Context, platformInfo string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.RevokedPlatformInfoKey(platformInfo), []byte{1})
+}
+
+func (k Keeper) SetTcbStatusRevoked(ctx sdk.Context, tcbStatus string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.RevokedTcbStatusKey(tcbStatus), []byte{1})
+}
+
+// ListRevokedPlatformInfos iterates the revoked platform infos, in upper case
+func (k Keeper) ListRevokedPlatformInfos(ctx sdk.Context, cb func(string) bool) {
+	k.listRevokedProperties(ctx, types.RevokedPlatformInfoPrefix, cb)
+}
+
+func (k Keeper) ListRevokedTcbStatuses(ctx sdk.Context, cb func(string) bool) {
+	k.listRevokedProperties(ctx, types.RevokedTcbStatusPrefix, cb)
+}
+
+func (k Keeper) listRevokedProperties(ctx sdk.Context, storePrefix []byte, cb func(string) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), storePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(string(iter.Key())) {
+			break
+		}
+	}
+}
+
+// IsAttestationRevoked returns whether governance revoked the platform info or the TCB status of an attestation
+func (k Keeper) IsAttestationRevoked(ctx sdk.Context, attestation *types.NodeAttestation) bool {
+	if attestation == nil {
+		return false
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if attestation.PlatformInfo != "" {
+		if revoked, _ := store.Has(types.RevokedPlatformInfoKey(attestation.PlatformInfo)); revoked {
+			return true
+		}
+	}
+	if attestation.TcbStatus != "" {
+		if revoked, _ := store.Has(types.RevokedTcbStatusKey(attestation.TcbStatus)); revoked {
+			return true
+		}
+	}
+	return false
+}
+
+// RevokeNodes revokes the nodes the message selects, and returns the public keys of the nodes that were not revoked
+// before. The platform infos and TCB statuses it revokes are kept, so that RegisterNode rejects nodes that have them
+func (k Keeper) RevokeNodes(ctx sdk.Context, msg types.MsgRevokeNodes) ([][]byte, error) {
+	for _, platformInfo := range msg.PlatformInfos {
+		if err := k.SetPlatformInfoRevoked(ctx, platformInfo); err != nil {
+			return nil, err
+		}
+	}
+	for _, tcbStatus := range msg.TcbStatuses {
+		if err := k.SetTcbStatusRevoked(ctx, tcbStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	selected := msg.PubKeys
+	if len(msg.PlatformInfos) != 0 || len(msg.TcbStatuses) != 0 {
+		k.ListRegistrationInfo(ctx, func(publicKey []byte, regInfo types.RegistrationNodeInfo) bool {
+			if msg.Selects(publicKey, regInfo) {
+				selected = append(selected, publicKey)
+			}
+			return false
+		})
+	}
+
+	var revoked [][]byte
+	for _, publicKey := range selected {
+		if k.IsNodeRevoked(ctx, publicKey) {
+			continue
+		}
+		if err := k.SetNodeRevoked(ctx, publicKey); err != nil {
+			return nil, err
+		}
+		revoked = append(revoked, publicKey)
+	}
+
+	return revoked, nil
+}
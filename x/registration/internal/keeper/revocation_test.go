@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+)
+
+func setTestNode(t *testing.T, ctx sdk.Context, keeper Keeper, id byte, attestation *types.NodeAttestation) []byte {
+	publicKey := bytes.Repeat([]byte{id}, 32)
+	regInfo := types.RegistrationNodeInfo{
+		Certificate:   []byte("certificate"),
+		EncryptedSeed: []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		Attestation:   attestation,
+	}
+	require.NoError(t, keeper.SetRegistrationInfo_Verified(ctx, regInfo, publicKey))
+	return publicKey
+}
+
+func TestMsgServer_RevokeNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	msgServer := NewMsgServerImpl(keeper, types.ModuleName)
+	querier := NewQuerier(keeper)
+
+	node1 := setTestNode(t, ctx, keeper, 1, &types.NodeAttestation{Type: ra.AttestationTypeDcap, PlatformInfo: "00906ED50000", TcbStatus: ra.TcbStatusUpToDate})
+	node2 := setTestNode(t, ctx, keeper, 2, &types.NodeAttestation{Type: ra.AttestationTypeDcap, PlatformInfo: "00A067110000", TcbStatus: ra.TcbStatusSWHardeningNeeded})
+	node3 := setTestNode(t, ctx, keeper, 3, &types.NodeAttestation{Type: ra.AttestationTypeDcap, PlatformInfo: "00A067110000", TcbStatus: ra.TcbStatusOutOfDate})
+	node4 := setTestNode(t, ctx, keeper, 4, nil)
+
+	_, err = msgServer.RevokeNodes(ctx, &types.MsgRevokeNodes{
+		Authority: sdk.AccAddress("not the gov account").String(),
+		PubKeys:   [][]byte{node1},
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.False(t, keeper.IsNodeRevoked(ctx, node1))
+
+	res, err := msgServer.RevokeNodes(ctx, &types.MsgRevokeNodes{
+		Authority:   keeper.GetAuthority(),
+		TcbStatuses: []string{ra.TcbStatusOutOfDate},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{node3}, res.RevokedPubKeys)
+
+	// node 3 was already revoked
+	res, err = msgServer.RevokeNodes(ctx, &types.MsgRevokeNodes{
+		Authority:     keeper.GetAuthority(),
+		PlatformInfos: []string{"00a067110000"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{node2}, res.RevokedPubKeys)
+
+	unregistered := bytes.Repeat([]byte{5}, 32)
+	res, err = msgServer.RevokeNodes(ctx, &types.MsgRevokeNodes{
+		Authority: keeper.GetAuthority(),
+		PubKeys:   [][]byte{node4, unregistered},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{node4, unregistered}, res.RevokedPubKeys)
+
+	require.False(t, keeper.IsNodeRevoked(ctx, node1))
+	for _, publicKey := range [][]byte{node2, node3, node4, unregistered} {
+		require.True(t, keeper.IsNodeRevoked(ctx, publicKey))
+
+		_, err = querier.EncryptedSeed(ctx, &types.QueryEncryptedSeedRequest{PubKey: publicKey})
+		require.ErrorIs(t, err, types.ErrNodeRevoked)
+	}
+
+	_, err = querier.EncryptedSeed(ctx, &types.QueryEncryptedSeedRequest{PubKey: node1})
+	require.NoError(t, err)
+
+	node, err := querier.RegisteredNode(ctx, &types.QueryRegisteredNodeRequest{PubKey: node2})
+	require.NoError(t, err)
+	require.True(t, node.Node.Revoked)
+}
+
+func TestKeeper_RegisterNodeRevoked(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+
+	_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+	require.NoError(t, err)
+
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+
+	_, err = keeper.RevokeNodes(ctx, types.MsgRevokeNodes{PubKeys: [][]byte{publicKey}})
+	require.NoError(t, err)
+
+	_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+	require.ErrorIs(t, err, types.ErrNodeRevoked)
+}
+
+// TestKeeper_RegisterNodeRevokedAttestation registers the recorded Intel DCAP quote, of a platform with FMSPC
+// 00606A000000 and TCB status SWHardeningNeeded, after revocations of its properties
+func TestKeeper_RegisterNodeRevokedAttestation(t *testing.T) {
+	cert, err := os.ReadFile("../../testdata/dcap/intel/attestation_cert.combined")
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		revocation types.MsgRevokeNodes
+		revoked    bool
+	}{
+		"platform info":       {types.MsgRevokeNodes{PlatformInfos: []string{"00606a000000"}}, true},
+		"TCB status":          {types.MsgRevokeNodes{TcbStatuses: []string{ra.TcbStatusSWHardeningNeeded}}, true},
+		"other platform info": {types.MsgRevokeNodes{PlatformInfos: []string{"00906ED50000"}}, false},
+		"other TCB status":    {types.MsgRevokeNodes{TcbStatuses: []string{ra.TcbStatusOutOfDate}}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tempDir, err := os.MkdirTemp("", "wasm")
+			require.NoError(t, err)
+			defer os.RemoveAll(tempDir)
+			ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+			// no node is registered yet, so the revocation only keeps the properties
+			revoked, err := keeper.RevokeNodes(ctx, tc.revocation)
+			require.NoError(t, err)
+			require.Empty(t, revoked)
+
+			_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+			publicKey, verifyErr := ra.VerifyCombinedCert(cert)
+			require.NoError(t, verifyErr)
+			if tc.revoked {
+				require.ErrorIs(t, err, types.ErrNodeRevoked)
+				require.Nil(t, keeper.getRegistrationInfo(ctx, publicKey))
+				return
+			}
+
+			require.NoError(t, err)
+			regInfo := keeper.getRegistrationInfo(ctx, publicKey)
+			require.NotNil(t, regInfo)
+			require.Equal(t, "00606A000000", regInfo.Attestation.PlatformInfo)
+			require.Equal(t, ra.TcbStatusSWHardeningNeeded, regInfo.Attestation.TcbStatus)
+		})
+	}
+}
+
+func TestExportGenesis_RevokedNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	key, err := FetchRawPubKeyFromLegacyCert(cert)
+	require.NoError(t, err)
+
+	data := types.GenesisState{
+		IoMasterKey:       &types.MasterKey{Bytes: key},
+		NodeExchMasterKey: &types.MasterKey{Bytes: key},
+		RevokedNodes:      [][]byte{bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)},
+		// platform infos are exported in upper case
+		RevokedPlatformInfos: []string{"00606A000000", "00906ED50000"},
+		RevokedTcbStatuses:   []string{ra.TcbStatusOutOfDate, ra.TcbStatusSWHardeningNeeded},
+	}
+	require.NoError(t, types.ValidateGenesis(data))
+
+	InitGenesis(ctx, keeper, data)
+	require.True(t, keeper.IsNodeRevoked(ctx, bytes.Repeat([]byte{2}, 32)))
+	require.True(t, keeper.IsAttestationRevoked(ctx, &types.NodeAttestation{PlatformInfo: "00906ed50000"}))
+	require.True(t, keeper.IsAttestationRevoked(ctx, &types.NodeAttestation{TcbStatus: ra.TcbStatusOutOfDate}))
+	require.False(t, keeper.IsAttestationRevoked(ctx, &types.NodeAttestation{PlatformInfo: "00A067110000", TcbStatus: ra.TcbStatusUpToDate}))
+
+	data2 := ExportGenesis(ctx, keeper)
+	require.Equal(t, data.RevokedNodes, data2.RevokedNodes)
+	require.Equal(t, data.RevokedPlatformInfos, data2.RevokedPlatformInfos)
+	require.Equal(t, data.RevokedTcbStatuses, data2.RevokedTcbStatuses)
+
+	duplicatePlatform := data
+	duplicatePlatform.RevokedPlatformInfos = append([]string{"00606a000000"}, data.RevokedPlatformInfos...)
+	require.Error(t, types.ValidateGenesis(duplicatePlatform))
+
+	data.RevokedNodes = append(data.RevokedNodes, bytes.Repeat([]byte{1}, 32))
+	require.Error(t, types.ValidateGenesis(data))
+}
@@ -0,0 +1,459 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// Seed rotation events
+const (
+	EventTypeSeedRotation       = "seed_rotation"
+	EventTypeSeedRotationFailed = "seed_rotation_failed"
+	AttributeSeedVersion        = "version"
+	AttributeSeedRotationError  = "error"
+)
+
+// seedRotationBatchSize is how many registered nodes a block encrypts the seed of a new version for, so that the
+// enclave calls of a rotation are spread over blocks
+const seedRotationBatchSize = 50
+
+// GetSeedVersion returns the consensus seed version of the network
+func (k Keeper) GetSeedVersion(ctx sdk.Context) uint32 {
+	version := k.getStoredSeedVersion(ctx)
+	if version == 0 {
+		return types.SeedConfigVersion
+	}
+	return version
+}
+
+// getStoredSeedVersion returns the seed version that was stored by a rotation or by genesis, or zero if the network
+// never rotated its seed
+func (k Keeper) getStoredSeedVersion(ctx sdk.Context) uint32 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.SeedVersionKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(bz)
+}
+
+func (k Keeper) setSeedVersion(ctx sdk.Context, version uint32) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.SeedVersionKey, binary.BigEndian.AppendUint32(nil, version))
+}
+
+// GetScheduledSeedRotation returns the rotation that did not take effect yet, or nil
+func (k Keeper) GetScheduledSeedRotation(ctx sdk.Context) *types.SeedRotation {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.SeedRotationKey)
+	if bz == nil {
+		return nil
+	}
+
+	var rotation types.SeedRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return &rotation
+}
+
+// ScheduleSeedRotation schedules the network to move to the next seed version, replacing the rotation that was
+// scheduled before. The enclave must serve the seeds of the version, which no enclave release does yet
+func (k Keeper) ScheduleSeedRotation(ctx sdk.Context, rotation types.SeedRotation) error {
+	if err := rotation.ValidateNext(k.GetSeedVersion(ctx)); err != nil {
+		return err
+	}
+	if err := k.checkServedSeedVersion(rotation.Version); err != nil {
+		return err
+	}
+	if k.isSeedRotationUnderWay(ctx) {
+		return errorsmod.Wrapf(types.ErrSeedRotation, "the rotation to version %d is under way", rotation.Version)
+	}
+	if rotation.Height <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrSeedRotation, "height %d is not after the current height %d", rotation.Height, ctx.BlockHeight())
+	}
+
+	return k.setScheduledSeedRotation(ctx, rotation)
+}
+
+// checkServedSeedVersion fails for seed versions that the enclave of this release does not serve. No enclave
+// release derives the seeds of versions after SeedConfigVersion yet, so on a real enclave every rotation fails here
+func (k Keeper) checkServedSeedVersion(version uint32) error {
+	served := k.enclave.ServedSeedVersion()
+	if served <= types.SeedConfigVersion && version > served {
+		return errorsmod.Wrapf(types.ErrSeedRotation, "seed rotation is not supported yet: the enclave can't derive the seed of version %d, it serves version %d alone", version, served)
+	}
+	if version > served {
+		return errorsmod.Wrapf(types.ErrSeedRotation, "the enclave serves seed versions up to %d, not %d", served, version)
+	}
+	return nil
+}
+
+func (k Keeper) setScheduledSeedRotation(ctx sdk.Context, rotation types.SeedRotation) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.SeedRotationKey, k.cdc.MustMarshal(&rotation))
+}
+
+func (k Keeper) deleteScheduledSeedRotation(ctx sdk.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.SeedRotationKey)
+}
+
+// isSeedRotationUnderWay returns true once the height of the scheduled rotation is reached, until the seeds of its
+// version are encrypted for all the registered nodes
+func (k Keeper) isSeedRotationUnderWay(ctx sdk.Context) bool {
+	rotation := k.GetScheduledSeedRotation(ctx)
+	return rotation != nil && ctx.BlockHeight() >= rotation.Height
+}
+
+// getSeedRotationProgress returns the public key of the last node that the rotation under way encrypted the seed of
+// its version for, or nil
+func (k Keeper) getSeedRotationProgress(ctx sdk.Context) []byte {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.SeedRotationProgressKey)
+	return bz
+}
+
+func (k Keeper) setSeedRotationProgress(ctx sdk.Context, publicKey []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.SeedRotationProgressKey, publicKey)
+}
+
+func (k Keeper) deleteSeedRotationProgress(ctx sdk.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.SeedRotationProgressKey)
+}
+
+// GetVersionedEncryptedSeed returns the encrypted seed of a node for a seed version that came from a rotation, or nil
+func (k Keeper) GetVersionedEncryptedSeed(ctx sdk.Context, version uint32, publicKey types.NodeID) []byte {
+	store := k.storeService.OpenKVStore(ctx)
+	seed, _ := store.Get(types.VersionedSeedKey(version, publicKey))
+	return seed
+}
+
+func (k Keeper) SetVersionedEncryptedSeed(ctx sdk.Context, version uint32, publicKey types.NodeID, seed []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.VersionedSeedKey(version, publicKey), seed)
+}
+
+// ListVersionedEncryptedSeeds iterates the encrypted seeds of all the versions that came from rotations, by version
+func (k Keeper) ListVersionedEncryptedSeeds(ctx sdk.Context, cb func(types.VersionedEncryptedSeed) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.VersionedSeedPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		seed := types.VersionedEncryptedSeed{
+			Version:       binary.BigEndian.Uint32(iter.Key()[:4]),
+			PubKey:        iter.Key()[4:],
+			EncryptedSeed: iter.Value(),
+		}
+		// cb returns true to stop early
+		if cb(seed) {
+			break
+		}
+	}
+}
+
+// GetEncryptedSeedForVersion returns the encrypted seed of a registered node for a seed version of the network
+func (k Keeper) GetEncryptedSeedForVersion(ctx sdk.Context, publicKey types.NodeID, version uint32) ([]byte, error) {
+	if version < types.SeedConfigVersion || version > k.GetSeedVersion(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "the network has no seed version %d", version)
+	}
+
+	regInfo := k.getRegistrationInfo(ctx, publicKey)
+	if regInfo == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "node %s is not registered", hex.EncodeToString(publicKey))
+	}
+
+	if version == types.SeedConfigVersion {
+		return regInfo.EncryptedSeed, nil
+	}
+
+	seed := k.GetVersionedEncryptedSeed(ctx, version, publicKey)
+	if seed == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "node %s has no seed of version %d", hex.EncodeToString(publicKey), version)
+	}
+	return seed, nil
+}
+
+// setRotatedSeeds stores the encrypted seeds of the versions that came from rotations for a newly registered node,
+// and of the version of the rotation under way
+func (k Keeper) setRotatedSeeds(ctx sdk.Context, publicKey types.NodeID, certificate []byte) error {
+	lastVersion := k.GetSeedVersion(ctx)
+	if k.isSeedRotationUnderWay(ctx) {
+		lastVersion = k.GetScheduledSeedRotation(ctx).Version
+	}
+
+	for version := uint32(types.SeedConfigVersion) + 1; version <= lastVersion; version++ {
+		seed, err := k.enclave.GetEncryptedSeedForVersion(certificate, version)
+		if err != nil {
+			return err
+		}
+
+		err = k.SetVersionedEncryptedSeed(ctx, version, publicKey, seed)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeginBlocker applies the scheduled seed rotation
+func (k Keeper) BeginBlocker(ctx sdk.Context) error {
+	return k.applySeedRotation(ctx)
+}
+
+// NodeSeedListener loads the seed of the network's seed version into the enclave of this node once a block that moved
+// the network to a new version is committed. It runs after consensus, so the disk and enclave calls of a node don't
+// take part in block processing, and the node uses the new version from the next block on
+type NodeSeedListener struct {
+	keeper Keeper
+}
+
+var _ storetypes.ABCIListener = NodeSeedListener{}
+
+func NewNodeSeedListener(keeper Keeper) NodeSeedListener {
+	return NodeSeedListener{keeper: keeper}
+}
+
+func (NodeSeedListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+func (l NodeSeedListener) ListenCommit(c context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	l.keeper.syncNodeSeed(ctx)
+	return nil
+}
+
+// applySeedRotation moves the network to the seed version of the scheduled rotation once its height is reached. From
+// that height on, every block has the enclave encrypt the seed of the new version for the next seedRotationBatchSize
+// registered nodes that were not revoked, so nodes can fetch it. The network moves to the new version with the last
+// batch. If the enclave fails to encrypt a seed, the rotation is dropped and the network stays on its current version
+func (k Keeper) applySeedRotation(ctx sdk.Context) error {
+	rotation := k.GetScheduledSeedRotation(ctx)
+	if rotation == nil || ctx.BlockHeight() < rotation.Height {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	done, err := k.rotateSeed(cacheCtx, rotation.Version)
+	if err != nil {
+		moduleLogger(ctx).Error("Seed rotation failed", "version", rotation.Version, "error", err.Error())
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeSeedRotationFailed,
+			sdk.NewAttribute(AttributeSeedVersion, fmt.Sprintf("%d", rotation.Version)),
+			sdk.NewAttribute(AttributeSeedRotationError, err.Error()),
+		))
+		return k.abortSeedRotation(ctx, rotation.Version)
+	}
+	write()
+
+	if !done {
+		return nil
+	}
+
+	moduleLogger(ctx).Info("Network moved to a new seed version", "version", rotation.Version)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeSeedRotation,
+		sdk.NewAttribute(AttributeSeedVersion, fmt.Sprintf("%d", rotation.Version)),
+	))
+	return nil
+}
+
+// rotateSeed encrypts the seed of the version for the next batch of registered nodes, and returns true once it moved
+// the network to the version
+func (k Keeper) rotateSeed(ctx sdk.Context, version uint32) (bool, error) {
+	// rotations that were imported with the genesis were not checked when they were scheduled
+	if err := k.checkServedSeedVersion(version); err != nil {
+		return false, err
+	}
+
+	// the batch starts right after the node that the previous batch ended with
+	var start []byte
+	if last := k.getSeedRotationProgress(ctx); last != nil {
+		start = append(append([]byte{}, last...), 0)
+	}
+
+	var nodes []types.RegistrationNodeInfo
+	var publicKeys [][]byte
+	done := true
+	k.listRegistrationInfoFrom(ctx, start, func(publicKey []byte, regInfo types.RegistrationNodeInfo) bool {
+		if len(nodes) == seedRotationBatchSize {
+			done = false
+			return true
+		}
+		publicKeys = append(publicKeys, publicKey)
+		nodes = append(nodes, regInfo)
+		return false
+	})
+
+	for i, regInfo := range nodes {
+		if k.IsNodeRevoked(ctx, publicKeys[i]) {
+			continue
+		}
+
+		seed, err := k.enclave.GetEncryptedSeedForVersion(regInfo.Certificate, version)
+		if err != nil {
+			return false, errorsmod.Wrapf(err, "node %s", hex.EncodeToString(publicKeys[i]))
+		}
+
+		err = k.SetVersionedEncryptedSeed(ctx, version, publicKeys[i], seed)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if !done {
+		return false, k.setSeedRotationProgress(ctx, publicKeys[len(publicKeys)-1])
+	}
+
+	err := k.setSeedVersion(ctx, version)
+	if err != nil {
+		return false, err
+	}
+
+	err = k.deleteSeedRotationProgress(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, k.deleteScheduledSeedRotation(ctx)
+}
+
+// abortSeedRotation drops the rotation under way, along with the seeds of its version that the previous batches
+// encrypted
+func (k Keeper) abortSeedRotation(ctx sdk.Context, version uint32) error {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.VersionedSeedVersionPrefix(version))
+	iter := prefixStore.Iterator(nil, nil)
+	var publicKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		publicKeys = append(publicKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, publicKey := range publicKeys {
+		prefixStore.Delete(publicKey)
+	}
+
+	err := k.deleteSeedRotationProgress(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.deleteScheduledSeedRotation(ctx)
+}
+
+// syncNodeSeed loads the seed of the network's seed version into the enclave of this node, when the node loaded an
+// earlier version. A version that fails to load is not tried again, the node operator configures it by hand instead
+func (k Keeper) syncNodeSeed(ctx sdk.Context) {
+	if k.node == nil {
+		return
+	}
+
+	version := k.GetSeedVersion(ctx)
+	if version <= k.node.version || version == k.node.failedVersion {
+		return
+	}
+
+	err := k.loadNodeSeed(ctx, version)
+	if err != nil {
+		k.node.failedVersion = version
+		moduleLogger(ctx).Error(fmt.Sprintf("This node loaded seed version %d, but the network moved to version %d. Query the "+
+			"seed of the node with 'secretd q register seed [node-id] --seed-version %d' and configure it with "+
+			"'secretd configure-secret [master-key] [seed] --seed-version %d' before restarting the node",
+			k.node.version, version, version, version), "error", err.Error())
+		return
+	}
+
+	k.node.version = version
+	moduleLogger(ctx).Info("Loaded consensus seed", "version", version)
+}
+
+// loadNodeSeed loads the encrypted seed of the node for a seed version into its enclave, and writes it to the seed
+// configuration of the node so that it is loaded on restarts
+func (k Keeper) loadNodeSeed(ctx sdk.Context, version uint32) error {
+	publicKey, err := nodePublicKey()
+	if err != nil {
+		return err
+	}
+
+	seed := k.GetVersionedEncryptedSeed(ctx, version, publicKey)
+	if seed == nil {
+		return errorsmod.Wrapf(types.ErrNotFound, "node %s has no seed of version %d", hex.EncodeToString(publicKey), version)
+	}
+
+	seedPath := filepath.Join(k.node.homeDir, types.SecretNodeCfgFolder, types.SecretNodeSeedNewConfig)
+	jsonContent, err := getFile(seedPath)
+	if err != nil {
+		return err
+	}
+
+	var seedCfg types.SeedConfig
+	err = json.Unmarshal(jsonContent, &seedCfg)
+	if err != nil {
+		return err
+	}
+
+	masterKey, _, err := seedCfg.Decode()
+	if err != nil {
+		return err
+	}
+
+	sizedSeed := getSizedEncSeed(seed)
+	err = validateEncryptedSeed(hex.EncodeToString(sizedSeed), version)
+	if err != nil {
+		return err
+	}
+
+	apiKey, err := types.GetApiKey()
+	if err != nil {
+		return err
+	}
+
+	_, err = k.enclave.LoadSeed(masterKey, sizedSeed, apiKey)
+	if err != nil {
+		return err
+	}
+
+	seedCfg.EncryptedKey = hex.EncodeToString(seed)
+	seedCfg.Version = version
+	cfgBytes, err := json.Marshal(&seedCfg)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(seedPath, cfgBytes, 0o600)
+}
+
+// nodePublicKey returns the public key this node registered with, from its attestation certificate
+func nodePublicKey() ([]byte, error) {
+	certPath := filepath.Join(sgxSecretsFolder(), types.AttestationCombinedPath)
+	if !fileExists(certPath) {
+		certPath = filepath.Join(sgxSecretsFolder(), types.AttestationCertPath)
+	}
+
+	cert, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := ra.ParseAttestation(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary.PubKey, nil
+}
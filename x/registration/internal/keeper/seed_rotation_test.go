@@ -0,0 +1,426 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/keeper/mock"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingEnclave can't serve seeds of new versions, like enclaves that were not upgraded for a rotation
+type failingEnclave struct {
+	mock.MockEnclaveApi
+}
+
+func (failingEnclave) GetEncryptedSeedForVersion(_ []byte, version uint32) ([]byte, error) {
+	return nil, errors.New("unsupported seed version")
+}
+
+// releasedEnclave serves the seed version it was built with alone, like the enclave of this release
+type releasedEnclave struct {
+	mock.MockEnclaveApi
+}
+
+func (releasedEnclave) ServedSeedVersion() uint32 {
+	return types.SeedConfigVersion
+}
+
+func TestMsgServer_ScheduleSeedRotation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	ctx = ctx.WithBlockHeight(10)
+
+	msgServer := NewMsgServerImpl(keeper, types.ModuleName)
+	querier := NewQuerier(keeper)
+
+	res, err := querier.SeedRotation(ctx, &types.QuerySeedRotationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.SeedConfigVersion), res.CurrentVersion)
+	require.Nil(t, res.Scheduled)
+
+	_, err = msgServer.ScheduleSeedRotation(ctx, &types.MsgScheduleSeedRotation{
+		Authority: sdk.AccAddress("not the gov account").String(),
+		Rotation:  types.SeedRotation{Version: 3, Height: 20},
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// the version must follow the current one, and the height must be ahead
+	for _, rotation := range []types.SeedRotation{{Version: 4, Height: 20}, {Version: 2, Height: 20}, {Version: 3, Height: 10}} {
+		_, err = msgServer.ScheduleSeedRotation(ctx, &types.MsgScheduleSeedRotation{
+			Authority: keeper.GetAuthority(),
+			Rotation:  rotation,
+		})
+		require.ErrorIs(t, err, types.ErrSeedRotation)
+	}
+
+	_, err = msgServer.ScheduleSeedRotation(ctx, &types.MsgScheduleSeedRotation{
+		Authority: keeper.GetAuthority(),
+		Rotation:  types.SeedRotation{Version: 3, Height: 20},
+	})
+	require.NoError(t, err)
+
+	// a new schedule replaces the previous one
+	_, err = msgServer.ScheduleSeedRotation(ctx, &types.MsgScheduleSeedRotation{
+		Authority: keeper.GetAuthority(),
+		Rotation:  types.SeedRotation{Version: 3, Height: 30},
+	})
+	require.NoError(t, err)
+
+	res, err = querier.SeedRotation(ctx, &types.QuerySeedRotationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.SeedConfigVersion), res.CurrentVersion)
+	require.Equal(t, &types.SeedRotation{Version: 3, Height: 30}, res.Scheduled)
+}
+
+func TestKeeper_SeedRotation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	ctx = ctx.WithBlockHeight(10)
+
+	querier := NewQuerier(keeper)
+
+	node1 := setTestNode(t, ctx, keeper, 1, nil)
+	node2 := setTestNode(t, ctx, keeper, 2, nil)
+	require.NoError(t, keeper.SetNodeRevoked(ctx, node2))
+
+	require.NoError(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 20}))
+
+	// nothing happens before the height of the rotation
+	require.NoError(t, keeper.BeginBlocker(ctx.WithBlockHeight(19)))
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.GetSeedVersion(ctx))
+
+	_, err = querier.EncryptedSeedByVersion(ctx, &types.QueryEncryptedSeedByVersionRequest{PubKey: node1, Version: 3})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(3), keeper.GetSeedVersion(ctx))
+	require.Nil(t, keeper.GetScheduledSeedRotation(ctx))
+
+	res, err := querier.EncryptedSeedByVersion(ctx, &types.QueryEncryptedSeedByVersionRequest{PubKey: node1, Version: 3})
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{3}, 3*48), res.EncryptedSeed)
+
+	// the seed of the previous version is still served
+	res, err = querier.EncryptedSeedByVersion(ctx, &types.QueryEncryptedSeedByVersionRequest{PubKey: node1, Version: types.SeedConfigVersion})
+	require.NoError(t, err)
+	require.Equal(t, []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"), res.EncryptedSeed)
+
+	// revoked nodes don't get the seed of the new version
+	require.Nil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, node2))
+	_, err = querier.EncryptedSeedByVersion(ctx, &types.QueryEncryptedSeedByVersionRequest{PubKey: node2, Version: 3})
+	require.ErrorIs(t, err, types.ErrNodeRevoked)
+
+	_, err = querier.EncryptedSeedByVersion(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// nodes that register after the rotation get the seed of the new version as well
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+	require.NoError(t, err)
+
+	var versions []uint32
+	keeper.ListVersionedEncryptedSeeds(ctx, func(seed types.VersionedEncryptedSeed) bool {
+		versions = append(versions, seed.Version)
+		return false
+	})
+	require.Equal(t, []uint32{3, 3}, versions)
+}
+
+func TestKeeper_SeedRotationFails(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	keeper.enclave = failingEnclave{}
+	ctx = ctx.WithBlockHeight(10)
+
+	node := setTestNode(t, ctx, keeper, 1, nil)
+	require.NoError(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 11}))
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+
+	// the rotation is dropped, and no seed of it is left behind
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.GetSeedVersion(ctx))
+	require.Nil(t, keeper.GetScheduledSeedRotation(ctx))
+	require.Nil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, node))
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == EventTypeSeedRotationFailed
+	}
+	require.True(t, failed)
+}
+
+func TestKeeper_SeedRotationBatches(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	ctx = ctx.WithBlockHeight(10)
+
+	// the rotation takes three blocks
+	var nodes [][]byte
+	for id := 1; id <= 2*seedRotationBatchSize+1; id++ {
+		nodes = append(nodes, setTestNode(t, ctx, keeper, byte(id), nil))
+	}
+	revoked := nodes[seedRotationBatchSize+1]
+	require.NoError(t, keeper.SetNodeRevoked(ctx, revoked))
+
+	require.NoError(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 20}))
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.GetSeedVersion(ctx))
+	require.NotNil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, nodes[seedRotationBatchSize-1]))
+	require.Nil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, nodes[seedRotationBatchSize]))
+
+	// the seeds of the new version are not served before the network moves to it
+	_, err = keeper.GetEncryptedSeedForVersion(ctx, nodes[0], 3)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// the rotation under way can't be replaced
+	require.ErrorIs(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 30}), types.ErrSeedRotation)
+
+	// nodes that register while the rotation is under way get the seed of the new version right away
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+	require.NoError(t, err)
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+	require.NotNil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, publicKey))
+
+	ctx = ctx.WithBlockHeight(21)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.GetSeedVersion(ctx))
+
+	ctx = ctx.WithBlockHeight(22)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(3), keeper.GetSeedVersion(ctx))
+	require.Nil(t, keeper.GetScheduledSeedRotation(ctx))
+	require.Nil(t, keeper.getSeedRotationProgress(ctx))
+
+	for _, node := range nodes {
+		if bytes.Equal(node, revoked) {
+			require.Nil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, node))
+			continue
+		}
+		seed, err := keeper.GetEncryptedSeedForVersion(ctx, node, 3)
+		require.NoError(t, err)
+		require.Equal(t, bytes.Repeat([]byte{3}, 3*48), seed)
+	}
+}
+
+func TestKeeper_SeedRotationFailsUnderWay(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	ctx = ctx.WithBlockHeight(10)
+
+	var nodes [][]byte
+	for id := 1; id <= seedRotationBatchSize+1; id++ {
+		nodes = append(nodes, setTestNode(t, ctx, keeper, byte(id), nil))
+	}
+	require.NoError(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 11}))
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.NotNil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, nodes[0]))
+
+	keeper.enclave = failingEnclave{}
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+
+	// the seeds of the previous batch are dropped with the rotation
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.GetSeedVersion(ctx))
+	require.Nil(t, keeper.GetScheduledSeedRotation(ctx))
+	require.Nil(t, keeper.getSeedRotationProgress(ctx))
+	keeper.ListVersionedEncryptedSeeds(ctx, func(seed types.VersionedEncryptedSeed) bool {
+		t.Fatalf("seed of version %d left for node %x", seed.Version, seed.PubKey)
+		return true
+	})
+}
+
+func TestKeeper_SeedRotationLoadsNodeSeed(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// the node registered with the software mode certificate, and loaded seed version 2
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	sgxSecrets := filepath.Join(tempDir, "sgx_secrets")
+	require.NoError(t, os.MkdirAll(sgxSecrets, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(sgxSecrets, types.AttestationCombinedPath), cert, 0o600))
+	t.Setenv("SCRT_SGX_STORAGE", sgxSecrets)
+
+	seedPath := filepath.Join(tempDir, types.SecretNodeCfgFolder, types.SecretNodeSeedNewConfig)
+	require.NoError(t, os.MkdirAll(filepath.Dir(seedPath), 0o700))
+	require.NoError(t, os.WriteFile(seedPath, CreateTestSeedConfig(t), 0o600))
+
+	ctx, keeper := CreateTestInput(t, false, tempDir, false)
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.node.version)
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err = keeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+	require.NoError(t, err)
+	require.NoError(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 11}))
+
+	// the node loads the new version once the block is committed, not while it processes the block
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(3), keeper.GetSeedVersion(ctx))
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.node.version)
+	require.NoError(t, NewNodeSeedListener(keeper).ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	require.Equal(t, uint32(3), keeper.node.version)
+
+	// the node loads the new version on restarts
+	jsonContent, err := os.ReadFile(seedPath)
+	require.NoError(t, err)
+	var seedCfg types.SeedConfig
+	require.NoError(t, json.Unmarshal(jsonContent, &seedCfg))
+	require.Equal(t, uint32(3), seedCfg.Version)
+	require.Equal(t, hex.EncodeToString(bytes.Repeat([]byte{3}, 3*48)), seedCfg.EncryptedKey)
+	_, _, version := getNewSeedParams(seedPath)
+	require.Equal(t, uint32(3), version)
+}
+
+func TestKeeper_SeedRotationNodeSeedMissing(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// the node is not registered, so the network has no seed of the new version for it
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	sgxSecrets := filepath.Join(tempDir, "sgx_secrets")
+	require.NoError(t, os.MkdirAll(sgxSecrets, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(sgxSecrets, types.AttestationCombinedPath), cert, 0o600))
+	t.Setenv("SCRT_SGX_STORAGE", sgxSecrets)
+
+	seedPath := filepath.Join(tempDir, types.SecretNodeCfgFolder, types.SecretNodeSeedNewConfig)
+	require.NoError(t, os.MkdirAll(filepath.Dir(seedPath), 0o700))
+	seedCfg := CreateTestSeedConfig(t)
+	require.NoError(t, os.WriteFile(seedPath, seedCfg, 0o600))
+
+	ctx, keeper := CreateTestInput(t, false, tempDir, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	setTestNode(t, ctx, keeper, 1, nil)
+	require.NoError(t, keeper.ScheduleSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 11}))
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(3), keeper.GetSeedVersion(ctx))
+	require.NoError(t, NewNodeSeedListener(keeper).ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	// the node stays on its version, and does not try again
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.node.version)
+	require.Equal(t, uint32(3), keeper.node.failedVersion)
+	jsonContent, err := os.ReadFile(seedPath)
+	require.NoError(t, err)
+	require.Equal(t, seedCfg, jsonContent)
+}
+
+func TestKeeper_SeedRotationUnservedVersion(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	keeper.enclave = releasedEnclave{}
+	ctx = ctx.WithBlockHeight(10)
+
+	node := setTestNode(t, ctx, keeper, 1, nil)
+
+	// the proposal that schedules the rotation fails
+	msgServer := NewMsgServerImpl(keeper, types.ModuleName)
+	_, err = msgServer.ScheduleSeedRotation(ctx, &types.MsgScheduleSeedRotation{
+		Authority: keeper.GetAuthority(),
+		Rotation:  types.SeedRotation{Version: 3, Height: 20},
+	})
+	require.ErrorIs(t, err, types.ErrSeedRotation)
+	require.ErrorContains(t, err, "seed rotation is not supported yet")
+	require.Nil(t, keeper.GetScheduledSeedRotation(ctx))
+
+	// and rotations that come with the genesis are dropped when they are due
+	require.NoError(t, keeper.setScheduledSeedRotation(ctx, types.SeedRotation{Version: 3, Height: 20}))
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, keeper.BeginBlocker(ctx))
+	require.Equal(t, uint32(types.SeedConfigVersion), keeper.GetSeedVersion(ctx))
+	require.Nil(t, keeper.GetScheduledSeedRotation(ctx))
+	require.Nil(t, keeper.GetVersionedEncryptedSeed(ctx, 3, node))
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == EventTypeSeedRotationFailed
+	}
+	require.True(t, failed)
+}
+
+func TestExportGenesis_SeedRotation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	key, err := FetchRawPubKeyFromLegacyCert(cert)
+	require.NoError(t, err)
+
+	data := types.GenesisState{
+		IoMasterKey:           &types.MasterKey{Bytes: key},
+		NodeExchMasterKey:     &types.MasterKey{Bytes: key},
+		Params:                types.DefaultParams(),
+		SeedVersion:           3,
+		ScheduledSeedRotation: &types.SeedRotation{Version: 4, Height: 100},
+		VersionedSeeds: []types.VersionedEncryptedSeed{
+			{Version: 3, PubKey: bytes.Repeat([]byte{1}, 32), EncryptedSeed: bytes.Repeat([]byte{1}, types.EncryptedSeedLength(3)/2)},
+			{Version: 3, PubKey: bytes.Repeat([]byte{2}, 32), EncryptedSeed: bytes.Repeat([]byte{2}, types.EncryptedSeedLength(3)/2)},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(data))
+
+	InitGenesis(ctx, keeper, data)
+	require.Equal(t, uint32(3), keeper.GetSeedVersion(ctx))
+
+	data2 := ExportGenesis(ctx, keeper)
+	require.Equal(t, data.SeedVersion, data2.SeedVersion)
+	require.Equal(t, data.ScheduledSeedRotation, data2.ScheduledSeedRotation)
+	require.Equal(t, data.VersionedSeeds, data2.VersionedSeeds)
+}
+
+func TestValidateEncryptedSeed(t *testing.T) {
+	sized := func(hexLen int) string {
+		return "00" + string(bytes.Repeat([]byte("a"), hexLen))
+	}
+
+	require.NoError(t, validateEncryptedSeed(sized(types.LegacyEncryptedKeyLength), 1))
+	require.NoError(t, validateEncryptedSeed(sized(types.EncryptedKeyLength), types.SeedConfigVersion))
+	require.NoError(t, validateEncryptedSeed(sized(types.LegacyEncryptedKeyLength), types.SeedConfigVersion))
+	require.NoError(t, validateEncryptedSeed(sized(types.EncryptedSeedLength(3)), 3))
+	require.Error(t, validateEncryptedSeed(sized(types.EncryptedKeyLength), 3))
+	require.Error(t, validateEncryptedSeed("zz"+sized(types.EncryptedSeedLength(3))[2:], 3))
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MeasurementLength is the length of an MRENCLAVE or MRSIGNER value
+const MeasurementLength = 32
+
+// Matches returns whether an enclave with the given measurements is accepted by the entry
+func (e AllowedEnclave) Matches(mrEnclave []byte, mrSigner []byte, isvSvn uint32) bool {
+	if len(e.MrEnclave) != 0 && !bytes.Equal(e.MrEnclave, mrEnclave) {
+		return false
+	}
+	if len(e.MrSigner) != 0 && !bytes.Equal(e.MrSigner, mrSigner) {
+		return false
+	}
+	return isvSvn >= e.MinIsvSvn
+}
+
+func (e AllowedEnclave) Validate() error {
+	if len(e.MrEnclave) == 0 && len(e.MrSigner) == 0 {
+		return errorsmod.Wrap(ErrInvalid, "allowlist entry must set mr_enclave or mr_signer")
+	}
+	if len(e.MrEnclave) != 0 && len(e.MrEnclave) != MeasurementLength {
+		return errorsmod.Wrapf(ErrInvalid, "mr_enclave must be %d bytes long", MeasurementLength)
+	}
+	if len(e.MrSigner) != 0 && len(e.MrSigner) != MeasurementLength {
+		return errorsmod.Wrapf(ErrInvalid, "mr_signer must be %d bytes long", MeasurementLength)
+	}
+	if e.MinIsvSvn > 0xffff {
+		return errorsmod.Wrap(ErrInvalid, "min_isv_svn does not fit in 16 bits")
+	}
+	return nil
+}
+
+// ValidateEnclaveAllowlist checks every entry of the allowlist, and that no entry appears twice
+func ValidateEnclaveAllowlist(allowlist []AllowedEnclave) error {
+	for i, entry := range allowlist {
+		if err := entry.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "entry %d", i)
+		}
+		for j := 0; j < i; j++ {
+			if entry.Equal(&allowlist[j]) {
+				return errorsmod.Wrapf(ErrInvalid, "entry %d duplicates entry %d", i, j)
+			}
+		}
+	}
+	return nil
+}
@@ -10,12 +10,20 @@ import (
 // RegisterCodec registers the account types and interface
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RaAuthenticate{}, "reg/authenticate", nil)
+	cdc.RegisterConcrete(&MsgUpdateEnclaveAllowlist{}, "reg/MsgUpdateEnclaveAllowlist", nil)
+	cdc.RegisterConcrete(&MsgRevokeNodes{}, "reg/MsgRevokeNodes", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "reg/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgScheduleSeedRotation{}, "reg/MsgScheduleSeedRotation", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&RaAuthenticate{},
+		&MsgUpdateEnclaveAllowlist{},
+		&MsgRevokeNodes{},
+		&MsgUpdateParams{},
+		&MsgScheduleSeedRotation{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
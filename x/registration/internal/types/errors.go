@@ -26,4 +26,12 @@ var (
 	ErrNotFound = errors.Register(DefaultCodespace, 7, "not found")
 
 	ErrInvalid = errors.Register(DefaultCodespace, 8, "invalid")
+
+	ErrEnclaveNotAllowed = errors.Register(DefaultCodespace, 9, "Enclave is not in the allowlist")
+
+	ErrNodeRevoked = errors.Register(DefaultCodespace, 10, "Node was revoked")
+
+	ErrAttestationPolicy = errors.Register(DefaultCodespace, 11, "Attestation does not satisfy the policy")
+
+	ErrSeedRotation = errors.Register(DefaultCodespace, 12, "Invalid seed rotation")
 )
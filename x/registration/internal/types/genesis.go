@@ -1,16 +1,119 @@
 package types
 
+import (
+	"fmt"
+
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// Lengths of the master public keys. Networks that were bootstrapped with legacy software mode certificates hold
+// master keys as long as the public keys of those certificates
+const (
+	MasterKeyLength       = 32
+	LegacyMasterKeyLength = 64
+)
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { 
-	// todo: do we want to use this, or just fail if they don't exist?
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateMasterKey(data.IoMasterKey); err != nil {
+		return ErrInvalid.Wrapf("io master key: %s", err)
+	}
+	if err := ValidateMasterKey(data.NodeExchMasterKey); err != nil {
+		return ErrInvalid.Wrapf("node exchange master key: %s", err)
+	}
+
+	if err := ValidateRegistrations(data.Registration); err != nil {
+		return err
+	}
+
+	if err := ValidateEnclaveAllowlist(data.EnclaveAllowlist); err != nil {
+		return err
+	}
 
-	// if data.IoMasterCertificate == nil {
-	//	return ErrCertificateInvalid
-	//}
-	// if data.NodeExchMasterCertificate == nil {
-	//	return ErrCertificateInvalid
-	//}
+	if err := ValidateRevokedNodes(data.RevokedNodes); err != nil {
+		return err
+	}
 
+	if err := ValidateRevokedAttestations(data.RevokedPlatformInfos, data.RevokedTcbStatuses); err != nil {
+		return err
+	}
+
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seedVersion := data.SeedVersion
+	if seedVersion == 0 {
+		seedVersion = SeedConfigVersion
+	}
+	if err := ValidateSeedVersion(seedVersion); err != nil {
+		return err
+	}
+
+	// a rotation that is under way has the seeds of its version for some of the nodes already
+	lastSeedVersion := seedVersion
+	if data.ScheduledSeedRotation != nil {
+		if err := data.ScheduledSeedRotation.ValidateNext(seedVersion); err != nil {
+			return err
+		}
+		lastSeedVersion = data.ScheduledSeedRotation.Version
+	}
+
+	return ValidateVersionedSeeds(data.VersionedSeeds, lastSeedVersion)
+}
+
+// ValidateMasterKey checks that a master key is set. It is empty until the network is bootstrapped
+func ValidateMasterKey(key *MasterKey) error {
+	if key == nil {
+		return fmt.Errorf("not set")
+	}
+
+	switch len(key.Bytes) {
+	case 0, MasterKeyLength, LegacyMasterKeyLength:
+		return nil
+	default:
+		return fmt.Errorf("invalid length %d, expected %d or %d bytes", len(key.Bytes), MasterKeyLength, LegacyMasterKeyLength)
+	}
+}
+
+// ValidateRegistrations checks that the certificates of registered nodes parse, that their encrypted seeds are
+// well-formed, and that no node is registered twice. Signatures are not verified, as the collateral of certificates
+// expires long before networks export their state
+func ValidateRegistrations(registrations []*RegistrationNodeInfo) error {
+	seen := make(map[string]bool, len(registrations))
+	for i, regInfo := range registrations {
+		if regInfo == nil {
+			return ErrInvalid.Wrapf("registration %d is empty", i)
+		}
+
+		pubKey, err := RegistrationPubKey(*regInfo)
+		if err != nil {
+			return ErrCertificateInvalid.Wrapf("registration %d: %s", i, err)
+		}
+
+		seedLength := len(regInfo.EncryptedSeed) * 2
+		if seedLength != EncryptedKeyLength && seedLength != LegacyEncryptedKeyLength {
+			return ErrInvalid.Wrapf("encrypted seed of node %x has length %d, expected %d or %d hex characters",
+				pubKey, seedLength, EncryptedKeyLength, LegacyEncryptedKeyLength)
+		}
+
+		if seen[string(pubKey)] {
+			return ErrInvalid.Wrapf("duplicate registration of node %x", pubKey)
+		}
+		seen[string(pubKey)] = true
+	}
 	return nil
 }
+
+// RegistrationPubKey returns the public key that a registered node is stored by, as attested by its certificate
+func RegistrationPubKey(regInfo RegistrationNodeInfo) (NodeID, error) {
+	summary, err := ra.ParseAttestation(regInfo.Certificate)
+	if err != nil {
+		return nil, err
+	}
+	if len(summary.PubKey) == 0 {
+		return nil, fmt.Errorf("certificate holds no public key")
+	}
+	return summary.PubKey, nil
+}
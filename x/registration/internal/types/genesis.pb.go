@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +28,18 @@ type GenesisState struct {
 	Registration      []*RegistrationNodeInfo `protobuf:"bytes,1,rep,name=registration,proto3" json:"reg_info"`
 	NodeExchMasterKey *MasterKey              `protobuf:"bytes,2,opt,name=node_exch_master_key,json=nodeExchMasterKey,proto3" json:"node_exch_key"`
 	IoMasterKey       *MasterKey              `protobuf:"bytes,3,opt,name=io_master_key,json=ioMasterKey,proto3" json:"io_exch_key"`
+	EnclaveAllowlist  []AllowedEnclave        `protobuf:"bytes,4,rep,name=enclave_allowlist,json=enclaveAllowlist,proto3" json:"enclave_allowlist,omitempty"`
+	RevokedNodes      [][]byte                `protobuf:"bytes,5,rep,name=revoked_nodes,json=revokedNodes,proto3" json:"revoked_nodes,omitempty"`
+	Params            Params                  `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	// seed_version is the consensus seed version of the network. Zero stands
+	// for the version of networks that never rotated their seed.
+	SeedVersion           uint32                   `protobuf:"varint,7,opt,name=seed_version,json=seedVersion,proto3" json:"seed_version,omitempty"`
+	ScheduledSeedRotation *SeedRotation            `protobuf:"bytes,8,opt,name=scheduled_seed_rotation,json=scheduledSeedRotation,proto3" json:"scheduled_seed_rotation,omitempty"`
+	VersionedSeeds        []VersionedEncryptedSeed `protobuf:"bytes,9,rep,name=versioned_seeds,json=versionedSeeds,proto3" json:"versioned_seeds,omitempty"`
+	// revoked_platform_infos and revoked_tcb_statuses are the attestation
+	// properties that governance revoked, which nodes can't register with.
+	RevokedPlatformInfos []string `protobuf:"bytes,10,rep,name=revoked_platform_infos,json=revokedPlatformInfos,proto3" json:"revoked_platform_infos,omitempty"`
+	RevokedTcbStatuses   []string `protobuf:"bytes,11,rep,name=revoked_tcb_statuses,json=revokedTcbStatuses,proto3" json:"revoked_tcb_statuses,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,29 +84,48 @@ func init() {
 }
 
 var fileDescriptor_ce4400b3c39a810a = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcf, 0x6a, 0xea, 0x40,
-	0x14, 0xc6, 0x13, 0x85, 0xcb, 0x25, 0x51, 0x2e, 0x06, 0x17, 0xe2, 0x85, 0x51, 0x2e, 0xdc, 0xd6,
-	0x6e, 0x12, 0xb4, 0x0f, 0x50, 0x10, 0x4a, 0x29, 0xa5, 0x2e, 0x74, 0xd7, 0x2e, 0xc2, 0x24, 0x1e,
-	0xc7, 0xa9, 0x3a, 0x47, 0x66, 0xa6, 0xad, 0x79, 0x8b, 0x3e, 0x46, 0x1f, 0xa2, 0x0f, 0xe0, 0xd2,
-	0x65, 0x57, 0xd2, 0xc6, 0x9d, 0x4f, 0x51, 0x4c, 0xa4, 0xc6, 0x4d, 0xa0, 0xbb, 0xf9, 0xf3, 0x3b,
-	0xdf, 0xef, 0xc0, 0x67, 0x9d, 0x29, 0x08, 0x25, 0x68, 0x4f, 0x02, 0xe3, 0x4a, 0x4b, 0xaa, 0x39,
-	0x0a, 0xef, 0xa9, 0x1d, 0x80, 0xa6, 0x6d, 0x8f, 0x81, 0x00, 0xc5, 0x95, 0x3b, 0x97, 0xa8, 0xd1,
-	0xf9, 0x9b, 0xa2, 0x6e, 0x16, 0x75, 0xf7, 0x68, 0xbd, 0xca, 0x90, 0x61, 0xc2, 0x79, 0xbb, 0x53,
-	0x3a, 0x52, 0x3f, 0xcd, 0x4b, 0xd7, 0xd1, 0x1c, 0xf6, 0xd9, 0xf5, 0xff, 0x79, 0xe0, 0x4c, 0xb1,
-	0x14, 0xfb, 0xf7, 0x56, 0xb0, 0x4a, 0x57, 0xe9, 0x52, 0x03, 0x4d, 0x35, 0x38, 0xa1, 0x55, 0xca,
-	0x8e, 0xd4, 0xcc, 0x66, 0xb1, 0x65, 0x77, 0xda, 0x6e, 0xce, 0xaa, 0x6e, 0x3f, 0xf3, 0xd8, 0xc3,
-	0x21, 0x5c, 0x8b, 0x11, 0x76, 0x4b, 0xdb, 0x75, 0xe3, 0xb7, 0x04, 0xe6, 0x73, 0x31, 0xc2, 0xfe,
-	0x51, 0xa8, 0xf3, 0x60, 0x55, 0x05, 0x0e, 0xc1, 0x87, 0x45, 0x38, 0xf6, 0x67, 0x54, 0x69, 0x90,
-	0xfe, 0x04, 0xa2, 0x5a, 0xa1, 0x69, 0xb6, 0xec, 0xce, 0x49, 0xae, 0xec, 0x36, 0xc1, 0x6f, 0x20,
-	0xea, 0x56, 0xb6, 0xeb, 0x46, 0xf9, 0x90, 0x33, 0x81, 0xa8, 0x5f, 0xd9, 0x5d, 0x2f, 0x17, 0xe1,
-	0xf8, 0x9b, 0x72, 0xee, 0xad, 0x32, 0xc7, 0xac, 0xa4, 0xf8, 0x23, 0xc9, 0x9f, 0xed, 0xba, 0x61,
-	0x73, 0x3c, 0x28, 0x6c, 0x8e, 0x87, 0x5f, 0xba, 0xfc, 0x24, 0xc6, 0x6b, 0x4c, 0xcc, 0x65, 0x4c,
-	0xcc, 0x55, 0x4c, 0xcc, 0x8f, 0x98, 0x98, 0x2f, 0x1b, 0x62, 0xac, 0x36, 0xc4, 0x78, 0xdf, 0x10,
-	0xe3, 0xee, 0x82, 0x71, 0x3d, 0x7e, 0x0c, 0xdc, 0x10, 0x67, 0x9e, 0x0a, 0xa5, 0x9e, 0xd2, 0x40,
-	0x79, 0x83, 0x44, 0xdd, 0x03, 0xfd, 0x8c, 0x72, 0xe2, 0x2d, 0x8e, 0x4b, 0xe2, 0x42, 0x83, 0x14,
-	0x74, 0x9a, 0xd6, 0x19, 0xfc, 0x4a, 0x8a, 0x3a, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8,
-	0xf1, 0x91, 0x58, 0x02, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x4c,
+	0x18, 0xdf, 0xbe, 0xcb, 0x8b, 0x30, 0xbb, 0x2b, 0xd2, 0x20, 0xd4, 0x25, 0x69, 0x17, 0x10, 0x5d,
+	0xa2, 0xd9, 0x06, 0x38, 0x1b, 0xa5, 0x09, 0x31, 0xc6, 0x48, 0xc8, 0x2e, 0xe1, 0x80, 0x87, 0x66,
+	0xb6, 0x7d, 0xe8, 0xd6, 0x6d, 0x3b, 0x9b, 0x99, 0x61, 0x61, 0x0f, 0xc6, 0xab, 0x47, 0x3f, 0x86,
+	0x5f, 0xc2, 0x3b, 0x47, 0x8e, 0x9e, 0x1a, 0x5d, 0x6e, 0xfd, 0x14, 0xa6, 0xed, 0x2c, 0xcc, 0x2a,
+	0x36, 0xf1, 0xd6, 0x3e, 0xbf, 0x3f, 0xcf, 0x6f, 0xe6, 0x99, 0x19, 0xb4, 0xc5, 0xc0, 0xa1, 0xc0,
+	0x4d, 0x0a, 0x9e, 0xcf, 0x38, 0xc5, 0xdc, 0x27, 0x91, 0x39, 0xdc, 0xee, 0x02, 0xc7, 0xdb, 0xa6,
+	0x07, 0x11, 0x30, 0x9f, 0xb5, 0x06, 0x94, 0x70, 0xa2, 0xae, 0xe6, 0xd4, 0x96, 0x4c, 0x6d, 0x09,
+	0x6a, 0x7d, 0xc9, 0x23, 0x1e, 0xc9, 0x78, 0x66, 0xfa, 0x95, 0x4b, 0xea, 0x4f, 0x8b, 0xdc, 0xf9,
+	0x68, 0x00, 0xc2, 0xbb, 0xbe, 0x59, 0x44, 0x0c, 0x99, 0x27, 0x68, 0xcd, 0x22, 0xda, 0x00, 0x53,
+	0x1c, 0x0a, 0xc3, 0xf5, 0x6f, 0x73, 0xa8, 0xfa, 0x3a, 0x8f, 0xdf, 0xe1, 0x98, 0x83, 0xea, 0xa0,
+	0xaa, 0xac, 0xd2, 0x94, 0x46, 0xb9, 0x59, 0xd9, 0xd9, 0x6e, 0x15, 0x2c, 0xaa, 0xd5, 0x96, 0x8a,
+	0x07, 0xc4, 0x85, 0x37, 0xd1, 0x29, 0xb1, 0xaa, 0x49, 0x6c, 0xcc, 0x51, 0xf0, 0x6c, 0x3f, 0x3a,
+	0x25, 0xed, 0x29, 0x53, 0xf5, 0x03, 0x5a, 0x8a, 0x88, 0x0b, 0x36, 0x5c, 0x38, 0x3d, 0x3b, 0xc4,
+	0x8c, 0x03, 0xb5, 0xfb, 0x30, 0xd2, 0xfe, 0x6b, 0x28, 0xcd, 0xca, 0xce, 0x93, 0xc2, 0x66, 0xef,
+	0x32, 0xfa, 0x5b, 0x18, 0x59, 0x8b, 0x49, 0x6c, 0xd4, 0x6e, 0x7d, 0xfa, 0x30, 0x6a, 0x2f, 0xa6,
+	0xbf, 0xfb, 0x17, 0x4e, 0xef, 0x86, 0xa5, 0xbe, 0x47, 0x35, 0x9f, 0xc8, 0x4d, 0xca, 0xff, 0xd4,
+	0x64, 0x21, 0x89, 0x8d, 0x8a, 0x4f, 0x6e, 0x5b, 0x54, 0x7c, 0x72, 0x6b, 0xfe, 0x11, 0x2d, 0x42,
+	0xe4, 0x04, 0x78, 0x08, 0x36, 0x0e, 0x02, 0x72, 0x1e, 0xf8, 0x8c, 0x6b, 0x33, 0xd9, 0x96, 0x3d,
+	0x2b, 0x6c, 0xb0, 0x97, 0xb2, 0xc1, 0xdd, 0xcf, 0xc5, 0xd6, 0xc6, 0x65, 0x6c, 0x94, 0x92, 0xd8,
+	0x58, 0xfd, 0xc3, 0xed, 0x39, 0x09, 0x7d, 0x0e, 0xe1, 0x80, 0x8f, 0xda, 0x0f, 0x04, 0xb8, 0x37,
+	0xc1, 0xd4, 0x57, 0xa8, 0x46, 0x61, 0x48, 0xfa, 0xe0, 0xda, 0xe9, 0xc2, 0x99, 0xf6, 0x7f, 0xa3,
+	0xdc, 0xac, 0x5a, 0xab, 0x49, 0x6c, 0xac, 0x4c, 0x01, 0x92, 0x4b, 0x55, 0x00, 0xe9, 0xa0, 0x98,
+	0xba, 0x87, 0x66, 0xf3, 0xf3, 0xa0, 0xcd, 0x66, 0xdb, 0xb2, 0x51, 0x98, 0xfa, 0x30, 0xa3, 0x5a,
+	0x33, 0x69, 0xda, 0xb6, 0x10, 0xaa, 0x2f, 0x50, 0x95, 0x01, 0xb8, 0xf6, 0x10, 0x28, 0x4b, 0x4f,
+	0xcc, 0xbd, 0x86, 0xd2, 0xac, 0x59, 0xf5, 0x24, 0x36, 0x96, 0xe5, 0xba, 0x14, 0xa1, 0x92, 0xd6,
+	0x8f, 0xf3, 0xb2, 0xfa, 0x59, 0x41, 0x2b, 0xcc, 0xe9, 0x81, 0x7b, 0x16, 0x80, 0x6b, 0x67, 0x0a,
+	0x4a, 0x78, 0x7e, 0xf8, 0xe6, 0xb2, 0x4c, 0x5b, 0x85, 0x99, 0x3a, 0x00, 0x6e, 0x5b, 0x08, 0xac,
+	0xcd, 0x24, 0x36, 0xd6, 0xfe, 0xe2, 0x26, 0x05, 0x78, 0x78, 0x43, 0x91, 0xd5, 0xea, 0x27, 0xb4,
+	0x20, 0xc2, 0x0a, 0x2d, 0xd3, 0xe6, 0xb3, 0x59, 0xee, 0x16, 0x26, 0x38, 0x9e, 0x68, 0xf6, 0x23,
+	0x87, 0x8e, 0x06, 0x3c, 0x77, 0xb5, 0xd6, 0xc4, 0x4c, 0x1f, 0xfd, 0xe6, 0x29, 0xe5, 0xb8, 0x7f,
+	0x03, 0xa5, 0x0a, 0xa6, 0x9e, 0xa0, 0xe5, 0xc9, 0xd8, 0x06, 0x01, 0xe6, 0xa7, 0x84, 0x86, 0xd9,
+	0xf5, 0x61, 0x1a, 0x6a, 0x94, 0x9b, 0xf3, 0xd6, 0xe3, 0x24, 0x36, 0x1a, 0x77, 0x33, 0x24, 0xd7,
+	0x25, 0xc1, 0x38, 0x14, 0x84, 0xf4, 0x3a, 0x32, 0xf5, 0x08, 0x4d, 0xea, 0x36, 0x77, 0xba, 0x36,
+	0xe3, 0x98, 0x9f, 0x31, 0x60, 0x5a, 0x25, 0x73, 0x5e, 0x4f, 0x62, 0x43, 0xbf, 0x0b, 0x97, 0x7c,
+	0x55, 0x81, 0x1f, 0x39, 0xdd, 0x8e, 0x40, 0x2d, 0x7c, 0xf9, 0x53, 0x2f, 0x7d, 0x1d, 0xeb, 0xca,
+	0xe5, 0x58, 0x57, 0xae, 0xc6, 0xba, 0xf2, 0x63, 0xac, 0x2b, 0x5f, 0xae, 0xf5, 0xd2, 0xd5, 0xb5,
+	0x5e, 0xfa, 0x7e, 0xad, 0x97, 0x4e, 0x5e, 0x7a, 0x3e, 0xef, 0x9d, 0x75, 0x5b, 0x0e, 0x09, 0x4d,
+	0xe6, 0x50, 0x1e, 0xe0, 0x2e, 0x33, 0x3b, 0xd9, 0x76, 0x1e, 0x00, 0x3f, 0x27, 0xb4, 0x6f, 0x5e,
+	0x4c, 0x3f, 0x54, 0x7e, 0xc4, 0x81, 0x46, 0x38, 0xc8, 0x5f, 0xbe, 0xee, 0x6c, 0xf6, 0x52, 0xed,
+	0xfe, 0x1a, 0x00, 0xbb, 0x90, 0xfb, 0xca, 0x83, 0x05, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -129,6 +161,55 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.IoMasterKey.Equal(that1.IoMasterKey) {
 		return false
 	}
+	if len(this.EnclaveAllowlist) != len(that1.EnclaveAllowlist) {
+		return false
+	}
+	for i := range this.EnclaveAllowlist {
+		if !this.EnclaveAllowlist[i].Equal(&that1.EnclaveAllowlist[i]) {
+			return false
+		}
+	}
+	if len(this.RevokedNodes) != len(that1.RevokedNodes) {
+		return false
+	}
+	for i := range this.RevokedNodes {
+		if !bytes.Equal(this.RevokedNodes[i], that1.RevokedNodes[i]) {
+			return false
+		}
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	if this.SeedVersion != that1.SeedVersion {
+		return false
+	}
+	if !this.ScheduledSeedRotation.Equal(that1.ScheduledSeedRotation) {
+		return false
+	}
+	if len(this.VersionedSeeds) != len(that1.VersionedSeeds) {
+		return false
+	}
+	for i := range this.VersionedSeeds {
+		if !this.VersionedSeeds[i].Equal(&that1.VersionedSeeds[i]) {
+			return false
+		}
+	}
+	if len(this.RevokedPlatformInfos) != len(that1.RevokedPlatformInfos) {
+		return false
+	}
+	for i := range this.RevokedPlatformInfos {
+		if this.RevokedPlatformInfos[i] != that1.RevokedPlatformInfos[i] {
+			return false
+		}
+	}
+	if len(this.RevokedTcbStatuses) != len(that1.RevokedTcbStatuses) {
+		return false
+	}
+	for i := range this.RevokedTcbStatuses {
+		if this.RevokedTcbStatuses[i] != that1.RevokedTcbStatuses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +232,88 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevokedTcbStatuses) > 0 {
+		for iNdEx := len(m.RevokedTcbStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedTcbStatuses[iNdEx])
+			copy(dAtA[i:], m.RevokedTcbStatuses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RevokedTcbStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RevokedPlatformInfos) > 0 {
+		for iNdEx := len(m.RevokedPlatformInfos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedPlatformInfos[iNdEx])
+			copy(dAtA[i:], m.RevokedPlatformInfos[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RevokedPlatformInfos[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VersionedSeeds) > 0 {
+		for iNdEx := len(m.VersionedSeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionedSeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ScheduledSeedRotation != nil {
+		{
+			size, err := m.ScheduledSeedRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SeedVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SeedVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.RevokedNodes) > 0 {
+		for iNdEx := len(m.RevokedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedNodes[iNdEx])
+			copy(dAtA[i:], m.RevokedNodes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RevokedNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EnclaveAllowlist) > 0 {
+		for iNdEx := len(m.EnclaveAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EnclaveAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.IoMasterKey != nil {
 		{
 			size, err := m.IoMasterKey.MarshalToSizedBuffer(dAtA[:i])
@@ -223,6 +386,45 @@ func (m *GenesisState) Size() (n int) {
 		l = m.IoMasterKey.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.EnclaveAllowlist) > 0 {
+		for _, e := range m.EnclaveAllowlist {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevokedNodes) > 0 {
+		for _, b := range m.RevokedNodes {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.SeedVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.SeedVersion))
+	}
+	if m.ScheduledSeedRotation != nil {
+		l = m.ScheduledSeedRotation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.VersionedSeeds) > 0 {
+		for _, e := range m.VersionedSeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevokedPlatformInfos) > 0 {
+		for _, s := range m.RevokedPlatformInfos {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevokedTcbStatuses) > 0 {
+		for _, s := range m.RevokedTcbStatuses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -367,6 +569,258 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnclaveAllowlist = append(m.EnclaveAllowlist, AllowedEnclave{})
+			if err := m.EnclaveAllowlist[len(m.EnclaveAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedNodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedNodes = append(m.RevokedNodes, make([]byte, postIndex-iNdEx))
+			copy(m.RevokedNodes[len(m.RevokedNodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedVersion", wireType)
+			}
+			m.SeedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeedVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSeedRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledSeedRotation == nil {
+				m.ScheduledSeedRotation = &SeedRotation{}
+			}
+			if err := m.ScheduledSeedRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionedSeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionedSeeds = append(m.VersionedSeeds, VersionedEncryptedSeed{})
+			if err := m.VersionedSeeds[len(m.VersionedSeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedPlatformInfos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedPlatformInfos = append(m.RevokedPlatformInfos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedTcbStatuses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedTcbStatuses = append(m.RevokedTcbStatuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesisRegistrations(t *testing.T) {
+	certSw, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	certHw, err := os.ReadFile("../../testdata/attestation_cert_hw_v2")
+	require.NoError(t, err)
+	certInvalid, err := os.ReadFile("../../testdata/attestation_cert_invalid")
+	require.NoError(t, err)
+
+	seed := bytes.Repeat([]byte{0xaa}, EncryptedKeyLength/2)
+	legacySeed := bytes.Repeat([]byte{0xaa}, LegacyEncryptedKeyLength/2)
+
+	node := func(cert []byte, seed []byte) *RegistrationNodeInfo {
+		return &RegistrationNodeInfo{Certificate: cert, EncryptedSeed: seed}
+	}
+
+	cases := map[string]struct {
+		registrations []*RegistrationNodeInfo
+		err           error
+	}{
+		"none":             {nil, nil},
+		"nodes":            {[]*RegistrationNodeInfo{node(certSw, seed), node(certHw, legacySeed)}, nil},
+		"empty":            {[]*RegistrationNodeInfo{nil}, ErrInvalid},
+		"invalid cert":     {[]*RegistrationNodeInfo{node(certInvalid, seed)}, ErrCertificateInvalid},
+		"no cert":          {[]*RegistrationNodeInfo{node(nil, seed)}, ErrCertificateInvalid},
+		"no seed":          {[]*RegistrationNodeInfo{node(certSw, nil)}, ErrInvalid},
+		"invalid seed":     {[]*RegistrationNodeInfo{node(certSw, seed[1:])}, ErrInvalid},
+		"duplicate node":   {[]*RegistrationNodeInfo{node(certSw, seed), node(certHw, seed), node(certSw, legacySeed)}, ErrInvalid},
+		"duplicate record": {[]*RegistrationNodeInfo{node(certHw, seed), node(certHw, seed)}, ErrInvalid},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateGenesis(GenesisState{
+				IoMasterKey:       &MasterKey{},
+				NodeExchMasterKey: &MasterKey{},
+				Registration:      tc.registrations,
+			})
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisMasterKeys(t *testing.T) {
+	cases := map[string]struct {
+		key   *MasterKey
+		valid bool
+	}{
+		"not bootstrapped": {&MasterKey{}, true},
+		"key":              {&MasterKey{Bytes: make([]byte, MasterKeyLength)}, true},
+		"legacy key":       {&MasterKey{Bytes: make([]byte, LegacyMasterKeyLength)}, true},
+		"not set":          {nil, false},
+		"invalid key":      {&MasterKey{Bytes: make([]byte, MasterKeyLength+1)}, false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, state := range []GenesisState{
+				{IoMasterKey: tc.key, NodeExchMasterKey: &MasterKey{}},
+				{IoMasterKey: &MasterKey{}, NodeExchMasterKey: tc.key},
+			} {
+				err := ValidateGenesis(state)
+				if tc.valid {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, ErrInvalid)
+				}
+			}
+		})
+	}
+}
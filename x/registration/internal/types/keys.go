@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/binary"
+	"strings"
+)
+
 const (
 	// ModuleName is the name of the contract module
 	ModuleName = "register"
@@ -20,6 +25,15 @@ const (
 var (
 	RegistrationStorePrefix     = []byte{0x01}
 	RegistrationMasterKeyPrefix = []byte{0x02}
+	EnclaveAllowlistKey         = []byte{0x03}
+	RevokedNodePrefix           = []byte{0x04}
+	ParamsKey                   = []byte{0x05}
+	SeedVersionKey              = []byte{0x06}
+	SeedRotationKey             = []byte{0x07}
+	VersionedSeedPrefix         = []byte{0x08}
+	RevokedPlatformInfoPrefix   = []byte{0x09}
+	RevokedTcbStatusPrefix      = []byte{0x0a}
+	SeedRotationProgressKey     = []byte{0x0b}
 )
 
 func RegistrationKeyPrefix(key []byte) []byte {
@@ -29,3 +43,27 @@ func RegistrationKeyPrefix(key []byte) []byte {
 func MasterKeyPrefix(key string) []byte {
 	return append(RegistrationMasterKeyPrefix, []byte(key)...)
 }
+
+func RevokedNodeKey(key []byte) []byte {
+	return append(RevokedNodePrefix, key...)
+}
+
+// RevokedPlatformInfoKey is the key of a revoked platform info. Platform infos are hex, and are stored in upper case
+// so that revocations match them in either case
+func RevokedPlatformInfoKey(platformInfo string) []byte {
+	return append(append([]byte{}, RevokedPlatformInfoPrefix...), strings.ToUpper(platformInfo)...)
+}
+
+func RevokedTcbStatusKey(tcbStatus string) []byte {
+	return append(append([]byte{}, RevokedTcbStatusPrefix...), tcbStatus...)
+}
+
+// VersionedSeedKey is the key of the encrypted seed of a node for a consensus seed version. Seeds are grouped by
+// version
+func VersionedSeedKey(version uint32, key []byte) []byte {
+	return append(VersionedSeedVersionPrefix(version), key...)
+}
+
+func VersionedSeedVersionPrefix(version uint32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, VersionedSeedPrefix...), version)
+}
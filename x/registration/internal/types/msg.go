@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
@@ -37,6 +40,161 @@ func (msg RaAuthenticate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+func (msg MsgUpdateEnclaveAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateEnclaveAllowlist) Type() string {
+	return "update-enclave-allowlist"
+}
+
+func (msg MsgUpdateEnclaveAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateEnclaveAllowlist(msg.Allowlist)
+}
+
+func (msg MsgRevokeNodes) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeNodes) Type() string {
+	return "revoke-nodes"
+}
+
+func (msg MsgRevokeNodes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(msg.PubKeys) == 0 && len(msg.PlatformInfos) == 0 && len(msg.TcbStatuses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("no nodes selected")
+	}
+
+	if err := ValidateRevokedNodes(msg.PubKeys); err != nil {
+		return err
+	}
+
+	for _, platformInfo := range msg.PlatformInfos {
+		if platformInfo == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty platform info")
+		}
+	}
+
+	for _, tcbStatus := range msg.TcbStatuses {
+		if tcbStatus == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty TCB status")
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateParams) Type() string {
+	return "update-params"
+}
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgScheduleSeedRotation) Route() string {
+	return RouterKey
+}
+
+func (msg MsgScheduleSeedRotation) Type() string {
+	return "schedule-seed-rotation"
+}
+
+func (msg MsgScheduleSeedRotation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Rotation.Validate()
+}
+
+// Selects returns whether the revocation selects the registered node
+func (msg MsgRevokeNodes) Selects(publicKey NodeID, regInfo RegistrationNodeInfo) bool {
+	for _, pk := range msg.PubKeys {
+		if bytes.Equal(pk, publicKey) {
+			return true
+		}
+	}
+
+	if regInfo.Attestation == nil {
+		return false
+	}
+
+	for _, platformInfo := range msg.PlatformInfos {
+		if strings.EqualFold(platformInfo, regInfo.Attestation.PlatformInfo) {
+			return true
+		}
+	}
+
+	for _, tcbStatus := range msg.TcbStatuses {
+		if tcbStatus == regInfo.Attestation.TcbStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateRevokedAttestations checks that the revoked platform infos and TCB statuses are set and unique. Platform
+// infos are compared in upper case, as they are stored
+func ValidateRevokedAttestations(platformInfos []string, tcbStatuses []string) error {
+	seen := make(map[string]bool, len(platformInfos))
+	for _, platformInfo := range platformInfos {
+		if platformInfo == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty revoked platform info")
+		}
+		if seen[strings.ToUpper(platformInfo)] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate revoked platform info %s", platformInfo)
+		}
+		seen[strings.ToUpper(platformInfo)] = true
+	}
+
+	seen = make(map[string]bool, len(tcbStatuses))
+	for _, tcbStatus := range tcbStatuses {
+		if tcbStatus == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty revoked TCB status")
+		}
+		if seen[tcbStatus] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate revoked TCB status %s", tcbStatus)
+		}
+		seen[tcbStatus] = true
+	}
+
+	return nil
+}
+
+// ValidateRevokedNodes checks that the public keys of revoked nodes are set and unique
+func ValidateRevokedNodes(pubKeys [][]byte) error {
+	seen := make(map[string]bool, len(pubKeys))
+	for _, pk := range pubKeys {
+		if len(pk) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+		}
+		if seen[string(pk)] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate public key %x", pk)
+		}
+		seen[string(pk)] = true
+	}
+
+	return nil
+}
+
 func validateCertificate(cert ra.Certificate) error {
 	// todo: add public key verification
 	_, err := ra.VerifyCombinedCert(cert)
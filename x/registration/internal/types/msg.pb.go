@@ -7,8 +7,10 @@ import (
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -182,11 +184,353 @@ func (m *Key) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Key proto.InternalMessageInfo
 
+// MsgUpdateEnclaveAllowlist is the MsgUpdateEnclaveAllowlist request type.
+type MsgUpdateEnclaveAllowlist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// allowlist replaces the current enclave allowlist. An empty allowlist
+	// accepts any enclave.
+	Allowlist []AllowedEnclave `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist"`
+}
+
+func (m *MsgUpdateEnclaveAllowlist) Reset()         { *m = MsgUpdateEnclaveAllowlist{} }
+func (m *MsgUpdateEnclaveAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEnclaveAllowlist) ProtoMessage()    {}
+func (*MsgUpdateEnclaveAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{4}
+}
+func (m *MsgUpdateEnclaveAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEnclaveAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEnclaveAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEnclaveAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEnclaveAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateEnclaveAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEnclaveAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEnclaveAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEnclaveAllowlist proto.InternalMessageInfo
+
+// MsgUpdateEnclaveAllowlistResponse returns empty data
+type MsgUpdateEnclaveAllowlistResponse struct {
+}
+
+func (m *MsgUpdateEnclaveAllowlistResponse) Reset()         { *m = MsgUpdateEnclaveAllowlistResponse{} }
+func (m *MsgUpdateEnclaveAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEnclaveAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateEnclaveAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{5}
+}
+func (m *MsgUpdateEnclaveAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEnclaveAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEnclaveAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEnclaveAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEnclaveAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateEnclaveAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEnclaveAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEnclaveAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEnclaveAllowlistResponse proto.InternalMessageInfo
+
+// MsgRevokeNodes is the MsgRevokeNodes request type. The nodes it selects are
+// revoked, a node is selected by its public key or by a property of its
+// attestation. Revoked properties are kept, so that nodes with them can't
+// register later either.
+type MsgRevokeNodes struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pub_keys selects nodes by public key. They don't have to be registered.
+	PubKeys [][]byte `protobuf:"bytes,2,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// platform_infos selects the registered nodes whose attestation has one of
+	// these platform infos (FMSPC of DCAP quotes, platform info blob of EPID
+	// reports).
+	PlatformInfos []string `protobuf:"bytes,3,rep,name=platform_infos,json=platformInfos,proto3" json:"platform_infos,omitempty"`
+	// tcb_statuses selects the registered nodes whose attestation has one of
+	// these TCB statuses.
+	TcbStatuses []string `protobuf:"bytes,4,rep,name=tcb_statuses,json=tcbStatuses,proto3" json:"tcb_statuses,omitempty"`
+}
+
+func (m *MsgRevokeNodes) Reset()         { *m = MsgRevokeNodes{} }
+func (m *MsgRevokeNodes) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNodes) ProtoMessage()    {}
+func (*MsgRevokeNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{6}
+}
+func (m *MsgRevokeNodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNodes.Merge(m, src)
+}
+func (m *MsgRevokeNodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeNodes proto.InternalMessageInfo
+
+// MsgRevokeNodesResponse returns the public keys of the nodes that were
+// revoked
+type MsgRevokeNodesResponse struct {
+	RevokedPubKeys [][]byte `protobuf:"bytes,1,rep,name=revoked_pub_keys,json=revokedPubKeys,proto3" json:"revoked_pub_keys,omitempty"`
+}
+
+func (m *MsgRevokeNodesResponse) Reset()         { *m = MsgRevokeNodesResponse{} }
+func (m *MsgRevokeNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNodesResponse) ProtoMessage()    {}
+func (*MsgRevokeNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{7}
+}
+func (m *MsgRevokeNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNodesResponse.Merge(m, src)
+}
+func (m *MsgRevokeNodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeNodesResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the MsgUpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/registration parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse returns empty data
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgScheduleSeedRotation is the MsgScheduleSeedRotation request type. It
+// replaces a rotation that was scheduled before and did not take effect yet.
+//
+// Seed rotation is not supported yet: no enclave release derives the seeds of
+// versions after the current seed version, so the message is rejected until
+// one does.
+type MsgScheduleSeedRotation struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rotation is the version to move to, and the height at which it takes
+	// effect. The version must follow the current version of the network, and
+	// the enclave of the release the network runs must serve it.
+	Rotation SeedRotation `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation"`
+}
+
+func (m *MsgScheduleSeedRotation) Reset()         { *m = MsgScheduleSeedRotation{} }
+func (m *MsgScheduleSeedRotation) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSeedRotation) ProtoMessage()    {}
+func (*MsgScheduleSeedRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{10}
+}
+func (m *MsgScheduleSeedRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSeedRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSeedRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleSeedRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSeedRotation.Merge(m, src)
+}
+func (m *MsgScheduleSeedRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSeedRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSeedRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSeedRotation proto.InternalMessageInfo
+
+// MsgScheduleSeedRotationResponse returns empty data
+type MsgScheduleSeedRotationResponse struct {
+}
+
+func (m *MsgScheduleSeedRotationResponse) Reset()         { *m = MsgScheduleSeedRotationResponse{} }
+func (m *MsgScheduleSeedRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSeedRotationResponse) ProtoMessage()    {}
+func (*MsgScheduleSeedRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{11}
+}
+func (m *MsgScheduleSeedRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSeedRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSeedRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleSeedRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSeedRotationResponse.Merge(m, src)
+}
+func (m *MsgScheduleSeedRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSeedRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSeedRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSeedRotationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RaAuthenticate)(nil), "secret.registration.v1beta1.RaAuthenticate")
 	proto.RegisterType((*RaAuthenticateResponse)(nil), "secret.registration.v1beta1.RaAuthenticateResponse")
 	proto.RegisterType((*MasterKey)(nil), "secret.registration.v1beta1.MasterKey")
 	proto.RegisterType((*Key)(nil), "secret.registration.v1beta1.Key")
+	proto.RegisterType((*MsgUpdateEnclaveAllowlist)(nil), "secret.registration.v1beta1.MsgUpdateEnclaveAllowlist")
+	proto.RegisterType((*MsgUpdateEnclaveAllowlistResponse)(nil), "secret.registration.v1beta1.MsgUpdateEnclaveAllowlistResponse")
+	proto.RegisterType((*MsgRevokeNodes)(nil), "secret.registration.v1beta1.MsgRevokeNodes")
+	proto.RegisterType((*MsgRevokeNodesResponse)(nil), "secret.registration.v1beta1.MsgRevokeNodesResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "secret.registration.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "secret.registration.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleSeedRotation)(nil), "secret.registration.v1beta1.MsgScheduleSeedRotation")
+	proto.RegisterType((*MsgScheduleSeedRotationResponse)(nil), "secret.registration.v1beta1.MsgScheduleSeedRotationResponse")
 }
 
 func init() {
@@ -194,35 +538,63 @@ func init() {
 }
 
 var fileDescriptor_91e653c4cfa6dfea = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0xbd, 0xc5, 0xc4, 0x91, 0x97, 0x08, 0xa4, 0x55, 0x14, 0x82, 0x91, 0xee, 0x82, 0x25, 0x24,
-	0x14, 0x94, 0x5b, 0x99, 0x74, 0x34, 0xc8, 0x81, 0x06, 0xa1, 0x80, 0xb4, 0x74, 0x14, 0x44, 0x7b,
-	0x7b, 0xc3, 0xe5, 0xe4, 0xf8, 0xd6, 0xda, 0x99, 0x18, 0xdc, 0xa0, 0x88, 0x8a, 0x92, 0x4f, 0xa0,
-	0xa4, 0xcc, 0x67, 0xa4, 0x4c, 0x49, 0x65, 0x81, 0x5d, 0x44, 0x4a, 0x43, 0x9f, 0x0a, 0xdd, 0xde,
-	0x21, 0xec, 0x26, 0x92, 0x9b, 0xbb, 0x19, 0xcd, 0xdb, 0x37, 0xef, 0xcd, 0x0c, 0x7f, 0x88, 0x60,
-	0x1c, 0x90, 0x74, 0x90, 0xe5, 0x48, 0x4e, 0x53, 0x6e, 0x0b, 0x39, 0xea, 0x26, 0x40, 0xba, 0x2b,
-	0x07, 0x98, 0xc5, 0x43, 0x67, 0xc9, 0x8a, 0xfb, 0x15, 0x2c, 0x9e, 0x87, 0xc5, 0x35, 0xac, 0xbd,
-	0x9e, 0xd9, 0xcc, 0x7a, 0x9c, 0x2c, 0xa3, 0xea, 0x49, 0xfb, 0xae, 0xb1, 0x38, 0xb0, 0x58, 0x92,
-	0xc8, 0xd1, 0x1c, 0x57, 0xe7, 0x0f, 0xe3, 0xb7, 0x95, 0xee, 0x1d, 0xd3, 0x21, 0x14, 0x94, 0x1b,
-	0x4d, 0x20, 0x5e, 0xf2, 0x26, 0x42, 0x91, 0x82, 0xdb, 0x64, 0x5b, 0xec, 0xd1, 0xda, 0x5e, 0xf7,
-	0x6a, 0x12, 0xed, 0x64, 0x39, 0x1d, 0x1e, 0x27, 0xb1, 0xb1, 0x03, 0x59, 0x53, 0x55, 0xbf, 0x1d,
-	0x4c, 0xfb, 0x92, 0xc6, 0x43, 0xc0, 0xb8, 0x67, 0x4c, 0x2f, 0x4d, 0x1d, 0x20, 0xaa, 0x9a, 0x40,
-	0x9c, 0x30, 0x7e, 0xcb, 0x80, 0xa3, 0xfc, 0x83, 0xa7, 0xde, 0xbc, 0xe1, 0x09, 0xdf, 0x5f, 0x4e,
-	0xa2, 0x55, 0xa7, 0x0f, 0xca, 0xca, 0xd5, 0x24, 0x7a, 0x33, 0xc7, 0x8d, 0xc6, 0xd1, 0x91, 0x4e,
-	0x50, 0xbe, 0xf5, 0x16, 0x5f, 0x03, 0x7d, 0xb4, 0xae, 0x2f, 0x3f, 0x2d, 0x8e, 0xc4, 0xc1, 0xc0,
-	0x12, 0x1c, 0x68, 0x22, 0x40, 0xaa, 0xec, 0x3f, 0xff, 0xdf, 0x45, 0xcd, 0xb7, 0x7c, 0x7a, 0xe7,
-	0xeb, 0xf7, 0x28, 0xf8, 0x72, 0x71, 0xba, 0x5d, 0x6b, 0xea, 0xbc, 0xe0, 0x1b, 0x8b, 0x86, 0x15,
-	0xe0, 0xd0, 0x16, 0x08, 0x42, 0xf0, 0x9b, 0xa9, 0x26, 0xed, 0x6d, 0xb7, 0x94, 0x8f, 0xc5, 0x06,
-	0x6f, 0xc2, 0x08, 0x0a, 0x42, 0xaf, 0xbd, 0xa5, 0xea, 0xac, 0xf3, 0x80, 0xb7, 0xf6, 0x35, 0x12,
-	0xb8, 0x57, 0x30, 0x16, 0xeb, 0x7c, 0x25, 0x19, 0x13, 0x60, 0x35, 0x30, 0x55, 0x25, 0x9d, 0x2d,
-	0xde, 0x28, 0x8b, 0xf7, 0x78, 0xa3, 0x0f, 0xe3, 0x7a, 0x96, 0xab, 0x97, 0x93, 0xa8, 0x4c, 0x55,
-	0xf9, 0x79, 0xf2, 0x99, 0x37, 0xf6, 0x31, 0x13, 0x43, 0xbe, 0xa6, 0xbc, 0x3d, 0x70, 0xa5, 0x2e,
-	0xf1, 0x38, 0xbe, 0x66, 0xc1, 0xf1, 0xa2, 0xf8, 0xf6, 0xee, 0x12, 0xe0, 0x7f, 0x4e, 0xdb, 0x2b,
-	0x27, 0x17, 0xa7, 0xdb, 0x6c, 0x4f, 0x9f, 0xfd, 0x0e, 0x83, 0x1f, 0xd3, 0x90, 0x9d, 0x4d, 0x43,
-	0x76, 0x3e, 0x0d, 0xd9, 0xaf, 0x69, 0xc8, 0xbe, 0xcd, 0xc2, 0xe0, 0x7c, 0x16, 0x06, 0x3f, 0x67,
-	0x61, 0xf0, 0xee, 0xd9, 0xd2, 0xbb, 0xc9, 0x0b, 0x02, 0x57, 0xe8, 0xa3, 0xea, 0x28, 0x92, 0xa6,
-	0x3f, 0xb3, 0xdd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x39, 0x18, 0xd5, 0xdb, 0x02, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd6, 0x4d, 0x52, 0x8f, 0x4d, 0x0a, 0x4b, 0x94, 0xda, 0x5b, 0x61, 0x27, 0x5b, 0x05,
+	0x4c, 0x4a, 0xbc, 0x24, 0x41, 0x1c, 0x2c, 0x04, 0x8a, 0xf9, 0x21, 0x55, 0x55, 0x4a, 0xb4, 0x86,
+	0x0b, 0x07, 0xac, 0xd9, 0xdd, 0x97, 0xcd, 0xca, 0xf6, 0xce, 0x6a, 0x66, 0xec, 0xe2, 0x5b, 0x05,
+	0x17, 0xd4, 0x53, 0xff, 0x04, 0x8e, 0x3d, 0xe6, 0xc0, 0x85, 0xff, 0x20, 0xc7, 0x0a, 0x38, 0x20,
+	0x21, 0x59, 0x90, 0x1c, 0x22, 0xf5, 0xc2, 0xbd, 0x27, 0xb4, 0x33, 0xe3, 0xcd, 0x9a, 0xc4, 0x0e,
+	0xf6, 0x65, 0xb3, 0xf3, 0x7e, 0x7c, 0xef, 0xfb, 0x5e, 0xde, 0x1b, 0x2f, 0xda, 0x60, 0xe0, 0x52,
+	0xe0, 0x16, 0x05, 0x3f, 0x60, 0x9c, 0x62, 0x1e, 0x90, 0xd0, 0xea, 0x6f, 0x3b, 0xc0, 0xf1, 0xb6,
+	0xd5, 0x65, 0x7e, 0x2d, 0xa2, 0x84, 0x13, 0xfd, 0xae, 0x0c, 0xab, 0xa5, 0xc3, 0x6a, 0x2a, 0xcc,
+	0x58, 0xf1, 0x89, 0x4f, 0x44, 0x9c, 0x15, 0xbf, 0xc9, 0x14, 0xe3, 0x8e, 0x4b, 0x58, 0x97, 0xb0,
+	0x18, 0xc4, 0xea, 0xa7, 0xb0, 0x8c, 0x92, 0x74, 0xb4, 0x64, 0x86, 0x3c, 0x28, 0xd7, 0x1b, 0xb8,
+	0x1b, 0x84, 0xc4, 0x12, 0x4f, 0x65, 0x7a, 0x67, 0x1a, 0x41, 0x3e, 0x88, 0x60, 0x94, 0x5b, 0x9d,
+	0x16, 0x18, 0x61, 0x8a, 0xbb, 0x2a, 0xd2, 0xfc, 0x47, 0x43, 0xcb, 0x36, 0xde, 0xeb, 0xf1, 0x23,
+	0x08, 0x79, 0xe0, 0x62, 0x0e, 0xfa, 0x03, 0xb4, 0xc8, 0x20, 0xf4, 0x80, 0x16, 0xb5, 0x35, 0xad,
+	0x5a, 0x68, 0x6c, 0xbf, 0x1a, 0x56, 0xb6, 0xfc, 0x80, 0x1f, 0xf5, 0x9c, 0x9a, 0x4b, 0xba, 0x8a,
+	0xa5, 0xfa, 0xb3, 0xc5, 0xbc, 0xb6, 0x2a, 0xbd, 0xe7, 0xba, 0x7b, 0x9e, 0x47, 0x81, 0x31, 0x5b,
+	0x01, 0xe8, 0x4f, 0x34, 0x94, 0x77, 0x81, 0xf2, 0xe0, 0x50, 0x40, 0x17, 0x6f, 0x08, 0xc0, 0x6f,
+	0x5f, 0x0e, 0x2b, 0x4b, 0x14, 0xb7, 0x62, 0xcf, 0xab, 0x61, 0xe5, 0xcb, 0x14, 0x36, 0x73, 0x29,
+	0xef, 0x60, 0x87, 0x59, 0x4d, 0x21, 0xe0, 0x11, 0xf0, 0xc7, 0x84, 0xb6, 0xad, 0xef, 0xc6, 0x95,
+	0x50, 0xe8, 0x12, 0x0e, 0x2d, 0xcc, 0x39, 0x30, 0x2e, 0xfb, 0xff, 0xe9, 0x45, 0x15, 0x3b, 0x5d,
+	0xb2, 0x7e, 0xfb, 0xc7, 0x9f, 0x2a, 0x99, 0xef, 0xcf, 0x8f, 0x37, 0x15, 0x27, 0xf3, 0x33, 0xb4,
+	0x3a, 0x2e, 0xd8, 0x06, 0x16, 0x91, 0x90, 0x81, 0xae, 0xa3, 0x9b, 0x1e, 0xe6, 0x58, 0xc8, 0xce,
+	0xd9, 0xe2, 0x5d, 0x5f, 0x45, 0x8b, 0xd0, 0x87, 0x90, 0x33, 0xc1, 0x3d, 0x67, 0xab, 0x93, 0xb9,
+	0x8e, 0x72, 0xfb, 0x98, 0x71, 0xa0, 0x0f, 0x61, 0xa0, 0xaf, 0xa0, 0x05, 0x67, 0xc0, 0x81, 0xc9,
+	0x86, 0xd9, 0xf2, 0x60, 0xae, 0xa1, 0x6c, 0xec, 0x2c, 0xa1, 0x6c, 0x1b, 0x06, 0xaa, 0x97, 0x4b,
+	0x2f, 0x87, 0x95, 0xf8, 0x68, 0xc7, 0x0f, 0xf3, 0x4f, 0x0d, 0x95, 0xf6, 0x99, 0xff, 0x75, 0xe4,
+	0x61, 0x0e, 0x9f, 0x87, 0x6e, 0x07, 0xf7, 0x61, 0xaf, 0xd3, 0x21, 0x8f, 0x3b, 0x01, 0xe3, 0xfa,
+	0x87, 0x28, 0x87, 0x7b, 0xfc, 0x88, 0xd0, 0x80, 0xcb, 0xf4, 0x5c, 0xa3, 0xf8, 0xeb, 0xcf, 0x5b,
+	0x2b, 0x6a, 0x4a, 0x54, 0xaf, 0x9b, 0x9c, 0x06, 0xa1, 0x6f, 0x5f, 0x84, 0xea, 0x5f, 0xa1, 0x1c,
+	0x1e, 0x81, 0x14, 0x6f, 0xac, 0x65, 0xab, 0xf9, 0x9d, 0xfb, 0xb5, 0x29, 0x33, 0x5b, 0x13, 0x25,
+	0xc1, 0x53, 0x04, 0x1a, 0xb9, 0x93, 0x61, 0x25, 0xf3, 0xfc, 0xfc, 0x78, 0x53, 0xb3, 0x2f, 0x80,
+	0xea, 0xef, 0xc7, 0x3d, 0xbc, 0xa8, 0xf2, 0xf4, 0xfc, 0x78, 0xf3, 0x2d, 0x0a, 0xbe, 0x35, 0x91,
+	0xbf, 0x79, 0x0f, 0xad, 0x4f, 0x74, 0x8e, 0x7a, 0x6e, 0xfe, 0xae, 0xa1, 0xe5, 0x7d, 0xe6, 0xdb,
+	0xd0, 0x27, 0x6d, 0x78, 0x44, 0x3c, 0x60, 0x73, 0xeb, 0x2e, 0xa1, 0x5b, 0x51, 0xcf, 0x69, 0xb5,
+	0x61, 0xc0, 0x84, 0xec, 0x82, 0xbd, 0x14, 0xf5, 0x9c, 0x87, 0x30, 0x60, 0xfa, 0x06, 0x5a, 0x8e,
+	0x3a, 0x98, 0x1f, 0x12, 0xda, 0x6d, 0x05, 0xe1, 0x21, 0x61, 0xc5, 0xec, 0x5a, 0xb6, 0x9a, 0xb3,
+	0x5f, 0x1b, 0x59, 0x1f, 0xc4, 0x46, 0x7d, 0x1d, 0x15, 0xb8, 0xeb, 0xb4, 0xe2, 0x99, 0xea, 0x31,
+	0x60, 0xc5, 0x9b, 0x22, 0x28, 0xcf, 0x5d, 0xa7, 0xa9, 0x4c, 0xf5, 0x8d, 0xcb, 0x6d, 0xd0, 0x55,
+	0x1b, 0x52, 0x1a, 0xcc, 0x06, 0x5a, 0x1d, 0xb7, 0x24, 0x43, 0x56, 0x45, 0xaf, 0x53, 0x61, 0xf6,
+	0x5a, 0x09, 0x5b, 0x4d, 0xb0, 0x5d, 0x56, 0xf6, 0x03, 0x49, 0xda, 0xfc, 0x45, 0x43, 0xb7, 0x93,
+	0x06, 0x1e, 0x88, 0xa5, 0x9d, 0xbb, 0x37, 0x5f, 0xa0, 0x45, 0xb9, 0xf6, 0x62, 0x8c, 0xf3, 0x3b,
+	0xf7, 0xa6, 0x0e, 0x84, 0x2c, 0x96, 0x1e, 0x04, 0x95, 0x5d, 0x7f, 0xfb, 0xb2, 0xfc, 0x37, 0xc7,
+	0xa6, 0x40, 0xa6, 0x9a, 0x25, 0x74, 0xe7, 0x3f, 0xa6, 0xe4, 0x3f, 0xfe, 0x9b, 0x26, 0x7c, 0x4d,
+	0xf7, 0x08, 0xbc, 0x5e, 0x07, 0x9a, 0x00, 0x9e, 0x4d, 0xe4, 0x16, 0xcf, 0x2d, 0xef, 0x00, 0xdd,
+	0xa2, 0x0a, 0x43, 0x09, 0x7c, 0x77, 0xaa, 0xc0, 0x74, 0xd1, 0xb4, 0xcc, 0x04, 0xa5, 0x5e, 0xbb,
+	0x2c, 0xf4, 0xae, 0x12, 0x7a, 0x15, 0x73, 0x73, 0x1d, 0x55, 0x26, 0xb8, 0x46, 0xc2, 0x77, 0x7e,
+	0x58, 0x40, 0xd9, 0x7d, 0xe6, 0xeb, 0x11, 0x2a, 0xd8, 0x82, 0x14, 0xd0, 0xf8, 0x1a, 0xd2, 0xa7,
+	0x2f, 0xe7, 0xf8, 0x5d, 0x65, 0xec, 0xce, 0x10, 0x9c, 0xcc, 0xdc, 0x33, 0x0d, 0xad, 0x4e, 0xba,
+	0x64, 0xa6, 0xe2, 0x4d, 0xdc, 0x5f, 0xe3, 0xe3, 0xf9, 0xf2, 0x12, 0x4a, 0x04, 0xe5, 0xd3, 0x3b,
+	0x7f, 0xff, 0x3a, 0xb8, 0x54, 0xb0, 0xb1, 0x3b, 0x43, 0x70, 0x52, 0x90, 0xa2, 0xc2, 0xd8, 0x26,
+	0xbd, 0xf7, 0xff, 0x04, 0xc8, 0x68, 0xe3, 0x83, 0x59, 0xa2, 0x93, 0x9a, 0x4f, 0x35, 0xb4, 0x72,
+	0xe5, 0x9c, 0x5f, 0x0b, 0x77, 0x55, 0x96, 0xf1, 0xd1, 0x3c, 0x59, 0x23, 0x32, 0xc6, 0xc2, 0x93,
+	0x78, 0xc4, 0x1b, 0xf8, 0xe4, 0xef, 0x72, 0xe6, 0xf9, 0x69, 0x59, 0x3b, 0x39, 0x2d, 0x6b, 0x2f,
+	0x4e, 0xcb, 0xda, 0x5f, 0xa7, 0x65, 0xed, 0xd9, 0x59, 0x39, 0xf3, 0xe2, 0xac, 0x9c, 0xf9, 0xe3,
+	0xac, 0x9c, 0xf9, 0xe6, 0x93, 0x99, 0x7f, 0x8f, 0x83, 0x90, 0x03, 0x0d, 0x71, 0x47, 0x7e, 0x08,
+	0x38, 0x8b, 0xe2, 0xd3, 0x62, 0xf7, 0xdf, 0x01, 0x00, 0x61, 0xbb, 0x0a, 0x4f, 0x50, 0x09, 0x00,
 	0x00,
 }
 
@@ -328,301 +700,1827 @@ func (this *Key) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Register and authenticate new node
-	RegisterAuth(ctx context.Context, in *RaAuthenticate, opts ...grpc.CallOption) (*RaAuthenticateResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) RegisterAuth(ctx context.Context, in *RaAuthenticate, opts ...grpc.CallOption) (*RaAuthenticateResponse, error) {
-	out := new(RaAuthenticateResponse)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/RegisterAuth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *MsgUpdateEnclaveAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Register and authenticate new node
-	RegisterAuth(context.Context, *RaAuthenticate) (*RaAuthenticateResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
-
-func (*UnimplementedMsgServer) RegisterAuth(ctx context.Context, req *RaAuthenticate) (*RaAuthenticateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuth not implemented")
-}
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
 
-func _Msg_RegisterAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaAuthenticate)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateEnclaveAllowlist)
+	if !ok {
+		that2, ok := that.(MsgUpdateEnclaveAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAuth(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Msg/RegisterAuth",
+	if this.Authority != that1.Authority {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAuth(ctx, req.(*RaAuthenticate))
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	for i := range this.Allowlist {
+		if !this.Allowlist[i].Equal(&that1.Allowlist[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgUpdateEnclaveAllowlistResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "secret.registration.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterAuth",
-			Handler:    _Msg_RegisterAuth_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "secret/registration/v1beta1/msg.proto",
+	that1, ok := that.(*MsgUpdateEnclaveAllowlistResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateEnclaveAllowlistResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgRevokeNodes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RaAuthenticate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRevokeNodes)
+	if !ok {
+		that2, ok := that.(MsgRevokeNodes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if len(this.PubKeys) != len(that1.PubKeys) {
+		return false
+	}
+	for i := range this.PubKeys {
+		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
+			return false
+		}
+	}
+	if len(this.PlatformInfos) != len(that1.PlatformInfos) {
+		return false
+	}
+	for i := range this.PlatformInfos {
+		if this.PlatformInfos[i] != that1.PlatformInfos[i] {
+			return false
+		}
+	}
+	if len(this.TcbStatuses) != len(that1.TcbStatuses) {
+		return false
+	}
+	for i := range this.TcbStatuses {
+		if this.TcbStatuses[i] != that1.TcbStatuses[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgRevokeNodesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RaAuthenticate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgRevokeNodesResponse)
+	if !ok {
+		that2, ok := that.(MsgRevokeNodesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RevokedPubKeys) != len(that1.RevokedPubKeys) {
+		return false
+	}
+	for i := range this.RevokedPubKeys {
+		if !bytes.Equal(this.RevokedPubKeys[i], that1.RevokedPubKeys[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RaAuthenticate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Certificate) > 0 {
-		i -= len(m.Certificate)
-		copy(dAtA[i:], m.Certificate)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Certificate)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RaAuthenticateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgScheduleSeedRotation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RaAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgScheduleSeedRotation)
+	if !ok {
+		that2, ok := that.(MsgScheduleSeedRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Rotation.Equal(&that1.Rotation) {
+		return false
+	}
+	return true
 }
+func (this *MsgScheduleSeedRotationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RaAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		i -= len(m.Events)
-		copy(dAtA[i:], m.Events)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Events)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*MsgScheduleSeedRotationResponse)
+	if !ok {
+		that2, ok := that.(MsgScheduleSeedRotationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *MasterKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Register and authenticate new node
+	RegisterAuth(ctx context.Context, in *RaAuthenticate, opts ...grpc.CallOption) (*RaAuthenticateResponse, error)
+	// Replace the allowlist of enclaves that may register
+	UpdateEnclaveAllowlist(ctx context.Context, in *MsgUpdateEnclaveAllowlist, opts ...grpc.CallOption) (*MsgUpdateEnclaveAllowlistResponse, error)
+	// Revoke registered nodes, so they can't fetch the seed again
+	RevokeNodes(ctx context.Context, in *MsgRevokeNodes, opts ...grpc.CallOption) (*MsgRevokeNodesResponse, error)
+	// Update the parameters of the module
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Schedule the network to move to a new consensus seed version
+	ScheduleSeedRotation(ctx context.Context, in *MsgScheduleSeedRotation, opts ...grpc.CallOption) (*MsgScheduleSeedRotationResponse, error)
 }
 
-func (m *MasterKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MasterKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bytes) > 0 {
-		i -= len(m.Bytes)
-		copy(dAtA[i:], m.Bytes)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Bytes)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *Key) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterAuth(ctx context.Context, in *RaAuthenticate, opts ...grpc.CallOption) (*RaAuthenticateResponse, error) {
+	out := new(RaAuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/RegisterAuth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateEnclaveAllowlist(ctx context.Context, in *MsgUpdateEnclaveAllowlist, opts ...grpc.CallOption) (*MsgUpdateEnclaveAllowlistResponse, error) {
+	out := new(MsgUpdateEnclaveAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/UpdateEnclaveAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (c *msgClient) RevokeNodes(ctx context.Context, in *MsgRevokeNodes, opts ...grpc.CallOption) (*MsgRevokeNodesResponse, error) {
+	out := new(MsgRevokeNodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/RevokeNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleSeedRotation(ctx context.Context, in *MsgScheduleSeedRotation, opts ...grpc.CallOption) (*MsgScheduleSeedRotationResponse, error) {
+	out := new(MsgScheduleSeedRotationResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/ScheduleSeedRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Register and authenticate new node
+	RegisterAuth(context.Context, *RaAuthenticate) (*RaAuthenticateResponse, error)
+	// Replace the allowlist of enclaves that may register
+	UpdateEnclaveAllowlist(context.Context, *MsgUpdateEnclaveAllowlist) (*MsgUpdateEnclaveAllowlistResponse, error)
+	// Revoke registered nodes, so they can't fetch the seed again
+	RevokeNodes(context.Context, *MsgRevokeNodes) (*MsgRevokeNodesResponse, error)
+	// Update the parameters of the module
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Schedule the network to move to a new consensus seed version
+	ScheduleSeedRotation(context.Context, *MsgScheduleSeedRotation) (*MsgScheduleSeedRotationResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAuth(ctx context.Context, req *RaAuthenticate) (*RaAuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuth not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEnclaveAllowlist(ctx context.Context, req *MsgUpdateEnclaveAllowlist) (*MsgUpdateEnclaveAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnclaveAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) RevokeNodes(ctx context.Context, req *MsgRevokeNodes) (*MsgRevokeNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNodes not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleSeedRotation(ctx context.Context, req *MsgScheduleSeedRotation) (*MsgScheduleSeedRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSeedRotation not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaAuthenticate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/RegisterAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAuth(ctx, req.(*RaAuthenticate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEnclaveAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEnclaveAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEnclaveAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/UpdateEnclaveAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEnclaveAllowlist(ctx, req.(*MsgUpdateEnclaveAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeNodes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/RevokeNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeNodes(ctx, req.(*MsgRevokeNodes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleSeedRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSeedRotation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSeedRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/ScheduleSeedRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSeedRotation(ctx, req.(*MsgScheduleSeedRotation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.registration.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAuth",
+			Handler:    _Msg_RegisterAuth_Handler,
+		},
+		{
+			MethodName: "UpdateEnclaveAllowlist",
+			Handler:    _Msg_UpdateEnclaveAllowlist_Handler,
+		},
+		{
+			MethodName: "RevokeNodes",
+			Handler:    _Msg_RevokeNodes_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ScheduleSeedRotation",
+			Handler:    _Msg_ScheduleSeedRotation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/registration/v1beta1/msg.proto",
+}
+
+func (m *RaAuthenticate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaAuthenticate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaAuthenticate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Key)))
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsg(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RaAuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RaAuthenticate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RaAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	if len(m.Events) > 0 {
+		i -= len(m.Events)
+		copy(dAtA[i:], m.Events)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Events)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Certificate)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RaAuthenticateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MasterKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MasterKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MasterKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
-	}
-	l = len(m.Events)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	if len(m.Bytes) > 0 {
+		i -= len(m.Bytes)
+		copy(dAtA[i:], m.Bytes)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Bytes)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MasterKey) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Key) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bytes)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEnclaveAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEnclaveAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEnclaveAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEnclaveAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEnclaveAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEnclaveAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TcbStatuses) > 0 {
+		for iNdEx := len(m.TcbStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TcbStatuses[iNdEx])
+			copy(dAtA[i:], m.TcbStatuses[iNdEx])
+			i = encodeVarintMsg(dAtA, i, uint64(len(m.TcbStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PlatformInfos) > 0 {
+		for iNdEx := len(m.PlatformInfos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlatformInfos[iNdEx])
+			copy(dAtA[i:], m.PlatformInfos[iNdEx])
+			i = encodeVarintMsg(dAtA, i, uint64(len(m.PlatformInfos[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintMsg(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedPubKeys) > 0 {
+		for iNdEx := len(m.RevokedPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedPubKeys[iNdEx])
+			copy(dAtA[i:], m.RevokedPubKeys[iNdEx])
+			i = encodeVarintMsg(dAtA, i, uint64(len(m.RevokedPubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleSeedRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSeedRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSeedRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleSeedRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSeedRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSeedRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RaAuthenticate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *RaAuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Events)
 	if l > 0 {
 		n += 1 + l + sovMsg(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MasterKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bytes)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateEnclaveAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovMsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateEnclaveAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeNodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovMsg(uint64(l))
+		}
+	}
+	if len(m.PlatformInfos) > 0 {
+		for _, s := range m.PlatformInfos {
+			l = len(s)
+			n += 1 + l + sovMsg(uint64(l))
+		}
+	}
+	if len(m.TcbStatuses) > 0 {
+		for _, s := range m.TcbStatuses {
+			l = len(s)
+			n += 1 + l + sovMsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RevokedPubKeys) > 0 {
+		for _, b := range m.RevokedPubKeys {
+			l = len(b)
+			n += 1 + l + sovMsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsg(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleSeedRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = m.Rotation.Size()
+	n += 1 + l + sovMsg(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleSeedRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsg(x uint64) (n int) {
+	return sovMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RaAuthenticate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaAuthenticate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaAuthenticate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaAuthenticateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MasterKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MasterKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MasterKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Key) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Key: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEnclaveAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEnclaveAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEnclaveAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, AllowedEnclave{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEnclaveAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEnclaveAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEnclaveAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeNodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PubKeys[len(m.PubKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformInfos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformInfos = append(m.PlatformInfos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TcbStatuses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TcbStatuses = append(m.TcbStatuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMsg(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsg(x uint64) (n int) {
-	return sovMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RaAuthenticate) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeNodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -645,49 +2543,15 @@ func (m *RaAuthenticate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaAuthenticate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeNodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaAuthenticate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsg
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedPubKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -714,10 +2578,8 @@ func (m *RaAuthenticate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Certificate == nil {
-				m.Certificate = []byte{}
-			}
+			m.RevokedPubKeys = append(m.RevokedPubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.RevokedPubKeys[len(m.RevokedPubKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -740,7 +2602,7 @@ func (m *RaAuthenticate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -763,15 +2625,15 @@ func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaAuthenticateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -799,13 +2661,13 @@ func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsg
@@ -815,23 +2677,24 @@ func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -854,7 +2717,7 @@ func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MasterKey) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,46 +2740,12 @@ func (m *MasterKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MasterKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MasterKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsg
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsg(dAtA[iNdEx:])
@@ -938,7 +2767,7 @@ func (m *MasterKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Key) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleSeedRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -961,17 +2790,17 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Key: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleSeedRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleSeedRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsg
@@ -981,24 +2810,55 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsg
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1022,6 +2882,56 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleSeedRotationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSeedRotationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSeedRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsg(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -96,3 +96,149 @@ func TestMsgSendGetSigners(t *testing.T) {
 	res := msg.GetSigners()
 	require.Equal(t, fmt.Sprintf("%v", res), "[71776C6E6D786A377072707838727973786D3275]")
 }
+
+func TestMsgUpdateEnclaveAllowlistValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("qwlnmxj7prpx8rysxm2u")).String()
+	mr := make([]byte, MeasurementLength)
+	mr[0] = 1
+
+	cases := map[string]struct {
+		valid bool
+		msg   MsgUpdateEnclaveAllowlist
+	}{
+		"empty allowlist":          {true, MsgUpdateEnclaveAllowlist{Authority: authority}},
+		"mr_enclave":               {true, MsgUpdateEnclaveAllowlist{authority, []AllowedEnclave{{MrEnclave: mr, MinIsvSvn: 2}}}},
+		"mr_signer and mr_enclave": {true, MsgUpdateEnclaveAllowlist{authority, []AllowedEnclave{{MrEnclave: mr, MrSigner: mr}}}},
+		"bad authority":            {false, MsgUpdateEnclaveAllowlist{"foo", nil}},
+		"no measurement":           {false, MsgUpdateEnclaveAllowlist{authority, []AllowedEnclave{{MinIsvSvn: 2}}}},
+		"short mr_signer":          {false, MsgUpdateEnclaveAllowlist{authority, []AllowedEnclave{{MrSigner: mr[:31]}}}},
+		"isv_svn too big":          {false, MsgUpdateEnclaveAllowlist{authority, []AllowedEnclave{{MrSigner: mr, MinIsvSvn: 0x10000}}}},
+		"duplicate entry":          {false, MsgUpdateEnclaveAllowlist{authority, []AllowedEnclave{{MrSigner: mr}, {MrSigner: mr}}}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeNodesValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("qwlnmxj7prpx8rysxm2u")).String()
+	pk := make([]byte, 32)
+
+	cases := map[string]struct {
+		valid bool
+		msg   MsgRevokeNodes
+	}{
+		"pub key":             {true, MsgRevokeNodes{Authority: authority, PubKeys: [][]byte{pk}}},
+		"platform info":       {true, MsgRevokeNodes{Authority: authority, PlatformInfos: []string{"00906ED50000"}}},
+		"tcb status":          {true, MsgRevokeNodes{Authority: authority, TcbStatuses: []string{"OutOfDate"}}},
+		"bad authority":       {false, MsgRevokeNodes{Authority: "foo", PubKeys: [][]byte{pk}}},
+		"nothing selected":    {false, MsgRevokeNodes{Authority: authority}},
+		"empty pub key":       {false, MsgRevokeNodes{Authority: authority, PubKeys: [][]byte{{}}}},
+		"duplicate pub key":   {false, MsgRevokeNodes{Authority: authority, PubKeys: [][]byte{pk, pk}}},
+		"empty platform info": {false, MsgRevokeNodes{Authority: authority, PlatformInfos: []string{""}}},
+		"empty tcb status":    {false, MsgRevokeNodes{Authority: authority, TcbStatuses: []string{""}}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgScheduleSeedRotationValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("qwlnmxj7prpx8rysxm2u")).String()
+
+	cases := map[string]struct {
+		valid bool
+		msg   MsgScheduleSeedRotation
+	}{
+		"next version":      {true, MsgScheduleSeedRotation{Authority: authority, Rotation: SeedRotation{Version: 3, Height: 100}}},
+		"bad authority":     {false, MsgScheduleSeedRotation{Authority: "foo", Rotation: SeedRotation{Version: 3, Height: 100}}},
+		"current version":   {false, MsgScheduleSeedRotation{Authority: authority, Rotation: SeedRotation{Version: SeedConfigVersion, Height: 100}}},
+		"version too high":  {false, MsgScheduleSeedRotation{Authority: authority, Rotation: SeedRotation{Version: MaxSeedVersion + 1, Height: 100}}},
+		"no height":         {false, MsgScheduleSeedRotation{Authority: authority, Rotation: SeedRotation{Version: 3}}},
+		"negative height":   {false, MsgScheduleSeedRotation{Authority: authority, Rotation: SeedRotation{Version: 3, Height: -1}}},
+		"no rotation given": {false, MsgScheduleSeedRotation{Authority: authority}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisSeedRotation(t *testing.T) {
+	pk := make([]byte, 32)
+	seed := make([]byte, EncryptedSeedLength(3)/2)
+
+	cases := map[string]struct {
+		valid bool
+		state GenesisState
+	}{
+		"never rotated": {true, GenesisState{}},
+		"rotated": {true, GenesisState{
+			SeedVersion:    3,
+			VersionedSeeds: []VersionedEncryptedSeed{{Version: 3, PubKey: pk, EncryptedSeed: seed}},
+		}},
+		"scheduled": {true, GenesisState{ScheduledSeedRotation: &SeedRotation{Version: 3, Height: 10}}},
+		"scheduled version skipped": {false, GenesisState{
+			ScheduledSeedRotation: &SeedRotation{Version: 4, Height: 10},
+		}},
+		"seed version too high": {false, GenesisState{SeedVersion: MaxSeedVersion + 1}},
+		"seed of a future version": {false, GenesisState{
+			VersionedSeeds: []VersionedEncryptedSeed{{Version: 3, PubKey: pk, EncryptedSeed: seed}},
+		}},
+		"rotation under way": {true, GenesisState{
+			ScheduledSeedRotation: &SeedRotation{Version: 3, Height: 10},
+			VersionedSeeds:        []VersionedEncryptedSeed{{Version: 3, PubKey: pk, EncryptedSeed: seed}},
+		}},
+		"duplicate seed": {false, GenesisState{
+			SeedVersion: 3,
+			VersionedSeeds: []VersionedEncryptedSeed{
+				{Version: 3, PubKey: pk, EncryptedSeed: seed},
+				{Version: 3, PubKey: pk, EncryptedSeed: seed},
+			},
+		}},
+		"empty seed": {false, GenesisState{
+			SeedVersion:    3,
+			VersionedSeeds: []VersionedEncryptedSeed{{Version: 3, PubKey: pk}},
+		}},
+		"seed of the previous version": {false, GenesisState{
+			SeedVersion:    3,
+			VersionedSeeds: []VersionedEncryptedSeed{{Version: 3, PubKey: pk, EncryptedSeed: seed[:EncryptedKeyLength/2]}},
+		}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.state.IoMasterKey = &MasterKey{}
+			tc.state.NodeExchMasterKey = &MasterKey{}
+			err := ValidateGenesis(tc.state)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrSeedRotation)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// NewNodeAttestation converts the summary of a certificate to what the registry stores
+func NewNodeAttestation(summary *ra.AttestationSummary) *NodeAttestation {
+	attestation := &NodeAttestation{
+		Type:         summary.Type,
+		TcbStatus:    summary.TcbStatus,
+		AdvisoryIds:  summary.AdvisoryIDs,
+		PlatformInfo: summary.PlatformInfo,
+	}
+
+	if summary.Report != nil {
+		attestation.MrEnclave = summary.Report.MrEnclave[:]
+		attestation.MrSigner = summary.Report.MrSigner[:]
+		attestation.IsvProdId = uint32(summary.Report.IsvProdID)
+		attestation.IsvSvn = uint32(summary.Report.IsvSvn)
+	}
+
+	return attestation
+}
+
+// NewRegisteredNode returns the registry entry of the node with the given public key
+func NewRegisteredNode(publicKey NodeID, info RegistrationNodeInfo, revoked bool) RegisteredNode {
+	node := RegisteredNode{
+		PubKey:             publicKey,
+		RegistrationHeight: info.RegistrationHeight,
+		Attestation:        info.Attestation,
+		Revoked:            revoked,
+	}
+
+	if len(info.Sender) != 0 {
+		node.Sender = info.Sender.String()
+	}
+
+	return node
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// DefaultParams returns default registration parameters
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate validates all parameters
+func (p Params) Validate() error {
+	return p.AttestationPolicy.Validate()
+}
+
+func (p AttestationPolicy) Validate() error {
+	if p.MaxReportAge < 0 {
+		return errorsmod.Wrap(ErrInvalid, "max_report_age must not be negative")
+	}
+	if err := validateUniqueStrings("allowed_quote_statuses", p.AllowedQuoteStatuses); err != nil {
+		return err
+	}
+	return validateUniqueStrings("denied_advisory_ids", p.DeniedAdvisoryIds)
+}
+
+func validateUniqueStrings(name string, values []string) error {
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if value == "" {
+			return errorsmod.Wrapf(ErrInvalid, "%s: empty value", name)
+		}
+		if seen[value] {
+			return errorsmod.Wrapf(ErrInvalid, "%s: duplicate value %s", name, value)
+		}
+		seen[value] = true
+	}
+	return nil
+}
+
+// Check fails unless the attestation satisfies the policy at the given (block) time
+func (p AttestationPolicy) Check(summary *ra.AttestationSummary, now time.Time) error {
+	if p.MaxReportAge > 0 && !summary.Timestamp.IsZero() {
+		if age := now.Sub(summary.Timestamp); age > p.MaxReportAge {
+			return errorsmod.Wrapf(ErrAttestationPolicy, "report is %s old, the maximum is %s", age, p.MaxReportAge)
+		}
+	}
+
+	if len(p.AllowedQuoteStatuses) != 0 && !containsString(p.AllowedQuoteStatuses, summary.TcbStatus) {
+		return errorsmod.Wrapf(ErrAttestationPolicy, "quote status %s is not allowed", summary.TcbStatus)
+	}
+
+	for _, advisoryID := range summary.AdvisoryIDs {
+		if containsString(p.DeniedAdvisoryIds, advisoryID) {
+			return errorsmod.Wrapf(ErrAttestationPolicy, "platform is affected by %s", advisoryID)
+		}
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
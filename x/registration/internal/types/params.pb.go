@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/registration/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the registration module.
+type Params struct {
+	AttestationPolicy AttestationPolicy `protobuf:"bytes,1,opt,name=attestation_policy,json=attestationPolicy,proto3" json:"attestation_policy"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19c75792d39b095b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// AttestationPolicy is what new registrations must satisfy on top of a valid
+// attestation. The default policy accepts any valid attestation.
+type AttestationPolicy struct {
+	// max_report_age is how old an EPID report, or the TCB info that a DCAP
+	// quote is verified with, may be relative to the block time. Zero accepts
+	// reports of any age.
+	MaxReportAge time.Duration `protobuf:"bytes,1,opt,name=max_report_age,json=maxReportAge,proto3,stdduration" json:"max_report_age"`
+	// allowed_quote_statuses are the accepted EPID quote statuses and DCAP TCB
+	// statuses. Empty accepts any status.
+	AllowedQuoteStatuses []string `protobuf:"bytes,2,rep,name=allowed_quote_statuses,json=allowedQuoteStatuses,proto3" json:"allowed_quote_statuses,omitempty"`
+	// denied_advisory_ids are the Intel security advisories that the platform
+	// must not be affected by.
+	DeniedAdvisoryIds []string `protobuf:"bytes,3,rep,name=denied_advisory_ids,json=deniedAdvisoryIds,proto3" json:"denied_advisory_ids,omitempty"`
+}
+
+func (m *AttestationPolicy) Reset()         { *m = AttestationPolicy{} }
+func (m *AttestationPolicy) String() string { return proto.CompactTextString(m) }
+func (*AttestationPolicy) ProtoMessage()    {}
+func (*AttestationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19c75792d39b095b, []int{1}
+}
+func (m *AttestationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPolicy.Merge(m, src)
+}
+func (m *AttestationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPolicy proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "secret.registration.v1beta1.Params")
+	proto.RegisterType((*AttestationPolicy)(nil), "secret.registration.v1beta1.AttestationPolicy")
+}
+
+func init() {
+	proto.RegisterFile("secret/registration/v1beta1/params.proto", fileDescriptor_19c75792d39b095b)
+}
+
+var fileDescriptor_19c75792d39b095b = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbb, 0xce, 0xd3, 0x30,
+	0x1c, 0xc5, 0x63, 0x2a, 0x55, 0x34, 0x20, 0xa4, 0x84, 0x0a, 0x95, 0x22, 0xb9, 0x55, 0xa7, 0x8a,
+	0xc1, 0x56, 0x81, 0x1d, 0xb5, 0x62, 0xe9, 0x82, 0x4a, 0xba, 0xb1, 0x44, 0x4e, 0xf2, 0xc7, 0xb5,
+	0x48, 0xe2, 0x60, 0x3b, 0xbd, 0xbc, 0x05, 0x23, 0x8f, 0xd0, 0x91, 0x87, 0x60, 0xe8, 0xd8, 0x91,
+	0x89, 0x4b, 0x3a, 0xf0, 0x1a, 0x28, 0x4e, 0x10, 0xdf, 0x45, 0xfa, 0x96, 0x28, 0xc9, 0xef, 0xfc,
+	0x74, 0x72, 0x22, 0xbb, 0x53, 0x0d, 0xb1, 0x02, 0x43, 0x15, 0x70, 0xa1, 0x8d, 0x62, 0x46, 0xc8,
+	0x9c, 0x6e, 0x67, 0x11, 0x18, 0x36, 0xa3, 0x05, 0x53, 0x2c, 0xd3, 0xa4, 0x50, 0xd2, 0x48, 0xff,
+	0x59, 0x93, 0x24, 0x57, 0x93, 0xa4, 0x4d, 0x0e, 0xfb, 0x5c, 0x72, 0x69, 0x73, 0xb4, 0xbe, 0x6b,
+	0x94, 0x21, 0xe6, 0x52, 0xf2, 0x14, 0xa8, 0x7d, 0x8a, 0xca, 0x0f, 0x34, 0x29, 0x5b, 0xaf, 0xe1,
+	0x1e, 0xcb, 0x44, 0x2e, 0xa9, 0xbd, 0x36, 0xaf, 0x26, 0xca, 0xed, 0xae, 0x6c, 0xab, 0xbf, 0x71,
+	0x7d, 0x66, 0x0c, 0x68, 0x63, 0x8d, 0xb0, 0x90, 0xa9, 0x88, 0x0f, 0x03, 0x34, 0x46, 0xd3, 0x07,
+	0x2f, 0x08, 0xb9, 0xe3, 0x63, 0xc8, 0xfc, 0xbf, 0xb6, 0xb2, 0xd6, 0xa2, 0x77, 0xfa, 0x31, 0x72,
+	0x8e, 0x7f, 0xbe, 0x3e, 0x47, 0x81, 0xc7, 0x6e, 0xd2, 0xc9, 0x37, 0xe4, 0x7a, 0xb7, 0x1c, 0x7f,
+	0xe9, 0x3e, 0xca, 0xd8, 0x3e, 0x54, 0x50, 0x48, 0x65, 0x42, 0xc6, 0xa1, 0xed, 0x7e, 0x4a, 0x9a,
+	0x55, 0xe4, 0xdf, 0x2a, 0xf2, 0xa6, 0x5d, 0xb5, 0xb8, 0x5f, 0xd7, 0x7c, 0xf9, 0x39, 0x42, 0xc1,
+	0xc3, 0x8c, 0xed, 0x03, 0x6b, 0xce, 0x39, 0xf8, 0xaf, 0xdc, 0x27, 0x2c, 0x4d, 0xe5, 0x0e, 0x92,
+	0xf0, 0x53, 0x29, 0x0d, 0x84, 0x75, 0x53, 0xa9, 0x41, 0x0f, 0xee, 0x8d, 0x3b, 0xd3, 0x5e, 0xd0,
+	0x6f, 0xe9, 0xbb, 0x1a, 0xae, 0x5b, 0xe6, 0x13, 0xf7, 0x71, 0x02, 0xb9, 0x80, 0x24, 0x64, 0xc9,
+	0x56, 0x68, 0xa9, 0x0e, 0xa1, 0x48, 0xf4, 0xa0, 0x63, 0x15, 0xaf, 0x41, 0xf3, 0x96, 0x2c, 0x13,
+	0xbd, 0x60, 0xa7, 0xdf, 0xd8, 0x39, 0x56, 0x18, 0x9d, 0x2a, 0x8c, 0xce, 0x15, 0x46, 0xbf, 0x2a,
+	0x8c, 0x3e, 0x5f, 0xb0, 0x73, 0xbe, 0x60, 0xe7, 0xfb, 0x05, 0x3b, 0xef, 0x5f, 0x73, 0x61, 0x36,
+	0x65, 0x44, 0x62, 0x99, 0x51, 0x1d, 0x2b, 0x93, 0xb2, 0x48, 0xd3, 0xb5, 0xfd, 0x93, 0x6f, 0xc1,
+	0xec, 0xa4, 0xfa, 0x48, 0xf7, 0xd7, 0x4f, 0x82, 0xc8, 0x0d, 0xa8, 0x9c, 0xa5, 0xd4, 0x1c, 0x0a,
+	0xd0, 0x51, 0xd7, 0x6e, 0x7e, 0xf9, 0x77, 0x00, 0x0a, 0xfc, 0x8d, 0x84, 0x36, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AttestationPolicy.Equal(&that1.AttestationPolicy) {
+		return false
+	}
+	return true
+}
+func (this *AttestationPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AttestationPolicy)
+	if !ok {
+		that2, ok := that.(AttestationPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxReportAge != that1.MaxReportAge {
+		return false
+	}
+	if len(this.AllowedQuoteStatuses) != len(that1.AllowedQuoteStatuses) {
+		return false
+	}
+	for i := range this.AllowedQuoteStatuses {
+		if this.AllowedQuoteStatuses[i] != that1.AllowedQuoteStatuses[i] {
+			return false
+		}
+	}
+	if len(this.DeniedAdvisoryIds) != len(that1.DeniedAdvisoryIds) {
+		return false
+	}
+	for i := range this.DeniedAdvisoryIds {
+		if this.DeniedAdvisoryIds[i] != that1.DeniedAdvisoryIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AttestationPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedAdvisoryIds) > 0 {
+		for iNdEx := len(m.DeniedAdvisoryIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedAdvisoryIds[iNdEx])
+			copy(dAtA[i:], m.DeniedAdvisoryIds[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedAdvisoryIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedQuoteStatuses) > 0 {
+		for iNdEx := len(m.AllowedQuoteStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedQuoteStatuses[iNdEx])
+			copy(dAtA[i:], m.AllowedQuoteStatuses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedQuoteStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxReportAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxReportAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AttestationPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *AttestationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxReportAge)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedQuoteStatuses) > 0 {
+		for _, s := range m.AllowedQuoteStatuses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedAdvisoryIds) > 0 {
+		for _, s := range m.DeniedAdvisoryIds {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxReportAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedQuoteStatuses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedQuoteStatuses = append(m.AllowedQuoteStatuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedAdvisoryIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedAdvisoryIds = append(m.DeniedAdvisoryIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+)
+
+// readManipulatedReport parses the recorded EPID certificate after replacing parts of its report. The replacements
+// keep the length of the certificate, and break Intel's signature, which the policy doesn't look at
+func readManipulatedReport(t *testing.T, replacements ...string) *ra.AttestationSummary {
+	cert, err := os.ReadFile("../../testdata/attestation_cert_hw_old")
+	require.NoError(t, err)
+
+	for i := 0; i < len(replacements); i += 2 {
+		old, new := []byte(replacements[i]), []byte(replacements[i+1])
+		require.Equal(t, len(old), len(new))
+		require.True(t, bytes.Contains(cert, old), replacements[i])
+		cert = bytes.Replace(cert, old, new, 1)
+	}
+
+	summary, err := ra.ParseAttestation(cert)
+	require.NoError(t, err)
+	return summary
+}
+
+func TestAttestationPolicyCheck(t *testing.T) {
+	// the recorded report is from 2020-05-09, with status SW_HARDENING_NEEDED and advisory INTEL-SA-00334
+	recorded := readManipulatedReport(t)
+	blockTime := time.Date(2020, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		policy  AttestationPolicy
+		summary *ra.AttestationSummary
+		valid   bool
+	}{
+		"default policy": {AttestationPolicy{}, recorded, true},
+		"fresh report":   {AttestationPolicy{MaxReportAge: 24 * time.Hour}, recorded, true},
+		"stale report": {
+			AttestationPolicy{MaxReportAge: time.Hour},
+			recorded,
+			false,
+		},
+		"fresh manipulated timestamp": {
+			AttestationPolicy{MaxReportAge: time.Hour},
+			readManipulatedReport(t, `"timestamp":"2020-05-09T10:10:11.895730"`, `"timestamp":"2020-05-09T23:30:00.000000"`),
+			true,
+		},
+		"allowed status": {
+			AttestationPolicy{AllowedQuoteStatuses: []string{"OK", "SW_HARDENING_NEEDED"}},
+			recorded,
+			true,
+		},
+		"status not allowed": {
+			AttestationPolicy{AllowedQuoteStatuses: []string{"OK"}},
+			recorded,
+			false,
+		},
+		"manipulated status not allowed": {
+			AttestationPolicy{AllowedQuoteStatuses: []string{"SW_HARDENING_NEEDED"}},
+			readManipulatedReport(t, `"SW_HARDENING_NEEDED",`, `"GROUP_OUT_OF_DATE"  ,`),
+			false,
+		},
+		"denied advisory": {
+			AttestationPolicy{DeniedAdvisoryIds: []string{"INTEL-SA-00334"}},
+			recorded,
+			false,
+		},
+		"other advisory denied": {
+			AttestationPolicy{DeniedAdvisoryIds: []string{"INTEL-SA-00615"}},
+			recorded,
+			true,
+		},
+		"manipulated advisory denied": {
+			AttestationPolicy{DeniedAdvisoryIds: []string{"INTEL-SA-00615"}},
+			readManipulatedReport(t, `"INTEL-SA-00334"`, `"INTEL-SA-00615"`),
+			false,
+		},
+		"dcap quotes are not timestamped": {
+			AttestationPolicy{MaxReportAge: time.Hour},
+			&ra.AttestationSummary{Type: ra.AttestationTypeDcap, TcbStatus: ra.TcbStatusUpToDate},
+			true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, tc.policy.Validate())
+
+			err := tc.policy.Check(tc.summary, blockTime)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrAttestationPolicy)
+			}
+		})
+	}
+}
+
+func TestAttestationPolicyValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+	require.Error(t, AttestationPolicy{MaxReportAge: -time.Second}.Validate())
+	require.Error(t, AttestationPolicy{AllowedQuoteStatuses: []string{""}}.Validate())
+	require.Error(t, AttestationPolicy{AllowedQuoteStatuses: []string{"OK", "OK"}}.Validate())
+	require.Error(t, AttestationPolicy{DeniedAdvisoryIds: []string{"INTEL-SA-00334", "INTEL-SA-00334"}}.Validate())
+}
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -105,9 +106,436 @@ func (m *QueryEncryptedSeedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEncryptedSeedResponse proto.InternalMessageInfo
 
+type QueryEnclaveAllowlistResponse struct {
+	Allowlist []AllowedEnclave `protobuf:"bytes,1,rep,name=allowlist,proto3" json:"allowlist"`
+}
+
+func (m *QueryEnclaveAllowlistResponse) Reset()         { *m = QueryEnclaveAllowlistResponse{} }
+func (m *QueryEnclaveAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEnclaveAllowlistResponse) ProtoMessage()    {}
+func (*QueryEnclaveAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{2}
+}
+func (m *QueryEnclaveAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnclaveAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnclaveAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEnclaveAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnclaveAllowlistResponse.Merge(m, src)
+}
+func (m *QueryEnclaveAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnclaveAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnclaveAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEnclaveAllowlistResponse proto.InternalMessageInfo
+
+// RegisteredNode is what is known about a registered node
+type RegisteredNode struct {
+	PubKey             []byte           `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	RegistrationHeight int64            `protobuf:"varint,2,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+	Sender             string           `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Attestation        *NodeAttestation `protobuf:"bytes,4,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	Revoked            bool             `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *RegisteredNode) Reset()         { *m = RegisteredNode{} }
+func (m *RegisteredNode) String() string { return proto.CompactTextString(m) }
+func (*RegisteredNode) ProtoMessage()    {}
+func (*RegisteredNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{3}
+}
+func (m *RegisteredNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredNode.Merge(m, src)
+}
+func (m *RegisteredNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredNode proto.InternalMessageInfo
+
+type QueryRegisteredNodesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredNodesRequest) Reset()         { *m = QueryRegisteredNodesRequest{} }
+func (m *QueryRegisteredNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodesRequest) ProtoMessage()    {}
+func (*QueryRegisteredNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{4}
+}
+func (m *QueryRegisteredNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodesRequest.Merge(m, src)
+}
+func (m *QueryRegisteredNodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredNodesRequest proto.InternalMessageInfo
+
+type QueryRegisteredNodesResponse struct {
+	Nodes      []RegisteredNode    `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredNodesResponse) Reset()         { *m = QueryRegisteredNodesResponse{} }
+func (m *QueryRegisteredNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodesResponse) ProtoMessage()    {}
+func (*QueryRegisteredNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{5}
+}
+func (m *QueryRegisteredNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodesResponse.Merge(m, src)
+}
+func (m *QueryRegisteredNodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredNodesResponse proto.InternalMessageInfo
+
+type QueryRegisteredNodeRequest struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryRegisteredNodeRequest) Reset()         { *m = QueryRegisteredNodeRequest{} }
+func (m *QueryRegisteredNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodeRequest) ProtoMessage()    {}
+func (*QueryRegisteredNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{6}
+}
+func (m *QueryRegisteredNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodeRequest.Merge(m, src)
+}
+func (m *QueryRegisteredNodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredNodeRequest proto.InternalMessageInfo
+
+type QueryRegisteredNodeResponse struct {
+	Node RegisteredNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node"`
+}
+
+func (m *QueryRegisteredNodeResponse) Reset()         { *m = QueryRegisteredNodeResponse{} }
+func (m *QueryRegisteredNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodeResponse) ProtoMessage()    {}
+func (*QueryRegisteredNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{7}
+}
+func (m *QueryRegisteredNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodeResponse.Merge(m, src)
+}
+func (m *QueryRegisteredNodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredNodeResponse proto.InternalMessageInfo
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+type QuerySeedRotationRequest struct {
+}
+
+func (m *QuerySeedRotationRequest) Reset()         { *m = QuerySeedRotationRequest{} }
+func (m *QuerySeedRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySeedRotationRequest) ProtoMessage()    {}
+func (*QuerySeedRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{10}
+}
+func (m *QuerySeedRotationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySeedRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySeedRotationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySeedRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySeedRotationRequest.Merge(m, src)
+}
+func (m *QuerySeedRotationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySeedRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySeedRotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySeedRotationRequest proto.InternalMessageInfo
+
+type QuerySeedRotationResponse struct {
+	CurrentVersion uint32 `protobuf:"varint,1,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// scheduled is the rotation that did not take effect yet, if any
+	Scheduled *SeedRotation `protobuf:"bytes,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+}
+
+func (m *QuerySeedRotationResponse) Reset()         { *m = QuerySeedRotationResponse{} }
+func (m *QuerySeedRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySeedRotationResponse) ProtoMessage()    {}
+func (*QuerySeedRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{11}
+}
+func (m *QuerySeedRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySeedRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySeedRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySeedRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySeedRotationResponse.Merge(m, src)
+}
+func (m *QuerySeedRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySeedRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySeedRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySeedRotationResponse proto.InternalMessageInfo
+
+type QueryEncryptedSeedByVersionRequest struct {
+	PubKey  []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryEncryptedSeedByVersionRequest) Reset()         { *m = QueryEncryptedSeedByVersionRequest{} }
+func (m *QueryEncryptedSeedByVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedSeedByVersionRequest) ProtoMessage()    {}
+func (*QueryEncryptedSeedByVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{12}
+}
+func (m *QueryEncryptedSeedByVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedSeedByVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedSeedByVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptedSeedByVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedSeedByVersionRequest.Merge(m, src)
+}
+func (m *QueryEncryptedSeedByVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedSeedByVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedSeedByVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptedSeedByVersionRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryEncryptedSeedRequest)(nil), "secret.registration.v1beta1.QueryEncryptedSeedRequest")
 	proto.RegisterType((*QueryEncryptedSeedResponse)(nil), "secret.registration.v1beta1.QueryEncryptedSeedResponse")
+	proto.RegisterType((*QueryEnclaveAllowlistResponse)(nil), "secret.registration.v1beta1.QueryEnclaveAllowlistResponse")
+	proto.RegisterType((*RegisteredNode)(nil), "secret.registration.v1beta1.RegisteredNode")
+	proto.RegisterType((*QueryRegisteredNodesRequest)(nil), "secret.registration.v1beta1.QueryRegisteredNodesRequest")
+	proto.RegisterType((*QueryRegisteredNodesResponse)(nil), "secret.registration.v1beta1.QueryRegisteredNodesResponse")
+	proto.RegisterType((*QueryRegisteredNodeRequest)(nil), "secret.registration.v1beta1.QueryRegisteredNodeRequest")
+	proto.RegisterType((*QueryRegisteredNodeResponse)(nil), "secret.registration.v1beta1.QueryRegisteredNodeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "secret.registration.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "secret.registration.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QuerySeedRotationRequest)(nil), "secret.registration.v1beta1.QuerySeedRotationRequest")
+	proto.RegisterType((*QuerySeedRotationResponse)(nil), "secret.registration.v1beta1.QuerySeedRotationResponse")
+	proto.RegisterType((*QueryEncryptedSeedByVersionRequest)(nil), "secret.registration.v1beta1.QueryEncryptedSeedByVersionRequest")
 }
 
 func init() {
@@ -115,34 +543,71 @@ func init() {
 }
 
 var fileDescriptor_7ee71413f073b37c = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xcd, 0x8a, 0xd4, 0x40,
-	0x18, 0x4c, 0x94, 0x5d, 0xa1, 0x71, 0x15, 0x1a, 0xf1, 0x27, 0xbb, 0x34, 0xcb, 0xe0, 0xea, 0x7a,
-	0xd8, 0x6e, 0x57, 0x65, 0x3d, 0x0a, 0xca, 0x9e, 0x16, 0x04, 0x67, 0x3d, 0x79, 0x59, 0x92, 0xcc,
-	0x67, 0x1b, 0x66, 0xa6, 0xbb, 0xa7, 0xbb, 0xa3, 0x13, 0x06, 0x2f, 0x3e, 0x81, 0xe0, 0x4b, 0xcc,
-	0x23, 0xf8, 0x08, 0x73, 0x1c, 0xf0, 0xe2, 0x51, 0x33, 0x3e, 0x88, 0xa4, 0xd3, 0x23, 0x19, 0x88,
-	0x01, 0xf1, 0x96, 0xf4, 0x57, 0x5f, 0x55, 0x7d, 0x55, 0xe8, 0xbe, 0x81, 0x54, 0x83, 0x65, 0x1a,
-	0x78, 0x66, 0xac, 0x8e, 0x6d, 0x26, 0x05, 0x7b, 0x7f, 0x9c, 0x80, 0x8d, 0x8f, 0xd9, 0x24, 0x07,
-	0x5d, 0x50, 0xa5, 0xa5, 0x95, 0x78, 0xb7, 0x06, 0xd2, 0x26, 0x90, 0x7a, 0x60, 0x74, 0x83, 0x4b,
-	0x2e, 0x1d, 0x8e, 0x55, 0x5f, 0xf5, 0x4a, 0xb4, 0xcb, 0xa5, 0xe4, 0x23, 0x60, 0xee, 0x2f, 0xc9,
-	0xdf, 0x32, 0x18, 0x2b, 0xeb, 0xf9, 0xa2, 0x3d, 0x3f, 0x8c, 0x55, 0xc6, 0x62, 0x21, 0xa4, 0x75,
-	0x8c, 0xc6, 0x4f, 0x0f, 0xba, 0x6c, 0x8d, 0x0d, 0xf7, 0xb0, 0x07, 0x5d, 0x30, 0x0e, 0x02, 0x4c,
-	0xe6, 0x19, 0x7b, 0x4f, 0xd0, 0x9d, 0x57, 0xd5, 0x39, 0xa7, 0x22, 0xd5, 0x85, 0xb2, 0x30, 0x38,
-	0x07, 0x18, 0xf4, 0x61, 0x92, 0x83, 0xb1, 0xf8, 0x16, 0xba, 0xa2, 0xf2, 0xe4, 0x62, 0x08, 0xc5,
-	0xed, 0x70, 0x3f, 0x3c, 0xbc, 0xda, 0xdf, 0x56, 0x79, 0x72, 0x06, 0x45, 0xef, 0x05, 0x8a, 0xda,
-	0xb6, 0x8c, 0x92, 0xc2, 0x00, 0x3e, 0x40, 0xd7, 0x60, 0x3d, 0xb8, 0x30, 0x00, 0x03, 0xbf, 0xbd,
-	0x03, 0x4d, 0xf8, 0xa3, 0xf9, 0x65, 0xb4, 0xe5, 0x58, 0x30, 0x47, 0x5b, 0xaf, 0xa7, 0x67, 0x50,
-	0xe0, 0x9b, 0xb4, 0x3e, 0x9f, 0xae, 0xb3, 0xa1, 0xa7, 0x55, 0x36, 0xd1, 0x3e, 0xed, 0x88, 0x99,
-	0x56, 0x8e, 0xee, 0x7e, 0xfa, 0xf6, 0xeb, 0xcb, 0x25, 0x82, 0xf7, 0xda, 0x8f, 0xb6, 0xd3, 0xa3,
-	0x21, 0x14, 0x78, 0x86, 0xae, 0xf7, 0x1b, 0xe3, 0xff, 0x93, 0xa4, 0x4e, 0xf2, 0x10, 0xdf, 0x6b,
-	0x97, 0x6c, 0x3e, 0x3a, 0xf1, 0xaf, 0x21, 0xda, 0xd9, 0x08, 0x0c, 0x9f, 0x74, 0x6a, 0xfc, 0xb5,
-	0x97, 0xe8, 0xe9, 0x3f, 0xef, 0xd5, 0xcd, 0xf4, 0x4e, 0x9c, 0xe5, 0x87, 0x98, 0xb6, 0x5b, 0xfe,
-	0xd3, 0xcf, 0x51, 0xd5, 0x1a, 0x9b, 0xf9, 0xf2, 0x3f, 0x3e, 0x8f, 0x17, 0x3f, 0x49, 0x30, 0x2f,
-	0x49, 0xb8, 0x28, 0x49, 0xb8, 0x2c, 0x49, 0xf8, 0xa3, 0x24, 0xe1, 0xe7, 0x15, 0x09, 0x96, 0x2b,
-	0x12, 0x7c, 0x5f, 0x91, 0xe0, 0xcd, 0x33, 0x9e, 0xd9, 0x77, 0x79, 0x42, 0x53, 0x39, 0x66, 0x26,
-	0xd5, 0x76, 0x14, 0x27, 0x86, 0x9d, 0x3b, 0x97, 0x2f, 0xc1, 0x7e, 0x90, 0x7a, 0xc8, 0xa6, 0x9b,
-	0xa2, 0x99, 0xb0, 0xa0, 0x45, 0x3c, 0x62, 0xb6, 0x50, 0x60, 0x92, 0x6d, 0x97, 0xff, 0xe3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x62, 0xa4, 0x1c, 0x7a, 0x03, 0x00, 0x00,
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0xf9, 0xe1, 0x92, 0x71, 0x93, 0xa0, 0x69, 0x15, 0xdc, 0x4d, 0xea, 0x5a, 0x1b,
+	0x52, 0x3b, 0x14, 0xef, 0xb6, 0x81, 0xa6, 0xa5, 0x97, 0x2a, 0xa9, 0x42, 0x40, 0x48, 0xa1, 0x6c,
+	0x11, 0x20, 0x2e, 0xd1, 0xda, 0xfb, 0xd8, 0xac, 0x6c, 0xef, 0x6e, 0x67, 0xc6, 0x69, 0xac, 0xa8,
+	0x17, 0xee, 0x48, 0x20, 0x0e, 0x5c, 0x39, 0x22, 0xb8, 0x71, 0x40, 0xfc, 0x05, 0x28, 0xc7, 0x4a,
+	0x5c, 0x38, 0x00, 0x82, 0x84, 0x03, 0x7f, 0x06, 0xda, 0x99, 0x59, 0x67, 0xb7, 0x5d, 0xaf, 0xe3,
+	0x70, 0xf3, 0xce, 0xbc, 0xef, 0xfb, 0x7e, 0xe6, 0xd7, 0x7b, 0xc6, 0x35, 0x06, 0x2d, 0x0a, 0xdc,
+	0xa4, 0xe0, 0x7a, 0x8c, 0x53, 0x9b, 0x7b, 0x81, 0x6f, 0xee, 0xdf, 0x6a, 0x02, 0xb7, 0x6f, 0x99,
+	0x8f, 0x7b, 0x40, 0xfb, 0x46, 0x48, 0x03, 0x1e, 0x90, 0x45, 0x19, 0x68, 0x24, 0x03, 0x0d, 0x15,
+	0xa8, 0x5d, 0x76, 0x03, 0x37, 0x10, 0x71, 0x66, 0xf4, 0x4b, 0x4a, 0xb4, 0x45, 0x37, 0x08, 0xdc,
+	0x0e, 0x98, 0xe2, 0xab, 0xd9, 0xfb, 0xcc, 0x84, 0x6e, 0xc8, 0x55, 0x3e, 0x6d, 0x49, 0x4d, 0xda,
+	0xa1, 0x67, 0xda, 0xbe, 0x1f, 0x70, 0x91, 0x91, 0xa9, 0xd9, 0xd7, 0x5a, 0x01, 0xeb, 0x06, 0xcc,
+	0x6c, 0xda, 0x0c, 0x24, 0xc6, 0x00, 0x2a, 0xb4, 0x5d, 0xcf, 0x97, 0xf6, 0x32, 0x76, 0x25, 0x6f,
+	0x09, 0x5d, 0xe6, 0xaa, 0xb0, 0xd5, 0xbc, 0x30, 0x17, 0x7c, 0x60, 0x5e, 0xec, 0x9e, 0xbb, 0x29,
+	0xbc, 0x1f, 0x42, 0x1c, 0x58, 0xcf, 0x0b, 0x0c, 0x6d, 0x6a, 0x77, 0x55, 0xa4, 0xfe, 0x26, 0xbe,
+	0xf2, 0x41, 0xb4, 0x8c, 0x2d, 0xbf, 0x45, 0xfb, 0x21, 0x07, 0xe7, 0x11, 0x80, 0x63, 0xc1, 0xe3,
+	0x1e, 0x30, 0x4e, 0x5e, 0xc1, 0x17, 0xc2, 0x5e, 0x73, 0xb7, 0x0d, 0xfd, 0x32, 0xaa, 0xa2, 0xfa,
+	0x45, 0xab, 0x18, 0xf6, 0x9a, 0xef, 0x41, 0x5f, 0x7f, 0x80, 0xb5, 0x2c, 0x15, 0x0b, 0x03, 0x9f,
+	0x01, 0x59, 0xc1, 0x73, 0x10, 0x4f, 0xec, 0x32, 0x00, 0x47, 0xa9, 0x67, 0x21, 0x19, 0xae, 0x87,
+	0xf8, 0x6a, 0x9c, 0xa4, 0x63, 0xef, 0xc3, 0x46, 0xa7, 0x13, 0x3c, 0xe9, 0x78, 0x8c, 0x0f, 0xf2,
+	0xbc, 0x8f, 0x67, 0xec, 0x78, 0xb0, 0x8c, 0xaa, 0x93, 0xf5, 0xd2, 0xda, 0x0d, 0x23, 0xe7, 0xb8,
+	0x0d, 0x91, 0x02, 0x1c, 0x95, 0x70, 0x73, 0xea, 0xe8, 0xcf, 0x6b, 0x05, 0xeb, 0x34, 0x87, 0xfe,
+	0x3b, 0xc2, 0x73, 0x96, 0x10, 0x02, 0x05, 0x67, 0x27, 0x70, 0x60, 0xe8, 0x12, 0x89, 0x89, 0x2f,
+	0x25, 0x3d, 0x76, 0xf7, 0xc0, 0x73, 0xf7, 0x78, 0x79, 0xa2, 0x8a, 0xea, 0x93, 0x16, 0x49, 0x4e,
+	0xbd, 0x23, 0x66, 0xc8, 0x02, 0x2e, 0x32, 0xf0, 0x1d, 0xa0, 0xe5, 0xc9, 0x2a, 0xaa, 0xcf, 0x58,
+	0xea, 0x8b, 0xec, 0xe0, 0x92, 0xcd, 0x39, 0x30, 0x79, 0x91, 0xca, 0x53, 0x55, 0x54, 0x2f, 0xad,
+	0xbd, 0x9e, 0xbb, 0x8e, 0x88, 0x6c, 0xe3, 0x54, 0x63, 0x25, 0x13, 0x90, 0x32, 0xbe, 0x40, 0x61,
+	0x3f, 0x68, 0x83, 0x53, 0x9e, 0xae, 0xa2, 0xfa, 0x4b, 0x56, 0xfc, 0xa9, 0xb7, 0xf1, 0xa2, 0xd8,
+	0xd0, 0xf4, 0x12, 0x59, 0x7c, 0x9a, 0x6f, 0x63, 0x7c, 0x7a, 0x47, 0xc5, 0x6a, 0x4b, 0x6b, 0xd7,
+	0x0d, 0x79, 0xa1, 0x8d, 0xe8, 0x42, 0x1b, 0xf2, 0x5d, 0xc5, 0x14, 0x0f, 0x6d, 0x17, 0x94, 0xd6,
+	0x4a, 0x28, 0xef, 0x4d, 0xfd, 0xfb, 0xed, 0xb5, 0x82, 0xfe, 0x13, 0xc2, 0x4b, 0xd9, 0x6e, 0xea,
+	0xf4, 0xb6, 0xf1, 0xb4, 0x1f, 0x0d, 0x9c, 0xe9, 0xe4, 0xd2, 0x49, 0xd4, 0xc9, 0x49, 0x3d, 0xd9,
+	0x4e, 0x71, 0x4f, 0x08, 0xee, 0xda, 0x48, 0x6e, 0x49, 0x91, 0x01, 0x7e, 0x5b, 0xdd, 0xdd, 0xb4,
+	0xe5, 0xc8, 0x2b, 0xef, 0x64, 0x6e, 0xee, 0x60, 0xb5, 0x5b, 0x78, 0x2a, 0xa2, 0x55, 0xdb, 0x7a,
+	0x8e, 0xc5, 0x0a, 0xb9, 0x7e, 0x19, 0x13, 0xe1, 0xf2, 0x50, 0xbc, 0x51, 0x05, 0xa5, 0x7f, 0x82,
+	0x2f, 0xa5, 0x46, 0x95, 0xe7, 0x06, 0x2e, 0xca, 0xb7, 0xac, 0x5c, 0x97, 0x73, 0x5d, 0xa5, 0x58,
+	0xb9, 0x29, 0xa1, 0xae, 0xe1, 0xb2, 0xc8, 0x2c, 0xde, 0xaf, 0xaa, 0x75, 0xb1, 0xeb, 0x17, 0x08,
+	0x5f, 0xc9, 0x98, 0x54, 0xe6, 0x35, 0x3c, 0xdf, 0xea, 0x51, 0x0a, 0x3e, 0xdf, 0xdd, 0x07, 0xca,
+	0xe2, 0x2b, 0x35, 0x6b, 0xcd, 0xa9, 0xe1, 0x8f, 0xe4, 0x28, 0xd9, 0xc6, 0x33, 0xac, 0xb5, 0x07,
+	0x4e, 0xaf, 0x03, 0x8e, 0x3a, 0xbd, 0xd5, 0x5c, 0xd0, 0x94, 0xdd, 0xa9, 0x56, 0xff, 0x18, 0xeb,
+	0x2f, 0x16, 0x9d, 0xcd, 0xbe, 0xf2, 0x19, 0x75, 0x80, 0xd1, 0xbb, 0x89, 0x41, 0x27, 0x04, 0x68,
+	0xfc, 0xb9, 0xf6, 0x4b, 0x09, 0x4f, 0x8b, 0xcc, 0xc4, 0xc5, 0xd3, 0x1f, 0x1e, 0x44, 0xc1, 0x0b,
+	0x86, 0x6c, 0x03, 0x46, 0xdc, 0x23, 0x8c, 0xad, 0xa8, 0x47, 0x68, 0xd5, 0x5c, 0xf2, 0xe8, 0x9e,
+	0xbc, 0xfa, 0xf9, 0xaf, 0xff, 0x7c, 0x3d, 0x51, 0x21, 0x4b, 0x43, 0xaa, 0xf4, 0x41, 0xa3, 0x0d,
+	0x7d, 0x72, 0x88, 0xe7, 0xad, 0xc4, 0xf4, 0xff, 0xb3, 0x34, 0x84, 0x65, 0x9d, 0x5c, 0xcf, 0xb6,
+	0x4c, 0x0e, 0x0a, 0xf3, 0x9f, 0x11, 0x9e, 0x4d, 0x6d, 0x22, 0x59, 0xcf, 0xf5, 0x18, 0xda, 0x20,
+	0xb4, 0x3b, 0x63, 0xeb, 0xe4, 0xed, 0xd1, 0xd7, 0x05, 0xf2, 0x4d, 0x62, 0x64, 0x23, 0x0f, 0x1a,
+	0x45, 0x83, 0x01, 0x38, 0xe6, 0xa1, 0x3a, 0xd1, 0xa7, 0xe4, 0x1b, 0x84, 0x5f, 0x7e, 0xbe, 0x5f,
+	0x0c, 0xdd, 0xb9, 0x7b, 0x67, 0xa2, 0xcb, 0xec, 0x3d, 0xba, 0x29, 0x00, 0x57, 0x49, 0x6d, 0x28,
+	0x60, 0xa4, 0x6b, 0x0c, 0x7a, 0x0b, 0xf9, 0x01, 0xe1, 0xf9, 0xf4, 0xc3, 0x66, 0xe4, 0xee, 0x68,
+	0x80, 0xec, 0x5a, 0xad, 0xbd, 0x75, 0x0e, 0xa5, 0x22, 0x5f, 0x16, 0xe4, 0x57, 0xc9, 0x62, 0x36,
+	0xb9, 0xac, 0xa9, 0x3f, 0xbe, 0xd8, 0x09, 0xef, 0x8c, 0x6b, 0x19, 0xb3, 0xde, 0x1d, 0x5f, 0xa8,
+	0x50, 0x1b, 0x02, 0xb5, 0x46, 0x56, 0x72, 0x50, 0x13, 0x87, 0xff, 0x15, 0xc2, 0x45, 0x59, 0xc5,
+	0x88, 0x39, 0xda, 0x33, 0x55, 0x42, 0xb5, 0x9b, 0x67, 0x17, 0x28, 0xb8, 0x11, 0x0f, 0x59, 0x16,
+	0x50, 0xf2, 0x3d, 0xc2, 0x17, 0x93, 0x05, 0x8b, 0xdc, 0x1e, 0x6d, 0x94, 0x51, 0x6c, 0xb5, 0xf5,
+	0x71, 0x65, 0x8a, 0xf2, 0x86, 0xa0, 0x5c, 0x21, 0xcb, 0xd9, 0x94, 0x0c, 0xc0, 0x69, 0xd0, 0x98,
+	0xed, 0x0f, 0x84, 0x17, 0xb2, 0xab, 0x27, 0xb9, 0x3f, 0xe6, 0x4b, 0x7e, 0xbe, 0xee, 0x9e, 0xbf,
+	0x14, 0xbc, 0x2b, 0x56, 0xf0, 0x80, 0x6c, 0x8c, 0x57, 0x0a, 0x4c, 0x55, 0xbd, 0x99, 0x79, 0xa8,
+	0x7e, 0x3d, 0xdd, 0xb4, 0x8f, 0xfe, 0xae, 0x14, 0xbe, 0x3b, 0xae, 0xa0, 0xa3, 0xe3, 0x0a, 0x7a,
+	0x76, 0x5c, 0x41, 0x7f, 0x1d, 0x57, 0xd0, 0x97, 0x27, 0x95, 0xc2, 0xb3, 0x93, 0x4a, 0xe1, 0xb7,
+	0x93, 0x4a, 0xe1, 0xd3, 0xfb, 0xae, 0xc7, 0xf7, 0x7a, 0x4d, 0xa3, 0x15, 0x74, 0x4d, 0xd6, 0xa2,
+	0xbc, 0x63, 0x37, 0x99, 0xf9, 0x48, 0x80, 0xef, 0x00, 0x7f, 0x12, 0xd0, 0xb6, 0x79, 0x90, 0xe6,
+	0xf0, 0x7c, 0x0e, 0xd4, 0xb7, 0x3b, 0xf2, 0xff, 0x75, 0xb3, 0x28, 0x6a, 0xcc, 0x1b, 0xff, 0x0d,
+	0x00, 0xcd, 0xad, 0x4b, 0xbe, 0xa0, 0x0c, 0x00, 0x00,
 }
 
 func (this *QueryEncryptedSeedRequest) Equal(that interface{}) bool {
@@ -193,28 +658,275 @@ func (this *QueryEncryptedSeedResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueryEnclaveAllowlistResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Returns the key used for transactions
-	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the key used for registration
-	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the encrypted seed for a registered node by public key
-	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
-}
-
-type queryClient struct {
+	that1, ok := that.(*QueryEnclaveAllowlistResponse)
+	if !ok {
+		that2, ok := that.(QueryEnclaveAllowlistResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if !this.Allowlist[i].Equal(&that1.Allowlist[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RegisteredNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisteredNode)
+	if !ok {
+		that2, ok := that.(RegisteredNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !this.Attestation.Equal(that1.Attestation) {
+		return false
+	}
+	if this.Revoked != that1.Revoked {
+		return false
+	}
+	return true
+}
+func (this *QueryRegisteredNodeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryRegisteredNodeRequest)
+	if !ok {
+		that2, ok := that.(QueryRegisteredNodeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	return true
+}
+func (this *QueryRegisteredNodeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryRegisteredNodeResponse)
+	if !ok {
+		that2, ok := that.(QueryRegisteredNodeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Node.Equal(&that1.Node) {
+		return false
+	}
+	return true
+}
+func (this *QueryParamsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryParamsRequest)
+	if !ok {
+		that2, ok := that.(QueryParamsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *QueryParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryParamsResponse)
+	if !ok {
+		that2, ok := that.(QueryParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *QuerySeedRotationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QuerySeedRotationRequest)
+	if !ok {
+		that2, ok := that.(QuerySeedRotationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *QuerySeedRotationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QuerySeedRotationResponse)
+	if !ok {
+		that2, ok := that.(QuerySeedRotationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CurrentVersion != that1.CurrentVersion {
+		return false
+	}
+	if !this.Scheduled.Equal(that1.Scheduled) {
+		return false
+	}
+	return true
+}
+func (this *QueryEncryptedSeedByVersionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryEncryptedSeedByVersionRequest)
+	if !ok {
+		that2, ok := that.(QueryEncryptedSeedByVersionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Returns the key used for transactions
+	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the key used for registration
+	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the encrypted seed for a registered node by public key
+	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
+	// Returns the allowlist of enclaves that may register
+	EnclaveAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryEnclaveAllowlistResponse, error)
+	// Returns the registered nodes
+	RegisteredNodes(ctx context.Context, in *QueryRegisteredNodesRequest, opts ...grpc.CallOption) (*QueryRegisteredNodesResponse, error)
+	// Returns a registered node by public key
+	RegisteredNode(ctx context.Context, in *QueryRegisteredNodeRequest, opts ...grpc.CallOption) (*QueryRegisteredNodeResponse, error)
+	// Returns the registration parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns the consensus seed version of the network, and the scheduled
+	// rotation if any
+	SeedRotation(ctx context.Context, in *QuerySeedRotationRequest, opts ...grpc.CallOption) (*QuerySeedRotationResponse, error)
+	// Returns the encrypted seed of a registered node for a consensus seed
+	// version
+	EncryptedSeedByVersion(ctx context.Context, in *QueryEncryptedSeedByVersionRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
+}
+
+type queryClient struct {
 	cc grpc1.ClientConn
 }
 
@@ -249,6 +961,60 @@ func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedR
 	return out, nil
 }
 
+func (c *queryClient) EnclaveAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryEnclaveAllowlistResponse, error) {
+	out := new(QueryEnclaveAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EnclaveAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredNodes(ctx context.Context, in *QueryRegisteredNodesRequest, opts ...grpc.CallOption) (*QueryRegisteredNodesResponse, error) {
+	out := new(QueryRegisteredNodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegisteredNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredNode(ctx context.Context, in *QueryRegisteredNodeRequest, opts ...grpc.CallOption) (*QueryRegisteredNodeResponse, error) {
+	out := new(QueryRegisteredNodeResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegisteredNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SeedRotation(ctx context.Context, in *QuerySeedRotationRequest, opts ...grpc.CallOption) (*QuerySeedRotationResponse, error) {
+	out := new(QuerySeedRotationResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/SeedRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedSeedByVersion(ctx context.Context, in *QueryEncryptedSeedByVersionRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
+	out := new(QueryEncryptedSeedResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeedByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns the key used for transactions
@@ -257,6 +1023,20 @@ type QueryServer interface {
 	RegistrationKey(context.Context, *emptypb.Empty) (*Key, error)
 	// Returns the encrypted seed for a registered node by public key
 	EncryptedSeed(context.Context, *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error)
+	// Returns the allowlist of enclaves that may register
+	EnclaveAllowlist(context.Context, *emptypb.Empty) (*QueryEnclaveAllowlistResponse, error)
+	// Returns the registered nodes
+	RegisteredNodes(context.Context, *QueryRegisteredNodesRequest) (*QueryRegisteredNodesResponse, error)
+	// Returns a registered node by public key
+	RegisteredNode(context.Context, *QueryRegisteredNodeRequest) (*QueryRegisteredNodeResponse, error)
+	// Returns the registration parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns the consensus seed version of the network, and the scheduled
+	// rotation if any
+	SeedRotation(context.Context, *QuerySeedRotationRequest) (*QuerySeedRotationResponse, error)
+	// Returns the encrypted seed of a registered node for a consensus seed
+	// version
+	EncryptedSeedByVersion(context.Context, *QueryEncryptedSeedByVersionRequest) (*QueryEncryptedSeedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -272,6 +1052,24 @@ func (*UnimplementedQueryServer) RegistrationKey(ctx context.Context, req *empty
 func (*UnimplementedQueryServer) EncryptedSeed(ctx context.Context, req *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeed not implemented")
 }
+func (*UnimplementedQueryServer) EnclaveAllowlist(ctx context.Context, req *emptypb.Empty) (*QueryEnclaveAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnclaveAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredNodes(ctx context.Context, req *QueryRegisteredNodesRequest) (*QueryRegisteredNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredNodes not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredNode(ctx context.Context, req *QueryRegisteredNodeRequest) (*QueryRegisteredNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredNode not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SeedRotation(ctx context.Context, req *QuerySeedRotationRequest) (*QuerySeedRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedRotation not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedSeedByVersion(ctx context.Context, req *QueryEncryptedSeedByVersionRequest) (*QueryEncryptedSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeedByVersion not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -331,7 +1129,115 @@ func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
+func _Query_EnclaveAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EnclaveAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/EnclaveAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EnclaveAllowlist(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/RegisteredNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredNodes(ctx, req.(*QueryRegisteredNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/RegisteredNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredNode(ctx, req.(*QueryRegisteredNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SeedRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeedRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SeedRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/SeedRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SeedRotation(ctx, req.(*QuerySeedRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedSeedByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedSeedByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedSeedByVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/EncryptedSeedByVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedSeedByVersion(ctx, req.(*QueryEncryptedSeedByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.registration.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -347,6 +1253,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EncryptedSeed",
 			Handler:    _Query_EncryptedSeed_Handler,
 		},
+		{
+			MethodName: "EnclaveAllowlist",
+			Handler:    _Query_EnclaveAllowlist_Handler,
+		},
+		{
+			MethodName: "RegisteredNodes",
+			Handler:    _Query_RegisteredNodes_Handler,
+		},
+		{
+			MethodName: "RegisteredNode",
+			Handler:    _Query_RegisteredNode_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SeedRotation",
+			Handler:    _Query_SeedRotation_Handler,
+		},
+		{
+			MethodName: "EncryptedSeedByVersion",
+			Handler:    _Query_EncryptedSeedByVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/registration/v1beta1/query.proto",
@@ -409,53 +1339,1613 @@ func (m *QueryEncryptedSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEnclaveAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEnclaveAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEnclaveAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeedRotationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeedRotationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeedRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeedRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeedRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeedRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheduled != nil {
+		{
+			size, err := m.Scheduled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CurrentVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedSeedByVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedSeedByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedSeedByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEncryptedSeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptedSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEnclaveAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisteredNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RegistrationHeight))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Node.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySeedRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySeedRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentVersion != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentVersion))
+	}
+	if m.Scheduled != nil {
+		l = m.Scheduled.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptedSeedByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSeed == nil {
+				m.EncryptedSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEnclaveAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEnclaveAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEnclaveAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, AllowedEnclave{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &NodeAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, RegisteredNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryEncryptedSeedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryEncryptedSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.EncryptedSeed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QuerySeedRotationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeedRotationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeedRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySeedRotationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,17 +2968,36 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySeedRotationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySeedRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersion", wireType)
+			}
+			m.CurrentVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -498,24 +3007,26 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			if m.Scheduled == nil {
+				m.Scheduled = &SeedRotation{}
+			}
+			if err := m.Scheduled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -539,7 +3050,7 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedSeedByVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -562,15 +3073,15 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedSeedByVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedSeedByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -597,11 +3108,30 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSeed == nil {
-				m.EncryptedSeed = []byte{}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxSeedVersion is the last consensus seed version whose encrypted seed fits the one byte size prefix that the
+	// node passes to the enclave with it
+	MaxSeedVersion = 5
+)
+
+// EncryptedSeedLength returns the hex encoded length of the encrypted seed of a node for a consensus seed version. The
+// encrypted seed of a version carries the seeds of all the versions up to it, so version 1 (the genesis seed alone) is
+// LegacyEncryptedKeyLength long and version 2 is EncryptedKeyLength long
+func EncryptedSeedLength(version uint32) int {
+	return int(version) * LegacyEncryptedKeyLength
+}
+
+// ValidateSeedVersion fails for versions that the network can't have
+func ValidateSeedVersion(version uint32) error {
+	if version < SeedConfigVersion || version > MaxSeedVersion {
+		return ErrSeedRotation.Wrapf("seed version %d is not between %d and %d", version, SeedConfigVersion, MaxSeedVersion)
+	}
+	return nil
+}
+
+func (r SeedRotation) Validate() error {
+	if r.Height <= 0 {
+		return ErrSeedRotation.Wrapf("invalid height %d", r.Height)
+	}
+	if r.Version <= SeedConfigVersion {
+		return ErrSeedRotation.Wrapf("seed version %d does not follow version %d", r.Version, SeedConfigVersion)
+	}
+	return ValidateSeedVersion(r.Version)
+}
+
+// ValidateNext fails unless the rotation moves the network from the current version to the next one
+func (r SeedRotation) ValidateNext(current uint32) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.Version != current+1 {
+		return ErrSeedRotation.Wrapf("seed version %d does not follow the current version %d", r.Version, current)
+	}
+	return nil
+}
+
+// ValidateVersionedSeeds fails for seeds of versions that a network of the given seed version can't hold, for seeds
+// of the wrong length, and for duplicates. Seeds of the first version are kept with the registrations of the nodes
+func ValidateVersionedSeeds(seeds []VersionedEncryptedSeed, current uint32) error {
+	seen := make(map[string]bool, len(seeds))
+	for _, seed := range seeds {
+		if seed.Version <= SeedConfigVersion || seed.Version > current {
+			return ErrSeedRotation.Wrapf("seed of version %d, expected a version between %d and %d", seed.Version, SeedConfigVersion+1, current)
+		}
+		if len(seed.PubKey) == 0 {
+			return ErrSeedRotation.Wrap("seed without a public key")
+		}
+		if len(seed.EncryptedSeed)*2 != EncryptedSeedLength(seed.Version) {
+			return ErrSeedRotation.Wrapf("seed of version %d for node %x has length %d, expected %d hex characters",
+				seed.Version, seed.PubKey, len(seed.EncryptedSeed)*2, EncryptedSeedLength(seed.Version))
+		}
+
+		key := fmt.Sprintf("%d/%x", seed.Version, seed.PubKey)
+		if seen[key] {
+			return ErrSeedRotation.Wrapf("duplicate seed of version %d for node %x", seed.Version, seed.PubKey)
+		}
+		seen[key] = true
+	}
+	return nil
+}
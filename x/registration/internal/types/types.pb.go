@@ -6,6 +6,7 @@ package types
 import (
 	bytes "bytes"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
@@ -105,6 +106,13 @@ var xxx_messageInfo_LegacySeedConfig proto.InternalMessageInfo
 type RegistrationNodeInfo struct {
 	Certificate   github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,1,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
 	EncryptedSeed []byte                                                                          `protobuf:"bytes,2,opt,name=encrypted_seed,json=encryptedSeed,proto3" json:"encrypted_seed,omitempty"`
+	// registration_height is the block height the node registered at, 0 for
+	// nodes that registered before it was recorded
+	RegistrationHeight int64 `protobuf:"varint,3,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+	// sender is the account that submitted the registration, empty for nodes
+	// that registered before it was recorded
+	Sender      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Attestation *NodeAttestation                              `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
 func (m *RegistrationNodeInfo) Reset()         { *m = RegistrationNodeInfo{} }
@@ -140,10 +148,227 @@ func (m *RegistrationNodeInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegistrationNodeInfo proto.InternalMessageInfo
 
+// NodeAttestation summarizes the attestation a node registered with
+type NodeAttestation struct {
+	// type is "epid", "dcap" or "software"
+	Type        string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	MrEnclave   []byte   `protobuf:"bytes,2,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	MrSigner    []byte   `protobuf:"bytes,3,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	IsvProdId   uint32   `protobuf:"varint,4,opt,name=isv_prod_id,json=isvProdId,proto3" json:"isv_prod_id,omitempty"`
+	IsvSvn      uint32   `protobuf:"varint,5,opt,name=isv_svn,json=isvSvn,proto3" json:"isv_svn,omitempty"`
+	TcbStatus   string   `protobuf:"bytes,6,opt,name=tcb_status,json=tcbStatus,proto3" json:"tcb_status,omitempty"`
+	AdvisoryIds []string `protobuf:"bytes,7,rep,name=advisory_ids,json=advisoryIds,proto3" json:"advisory_ids,omitempty"`
+	// platform_info is the platform info blob of EPID reports, or the FMSPC of
+	// DCAP quotes
+	PlatformInfo string `protobuf:"bytes,8,opt,name=platform_info,json=platformInfo,proto3" json:"platform_info,omitempty"`
+}
+
+func (m *NodeAttestation) Reset()         { *m = NodeAttestation{} }
+func (m *NodeAttestation) String() string { return proto.CompactTextString(m) }
+func (*NodeAttestation) ProtoMessage()    {}
+func (*NodeAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{3}
+}
+func (m *NodeAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAttestation.Merge(m, src)
+}
+func (m *NodeAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAttestation proto.InternalMessageInfo
+
+// AllowedEnclave is an entry of the enclave allowlist. A node matches the
+// entry if its enclave has the given MRENCLAVE and MRSIGNER (an empty field
+// matches any value) and an ISVSVN of at least min_isv_svn
+type AllowedEnclave struct {
+	MrEnclave []byte `protobuf:"bytes,1,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	MrSigner  []byte `protobuf:"bytes,2,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	MinIsvSvn uint32 `protobuf:"varint,3,opt,name=min_isv_svn,json=minIsvSvn,proto3" json:"min_isv_svn,omitempty"`
+}
+
+func (m *AllowedEnclave) Reset()         { *m = AllowedEnclave{} }
+func (m *AllowedEnclave) String() string { return proto.CompactTextString(m) }
+func (*AllowedEnclave) ProtoMessage()    {}
+func (*AllowedEnclave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{4}
+}
+func (m *AllowedEnclave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedEnclave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedEnclave.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedEnclave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedEnclave.Merge(m, src)
+}
+func (m *AllowedEnclave) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedEnclave) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedEnclave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedEnclave proto.InternalMessageInfo
+
+// EnclaveAllowlist is the enclave allowlist, as kept in the module store
+type EnclaveAllowlist struct {
+	Entries []AllowedEnclave `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *EnclaveAllowlist) Reset()         { *m = EnclaveAllowlist{} }
+func (m *EnclaveAllowlist) String() string { return proto.CompactTextString(m) }
+func (*EnclaveAllowlist) ProtoMessage()    {}
+func (*EnclaveAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{5}
+}
+func (m *EnclaveAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveAllowlist.Merge(m, src)
+}
+func (m *EnclaveAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveAllowlist proto.InternalMessageInfo
+
+// SeedRotation schedules the network to move to a new consensus seed version
+type SeedRotation struct {
+	// version is the consensus seed version the network moves to
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the block height at which the rotation starts. The enclave
+	// encrypts the seed of the new version for a batch of registered nodes
+	// every block, and the version takes effect with the last batch
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SeedRotation) Reset()         { *m = SeedRotation{} }
+func (m *SeedRotation) String() string { return proto.CompactTextString(m) }
+func (*SeedRotation) ProtoMessage()    {}
+func (*SeedRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{6}
+}
+func (m *SeedRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeedRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeedRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedRotation.Merge(m, src)
+}
+func (m *SeedRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedRotation proto.InternalMessageInfo
+
+// VersionedEncryptedSeed is the encrypted seed of a registered node for a
+// consensus seed version, as served after a seed rotation
+type VersionedEncryptedSeed struct {
+	Version       uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	PubKey        []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	EncryptedSeed []byte `protobuf:"bytes,3,opt,name=encrypted_seed,json=encryptedSeed,proto3" json:"encrypted_seed,omitempty"`
+}
+
+func (m *VersionedEncryptedSeed) Reset()         { *m = VersionedEncryptedSeed{} }
+func (m *VersionedEncryptedSeed) String() string { return proto.CompactTextString(m) }
+func (*VersionedEncryptedSeed) ProtoMessage()    {}
+func (*VersionedEncryptedSeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{7}
+}
+func (m *VersionedEncryptedSeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionedEncryptedSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionedEncryptedSeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionedEncryptedSeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionedEncryptedSeed.Merge(m, src)
+}
+func (m *VersionedEncryptedSeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionedEncryptedSeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionedEncryptedSeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionedEncryptedSeed proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SeedConfig)(nil), "secret.registration.v1beta1.SeedConfig")
 	proto.RegisterType((*LegacySeedConfig)(nil), "secret.registration.v1beta1.LegacySeedConfig")
 	proto.RegisterType((*RegistrationNodeInfo)(nil), "secret.registration.v1beta1.RegistrationNodeInfo")
+	proto.RegisterType((*NodeAttestation)(nil), "secret.registration.v1beta1.NodeAttestation")
+	proto.RegisterType((*AllowedEnclave)(nil), "secret.registration.v1beta1.AllowedEnclave")
+	proto.RegisterType((*EnclaveAllowlist)(nil), "secret.registration.v1beta1.EnclaveAllowlist")
+	proto.RegisterType((*SeedRotation)(nil), "secret.registration.v1beta1.SeedRotation")
+	proto.RegisterType((*VersionedEncryptedSeed)(nil), "secret.registration.v1beta1.VersionedEncryptedSeed")
 }
 
 func init() {
@@ -151,32 +376,58 @@ func init() {
 }
 
 var fileDescriptor_f3db05f1d182f4de = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6e, 0xd4, 0x40,
-	0x14, 0x80, 0x3d, 0x89, 0xb4, 0x51, 0x66, 0x37, 0x08, 0x59, 0x29, 0x22, 0x90, 0xc6, 0xd1, 0x4a,
-	0x21, 0xa9, 0x3c, 0x0a, 0x1c, 0x00, 0xc9, 0x5b, 0xa1, 0xa0, 0x20, 0x79, 0x3b, 0x9a, 0x68, 0x3c,
-	0x7e, 0x6b, 0x46, 0xde, 0x9d, 0xb1, 0x66, 0x5e, 0x02, 0xbe, 0x03, 0x05, 0xc7, 0xe0, 0x00, 0x1c,
-	0x22, 0x65, 0x4a, 0x2a, 0x0b, 0xbc, 0x9d, 0x8f, 0x40, 0x85, 0xfc, 0x13, 0xd6, 0x94, 0xe9, 0x9e,
-	0xec, 0xef, 0xcd, 0xfb, 0xde, 0x0f, 0x3d, 0x77, 0x20, 0x2d, 0x20, 0xb7, 0x90, 0x29, 0x87, 0x56,
-	0xa0, 0x32, 0x9a, 0xdf, 0x5d, 0x26, 0x80, 0xe2, 0x92, 0x63, 0x59, 0x80, 0x0b, 0x0b, 0x6b, 0xd0,
-	0xf8, 0x2f, 0x7b, 0x30, 0x1c, 0x83, 0xe1, 0x00, 0xbe, 0x38, 0xce, 0x4c, 0x66, 0x3a, 0x8e, 0xb7,
-	0x51, 0x9f, 0x32, 0xff, 0x4a, 0x28, 0x5d, 0x02, 0xa4, 0x0b, 0xa3, 0x57, 0x2a, 0xf3, 0x5f, 0x51,
-	0xba, 0x11, 0x0e, 0xc1, 0xde, 0xe4, 0x50, 0x9e, 0x90, 0x53, 0x72, 0x71, 0x18, 0x1d, 0x34, 0x55,
-	0xb0, 0x5f, 0xe4, 0xaf, 0xe3, 0xc3, 0xfe, 0xd7, 0x15, 0x94, 0x3e, 0xa7, 0x47, 0xa0, 0xa5, 0x2d,
-	0x0b, 0x84, 0xb4, 0x43, 0xf7, 0x3a, 0x94, 0x36, 0x55, 0x30, 0x01, 0x2d, 0xaf, 0xa0, 0x8c, 0x67,
-	0xff, 0x80, 0x36, 0xe1, 0x8c, 0x1e, 0xdc, 0x81, 0x75, 0xca, 0xe8, 0x93, 0xfd, 0x53, 0x72, 0x71,
-	0x14, 0x4d, 0x9b, 0x2a, 0x78, 0xfc, 0x14, 0x3f, 0x06, 0xf3, 0x35, 0x7d, 0xfe, 0x1e, 0x32, 0x21,
-	0xcb, 0x91, 0xd3, 0x39, 0x9d, 0x0e, 0x4e, 0x12, 0x2c, 0x0e, 0x52, 0x93, 0xa6, 0x0a, 0xf6, 0x8a,
-	0x3c, 0x1e, 0x74, 0x17, 0x60, 0xf1, 0xc9, 0x52, 0xf3, 0x1f, 0x84, 0x1e, 0xc7, 0xa3, 0x59, 0x5d,
-	0x9b, 0x14, 0xde, 0xe9, 0x95, 0xf1, 0x6f, 0xe9, 0xb4, 0xad, 0xa5, 0x56, 0x4a, 0x0a, 0x84, 0xae,
-	0xe4, 0x2c, 0x5a, 0xfe, 0xa9, 0x82, 0x0f, 0x99, 0xc2, 0x4f, 0xb7, 0x49, 0x28, 0xcd, 0x86, 0x3b,
-	0x69, 0x71, 0x2d, 0x12, 0xc7, 0x97, 0xdd, 0xd4, 0xaf, 0x01, 0x3f, 0x1b, 0x9b, 0xf3, 0x2f, 0xff,
-	0xef, 0xc9, 0xc2, 0xc6, 0x20, 0xdc, 0x08, 0x44, 0x70, 0xd8, 0x6f, 0x64, 0xb1, 0x7b, 0x3a, 0x1e,
-	0xd7, 0xf1, 0xcf, 0xe8, 0xb3, 0x5d, 0x03, 0x0e, 0x20, 0xed, 0x3a, 0x98, 0xc5, 0xbb, 0xb6, 0xda,
-	0xb1, 0x44, 0xe2, 0xfe, 0x37, 0xf3, 0xbe, 0xd7, 0x8c, 0xdc, 0xd7, 0x8c, 0x3c, 0xd4, 0x8c, 0xfc,
-	0xaa, 0x19, 0xf9, 0xb6, 0x65, 0xde, 0xc3, 0x96, 0x79, 0x3f, 0xb7, 0xcc, 0xfb, 0xf8, 0xf6, 0xc9,
-	0x9a, 0x4a, 0x23, 0x58, 0x2d, 0xd6, 0xfd, 0x3d, 0x25, 0x93, 0xee, 0x3a, 0xde, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xcd, 0xcc, 0xb9, 0x28, 0x7b, 0x02, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x37, 0x4b, 0xd2, 0xbc, 0x24, 0xa5, 0x9a, 0xae, 0xba, 0x16, 0x15, 0x76, 0x08, 0x2a,
+	0x8d, 0x44, 0x1b, 0x6b, 0x17, 0x24, 0x8e, 0xb0, 0x59, 0x21, 0x11, 0x2d, 0x5a, 0xd0, 0x44, 0xe2,
+	0xc0, 0xc5, 0x72, 0xec, 0x97, 0xec, 0x28, 0xf1, 0x8c, 0x35, 0x33, 0x71, 0xc9, 0x0d, 0xee, 0x1c,
+	0xf8, 0x07, 0x5c, 0xf9, 0x29, 0x7b, 0xec, 0x91, 0x93, 0x05, 0xbb, 0xb7, 0xfc, 0x84, 0x9e, 0x90,
+	0xc7, 0xce, 0xc6, 0xa9, 0x68, 0xa5, 0x3d, 0xd9, 0xf3, 0xe6, 0xcd, 0x7b, 0xdf, 0xf7, 0xcd, 0x37,
+	0x0f, 0x9e, 0x2b, 0x0c, 0x25, 0x6a, 0x4f, 0xe2, 0x9c, 0x29, 0x2d, 0x03, 0xcd, 0x04, 0xf7, 0xd2,
+	0x93, 0x29, 0xea, 0xe0, 0xc4, 0xd3, 0xeb, 0x04, 0xd5, 0x30, 0x91, 0x42, 0x0b, 0xf2, 0xb4, 0x48,
+	0x1c, 0x56, 0x13, 0x87, 0x65, 0xe2, 0x47, 0x47, 0x73, 0x31, 0x17, 0x26, 0xcf, 0xcb, 0xff, 0x8a,
+	0x23, 0xfd, 0xdf, 0x2d, 0x80, 0x09, 0x62, 0x74, 0x2e, 0xf8, 0x8c, 0xcd, 0xc9, 0x67, 0x00, 0x71,
+	0xa0, 0x34, 0x4a, 0x7f, 0x81, 0x6b, 0xdb, 0xea, 0x59, 0x83, 0xd6, 0xa8, 0xb9, 0xc9, 0xdc, 0x7a,
+	0xb2, 0x38, 0xa5, 0xad, 0x62, 0xeb, 0x02, 0xd7, 0xc4, 0x83, 0x2e, 0xf2, 0x50, 0xae, 0x13, 0x8d,
+	0x91, 0x49, 0x3d, 0x30, 0xa9, 0xb0, 0xc9, 0xdc, 0x06, 0xf2, 0xf0, 0x02, 0xd7, 0xb4, 0x73, 0x97,
+	0x90, 0x1f, 0x78, 0x06, 0xcd, 0x14, 0xa5, 0x62, 0x82, 0xdb, 0xf5, 0x9e, 0x35, 0xe8, 0x8e, 0xda,
+	0x9b, 0xcc, 0xdd, 0x86, 0xe8, 0xf6, 0xa7, 0xbf, 0x84, 0x47, 0xdf, 0xe3, 0x3c, 0x08, 0xd7, 0x15,
+	0x4c, 0xcf, 0xa1, 0x5d, 0x62, 0x0a, 0x51, 0xea, 0x12, 0x54, 0x63, 0x93, 0xb9, 0x07, 0xc9, 0x82,
+	0x96, 0x70, 0xcf, 0x51, 0xea, 0x7b, 0x83, 0xea, 0xff, 0x5a, 0x87, 0x23, 0x5a, 0xd1, 0xea, 0x52,
+	0x44, 0x38, 0xe6, 0x33, 0x41, 0x56, 0xd0, 0xce, 0x7b, 0xb1, 0x19, 0x0b, 0x03, 0x8d, 0xa6, 0x65,
+	0x67, 0x34, 0x79, 0x93, 0xb9, 0x3f, 0xcc, 0x99, 0xbe, 0x5a, 0x4d, 0x87, 0xa1, 0x88, 0x3d, 0x15,
+	0x4a, 0xbd, 0x0c, 0xa6, 0xca, 0x9b, 0x18, 0xd5, 0x2f, 0x51, 0xbf, 0x12, 0x72, 0xe1, 0xfd, 0xb2,
+	0x7f, 0x4f, 0x12, 0x63, 0xa1, 0xd1, 0x0f, 0xb4, 0x46, 0xa5, 0x8b, 0x1b, 0x39, 0xdf, 0x95, 0xa6,
+	0xd5, 0x3e, 0xe4, 0x19, 0x3c, 0xdc, 0x11, 0x50, 0x88, 0x91, 0x61, 0xd0, 0xa1, 0x3b, 0x5a, 0xb9,
+	0x2c, 0xc4, 0x83, 0xc7, 0xd5, 0x16, 0xfe, 0x15, 0xb2, 0xf9, 0x95, 0x36, 0xba, 0xd6, 0x29, 0xa9,
+	0x6e, 0x7d, 0x67, 0x76, 0xc8, 0x18, 0x1a, 0x0a, 0x79, 0x84, 0xd2, 0x3e, 0x34, 0x4c, 0x4e, 0xde,
+	0x64, 0xee, 0xcb, 0x0a, 0x93, 0x50, 0xa8, 0x58, 0xa8, 0xf2, 0xf3, 0x52, 0x45, 0x8b, 0xd2, 0x55,
+	0x67, 0x61, 0x78, 0x16, 0x45, 0x12, 0x95, 0xa2, 0x65, 0x01, 0x72, 0x09, 0xed, 0x0a, 0x15, 0xfb,
+	0x83, 0x9e, 0x35, 0x68, 0x9f, 0xbe, 0x18, 0xbe, 0xc7, 0x78, 0xc3, 0x5c, 0xd5, 0xb3, 0xdd, 0x19,
+	0x5a, 0x2d, 0xd0, 0xff, 0xed, 0x00, 0x3e, 0x7c, 0x2b, 0x81, 0x10, 0x38, 0xcc, 0xfb, 0x17, 0x37,
+	0x4d, 0xcd, 0x3f, 0xf9, 0x18, 0x20, 0x96, 0x3e, 0xf2, 0x70, 0x19, 0xa4, 0x58, 0xca, 0xd2, 0x8a,
+	0xe5, 0xb7, 0x45, 0x80, 0x3c, 0x85, 0x56, 0x2c, 0x7d, 0xc5, 0xe6, 0x1c, 0xa5, 0x11, 0xa2, 0x43,
+	0x1f, 0xc4, 0x72, 0x62, 0xd6, 0xc4, 0x81, 0x36, 0x53, 0xa9, 0x9f, 0x48, 0x11, 0xf9, 0x2c, 0x32,
+	0x1a, 0x74, 0x69, 0x8b, 0xa9, 0xf4, 0x47, 0x29, 0xa2, 0x71, 0x44, 0x8e, 0xa1, 0x99, 0xef, 0xab,
+	0xb4, 0xe0, 0xd3, 0xa5, 0x0d, 0xa6, 0xd2, 0x49, 0xca, 0xf3, 0xa6, 0x3a, 0x9c, 0xfa, 0x39, 0xae,
+	0x95, 0xb2, 0x1b, 0x06, 0x4e, 0x4b, 0x87, 0xd3, 0x89, 0x09, 0x90, 0x4f, 0xa0, 0x13, 0x44, 0x29,
+	0x53, 0x42, 0xae, 0x7d, 0x16, 0x29, 0xbb, 0xd9, 0xab, 0x0f, 0x5a, 0xb4, 0xbd, 0x8d, 0x8d, 0x23,
+	0x45, 0x3e, 0x85, 0x6e, 0xb2, 0x0c, 0xf4, 0x4c, 0xc8, 0xd8, 0x67, 0x7c, 0x26, 0xec, 0x07, 0xa6,
+	0x48, 0x67, 0x1b, 0xcc, 0xdd, 0xd6, 0xff, 0xd3, 0x82, 0x87, 0x67, 0xcb, 0xa5, 0x78, 0x85, 0xd1,
+	0x96, 0xcf, 0x57, 0x7b, 0x74, 0x0b, 0xff, 0xd9, 0x9b, 0xcc, 0x3d, 0xda, 0x45, 0x5f, 0x88, 0x98,
+	0x69, 0x8c, 0x13, 0xbd, 0xae, 0x0a, 0xf1, 0x65, 0x55, 0x08, 0x23, 0xd3, 0xe8, 0x78, 0x93, 0xb9,
+	0x8f, 0xef, 0x82, 0x95, 0x63, 0x7b, 0x0a, 0xc5, 0x8c, 0xfb, 0x5b, 0x15, 0xea, 0x85, 0x42, 0x31,
+	0xe3, 0x63, 0x23, 0x44, 0xdf, 0x87, 0x47, 0x65, 0x03, 0x83, 0x73, 0xc9, 0x94, 0x26, 0x17, 0xd0,
+	0x44, 0xae, 0x25, 0x43, 0x65, 0x5b, 0xbd, 0xfa, 0xa0, 0x7d, 0xfa, 0xf9, 0x7b, 0x5d, 0xb0, 0x4f,
+	0x70, 0x74, 0x78, 0x9d, 0xb9, 0x35, 0xba, 0xad, 0xd0, 0xff, 0x06, 0x3a, 0xb9, 0xb5, 0xa9, 0x28,
+	0x2d, 0x60, 0xef, 0xc6, 0x85, 0x65, 0xc0, 0x6c, 0x97, 0xe4, 0x09, 0x34, 0x4a, 0xbf, 0x1f, 0x18,
+	0xbf, 0x97, 0xab, 0xbe, 0x84, 0x27, 0x3f, 0x15, 0x29, 0xa6, 0x49, 0xe5, 0xb9, 0xbc, 0xbb, 0xd6,
+	0x31, 0x34, 0x93, 0xd5, 0xf4, 0x6e, 0x54, 0x74, 0x68, 0x23, 0x59, 0x4d, 0x8b, 0x69, 0xf5, 0xf6,
+	0x43, 0xac, 0xff, 0xcf, 0x43, 0x1c, 0x05, 0xd7, 0xff, 0x3a, 0xb5, 0xbf, 0x6e, 0x1c, 0xeb, 0xfa,
+	0xc6, 0xb1, 0x5e, 0xdf, 0x38, 0xd6, 0x3f, 0x37, 0x8e, 0xf5, 0xc7, 0xad, 0x53, 0x7b, 0x7d, 0xeb,
+	0xd4, 0xfe, 0xbe, 0x75, 0x6a, 0x3f, 0x7f, 0x7d, 0xef, 0x79, 0xc1, 0xb8, 0x46, 0xc9, 0x83, 0x65,
+	0xf1, 0x04, 0xa7, 0x0d, 0x33, 0xa6, 0xbf, 0xf8, 0x6f, 0x00, 0x13, 0x5e, 0xa9, 0x85, 0x04, 0x06,
+	0x00, 0x00,
 }
 
 func (this *SeedConfig) Equal(that interface{}) bool {
@@ -261,6 +512,181 @@ func (this *RegistrationNodeInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
 		return false
 	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if !this.Attestation.Equal(that1.Attestation) {
+		return false
+	}
+	return true
+}
+func (this *NodeAttestation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodeAttestation)
+	if !ok {
+		that2, ok := that.(NodeAttestation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.MrEnclave, that1.MrEnclave) {
+		return false
+	}
+	if !bytes.Equal(this.MrSigner, that1.MrSigner) {
+		return false
+	}
+	if this.IsvProdId != that1.IsvProdId {
+		return false
+	}
+	if this.IsvSvn != that1.IsvSvn {
+		return false
+	}
+	if this.TcbStatus != that1.TcbStatus {
+		return false
+	}
+	if len(this.AdvisoryIds) != len(that1.AdvisoryIds) {
+		return false
+	}
+	for i := range this.AdvisoryIds {
+		if this.AdvisoryIds[i] != that1.AdvisoryIds[i] {
+			return false
+		}
+	}
+	if this.PlatformInfo != that1.PlatformInfo {
+		return false
+	}
+	return true
+}
+func (this *AllowedEnclave) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AllowedEnclave)
+	if !ok {
+		that2, ok := that.(AllowedEnclave)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.MrEnclave, that1.MrEnclave) {
+		return false
+	}
+	if !bytes.Equal(this.MrSigner, that1.MrSigner) {
+		return false
+	}
+	if this.MinIsvSvn != that1.MinIsvSvn {
+		return false
+	}
+	return true
+}
+func (this *EnclaveAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnclaveAllowlist)
+	if !ok {
+		that2, ok := that.(EnclaveAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SeedRotation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SeedRotation)
+	if !ok {
+		that2, ok := that.(SeedRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *VersionedEncryptedSeed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersionedEncryptedSeed)
+	if !ok {
+		that2, ok := that.(VersionedEncryptedSeed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
+		return false
+	}
 	return true
 }
 func (m *SeedConfig) Marshal() (dAtA []byte, err error) {
@@ -362,6 +788,30 @@ func (m *RegistrationNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EncryptedSeed) > 0 {
 		i -= len(m.EncryptedSeed)
 		copy(dAtA[i:], m.EncryptedSeed)
@@ -379,8 +829,239 @@ func (m *RegistrationNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
+func (m *NodeAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlatformInfo) > 0 {
+		i -= len(m.PlatformInfo)
+		copy(dAtA[i:], m.PlatformInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PlatformInfo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AdvisoryIds) > 0 {
+		for iNdEx := len(m.AdvisoryIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdvisoryIds[iNdEx])
+			copy(dAtA[i:], m.AdvisoryIds[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AdvisoryIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TcbStatus) > 0 {
+		i -= len(m.TcbStatus)
+		copy(dAtA[i:], m.TcbStatus)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TcbStatus)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.IsvSvn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IsvSvn))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsvProdId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IsvProdId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedEnclave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedEnclave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedEnclave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinIsvSvn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinIsvSvn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeedRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionedEncryptedSeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionedEncryptedSeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionedEncryptedSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedSeed) > 0 {
+		i -= len(m.EncryptedSeed)
+		copy(dAtA[i:], m.EncryptedSeed)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EncryptedSeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -424,33 +1105,893 @@ func (m *LegacySeedConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *RegistrationNodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RegistrationHeight))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IsvProdId != 0 {
+		n += 1 + sovTypes(uint64(m.IsvProdId))
+	}
+	if m.IsvSvn != 0 {
+		n += 1 + sovTypes(uint64(m.IsvSvn))
+	}
+	l = len(m.TcbStatus)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AdvisoryIds) > 0 {
+		for _, s := range m.AdvisoryIds {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PlatformInfo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedEnclave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MinIsvSvn != 0 {
+		n += 1 + sovTypes(uint64(m.MinIsvSvn))
+	}
+	return n
+}
+
+func (m *EnclaveAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SeedRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *VersionedEncryptedSeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SeedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacySeedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacySeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterCert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterCert = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationNodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationNodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSeed == nil {
+				m.EncryptedSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &NodeAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrEnclave = append(m.MrEnclave[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrEnclave == nil {
+				m.MrEnclave = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrSigner = append(m.MrSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrSigner == nil {
+				m.MrSigner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsvProdId", wireType)
+			}
+			m.IsvProdId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsvProdId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsvSvn", wireType)
+			}
+			m.IsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TcbStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TcbStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvisoryIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdvisoryIds = append(m.AdvisoryIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RegistrationNodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Certificate)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.EncryptedSeed)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SeedConfig) Unmarshal(dAtA []byte) error {
+func (m *AllowedEnclave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -473,17 +2014,17 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedEnclave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedEnclave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -493,29 +2034,31 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterKey = string(dAtA[iNdEx:postIndex])
+			m.MrEnclave = append(m.MrEnclave[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrEnclave == nil {
+				m.MrEnclave = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -525,29 +2068,31 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			m.MrSigner = append(m.MrSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrSigner == nil {
+				m.MrSigner = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIsvSvn", wireType)
 			}
-			m.Version = 0
+			m.MinIsvSvn = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -557,7 +2102,7 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.MinIsvSvn |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -583,7 +2128,7 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
+func (m *EnclaveAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,17 +2151,17 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacySeedConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnclaveAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacySeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnclaveAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -626,29 +2171,81 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterCert = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, AllowedEnclave{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -658,24 +2255,30 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -697,7 +2300,7 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
+func (m *VersionedEncryptedSeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,15 +2323,34 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegistrationNodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionedEncryptedSeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegistrationNodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionedEncryptedSeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -755,12 +2377,12 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Certificate == nil {
-				m.Certificate = []byte{}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
 			}
@@ -45,7 +45,7 @@ func (AppModuleBasic) Name() string {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the compute
 // module.
@@ -53,6 +53,7 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(&GenesisState{
 		NodeExchMasterKey: &MasterKey{},
 		IoMasterKey:       &MasterKey{},
+		Params:            DefaultParams(),
 	})
 }
 
@@ -100,6 +101,12 @@ func NewAppModule(keeper Keeper) AppModule {
 func (am AppModule) RegisterServices(configurator module.Configurator) {
 	types.RegisterMsgServer(configurator.MsgServer(), keeper.NewMsgServerImpl(am.keeper, ModuleName))
 	types.RegisterQueryServer(configurator.QueryServer(), NewQuerier(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	err := configurator.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the compute module. It returns
@@ -118,8 +125,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the compute module.
-func (am AppModule) BeginBlock(_ sdk.Context) {}
+// BeginBlock returns the begin blocker for the registration module, which applies scheduled seed rotations.
+func (am AppModule) BeginBlock(c context.Context) error {
+	return am.keeper.BeginBlocker(sdk.UnwrapSDKContext(c))
+}
 
 // EndBlock returns the end blocker for the compute module. It returns no validator
 // updates.
@@ -0,0 +1,864 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	dcapQuoteHeaderSize = 48
+	sgxReportBodySize   = 384
+	ecdsaSignatureSize  = 64
+	ecdsaPublicKeySize  = 64
+
+	dcapAttestationKeyTypeECDSA256 = 2
+	dcapTeeTypeSGX                 = 0
+
+	dcapCertDataTypePckCertChain          = 5
+	dcapCertDataTypeQeReportCertification = 6
+)
+
+// intelQeVendorID is the QE vendor id of quotes generated by the Intel quoting enclave
+var intelQeVendorID = [16]byte{0x93, 0x9a, 0x72, 0x33, 0xf7, 0x9c, 0x4c, 0xa9, 0x94, 0x0a, 0x0d, 0xb3, 0x95, 0x7f, 0x06, 0x07}
+
+// OIDs of the Intel SGX PCK certificate extensions
+var (
+	oidSgxExtensions = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1}
+	oidSgxTcb        = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 2}
+	oidSgxPceSvn     = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 2, 17}
+	oidSgxCpuSvn     = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 2, 18}
+	oidSgxPceID      = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 3}
+	oidSgxFmspc      = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 4}
+)
+
+// TCB statuses, as reported by the Intel TCB info and QE identity collateral
+const (
+	TcbStatusUpToDate                          = "UpToDate"
+	TcbStatusSWHardeningNeeded                 = "SWHardeningNeeded"
+	TcbStatusConfigurationNeeded               = "ConfigurationNeeded"
+	TcbStatusConfigurationAndSWHardeningNeeded = "ConfigurationAndSWHardeningNeeded"
+	TcbStatusOutOfDate                         = "OutOfDate"
+	TcbStatusOutOfDateConfigurationNeeded      = "OutOfDateConfigurationNeeded"
+	TcbStatusRevoked                           = "Revoked"
+)
+
+// SgxReportBody is the sgx_report_body_t of the enclave (or of the quoting enclave) that was attested
+type SgxReportBody struct {
+	CpuSvn     [16]byte
+	MiscSelect uint32
+	_          [12]byte
+	IsvExtProd [16]byte
+	Attributes [16]byte
+	MrEnclave  [32]byte
+	_          [32]byte
+	MrSigner   [32]byte
+	_          [32]byte
+	ConfigID   [64]byte
+	IsvProdID  uint16
+	IsvSvn     uint16
+	ConfigSvn  uint16
+	_          [42]byte
+	IsvFamily  [16]byte
+	ReportData [64]byte
+}
+
+// DcapQuoteHeader is the sgx_quote_header_t of a v3 or v4 ECDSA quote
+type DcapQuoteHeader struct {
+	Version            uint16
+	AttestationKeyType uint16
+	TeeType            uint32
+	QeSvn              uint16
+	PceSvn             uint16
+	QeVendorID         [16]byte
+	UserData           [20]byte
+}
+
+// ParsedDcapQuote is a v3 or v4 ECDSA quote of an SGX enclave
+type ParsedDcapQuote struct {
+	Header     DcapQuoteHeader
+	ReportBody SgxReportBody
+
+	// Signature is the ECDSA signature of the header and the report body by the attestation key
+	Signature      []byte
+	AttestationKey []byte
+
+	// QeReport is the report of the quoting enclave, signed by the PCK
+	QeReport          SgxReportBody
+	QeReportSignature []byte
+	QeAuthData        []byte
+
+	// PckCertChain is the PEM encoded PCK certificate chain, leaf first
+	PckCertChain []byte
+
+	signedData   []byte
+	qeReportData []byte
+}
+
+// DcapCollateral is the quote verification collateral, as serialized by the node next to the quote
+type DcapCollateral struct {
+	TeeType               uint32
+	PckCrlIssuerChain     []byte
+	RootCaCrl             []byte
+	PckCrl                []byte
+	TcbInfoIssuerChain    []byte
+	TcbInfo               []byte
+	QeIdentityIssuerChain []byte
+	QeIdentity            []byte
+}
+
+// DcapVerificationResult is what we learn about the attested enclave and its platform from a verified quote
+type DcapVerificationResult struct {
+	ReportBody  SgxReportBody
+	TcbStatus   string
+	AdvisoryIDs []string
+	Fmspc       string
+	// TcbDate is the date of the TCB level the platform matched, empty for unknown levels
+	TcbDate time.Time
+	// CollateralIssueDate is the issue date of the TCB info, i.e. when the TCB status was evaluated by Intel
+	CollateralIssueDate time.Time
+}
+
+type dcapReader struct {
+	buf []byte
+	err error
+}
+
+func (r *dcapReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.buf) < n {
+		r.err = errors.New("DCAP quote too short")
+		return nil
+	}
+	ret := r.buf[:n]
+	r.buf = r.buf[n:]
+	return ret
+}
+
+func (r *dcapReader) uint16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (r *dcapReader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func parseReportBody(raw []byte) (SgxReportBody, error) {
+	var body SgxReportBody
+	err := binary.Read(bytes.NewReader(raw), binary.LittleEndian, &body)
+	return body, err
+}
+
+// ParseDcapQuote parses an SGX ECDSA quote of version 3 or 4. It does not verify anything
+func ParseDcapQuote(raw []byte) (*ParsedDcapQuote, error) {
+	var quote ParsedDcapQuote
+	r := &dcapReader{buf: raw}
+
+	headerBytes := r.next(dcapQuoteHeaderSize)
+	bodyBytes := r.next(sgxReportBodySize)
+	if r.err != nil {
+		return nil, r.err
+	}
+	if err := binary.Read(bytes.NewReader(headerBytes), binary.LittleEndian, &quote.Header); err != nil {
+		return nil, err
+	}
+
+	switch quote.Header.Version {
+	case 3:
+	case 4:
+		if quote.Header.TeeType != dcapTeeTypeSGX {
+			return nil, fmt.Errorf("unsupported DCAP quote TEE type %#x", quote.Header.TeeType)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported DCAP quote version %d", quote.Header.Version)
+	}
+	if quote.Header.AttestationKeyType != dcapAttestationKeyTypeECDSA256 {
+		return nil, fmt.Errorf("unsupported DCAP attestation key type %d", quote.Header.AttestationKeyType)
+	}
+
+	var err error
+	quote.ReportBody, err = parseReportBody(bodyBytes)
+	if err != nil {
+		return nil, err
+	}
+	quote.signedData = raw[:dcapQuoteHeaderSize+sgxReportBodySize]
+
+	sigDataLen := r.uint32()
+	sig := &dcapReader{buf: r.next(int(sigDataLen))}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	quote.Signature = sig.next(ecdsaSignatureSize)
+	quote.AttestationKey = sig.next(ecdsaPublicKeySize)
+
+	// v4 quotes wrap the QE report in its own certification data
+	qe := sig
+	if quote.Header.Version == 4 {
+		certType := sig.uint16()
+		certSize := sig.uint32()
+		qe = &dcapReader{buf: sig.next(int(certSize))}
+		if sig.err == nil && certType != dcapCertDataTypeQeReportCertification {
+			return nil, fmt.Errorf("unsupported DCAP certification data type %d", certType)
+		}
+		if sig.err != nil {
+			return nil, sig.err
+		}
+	}
+
+	quote.qeReportData = qe.next(sgxReportBodySize)
+	quote.QeReportSignature = qe.next(ecdsaSignatureSize)
+	quote.QeAuthData = qe.next(int(qe.uint16()))
+	certType := qe.uint16()
+	quote.PckCertChain = qe.next(int(qe.uint32()))
+	if sig.err != nil {
+		return nil, sig.err
+	}
+	if qe.err != nil {
+		return nil, qe.err
+	}
+	if certType != dcapCertDataTypePckCertChain {
+		return nil, fmt.Errorf("unsupported DCAP certification data type %d", certType)
+	}
+
+	quote.QeReport, err = parseReportBody(quote.qeReportData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// ParseDcapCollateral parses the collateral the node serializes after its quote (see sgx_ql_qve_collateral_serialize)
+func ParseDcapCollateral(raw []byte) (*DcapCollateral, error) {
+	r := &dcapReader{buf: raw}
+
+	var c DcapCollateral
+	c.TeeType = r.uint32()
+	sizes := make([]uint32, 7)
+	for i := range sizes {
+		sizes[i] = r.uint32()
+	}
+	fields := []*[]byte{
+		&c.PckCrlIssuerChain,
+		&c.RootCaCrl,
+		&c.PckCrl,
+		&c.TcbInfoIssuerChain,
+		&c.TcbInfo,
+		&c.QeIdentityIssuerChain,
+		&c.QeIdentity,
+	}
+	for i, field := range fields {
+		// the collateral strings are usually null terminated
+		*field = bytes.TrimRight(r.next(int(sizes[i])), "\x00")
+	}
+	if r.err != nil {
+		return nil, errors.New("DCAP collateral too short")
+	}
+
+	return &c, nil
+}
+
+// VerifyDcapQuote verifies an SGX ECDSA quote against its collateral, at the given time:
+//   - the PCK certificate chain up to the Intel SGX root CA, and its CRLs
+//   - the QE report signature and the attestation key binding
+//   - the quote signature
+//   - the QE identity and the TCB status of the platform
+//
+// It returns an error for revoked platforms, any other TCB status is left to the caller
+func VerifyDcapQuote(rawQuote []byte, collateral *DcapCollateral, now time.Time) (*DcapVerificationResult, error) {
+	return verifyDcapQuote(rawQuote, collateral, intelSgxRootCA(), now)
+}
+
+func verifyDcapQuote(rawQuote []byte, collateral *DcapCollateral, root *x509.Certificate, now time.Time) (*DcapVerificationResult, error) {
+	quote, err := ParseDcapQuote(rawQuote)
+	if err != nil {
+		return nil, err
+	}
+	if collateral == nil {
+		return nil, errors.New("missing DCAP collateral")
+	}
+	if quote.Header.QeVendorID != intelQeVendorID {
+		return nil, errors.New("DCAP quote was not generated by the Intel quoting enclave")
+	}
+
+	// 1. PCK certificate chain
+	pckChain, err := verifyPemCertChain(quote.PckCertChain, root, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "PCK certificate chain")
+	}
+	pckCert := pckChain[0]
+	if err := checkCrls(collateral, pckChain, root, now); err != nil {
+		return nil, err
+	}
+
+	// 2. QE report, signed by the PCK
+	pckKey, ok := pckCert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("PCK certificate key is not ECDSA")
+	}
+	if !verifyRawEcdsaSignature(pckKey, quote.qeReportData, quote.QeReportSignature) {
+		return nil, errors.New("invalid QE report signature")
+	}
+
+	// 3. the attestation key is bound to the QE report
+	binding := sha256.Sum256(append(append([]byte{}, quote.AttestationKey...), quote.QeAuthData...))
+	if !bytes.Equal(quote.QeReport.ReportData[:32], binding[:]) || !isZero(quote.QeReport.ReportData[32:]) {
+		return nil, errors.New("attestation key is not bound to the QE report")
+	}
+
+	// 4. quote signature
+	attestationKey, err := parseRawEcdsaPublicKey(quote.AttestationKey)
+	if err != nil {
+		return nil, err
+	}
+	if !verifyRawEcdsaSignature(attestationKey, quote.signedData, quote.Signature) {
+		return nil, errors.New("invalid DCAP quote signature")
+	}
+
+	// 5. QE identity
+	qeIdentity, err := verifyQeIdentity(collateral, root, now)
+	if err != nil {
+		return nil, err
+	}
+	qeStatus, err := qeIdentity.status(&quote.QeReport)
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. TCB level of the platform
+	tcbInfo, err := verifyTcbInfo(collateral, root, now)
+	if err != nil {
+		return nil, err
+	}
+	pckTcb, err := parsePckTcb(pckCert)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(tcbInfo.Fmspc, hex.EncodeToString(pckTcb.fmspc)) {
+		return nil, errors.New("TCB info FMSPC does not match the PCK certificate")
+	}
+	if !strings.EqualFold(tcbInfo.PceID, hex.EncodeToString(pckTcb.pceID)) {
+		return nil, errors.New("TCB info PCE ID does not match the PCK certificate")
+	}
+	level, ok := tcbInfo.matchLevel(pckTcb)
+	if !ok {
+		return nil, errors.New("no TCB level matches the platform")
+	}
+
+	status := convergeTcbStatus(level.TcbStatus, qeStatus)
+	if status == TcbStatusRevoked {
+		return nil, errors.New("platform TCB is revoked")
+	}
+
+	return &DcapVerificationResult{
+		ReportBody:          quote.ReportBody,
+		TcbStatus:           status,
+		AdvisoryIDs:         level.AdvisoryIDs,
+		Fmspc:               strings.ToUpper(tcbInfo.Fmspc),
+		TcbDate:             level.TcbDate,
+		CollateralIssueDate: tcbInfo.IssueDate,
+	}, nil
+}
+
+// convergeTcbStatus combines the TCB status of the platform with that of the quoting enclave
+func convergeTcbStatus(platformStatus string, qeStatus string) string {
+	switch qeStatus {
+	case TcbStatusRevoked:
+		return TcbStatusRevoked
+	case TcbStatusOutOfDate:
+		switch platformStatus {
+		case TcbStatusUpToDate, TcbStatusSWHardeningNeeded:
+			return TcbStatusOutOfDate
+		case TcbStatusConfigurationNeeded, TcbStatusConfigurationAndSWHardeningNeeded:
+			return TcbStatusOutOfDateConfigurationNeeded
+		}
+	}
+	return platformStatus
+}
+
+func verifyPemCertChain(chainPem []byte, root *x509.Certificate, now time.Time) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	rest := chainPem
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("empty certificate chain")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	verified, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return verified[0], nil
+}
+
+// checkCrls verifies the CRLs of the collateral and makes sure they don't revoke the PCK chain. Both CRLs are required.
+// The root CA CRL must be signed by the root, and the PCK CRL by the CA of the PCK CRL issuer chain, which must be the
+// CA that issued the PCK certificate. CRLs past their next update are stale, while CRLs issued after now only know of
+// more revocations and are accepted. chain is the verified PCK chain, leaf first and root last
+func checkCrls(collateral *DcapCollateral, chain []*x509.Certificate, root *x509.Certificate, now time.Time) error {
+	if len(chain) < 2 {
+		return errors.New("PCK certificate chain too short")
+	}
+
+	issuerChain, err := verifyPemCertChain(collateral.PckCrlIssuerChain, root, now)
+	if err != nil {
+		return errors.Wrap(err, "PCK CRL issuer chain")
+	}
+	if !issuerChain[0].Equal(chain[1]) {
+		return errors.New("PCK CRL issuer is not the issuer of the PCK certificate")
+	}
+
+	crls := []struct {
+		name    string
+		raw     []byte
+		issuer  *x509.Certificate
+		checked []*x509.Certificate
+	}{
+		{"root CA CRL", collateral.RootCaCrl, root, chain[1 : len(chain)-1]},
+		{"PCK CRL", collateral.PckCrl, issuerChain[0], chain[:1]},
+	}
+
+	for _, c := range crls {
+		if len(c.raw) == 0 {
+			return fmt.Errorf("missing %s", c.name)
+		}
+		crl, err := x509.ParseRevocationList(decodeCrl(c.raw))
+		if err != nil {
+			return errors.Wrap(err, c.name)
+		}
+		if err := crl.CheckSignatureFrom(c.issuer); err != nil {
+			return errors.Wrap(err, c.name)
+		}
+		if crl.NextUpdate.Before(now) {
+			return fmt.Errorf("%s is stale, its next update was %s", c.name, crl.NextUpdate.UTC().Format(time.RFC3339))
+		}
+		for _, revoked := range crl.RevokedCertificateEntries {
+			for _, cert := range c.checked {
+				if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+					return fmt.Errorf("certificate %s is revoked", cert.Subject.CommonName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// decodeCrl accepts CRLs in PEM, hex encoded DER or DER, as returned by the different versions of the quote provider library
+func decodeCrl(raw []byte) []byte {
+	raw = bytes.TrimSpace(raw)
+	if block, _ := pem.Decode(raw); block != nil {
+		return block.Bytes
+	}
+	if der, err := hex.DecodeString(string(raw)); err == nil {
+		return der
+	}
+	return raw
+}
+
+func parseRawEcdsaPublicKey(raw []byte) (*ecdsa.PublicKey, error) {
+	if len(raw) != ecdsaPublicKeySize {
+		return nil, errors.New("invalid attestation key")
+	}
+	key := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(raw[:32]),
+		Y:     new(big.Int).SetBytes(raw[32:]),
+	}
+	if !key.Curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("attestation key is not on the P-256 curve")
+	}
+	return key, nil
+}
+
+// verifyRawEcdsaSignature verifies a P-256 signature encoded as r || s
+func verifyRawEcdsaSignature(key *ecdsa.PublicKey, data []byte, sig []byte) bool {
+	if len(sig) != ecdsaSignatureSize {
+		return false
+	}
+	hash := sha256.Sum256(data)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	return ecdsa.Verify(key, hash[:], r, s)
+}
+
+func isZero(b []byte) bool {
+	for _, x := range b {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type pckTcb struct {
+	compSvn [16]uint8
+	pceSvn  uint16
+	cpuSvn  []byte
+	pceID   []byte
+	fmspc   []byte
+}
+
+type asn1Extension struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+// parsePckTcb reads the TCB, PCE ID and FMSPC from the Intel SGX extensions of a PCK certificate
+func parsePckTcb(cert *x509.Certificate) (*pckTcb, error) {
+	var raw []byte
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidSgxExtensions) {
+			raw = ext.Value
+		}
+	}
+	if raw == nil {
+		return nil, errors.New("PCK certificate has no SGX extensions")
+	}
+
+	var exts []asn1Extension
+	if _, err := asn1.Unmarshal(raw, &exts); err != nil {
+		return nil, errors.Wrap(err, "PCK certificate SGX extensions")
+	}
+
+	var tcb pckTcb
+	foundTcb := false
+	for _, ext := range exts {
+		switch {
+		case ext.ID.Equal(oidSgxTcb):
+			var comps []asn1Extension
+			if _, err := asn1.Unmarshal(ext.Value.FullBytes, &comps); err != nil {
+				return nil, errors.Wrap(err, "PCK certificate TCB")
+			}
+			for _, comp := range comps {
+				switch {
+				case comp.ID.Equal(oidSgxCpuSvn):
+					tcb.cpuSvn = comp.Value.Bytes
+				case comp.ID.Equal(oidSgxPceSvn):
+					var svn int
+					if _, err := asn1.Unmarshal(comp.Value.FullBytes, &svn); err != nil {
+						return nil, errors.Wrap(err, "PCK certificate PCESVN")
+					}
+					tcb.pceSvn = uint16(svn)
+				case len(comp.ID) == len(oidSgxTcb)+1 && comp.ID[:len(oidSgxTcb)].Equal(oidSgxTcb):
+					idx := comp.ID[len(oidSgxTcb)]
+					if idx < 1 || idx > 16 {
+						continue
+					}
+					var svn int
+					if _, err := asn1.Unmarshal(comp.Value.FullBytes, &svn); err != nil {
+						return nil, errors.Wrap(err, "PCK certificate TCB component")
+					}
+					tcb.compSvn[idx-1] = uint8(svn)
+				}
+			}
+			foundTcb = true
+		case ext.ID.Equal(oidSgxPceID):
+			tcb.pceID = ext.Value.Bytes
+		case ext.ID.Equal(oidSgxFmspc):
+			tcb.fmspc = ext.Value.Bytes
+		}
+	}
+	if !foundTcb || tcb.pceID == nil || tcb.fmspc == nil {
+		return nil, errors.New("PCK certificate is missing SGX extensions")
+	}
+
+	return &tcb, nil
+}
+
+type signedCollateral struct {
+	TcbInfo         json.RawMessage `json:"tcbInfo"`
+	EnclaveIdentity json.RawMessage `json:"enclaveIdentity"`
+	Signature       string          `json:"signature"`
+}
+
+// verifySignedCollateral checks the signature of a TCB info or QE identity document and returns the signed body
+func verifySignedCollateral(document []byte, issuerChain []byte, root *x509.Certificate, now time.Time) (json.RawMessage, error) {
+	var signed signedCollateral
+	if err := json.Unmarshal(document, &signed); err != nil {
+		return nil, err
+	}
+	body := signed.TcbInfo
+	if body == nil {
+		body = signed.EnclaveIdentity
+	}
+	if body == nil {
+		return nil, errors.New("unknown collateral document")
+	}
+
+	chain, err := verifyPemCertChain(issuerChain, root, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "issuer chain")
+	}
+	key, ok := chain[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("signing certificate key is not ECDSA")
+	}
+	sig, err := hex.DecodeString(signed.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if !verifyRawEcdsaSignature(key, body, sig) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return body, nil
+}
+
+type tcbInfo struct {
+	ID         string     `json:"id"`
+	Version    int        `json:"version"`
+	IssueDate  time.Time  `json:"issueDate"`
+	NextUpdate time.Time  `json:"nextUpdate"`
+	Fmspc      string     `json:"fmspc"`
+	PceID      string     `json:"pceId"`
+	TcbLevels  []tcbLevel `json:"tcbLevels"`
+}
+
+type tcbLevel struct {
+	Tcb         tcbLevelComponents `json:"tcb"`
+	TcbDate     time.Time          `json:"tcbDate"`
+	TcbStatus   string             `json:"tcbStatus"`
+	AdvisoryIDs []string           `json:"advisoryIDs"`
+}
+
+// tcbLevelComponents reads both the v2 (sgxtcbcompXXsvn) and the v3 (sgxtcbcomponents) TCB info formats
+type tcbLevelComponents struct {
+	CompSvn [16]uint8
+	PceSvn  uint16
+}
+
+func (c *tcbLevelComponents) UnmarshalJSON(data []byte) error {
+	var v3 struct {
+		SgxTcbComponents []struct {
+			Svn uint8 `json:"svn"`
+		} `json:"sgxtcbcomponents"`
+		PceSvn uint16 `json:"pcesvn"`
+	}
+	if err := json.Unmarshal(data, &v3); err != nil {
+		return err
+	}
+	c.PceSvn = v3.PceSvn
+
+	if v3.SgxTcbComponents != nil {
+		if len(v3.SgxTcbComponents) != 16 {
+			return errors.New("TCB level must have 16 SGX TCB components")
+		}
+		for i, comp := range v3.SgxTcbComponents {
+			c.CompSvn[i] = comp.Svn
+		}
+		return nil
+	}
+
+	var v2 map[string]json.RawMessage
+	if err := json.Unmarshal(data, &v2); err != nil {
+		return err
+	}
+	for i := range c.CompSvn {
+		svn, ok := v2[fmt.Sprintf("sgxtcbcomp%02dsvn", i+1)]
+		if !ok {
+			return errors.New("TCB level is missing SGX TCB components")
+		}
+		if err := json.Unmarshal(svn, &c.CompSvn[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyTcbInfo(collateral *DcapCollateral, root *x509.Certificate, now time.Time) (*tcbInfo, error) {
+	body, err := verifySignedCollateral(collateral.TcbInfo, collateral.TcbInfoIssuerChain, root, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "TCB info")
+	}
+
+	var info tcbInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, errors.Wrap(err, "TCB info")
+	}
+	if info.Version != 2 && info.Version != 3 {
+		return nil, fmt.Errorf("unsupported TCB info version %d", info.Version)
+	}
+	if info.Version == 3 && info.ID != "SGX" {
+		return nil, fmt.Errorf("unsupported TCB info id %s", info.ID)
+	}
+	if now.Before(info.IssueDate) || now.After(info.NextUpdate) {
+		return nil, errors.New("TCB info is not valid at the verification time")
+	}
+
+	return &info, nil
+}
+
+// matchLevel returns the first (i.e. highest) TCB level the platform is at
+func (info *tcbInfo) matchLevel(tcb *pckTcb) (*tcbLevel, bool) {
+	for i := range info.TcbLevels {
+		level := &info.TcbLevels[i]
+		if tcb.pceSvn < level.Tcb.PceSvn {
+			continue
+		}
+		matches := true
+		for j := range level.Tcb.CompSvn {
+			if tcb.compSvn[j] < level.Tcb.CompSvn[j] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return level, true
+		}
+	}
+	return nil, false
+}
+
+type qeIdentity struct {
+	ID             string    `json:"id"`
+	Version        int       `json:"version"`
+	IssueDate      time.Time `json:"issueDate"`
+	NextUpdate     time.Time `json:"nextUpdate"`
+	MiscSelect     string    `json:"miscselect"`
+	MiscSelectMask string    `json:"miscselectMask"`
+	Attributes     string    `json:"attributes"`
+	AttributesMask string    `json:"attributesMask"`
+	MrSigner       string    `json:"mrsigner"`
+	IsvProdID      uint16    `json:"isvprodid"`
+	TcbLevels      []struct {
+		Tcb struct {
+			IsvSvn uint16 `json:"isvsvn"`
+		} `json:"tcb"`
+		TcbStatus string `json:"tcbStatus"`
+	} `json:"tcbLevels"`
+}
+
+func verifyQeIdentity(collateral *DcapCollateral, root *x509.Certificate, now time.Time) (*qeIdentity, error) {
+	body, err := verifySignedCollateral(collateral.QeIdentity, collateral.QeIdentityIssuerChain, root, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "QE identity")
+	}
+
+	var identity qeIdentity
+	if err := json.Unmarshal(body, &identity); err != nil {
+		return nil, errors.Wrap(err, "QE identity")
+	}
+	if identity.ID != "QE" {
+		return nil, fmt.Errorf("unsupported QE identity id %s", identity.ID)
+	}
+	if now.Before(identity.IssueDate) || now.After(identity.NextUpdate) {
+		return nil, errors.New("QE identity is not valid at the verification time")
+	}
+
+	return &identity, nil
+}
+
+// status checks the QE report against the identity and returns the TCB status of the quoting enclave
+func (identity *qeIdentity) status(report *SgxReportBody) (string, error) {
+	mrSigner, err := hex.DecodeString(identity.MrSigner)
+	if err != nil {
+		return "", errors.Wrap(err, "QE identity mrsigner")
+	}
+	if !bytes.Equal(mrSigner, report.MrSigner[:]) {
+		return "", errors.New("QE report mrsigner does not match the QE identity")
+	}
+	if report.IsvProdID != identity.IsvProdID {
+		return "", errors.New("QE report isvprodid does not match the QE identity")
+	}
+
+	miscSelect := make([]byte, 4)
+	binary.LittleEndian.PutUint32(miscSelect, report.MiscSelect)
+	// the QE identity stores miscselect as a big endian hex string
+	for i, j := 0, len(miscSelect)-1; i < j; i, j = i+1, j-1 {
+		miscSelect[i], miscSelect[j] = miscSelect[j], miscSelect[i]
+	}
+	if err := checkMasked("miscselect", miscSelect, identity.MiscSelect, identity.MiscSelectMask); err != nil {
+		return "", err
+	}
+	if err := checkMasked("attributes", report.Attributes[:], identity.Attributes, identity.AttributesMask); err != nil {
+		return "", err
+	}
+
+	for _, level := range identity.TcbLevels {
+		if report.IsvSvn >= level.Tcb.IsvSvn {
+			return level.TcbStatus, nil
+		}
+	}
+	return TcbStatusRevoked, nil
+}
+
+func checkMasked(name string, value []byte, expectedHex string, maskHex string) error {
+	expected, err := hex.DecodeString(expectedHex)
+	if err != nil {
+		return errors.Wrapf(err, "QE identity %s", name)
+	}
+	mask, err := hex.DecodeString(maskHex)
+	if err != nil {
+		return errors.Wrapf(err, "QE identity %s mask", name)
+	}
+	if len(expected) != len(value) || len(mask) != len(value) {
+		return fmt.Errorf("QE identity %s has the wrong size", name)
+	}
+	for i := range value {
+		if value[i]&mask[i] != expected[i]&mask[i] {
+			return fmt.Errorf("QE report %s does not match the QE identity", name)
+		}
+	}
+	return nil
+}
+
+func intelSgxRootCA() *x509.Certificate {
+	block, _ := pem.Decode([]byte(intelSgxRootCAPEM))
+	if block == nil {
+		panic("failed to parse Intel SGX root CA")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		panic("failed to parse Intel SGX root CA")
+	}
+	return cert
+}
@@ -0,0 +1,241 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The fixtures are recorded by ../testdata/dcap/gen.go, with a test PKI instead of Intel's, except for the recorded
+// Intel vector in ../testdata/dcap/intel
+var dcapFixtureTime = time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+
+func readDcapFixture(t *testing.T, name string) []byte {
+	data, err := os.ReadFile("../testdata/dcap/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func dcapFixtureRoot(t *testing.T) *x509.Certificate {
+	block, _ := pem.Decode(readDcapFixture(t, "root_ca.pem"))
+	require.NotNil(t, block)
+	root, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return root
+}
+
+func dcapFixtureCollateral(t *testing.T, name string) *DcapCollateral {
+	collateral, err := ParseDcapCollateral(readDcapFixture(t, name))
+	require.NoError(t, err)
+	return collateral
+}
+
+func Test_IntelSgxRootCA(t *testing.T) {
+	root := intelSgxRootCA()
+	require.Equal(t, "Intel SGX Root CA", root.Subject.CommonName)
+	require.NoError(t, root.CheckSignatureFrom(root))
+}
+
+func Test_VerifyDcapQuote(t *testing.T) {
+	nodePubKey := sha256.Sum256([]byte("secret node"))
+
+	for _, name := range []string{"quote_v3.bin", "quote_v4.bin"} {
+		t.Run(name, func(t *testing.T) {
+			res, err := verifyDcapQuote(readDcapFixture(t, name), dcapFixtureCollateral(t, "collateral.bin"), dcapFixtureRoot(t), dcapFixtureTime)
+			require.NoError(t, err)
+			require.Equal(t, TcbStatusUpToDate, res.TcbStatus)
+			require.Empty(t, res.AdvisoryIDs)
+			require.Equal(t, "00906ED50000", res.Fmspc)
+			require.Equal(t, nodePubKey[:], res.ReportBody.ReportData[:32])
+			require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), res.CollateralIssueDate)
+		})
+	}
+}
+
+func Test_VerifyDcapQuoteTcbLevel(t *testing.T) {
+	res, err := verifyDcapQuote(readDcapFixture(t, "quote_v3_sw_hardening.bin"), dcapFixtureCollateral(t, "collateral.bin"), dcapFixtureRoot(t), dcapFixtureTime)
+	require.NoError(t, err)
+	require.Equal(t, TcbStatusSWHardeningNeeded, res.TcbStatus)
+	require.Equal(t, []string{"INTEL-SA-00615"}, res.AdvisoryIDs)
+}
+
+func Test_VerifyDcapQuoteInvalid(t *testing.T) {
+	quote := readDcapFixture(t, "quote_v3.bin")
+	root := dcapFixtureRoot(t)
+	collateral := dcapFixtureCollateral(t, "collateral.bin")
+
+	tamperedBody := append([]byte{}, quote...)
+	tamperedBody[dcapQuoteHeaderSize+320] ^= 1 // report_data
+
+	tamperedQeReport := append([]byte{}, quote...)
+	tamperedQeReport[dcapQuoteHeaderSize+sgxReportBodySize+4+ecdsaSignatureSize+ecdsaPublicKeySize+64] ^= 1 // QE mrenclave
+
+	tamperedTcbInfo := *collateral
+	tamperedTcbInfo.TcbInfo = bytes.Replace(collateral.TcbInfo, []byte("UpToDate"), []byte("Revoked"), 1)
+
+	withoutCrls := *collateral
+	withoutCrls.RootCaCrl = nil
+	withoutCrls.PckCrl = nil
+
+	for name, tc := range map[string]struct {
+		quote      []byte
+		collateral *DcapCollateral
+		root       *x509.Certificate
+		now        time.Time
+	}{
+		"tampered report body":   {tamperedBody, collateral, root, dcapFixtureTime},
+		"tampered QE report":     {tamperedQeReport, collateral, root, dcapFixtureTime},
+		"truncated quote":        {quote[:len(quote)-10], collateral, root, dcapFixtureTime},
+		"not an Intel chain":     {quote, collateral, intelSgxRootCA(), dcapFixtureTime},
+		"expired collateral":     {quote, collateral, root, dcapFixtureTime.AddDate(0, 1, 0)},
+		"revoked PCK":            {quote, dcapFixtureCollateral(t, "collateral_pck_revoked.bin"), root, dcapFixtureTime},
+		"tampered TCB info":      {quote, &tamperedTcbInfo, root, dcapFixtureTime},
+		"missing CRLs":           {quote, &withoutCrls, root, dcapFixtureTime},
+		"missing collateral":     {quote, nil, root, dcapFixtureTime},
+		"random data as a quote": {[]byte("Here is a string...."), collateral, root, dcapFixtureTime},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := verifyDcapQuote(tc.quote, tc.collateral, tc.root, tc.now)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_ParseDcapCollateralTooShort(t *testing.T) {
+	collateral := readDcapFixture(t, "collateral.bin")
+	_, err := ParseDcapCollateral(collateral[:len(collateral)-1])
+	require.Error(t, err)
+}
+
+// intelDcapCollateral is the collateral of the recorded Intel vector, with CRLs that Intel issued later
+func intelDcapCollateral(t *testing.T) *DcapCollateral {
+	issuerChain := readDcapFixture(t, "intel/tcb_info_v3_fmspc_00606A000000_certs.pem")
+	return &DcapCollateral{
+		PckCrlIssuerChain:     readDcapFixture(t, "intel/pck_crl_issuer_chain.pem"),
+		RootCaCrl:             readDcapFixture(t, "intel/root_ca_crl.der"),
+		PckCrl:                readDcapFixture(t, "intel/pck_platform_ca_crl.der"),
+		TcbInfoIssuerChain:    issuerChain,
+		TcbInfo:               readDcapFixture(t, "intel/tcb_info_v3_fmspc_00606A000000.json"),
+		QeIdentityIssuerChain: issuerChain,
+		QeIdentity:            readDcapFixture(t, "intel/qe_identity_v2.json"),
+	}
+}
+
+// intelDcapFixtureTime is when the recorded Intel vector was verified, within the validity of all its collateral
+var intelDcapFixtureTime = time.Unix(1671497404, 0)
+
+func Test_VerifyDcapQuoteIntel(t *testing.T) {
+	res, err := VerifyDcapQuote(readDcapFixture(t, "intel/quote_v3_ecdsa_p256_pck_chain.bin"), intelDcapCollateral(t), intelDcapFixtureTime)
+	require.NoError(t, err)
+	require.Equal(t, "68823bc62f409ee33a32ea270cfe45d4b19a6fb3c8570d7bc186cbe062398e8f", hex.EncodeToString(res.ReportBody.MrEnclave[:]))
+	require.Equal(t, "9affcfae47b848ec2caf1c49b4b283531e1cc425f93582b36806e52a43d78d1a", hex.EncodeToString(res.ReportBody.MrSigner[:]))
+	require.Equal(t, TcbStatusSWHardeningNeeded, res.TcbStatus)
+	require.Equal(t, []string{"INTEL-SA-00615", "INTEL-SA-00657"}, res.AdvisoryIDs)
+	require.Equal(t, "00606A000000", res.Fmspc)
+	require.Equal(t, time.Date(2022, 8, 10, 0, 0, 0, 0, time.UTC), res.TcbDate)
+	require.Equal(t, time.Date(2022, 12, 19, 9, 40, 10, 0, time.UTC), res.CollateralIssueDate)
+}
+
+func Test_VerifyDcapQuoteIntelInvalid(t *testing.T) {
+	quote := readDcapFixture(t, "intel/quote_v3_ecdsa_p256_pck_chain.bin")
+	collateral := intelDcapCollateral(t)
+
+	tamperedBody := append([]byte{}, quote...)
+	tamperedBody[dcapQuoteHeaderSize+320] ^= 1 // report_data
+
+	tamperedTcbInfo := *collateral
+	tamperedTcbInfo.TcbInfo = bytes.Replace(collateral.TcbInfo, []byte("SWHardeningNeeded"), []byte("UpToDate"), 1)
+
+	for name, tc := range map[string]struct {
+		quote      []byte
+		collateral *DcapCollateral
+		now        time.Time
+	}{
+		"tampered report body":           {tamperedBody, collateral, intelDcapFixtureTime},
+		"tampered TCB info":              {quote, &tamperedTcbInfo, intelDcapFixtureTime},
+		"collateral not yet issued":      {quote, collateral, time.Date(2022, 12, 19, 9, 40, 9, 0, time.UTC)},
+		"expired QE identity":            {quote, collateral, time.Date(2023, 1, 15, 12, 45, 37, 0, time.UTC)},
+		"expired PCK certificate":        {quote, collateral, time.Unix(1891163522, 0)},
+		"PCK certificate not yet issued": {quote, collateral, time.Unix(1670238720, 0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := VerifyDcapQuote(tc.quote, tc.collateral, tc.now)
+			require.Error(t, err)
+		})
+	}
+}
+
+// Test_VerifyDcapQuoteIntelCrls checks that the quote is only accepted with both CRLs, fresh and signed by the CAs of
+// the PCK chain
+func Test_VerifyDcapQuoteIntelCrls(t *testing.T) {
+	quote := readDcapFixture(t, "intel/quote_v3_ecdsa_p256_pck_chain.bin")
+
+	for name, tc := range map[string]struct {
+		modify func(c *DcapCollateral)
+		now    time.Time
+		err    string
+	}{
+		"missing root CA CRL":        {func(c *DcapCollateral) { c.RootCaCrl = nil }, intelDcapFixtureTime, "missing root CA CRL"},
+		"missing PCK CRL":            {func(c *DcapCollateral) { c.PckCrl = nil }, intelDcapFixtureTime, "missing PCK CRL"},
+		"missing PCK CRL issuer":     {func(c *DcapCollateral) { c.PckCrlIssuerChain = nil }, intelDcapFixtureTime, "PCK CRL issuer chain"},
+		"PCK CRL issuer of TCB info": {func(c *DcapCollateral) { c.PckCrlIssuerChain = c.TcbInfoIssuerChain }, intelDcapFixtureTime, "PCK CRL issuer is not the issuer"},
+		"root CA CRL as PCK CRL":     {func(c *DcapCollateral) { c.PckCrl = c.RootCaCrl }, intelDcapFixtureTime, "PCK CRL"},
+		"stale PCK CRL":              {func(c *DcapCollateral) {}, time.Date(2023, 7, 9, 0, 0, 0, 0, time.UTC), "PCK CRL is stale"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			collateral := intelDcapCollateral(t)
+			tc.modify(collateral)
+			_, err := VerifyDcapQuote(quote, collateral, tc.now)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// serializeDcapCollateral serializes collateral like the node does, see ParseDcapCollateral
+func serializeDcapCollateral(c *DcapCollateral) []byte {
+	fields := [][]byte{c.PckCrlIssuerChain, c.RootCaCrl, c.PckCrl, c.TcbInfoIssuerChain, c.TcbInfo, c.QeIdentityIssuerChain, c.QeIdentity}
+	buf := binary.LittleEndian.AppendUint32(nil, c.TeeType)
+	for _, field := range fields {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(field)))
+	}
+	for _, field := range fields {
+		buf = append(buf, field...)
+	}
+	return buf
+}
+
+// Test_VerifyCombinedDcapQuoteTime checks that the collateral a node sends is verified at the time of the caller, so
+// that nodes can't register with collateral that expired
+func Test_VerifyCombinedDcapQuoteTime(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quote      []byte
+		collateral []byte
+		root       *x509.Certificate
+		now        time.Time
+		// expired is right after the next update of the TCB info or the QE identity
+		expired time.Time
+	}{
+		"test PKI": {readDcapFixture(t, "quote_v3.bin"), readDcapFixture(t, "collateral.bin"), dcapFixtureRoot(t), dcapFixtureTime, time.Date(2024, 6, 1, 0, 0, 1, 0, time.UTC)},
+		"Intel":    {readDcapFixture(t, "intel/quote_v3_ecdsa_p256_pck_chain.bin"), serializeDcapCollateral(intelDcapCollateral(t)), intelSgxRootCA(), intelDcapFixtureTime, time.Date(2023, 1, 15, 12, 45, 37, 0, time.UTC)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := verifyCombinedDcapQuote(tc.quote, tc.collateral, tc.root, tc.now)
+			require.NoError(t, err)
+
+			// without a time, the collateral is only checked against itself
+			_, err = verifyCombinedDcapQuote(tc.quote, tc.collateral, tc.root, time.Time{})
+			require.NoError(t, err)
+
+			_, err = verifyCombinedDcapQuote(tc.quote, tc.collateral, tc.root, tc.expired)
+			require.ErrorContains(t, err, "not valid at the verification time")
+		})
+	}
+}
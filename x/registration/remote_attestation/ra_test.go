@@ -15,6 +15,18 @@ func Test_ValidateCertificateHwMode(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ValidateCertificateHwModeReport(t *testing.T) {
+	cert, err := os.ReadFile("../testdata/attestation_cert_hw_v2")
+	require.NoError(t, err)
+	_ = os.Setenv("SGX_MODE", "HW")
+	pk, report, err := verifyRaCertReport(cert)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+	require.Equal(t, pk, report.ReportData[:32])
+	require.NotEqual(t, [32]byte{}, report.MrEnclave)
+	require.NotEqual(t, [32]byte{}, report.MrSigner)
+}
+
 func Test_ValidateCertificateSwMode(t *testing.T) {
 	cert, err := os.ReadFile("../testdata/attestation_cert_sw")
 	require.NoError(t, err)
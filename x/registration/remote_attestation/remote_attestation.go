@@ -27,52 +27,108 @@ type DcapQuote struct {
 	M_SigLen  uint32
 }
 
+// VerifyCombinedCert verifies the combined certificate without a clock, for stateless checks such as ValidateBasic.
+// DCAP collateral is verified at its own issue date, which only checks that the quote and the collateral match, so
+// registrations must check the certificate with VerifyCombinedCertReport at the block time
 func VerifyCombinedCert(blob []byte) ([]byte, error) {
+	pk, _, err := VerifyCombinedCertReport(blob, time.Time{})
+	return pk, err
+}
+
+// VerifyCombinedCertReport verifies the combined certificate, and also returns the report body of the attested enclave,
+// so the caller can check its measurements. The report body is nil in software mode, where nothing is attested.
+// DCAP certificates, CRLs and collateral must be valid at now, or at the issue date of the collateral if now is zero
+func VerifyCombinedCertReport(blob []byte, now time.Time) ([]byte, *SgxReportBody, error) {
+	epidCert, dcapQuote, dcapCollateral, err := splitCombinedCert(blob)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(epidCert) > 0 {
+		ret_pk, ret_report, ret_err := verifyRaCertReport(epidCert)
+		if ret_pk != nil {
+			fmt.Println("EPID quote Extracted pk: ", hex.EncodeToString(ret_pk))
+		}
+		return ret_pk, ret_report, ret_err
+	}
+
+	if len(dcapQuote) > 0 {
+		if !isSgxHardwareMode() {
+			var quote DcapQuote
+
+			buf := bytes.NewReader(dcapQuote)
+			err := binary.Read(buf, binary.LittleEndian, &quote)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			fmt.Println("DCAP quote Extracted pk: ", hex.EncodeToString(quote.M_PubKey[:]))
+			return quote.M_PubKey[:], nil, nil
+		}
+
+		res, err := verifyCombinedDcapQuote(dcapQuote, dcapCollateral, intelSgxRootCA(), now)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("DCAP verification failed: %v", err)
+		}
+
+		pk := res.ReportBody.ReportData[:32]
+		fmt.Println("DCAP quote Extracted pk: ", hex.EncodeToString(pk))
+		return pk, &res.ReportBody, nil
+	}
+
+	return nil, nil, errors.New("No valid attestatoin found")
+}
+
+// splitCombinedCert splits a combined certificate into its EPID certificate, DCAP quote and DCAP collateral. Parts that
+// the node did not produce are empty
+func splitCombinedCert(blob []byte) ([]byte, []byte, []byte, error) {
 	var hdr CombinedHdr
 
 	if uintptr(len(blob)) < unsafe.Sizeof(hdr) {
-		return nil, errors.New("Combined hdr too small")
+		return nil, nil, nil, errors.New("Combined hdr too small")
 	}
 
 	{
 		buf := bytes.NewReader(blob)
 		err := binary.Read(buf, binary.LittleEndian, &hdr)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 	}
 
-	idx0 := unsafe.Sizeof(hdr)
-	idx1 := idx0 + uintptr(hdr.M_CombinedSizes[0])
-	idx2 := idx1 + uintptr(hdr.M_CombinedSizes[1])
-	idx3 := idx2 + uintptr(hdr.M_CombinedSizes[2])
+	idx0 := uint64(unsafe.Sizeof(hdr))
+	idx1 := idx0 + uint64(hdr.M_CombinedSizes[0])
+	idx2 := idx1 + uint64(hdr.M_CombinedSizes[1])
+	idx3 := idx2 + uint64(hdr.M_CombinedSizes[2])
 
-	if uintptr(len(blob)) < idx3 {
-		return nil, errors.New("combined hdr invalid")
+	if uint64(len(blob)) < idx3 {
+		return nil, nil, nil, errors.New("combined hdr invalid")
 	}
 
-	if idx1 > idx0 {
-		ret_pk, ret_err := VerifyRaCert(blob[idx0:idx1])
-		if ret_pk != nil {
-			fmt.Println("EPID quote Extracted pk: ", hex.EncodeToString(ret_pk))
-		}
-		return ret_pk, ret_err
-	}
-
-	if idx2 > idx1 {
-		var quote DcapQuote
+	return blob[idx0:idx1], blob[idx1:idx2], blob[idx2:idx3], nil
+}
 
-		buf := bytes.NewReader(blob[idx1:idx2])
-		err := binary.Read(buf, binary.LittleEndian, &quote)
-		if err != nil {
-			return nil, err
-		}
+// verifyCombinedDcapQuote verifies the DCAP quote of a combined certificate against the collateral that comes with it,
+// at now. The collateral is supplied by the node, so only a zero now falls back to the issue date of its TCB info
+func verifyCombinedDcapQuote(quote []byte, rawCollateral []byte, root *x509.Certificate, now time.Time) (*DcapVerificationResult, error) {
+	collateral, err := ParseDcapCollateral(rawCollateral)
+	if err != nil {
+		return nil, err
+	}
+	if !now.IsZero() {
+		return verifyDcapQuote(quote, collateral, root, now)
+	}
 
-		fmt.Println("DCAP quote Extracted pk: ", hex.EncodeToString(quote.M_PubKey[:]))
-		return quote.M_PubKey[:], nil
+	var tcbInfo struct {
+		TcbInfo struct {
+			IssueDate time.Time `json:"issueDate"`
+		} `json:"tcbInfo"`
+	}
+	if err := json.Unmarshal(collateral.TcbInfo, &tcbInfo); err != nil {
+		return nil, xerrors.Errorf("TCB info: %v", err)
 	}
 
-	return nil, errors.New("No valid attestatoin found")
+	return verifyDcapQuote(quote, collateral, root, tcbInfo.TcbInfo.IssueDate)
 }
 
 /*
@@ -86,36 +142,69 @@ func VerifyCombinedCert(blob []byte) ([]byte, error) {
 	 In software mode this will just return the raw netscape comment, as it is the public key of the signer
 */
 func VerifyRaCert(rawCert []byte) ([]byte, error) {
+	pk, _, err := verifyRaCertReport(rawCert)
+	return pk, err
+}
+
+func verifyRaCertReport(rawCert []byte) ([]byte, *SgxReportBody, error) {
 	// printCert(rawCert)
 	// get the pubkey and payload from raw data
 
 	pubK, payload, err := unmarshalCert(rawCert)
 	if err != nil {
-		return nil, xerrors.Errorf("Unmarshal certificate failed: %v", err)
+		return nil, nil, xerrors.Errorf("Unmarshal certificate failed: %v", err)
 	}
 
 	if !isSgxHardwareMode() {
 		pk, err := base64.StdEncoding.DecodeString(string(payload))
 		if err != nil {
-			return nil, xerrors.Errorf("Decode certificate failed: %v", err)
+			return nil, nil, xerrors.Errorf("Decode certificate failed: %v", err)
 		}
 
-		return pk, nil
+		return pk, nil, nil
 	}
 
 	// Load Intel CA, Verify Cert and Signature
 	attnReportRaw, err := verifyCert(payload)
 	if err != nil {
-		return nil, xerrors.Errorf("Intel verification failed: %v", err)
+		return nil, nil, xerrors.Errorf("Intel verification failed: %v", err)
 	}
 
 	// Verify attestation report
 	pubK, err = verifyAttReport(attnReportRaw, pubK)
 	if err != nil {
-		return nil, xerrors.Errorf("Attestation report failed: %v", err)
+		return nil, nil, xerrors.Errorf("Attestation report failed: %v", err)
 	}
+
+	report, err := epidReportBody(attnReportRaw)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("Attestation report failed: %v", err)
+	}
+
 	// verifyAttReport returns all the report_data field, which is 64 bytes - we just want the first 32 of them (rest are 0)
-	return pubK[0:32], nil
+	return pubK[0:32], report, nil
+}
+
+// epidReportBody extracts the enclave report body from the quote of an (already verified) EPID attestation report
+func epidReportBody(attnReportRaw []byte) (*SgxReportBody, error) {
+	var qr QuoteReport
+	if err := json.Unmarshal(attnReportRaw, &qr); err != nil {
+		return nil, err
+	}
+
+	qb, err := base64.StdEncoding.DecodeString(qr.IsvEnclaveQuoteBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(qb) < dcapQuoteHeaderSize+sgxReportBodySize {
+		return nil, errors.New("quote body too short")
+	}
+
+	report, err := parseReportBody(qb[dcapQuoteHeaderSize : dcapQuoteHeaderSize+sgxReportBodySize])
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
 }
 
 // UNSAFE_VerifyRaCert This function is a variant that should be used in the CLI - since parsing certificates is different in
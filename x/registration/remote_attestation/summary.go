@@ -0,0 +1,145 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+	"unsafe"
+
+	"github.com/pkg/errors"
+)
+
+// Attestation types of an AttestationSummary
+const (
+	AttestationTypeSoftware = "software"
+	AttestationTypeEpid     = "epid"
+	AttestationTypeDcap     = "dcap"
+)
+
+// AttestationSummary is what a certificate tells about the attested enclave and its platform
+type AttestationSummary struct {
+	Type   string
+	PubKey []byte
+	// Report is the report body of the attested enclave, nil for software mode certificates
+	Report      *SgxReportBody
+	TcbStatus   string
+	AdvisoryIDs []string
+	// PlatformInfo is the platform info blob of EPID reports, or the FMSPC of DCAP quotes
+	PlatformInfo string
+	// Timestamp is when Intel's attestation service issued an EPID report. DCAP quotes are not timestamped, so for them
+	// it is when Intel issued the TCB info they were verified with, and zero if they did not verify
+	Timestamp time.Time
+}
+
+// epidTimestampLayout is the layout of the timestamps of EPID reports, which are in UTC
+const epidTimestampLayout = "2006-01-02T15:04:05.999999"
+
+// ParseAttestation summarizes a combined certificate, or a legacy (EPID or software mode) certificate. Legacy
+// certificates of both the current format and of the "report|signature|signing cert" format of early nodes are
+// supported. It does not
+// verify Intel's signatures on EPID reports, so it is meant for certificates that were already verified, or for
+// inspecting them.
+// The TCB status of a DCAP quote comes from matching its platform against the collateral, so it is only filled in when
+// the collateral verifies at its own issue date
+func ParseAttestation(cert []byte) (*AttestationSummary, error) {
+	epidCert, dcapQuote, dcapCollateral, err := splitCombinedCert(cert)
+	if err != nil {
+		// not a combined certificate
+		return parseRaCert(cert)
+	}
+
+	if len(epidCert) > 0 {
+		return parseRaCert(epidCert)
+	}
+
+	if len(dcapQuote) > 0 {
+		return parseDcapAttestation(dcapQuote, dcapCollateral, intelSgxRootCA())
+	}
+
+	return nil, errors.New("No valid attestation found")
+}
+
+func parseRaCert(rawCert []byte) (*AttestationSummary, error) {
+	_, payload, err := unmarshalCert(rawCert)
+	if err != nil {
+		return nil, err
+	}
+
+	var attnReportRaw []byte
+	var signedReport EndorsedAttestationReport
+	if err := json.Unmarshal(payload, &signedReport); err == nil {
+		attnReportRaw = signedReport.Report
+	} else if parts := bytes.Split(payload, []byte("|")); len(parts) == 3 && bytes.HasPrefix(parts[0], []byte("{")) {
+		attnReportRaw = parts[0]
+	} else {
+		// software mode certificates hold the raw public key instead of a report
+		pk, err := base64.StdEncoding.DecodeString(string(payload))
+		if err != nil {
+			return nil, err
+		}
+
+		return &AttestationSummary{Type: AttestationTypeSoftware, PubKey: pk}, nil
+	}
+
+	var qr QuoteReport
+	if err := json.Unmarshal(attnReportRaw, &qr); err != nil {
+		return nil, err
+	}
+
+	report, err := epidReportBody(attnReportRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &AttestationSummary{
+		Type:         AttestationTypeEpid,
+		PubKey:       report.ReportData[:32],
+		Report:       report,
+		TcbStatus:    qr.IsvEnclaveQuoteStatus,
+		AdvisoryIDs:  qr.AdvisoryIDs,
+		PlatformInfo: qr.PlatformInfoBlob,
+	}
+
+	if qr.Timestamp != "" {
+		summary.Timestamp, err = time.Parse(epidTimestampLayout, qr.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return summary, nil
+}
+
+func parseDcapAttestation(rawQuote []byte, rawCollateral []byte, root *x509.Certificate) (*AttestationSummary, error) {
+	quote, err := ParseDcapQuote(rawQuote)
+	if err != nil {
+		// software mode nodes produce a bare quote that only carries the public key
+		var swQuote DcapQuote
+		if uintptr(len(rawQuote)) < unsafe.Sizeof(swQuote) {
+			return nil, err
+		}
+		if err := binary.Read(bytes.NewReader(rawQuote), binary.LittleEndian, &swQuote); err != nil {
+			return nil, err
+		}
+
+		return &AttestationSummary{Type: AttestationTypeSoftware, PubKey: swQuote.M_PubKey[:]}, nil
+	}
+
+	summary := &AttestationSummary{
+		Type:   AttestationTypeDcap,
+		PubKey: quote.ReportBody.ReportData[:32],
+		Report: &quote.ReportBody,
+	}
+
+	if res, err := verifyCombinedDcapQuote(rawQuote, rawCollateral, root, time.Time{}); err == nil {
+		summary.TcbStatus = res.TcbStatus
+		summary.AdvisoryIDs = res.AdvisoryIDs
+		summary.PlatformInfo = res.Fmspc
+		summary.Timestamp = res.CollateralIssueDate
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,120 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func combinedCert(t *testing.T, epidCert []byte, dcapQuote []byte, dcapCollateral []byte) []byte {
+	var buf bytes.Buffer
+	hdr := CombinedHdr{M_CombinedSizes: [3]uint32{uint32(len(epidCert)), uint32(len(dcapQuote)), uint32(len(dcapCollateral))}}
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &hdr))
+	buf.Write(epidCert)
+	buf.Write(dcapQuote)
+	buf.Write(dcapCollateral)
+	return buf.Bytes()
+}
+
+func Test_ParseAttestationEpid(t *testing.T) {
+	cert, err := os.ReadFile("../testdata/attestation_cert_hw_v2")
+	require.NoError(t, err)
+
+	for _, c := range [][]byte{cert, combinedCert(t, cert, nil, nil)} {
+		summary, err := ParseAttestation(c)
+		require.NoError(t, err)
+		require.Equal(t, AttestationTypeEpid, summary.Type)
+		require.NotNil(t, summary.Report)
+		require.Equal(t, summary.Report.ReportData[:32], summary.PubKey)
+		require.NotEmpty(t, summary.TcbStatus)
+		require.False(t, summary.Timestamp.IsZero())
+	}
+}
+
+func Test_ParseAttestationCorpus(t *testing.T) {
+	// an EPID certificate of an early node, in the "report|signature|signing cert" format
+	cert, err := os.ReadFile("corpus/0")
+	require.NoError(t, err)
+
+	summary, err := ParseAttestation(cert)
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeEpid, summary.Type)
+	require.Equal(t, "SW_HARDENING_NEEDED", summary.TcbStatus)
+	require.Equal(t, []string{"INTEL-SA-00334"}, summary.AdvisoryIDs)
+	require.Equal(t, time.Date(2020, 5, 9, 10, 10, 11, 895730000, time.UTC), summary.Timestamp)
+	require.NotNil(t, summary.Report)
+	require.Equal(t, "83d719e77deaca1470f6baf62a4d774303c899db69020f9c70ee1dfc08c7ce9e", hex.EncodeToString(summary.Report.MrSigner[:]))
+	require.Equal(t, summary.Report.ReportData[:32], summary.PubKey)
+
+	// a software mode certificate
+	cert, err = os.ReadFile("corpus/1")
+	require.NoError(t, err)
+
+	summary, err = ParseAttestation(cert)
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeSoftware, summary.Type)
+	require.Nil(t, summary.Report)
+
+	// a corrupted certificate
+	cert, err = os.ReadFile("corpus/2")
+	require.NoError(t, err)
+
+	_, err = ParseAttestation(cert)
+	require.Error(t, err)
+}
+
+func Test_ParseAttestationSoftware(t *testing.T) {
+	for _, name := range []string{"attestation_cert_sw", "attestation_cert_sw.combined"} {
+		cert, err := os.ReadFile("../testdata/" + name)
+		require.NoError(t, err)
+
+		summary, err := ParseAttestation(cert)
+		require.NoError(t, err, name)
+		require.Equal(t, AttestationTypeSoftware, summary.Type, name)
+		require.Nil(t, summary.Report, name)
+		require.NotEmpty(t, summary.PubKey, name)
+	}
+}
+
+func Test_ParseAttestationDcap(t *testing.T) {
+	quote := readDcapFixture(t, "quote_v3.bin")
+	collateral := readDcapFixture(t, "collateral.bin")
+
+	summary, err := parseDcapAttestation(quote, collateral, dcapFixtureRoot(t))
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeDcap, summary.Type)
+	require.Equal(t, TcbStatusUpToDate, summary.TcbStatus)
+	require.Equal(t, "00906ED50000", summary.PlatformInfo)
+	require.Equal(t, summary.Report.ReportData[:32], summary.PubKey)
+	// DCAP quotes are as old as the TCB info they verify with
+	require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), summary.Timestamp)
+
+	// the fixtures are not signed by Intel, so the TCB status is unknown
+	summary, err = ParseAttestation(combinedCert(t, nil, quote, collateral))
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeDcap, summary.Type)
+	require.NotNil(t, summary.Report)
+	require.Empty(t, summary.TcbStatus)
+	require.True(t, summary.Timestamp.IsZero())
+
+	// the recorded Intel vector is
+	summary, err = ParseAttestation(readDcapFixture(t, "intel/attestation_cert.combined"))
+	require.NoError(t, err)
+	require.Equal(t, TcbStatusSWHardeningNeeded, summary.TcbStatus)
+	require.Equal(t, []string{"INTEL-SA-00615", "INTEL-SA-00657"}, summary.AdvisoryIDs)
+	require.Equal(t, "00606A000000", summary.PlatformInfo)
+	require.Equal(t, time.Date(2022, 12, 19, 9, 40, 10, 0, time.UTC), summary.Timestamp)
+}
+
+func Test_ParseAttestationInvalid(t *testing.T) {
+	_, err := ParseAttestation([]byte("Here is a string...."))
+	require.Error(t, err)
+
+	_, err = ParseAttestation(combinedCert(t, nil, nil, nil))
+	require.Error(t, err)
+}
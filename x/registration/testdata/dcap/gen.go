@@ -0,0 +1,315 @@
+//go:build ignore
+
+// gen.go records the DCAP fixtures used by the remote_attestation tests.
+// The PKI is a test one, rooted in root_ca.pem instead of the Intel SGX root CA.
+//
+//	go run gen.go
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+)
+
+var (
+	notBefore = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter  = time.Date(2033, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	issueDate  = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	nextUpdate = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	fmspc = []byte{0x00, 0x90, 0x6e, 0xd5, 0x00, 0x00}
+	pceID = []byte{0x00, 0x00}
+
+	qeMrSigner = bytes.Repeat([]byte{0x8c}, 32)
+	qeVendorID = []byte{0x93, 0x9a, 0x72, 0x33, 0xf7, 0x9c, 0x4c, 0xa9, 0x94, 0x0a, 0x0d, 0xb3, 0x95, 0x7f, 0x06, 0x07}
+
+	nodePubKey = sha256.Sum256([]byte("secret node"))
+	serial     = int64(1)
+)
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newKey() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	must(err)
+	return key
+}
+
+func newCert(cn string, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool, exts []pkix.Extension) *x509.Certificate {
+	serial++
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: cn, Organization: []string{"Test"}},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		ExtraExtensions:       exts,
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	} else {
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	must(err)
+	cert, err := x509.ParseCertificate(der)
+	must(err)
+	return cert
+}
+
+func pemCerts(certs ...*x509.Certificate) []byte {
+	var out []byte
+	for _, c := range certs {
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+	}
+	return out
+}
+
+func sign(key *ecdsa.PrivateKey, data []byte) []byte {
+	hash := sha256.Sum256(data)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	must(err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig
+}
+
+type ext struct {
+	ID    asn1.ObjectIdentifier
+	Value interface{}
+}
+
+func sgxExtension(svn uint8, pceSvn int) pkix.Extension {
+	base := asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1}
+	oid := func(ids ...int) asn1.ObjectIdentifier {
+		return append(append(asn1.ObjectIdentifier{}, base...), ids...)
+	}
+
+	var tcb []ext
+	for i := 1; i <= 16; i++ {
+		tcb = append(tcb, ext{oid(2, i), int(svn)})
+	}
+	tcb = append(tcb, ext{oid(2, 17), pceSvn})
+	tcb = append(tcb, ext{oid(2, 18), bytes.Repeat([]byte{svn}, 16)})
+
+	value, err := asn1.Marshal([]ext{
+		{oid(1), bytes.Repeat([]byte{0x42}, 16)},
+		{oid(2), tcb},
+		{oid(3), pceID},
+		{oid(4), fmspc},
+		{oid(5), asn1.Enumerated(0)},
+	})
+	must(err)
+	return pkix.Extension{Id: base, Value: value}
+}
+
+func reportBody(mrSigner []byte, isvProdID uint16, isvSvn uint16, reportData []byte) []byte {
+	body := make([]byte, 384)
+	binary.LittleEndian.PutUint32(body[16:], 0)              // miscselect
+	copy(body[48:], []byte{0x11, 0, 0, 0, 0, 0, 0, 0, 0x07}) // attributes
+	copy(body[64:], bytes.Repeat([]byte{0xe1}, 32))          // mrenclave
+	copy(body[128:], mrSigner)
+	binary.LittleEndian.PutUint16(body[256:], isvProdID)
+	binary.LittleEndian.PutUint16(body[258:], isvSvn)
+	copy(body[320:], reportData)
+	return body
+}
+
+func quote(version uint16, pckChain []byte, pckKey *ecdsa.PrivateKey) []byte {
+	attestationKey := newKey()
+	rawAttestationKey := make([]byte, 64)
+	attestationKey.X.FillBytes(rawAttestationKey[:32])
+	attestationKey.Y.FillBytes(rawAttestationKey[32:])
+	authData := bytes.Repeat([]byte{0xaa}, 32)
+
+	binding := sha256.Sum256(append(append([]byte{}, rawAttestationKey...), authData...))
+	qeReportData := make([]byte, 64)
+	copy(qeReportData, binding[:])
+	qeReport := reportBody(qeMrSigner, 1, 8, qeReportData)
+
+	header := make([]byte, 48)
+	binary.LittleEndian.PutUint16(header[0:], version)
+	binary.LittleEndian.PutUint16(header[2:], 2)
+	binary.LittleEndian.PutUint16(header[8:], 8)
+	binary.LittleEndian.PutUint16(header[10:], 11)
+	copy(header[12:], qeVendorID)
+
+	reportData := make([]byte, 64)
+	copy(reportData, nodePubKey[:])
+	body := reportBody(bytes.Repeat([]byte{0x5e}, 32), 0, 1, reportData)
+
+	signed := append(append([]byte{}, header...), body...)
+
+	var certData bytes.Buffer
+	certData.Write(qeReport)
+	certData.Write(sign(pckKey, qeReport))
+	_ = binary.Write(&certData, binary.LittleEndian, uint16(len(authData)))
+	certData.Write(authData)
+	_ = binary.Write(&certData, binary.LittleEndian, uint16(5))
+	_ = binary.Write(&certData, binary.LittleEndian, uint32(len(pckChain)))
+	certData.Write(pckChain)
+
+	var sigData bytes.Buffer
+	sigData.Write(sign(attestationKey, signed))
+	sigData.Write(rawAttestationKey)
+	if version == 4 {
+		_ = binary.Write(&sigData, binary.LittleEndian, uint16(6))
+		_ = binary.Write(&sigData, binary.LittleEndian, uint32(certData.Len()))
+	}
+	sigData.Write(certData.Bytes())
+
+	var out bytes.Buffer
+	out.Write(signed)
+	_ = binary.Write(&out, binary.LittleEndian, uint32(sigData.Len()))
+	out.Write(sigData.Bytes())
+	return out.Bytes()
+}
+
+func signedDocument(name string, body interface{}, key *ecdsa.PrivateKey) []byte {
+	raw, err := json.Marshal(body)
+	must(err)
+	return []byte(fmt.Sprintf(`{"%s":%s,"signature":"%s"}`, name, raw, hex.EncodeToString(sign(key, raw))))
+}
+
+func tcbLevel(svn uint8, pceSvn int, status string, advisories []string) map[string]interface{} {
+	var comps []map[string]interface{}
+	for i := 0; i < 16; i++ {
+		comps = append(comps, map[string]interface{}{"svn": svn})
+	}
+	level := map[string]interface{}{
+		"tcb":       map[string]interface{}{"sgxtcbcomponents": comps, "pcesvn": pceSvn},
+		"tcbDate":   "2024-03-13T00:00:00Z",
+		"tcbStatus": status,
+	}
+	if advisories != nil {
+		level["advisoryIDs"] = advisories
+	}
+	return level
+}
+
+func crl(issuer *x509.Certificate, key *ecdsa.PrivateKey, revoked ...*x509.Certificate) []byte {
+	var entries []x509.RevocationListEntry
+	for _, c := range revoked {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: c.SerialNumber, RevocationTime: notBefore})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                notBefore,
+		NextUpdate:                notAfter,
+		RevokedCertificateEntries: entries,
+	}, issuer, key)
+	must(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func collateral(parts ...[]byte) []byte {
+	var out bytes.Buffer
+	_ = binary.Write(&out, binary.LittleEndian, uint32(0))
+	for _, p := range parts {
+		_ = binary.Write(&out, binary.LittleEndian, uint32(len(p)+1))
+	}
+	for _, p := range parts {
+		out.Write(p)
+		out.WriteByte(0)
+	}
+	return out.Bytes()
+}
+
+func write(name string, data []byte) {
+	must(os.WriteFile(name, data, 0o644))
+}
+
+func main() {
+	rootKey := newKey()
+	root := newCert("Test SGX Root CA", rootKey, nil, nil, true, nil)
+	platformKey := newKey()
+	platform := newCert("Test SGX PCK Platform CA", platformKey, root, rootKey, true, nil)
+	tcbSigningKey := newKey()
+	tcbSigning := newCert("Test SGX TCB Signing", tcbSigningKey, root, rootKey, false, nil)
+
+	pckKey := newKey()
+	pck := newCert("Test SGX PCK Certificate", pckKey, platform, platformKey, false, []pkix.Extension{sgxExtension(5, 11)})
+	oldPckKey := newKey()
+	oldPck := newCert("Test SGX PCK Certificate", oldPckKey, platform, platformKey, false, []pkix.Extension{sgxExtension(3, 11)})
+
+	tcbInfo := signedDocument("tcbInfo", map[string]interface{}{
+		"id":                      "SGX",
+		"version":                 3,
+		"issueDate":               issueDate,
+		"nextUpdate":              nextUpdate,
+		"fmspc":                   hex.EncodeToString(fmspc),
+		"pceId":                   hex.EncodeToString(pceID),
+		"tcbType":                 0,
+		"tcbEvaluationDataNumber": 16,
+		"tcbLevels": []interface{}{
+			tcbLevel(5, 11, "UpToDate", nil),
+			tcbLevel(3, 11, "SWHardeningNeeded", []string{"INTEL-SA-00615"}),
+			tcbLevel(1, 5, "OutOfDate", []string{"INTEL-SA-00615", "INTEL-SA-00657"}),
+		},
+	}, tcbSigningKey)
+
+	qeIdentity := signedDocument("enclaveIdentity", map[string]interface{}{
+		"id":                      "QE",
+		"version":                 2,
+		"issueDate":               issueDate,
+		"nextUpdate":              nextUpdate,
+		"tcbEvaluationDataNumber": 16,
+		"miscselect":              "00000000",
+		"miscselectMask":          "FFFFFFFF",
+		"attributes":              "11000000000000000000000000000000",
+		"attributesMask":          "FBFFFFFFFFFFFFFF0000000000000000",
+		"mrsigner":                hex.EncodeToString(qeMrSigner),
+		"isvprodid":               1,
+		"tcbLevels": []interface{}{
+			map[string]interface{}{"tcb": map[string]interface{}{"isvsvn": 8}, "tcbDate": "2024-03-13T00:00:00Z", "tcbStatus": "UpToDate"},
+			map[string]interface{}{"tcb": map[string]interface{}{"isvsvn": 6}, "tcbDate": "2023-08-09T00:00:00Z", "tcbStatus": "OutOfDate"},
+		},
+	}, tcbSigningKey)
+
+	write("root_ca.pem", pemCerts(root))
+	write("quote_v3.bin", quote(3, pemCerts(pck, platform, root), pckKey))
+	write("quote_v4.bin", quote(4, pemCerts(pck, platform, root), pckKey))
+	write("quote_v3_sw_hardening.bin", quote(3, pemCerts(oldPck, platform, root), oldPckKey))
+	write("collateral.bin", collateral(
+		pemCerts(platform, root),
+		crl(root, rootKey),
+		crl(platform, platformKey),
+		pemCerts(tcbSigning, root),
+		tcbInfo,
+		pemCerts(tcbSigning, root),
+		qeIdentity,
+	))
+	write("collateral_pck_revoked.bin", collateral(
+		pemCerts(platform, root),
+		crl(root, rootKey),
+		crl(platform, platformKey, pck),
+		pemCerts(tcbSigning, root),
+		tcbInfo,
+		pemCerts(tcbSigning, root),
+		qeIdentity,
+	))
+}